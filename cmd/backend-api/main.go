@@ -8,8 +8,10 @@ import (
 	"syscall"
 
 	"github.com/apahim/cls-backend/internal/api"
+	"github.com/apahim/cls-backend/internal/auth"
 	"github.com/apahim/cls-backend/internal/config"
 	"github.com/apahim/cls-backend/internal/database"
+	"github.com/apahim/cls-backend/internal/middleware"
 	"github.com/apahim/cls-backend/internal/pubsub"
 	"github.com/apahim/cls-backend/internal/reconciliation"
 	"github.com/apahim/cls-backend/internal/utils"
@@ -40,37 +42,94 @@ func main() {
 		zap.String("environment", cfg.Server.Environment),
 	)
 
+	// Build the authenticator before connecting to anything so a bad key fails fast
+	var authenticator auth.Authenticator
+	if cfg.Auth.Enabled {
+		authenticator, err = middleware.NewAuthenticator(cfg.Auth)
+		if err != nil {
+			logger.Fatal("Failed to initialize authenticator", zap.Error(err))
+		}
+		logger.Info("Authentication enabled", zap.String("provider", cfg.Auth.Provider))
+	}
+
 	// Initialize database connection
 	repo, err := database.NewRepository(cfg.Database)
 	if err != nil {
 		logger.Fatal("Failed to initialize database", zap.Error(err))
 	}
 	defer repo.Close()
+	repo.Status.SetCollapseErrors(cfg.Aggregation.CollapseErrors)
+	repo.Status.SetStatusHistoryLimit(cfg.Aggregation.StatusHistoryLimit)
+	readiness := database.ReadinessConfig{
+		ControllerWeights:  cfg.Aggregation.ControllerWeights,
+		ReadyThreshold:     cfg.Aggregation.ReadyThreshold,
+		StalenessThreshold: cfg.Aggregation.StalenessThreshold,
+	}
+	repo.SetReadinessConfig(readiness)
+
+	// Pick the cluster status aggregation strategy of each configured platform
+	strategies := make(map[string]database.StatusStrategy, len(cfg.Aggregation.PlatformStrategies))
+	for platform, name := range cfg.Aggregation.PlatformStrategies {
+		strategy, err := database.NewStatusStrategy(name, readiness)
+		if err != nil {
+			logger.Fatal("Invalid status aggregation strategy", zap.String("platform", platform), zap.Error(err))
+		}
+		strategies[platform] = strategy
+	}
+	repo.SetStatusStrategies(strategies)
+
+	// Notify HTTP webhooks of cluster phase changes, if any are configured
+	if len(cfg.Webhooks.URLs) > 0 {
+		phaseNotifier := pubsub.NewPhaseWebhookNotifier(cfg.Webhooks)
+		defer phaseNotifier.Wait()
+		repo.SetPhaseTransitionNotifier(phaseNotifier)
+		logger.Info("Phase webhooks enabled", zap.Int("webhooks", len(cfg.Webhooks.URLs)))
+	}
 
-	// Initialize Pub/Sub service (publisher-only for fan-out architecture)
+	// Initialize Pub/Sub service (publisher-only for fan-out architecture). Webhook delivery
+	// doesn't need Pub/Sub for reconcile events, so there a failure only disables lifecycle events.
+	pubsubRequired := cfg.Reconciliation.Delivery != config.DeliveryWebhook
 	pubsubService, err := pubsub.NewService(cfg.PubSub)
 	if err != nil {
-		logger.Fatal("Failed to initialize Pub/Sub service", zap.Error(err))
+		if pubsubRequired {
+			logger.Fatal("Failed to initialize Pub/Sub service", zap.Error(err))
+		}
+		logger.Warn("Failed to initialize Pub/Sub service; lifecycle events will not be published", zap.Error(err))
+		pubsubService = nil
+	} else {
+		defer pubsubService.Stop()
+
+		// Start Pub/Sub service
+		if err := pubsubService.Start(); err != nil {
+			if pubsubRequired {
+				logger.Fatal("Failed to start Pub/Sub service", zap.Error(err))
+			}
+			logger.Warn("Failed to start Pub/Sub service; lifecycle events will not be published", zap.Error(err))
+		}
 	}
-	defer pubsubService.Stop()
 
-	// Start Pub/Sub service
-	if err := pubsubService.Start(); err != nil {
-		logger.Fatal("Failed to start Pub/Sub service", zap.Error(err))
+	// Select how reconcile events reach controllers (Pub/Sub by default)
+	reconcilePublisher := pubsub.NewReconcileEventPublisher(cfg.Reconciliation, pubsubService)
+	if cfg.Reconciliation.Delivery == config.DeliveryWebhook {
+		logger.Info("Delivering reconcile events via webhook",
+			zap.String("url", cfg.Reconciliation.Webhook.URL),
+		)
 	}
 
 	// Initialize and start reconciliation scheduler
-	scheduler := reconciliation.NewScheduler(repo, pubsubService.GetPublisher(), &cfg.Reconciliation)
+	scheduler := reconciliation.NewScheduler(repo, reconcilePublisher, &cfg.Reconciliation)
 
 	ctx := context.Background()
 	if err := scheduler.Start(ctx); err != nil {
 		logger.Fatal("Failed to start reconciliation scheduler", zap.Error(err))
 	}
-	defer scheduler.Stop()
 
 	// Initialize and start reactive reconciler (database change-driven reconciliation)
 	reactiveReconcilerConfig := reconciliation.DefaultReactiveReconciliationConfig()
-	reactiveReconciler := reconciliation.NewReactiveReconciler(repo, pubsubService.GetPublisher(), &cfg.Database, reactiveReconcilerConfig)
+	reactiveReconcilerConfig.Enabled = cfg.Reconciliation.ReactiveEnabled
+	reactiveReconcilerConfig.DebounceInterval = cfg.Reconciliation.ReactiveDebounce
+	reactiveReconcilerConfig.MaxEventsPerMinute = cfg.Reconciliation.ReactiveMaxEventsPerMinute
+	reactiveReconciler := reconciliation.NewReactiveReconciler(repo, reconcilePublisher, &cfg.Database, reactiveReconcilerConfig)
 
 	if err := reactiveReconciler.Start(ctx); err != nil {
 		logger.Warn("Failed to start reactive reconciler", zap.Error(err))
@@ -78,14 +137,26 @@ func main() {
 	} else {
 		logger.Info("Reactive reconciler started successfully")
 	}
-	defer func() {
-		if err := reactiveReconciler.Stop(ctx); err != nil {
-			logger.Error("Error stopping reactive reconciler", zap.Error(err))
+
+	// Checked after both reconcilers start, since reactive reconciliation can be enabled in the database
+	reconciliationEnabled := func() bool {
+		return scheduler.IsRunning() || reactiveReconciler.IsEnabled()
+	}
+	if !reconciliationEnabled() {
+		if cfg.Reconciliation.RequireReconciler {
+			logger.Fatal("Neither the reconciliation scheduler nor the reactive reconciler is running and RECONCILIATION_REQUIRE_RECONCILER is set")
 		}
-	}()
+		logger.Warn("Both the reconciliation scheduler and the reactive reconciler are disabled; clusters will not be reconciled")
+	}
 
 	// Initialize the simplified HTTP server
-	server := api.NewServer(cfg, repo, pubsubService)
+	server := api.NewServer(cfg, repo, pubsubService, authenticator, api.BuildInfo{
+		Version:   Version,
+		GitCommit: GitCommit,
+		BuildTime: BuildTime,
+	})
+	server.SetScheduler(scheduler)
+	server.SetReconciliationStatus(reconciliationEnabled)
 
 	// Start server with context
 	serverCtx, serverCancel := context.WithCancel(ctx)
@@ -106,10 +177,17 @@ func main() {
 
 	logger.Info("Shutting down server...")
 
-	// Graceful shutdown
+	// Graceful shutdown: drain in-flight requests first so active status updates complete
 	if err := server.Stop(); err != nil {
 		logger.Error("Server forced to shutdown", zap.Error(err))
 	}
 
+	// Stop the reconcilers before the deferred Pub/Sub and database shutdown so they
+	// never publish or query on a closed connection
+	if err := reactiveReconciler.Stop(ctx); err != nil {
+		logger.Error("Error stopping reactive reconciler", zap.Error(err))
+	}
+	scheduler.Stop()
+
 	logger.Info("Server exited")
 }
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/auth"
+	"github.com/apahim/cls-backend/internal/database"
+	"github.com/apahim/cls-backend/internal/middleware"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// AuditHandler exposes the audit trail of cluster and nodepool write operations
+type AuditHandler struct {
+	repository *database.Repository
+	logger     *zap.Logger
+}
+
+// NewAuditHandler creates a new audit handler
+func NewAuditHandler(repository *database.Repository) *AuditHandler {
+	return &AuditHandler{
+		repository: repository,
+		logger:     zap.L().Named("audit_handler"),
+	}
+}
+
+// RegisterRoutes registers audit log routes
+func (h *AuditHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/audit", h.requireAdmin, h.ListAuditEntries)
+}
+
+// requireAdmin rejects callers that are not allowed to read the audit log
+func (h *AuditHandler) requireAdmin(c *gin.Context) {
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	if !auth.CanViewAuditLog(userCtx) {
+		c.AbortWithStatusJSON(http.StatusForbidden, utils.NewAPIError(
+			utils.ErrCodeForbidden,
+			"Access denied",
+			"only system controllers can view the audit log",
+		))
+		return
+	}
+
+	c.Next()
+}
+
+// ListAuditEntries lists the audit entries for a cluster or nodepool, oldest first
+func (h *AuditHandler) ListAuditEntries(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	resourceID, err := uuid.Parse(c.Query("resource_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid resource ID",
+			"resource_id query parameter must be a valid UUID",
+		))
+		return
+	}
+
+	limit := 50
+	if limitStr := c.Query("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil || parsedLimit <= 0 || parsedLimit > 1000 {
+			c.JSON(http.StatusBadRequest, utils.NewAPIError(
+				utils.ErrCodeValidation,
+				"Invalid limit",
+				"limit must be between 1 and 1000",
+			))
+			return
+		}
+		limit = parsedLimit
+	}
+
+	entries, err := h.repository.Audit.ListByResource(ctx, resourceID, limit)
+	if err != nil {
+		h.logger.Error("Failed to list audit entries",
+			zap.String("resource_id", resourceID.String()),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to list audit entries",
+			err.Error(),
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"resource_id":   resourceID.String(),
+		"audit_entries": entries,
+		"limit":         limit,
+	})
+}
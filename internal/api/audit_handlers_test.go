@@ -0,0 +1,67 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/google/uuid"
+)
+
+func TestAuditHandler_AdminOnly(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	w := doRequest(router, http.MethodGet, "/api/v1/audit?resource_id="+uuid.New().String(), "user@example.com", "")
+	utils.AssertEqual(t, http.StatusForbidden, w.Code, "Regular users cannot read the audit log")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/audit?resource_id=not-a-uuid", "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid resource ID should be rejected")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/audit", "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Missing resource ID should be rejected")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/audit?resource_id="+uuid.New().String()+"&limit=0", "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid limit should be rejected")
+}
+
+func TestAuditHandler_ClusterUpdateRecordsDiff(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+
+	owner := "owner@example.com"
+	w := doCreateCluster(router, owner, "", `{"name":"audited-cluster","spec":{"platform":{"type":"AWS"},"release":{"version":"4.16.0","channelGroup":"stable"}}}`)
+	utils.AssertEqual(t, http.StatusCreated, w.Code, "Should create cluster")
+
+	var cluster models.Cluster
+	err := json.Unmarshal(w.Body.Bytes(), &cluster)
+	utils.AssertError(t, err, false, "Should decode created cluster")
+
+	w = doRequest(router, http.MethodPut, "/api/v1/clusters/"+cluster.ID.String(), owner,
+		`{"spec":{"platform":{"type":"AWS"},"release":{"version":"4.17.0","channelGroup":"stable"}}}`)
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Should update cluster")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/audit?resource_id="+cluster.ID.String(), "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Controller should read the audit log")
+
+	var response struct {
+		AuditEntries []models.AuditEntry `json:"audit_entries"`
+	}
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode audit response")
+	utils.AssertEqual(t, 2, len(response.AuditEntries), "Create and update should each be audited")
+
+	created := response.AuditEntries[0]
+	utils.AssertEqual(t, models.AuditActionCreate, created.Action, "First entry should be the create")
+	utils.AssertEqual(t, models.AuditResourceCluster, created.ResourceType, "Entry should be for a cluster")
+	utils.AssertEqual(t, owner, created.ActorEmail, "Entry should record the actor")
+
+	updated := response.AuditEntries[1]
+	utils.AssertEqual(t, models.AuditActionUpdate, updated.Action, "Second entry should be the update")
+	utils.AssertEqual(t, cluster.ID, updated.ResourceID, "Entry should be for the updated cluster")
+	utils.AssertEqual(t, owner, updated.ActorEmail, "Entry should record the actor")
+	utils.AssertEqual(t, 1, len(updated.Diff), "Only the changed field should be in the diff")
+	utils.AssertEqual(t, "4.16.0", updated.Diff["release.version"].Before, "Diff should keep the old version")
+	utils.AssertEqual(t, "4.17.0", updated.Diff["release.version"].After, "Diff should keep the new version")
+}
@@ -2,12 +2,17 @@ package api
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/apahim/cls-backend/internal/auth"
+	"github.com/apahim/cls-backend/internal/config"
 	"github.com/apahim/cls-backend/internal/database"
 	"github.com/apahim/cls-backend/internal/middleware"
 	"github.com/apahim/cls-backend/internal/models"
@@ -18,10 +23,23 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	// idempotencyKeyHeader lets clients retry POST /clusters without creating duplicates
+	idempotencyKeyHeader = "Idempotency-Key"
+	// idempotentReplayedHeader marks a create response returned for a repeated idempotency key
+	idempotentReplayedHeader = "Idempotent-Replayed"
+	// maxIdempotencyKeyLength matches the idempotency_keys column size
+	maxIdempotencyKeyLength = 255
+	// ifNoneMatchHeader lets polling clients skip unchanged GET /clusters/{id} responses
+	ifNoneMatchHeader = "If-None-Match"
+)
+
 // ClusterHandler handles cluster operations
 type ClusterHandler struct {
 	clusterService   *services.ClusterService
 	statusRepository *database.StatusRepository
+	actions          map[string]gin.HandlerFunc
+	maxBodyBytes     int64
 	logger           *zap.Logger
 }
 
@@ -30,281 +48,786 @@ func NewClusterHandler(clusterService *services.ClusterService, statusRepository
 	return &ClusterHandler{
 		clusterService:   clusterService,
 		statusRepository: statusRepository,
+		actions:          make(map[string]gin.HandlerFunc),
+		maxBodyBytes:     config.DefaultMaxRequestBodyBytes,
 		logger:           zap.L().Named("cluster_handler"),
 	}
 }
 
+// SetMaxBodyBytes sets the largest request body accepted by the create, update and status
+// report handlers
+func (h *ClusterHandler) SetMaxBodyBytes(limit int64) {
+	h.maxBodyBytes = limit
+}
+
+// log returns the handler's logger tagged with the request's ID
+func (h *ClusterHandler) log(c *gin.Context) *zap.Logger {
+	return utils.WithContextFields(h.logger, c.Request.Context())
+}
+
 // RegisterRoutes registers cluster routes
 func (h *ClusterHandler) RegisterRoutes(router *gin.RouterGroup) {
 	clusters := router.Group("/clusters")
 	{
 		clusters.GET("", h.ListClusters)
 		clusters.POST("", h.CreateCluster)
+		clusters.GET("/statuses", h.ListClusterStatuses)
+		clusters.GET("/by-release-image", h.ListClustersByReleaseImage)
 		clusters.GET("/:cluster_id", h.GetCluster)
 		clusters.PUT("/:cluster_id", h.UpdateCluster)
 		clusters.DELETE("/:cluster_id", h.DeleteCluster)
 		clusters.GET("/:cluster_id/status", h.GetClusterStatus)
 		clusters.PUT("/:cluster_id/status", h.UpdateClusterStatus)
+		clusters.GET("/:cluster_id/status/history", h.ListClusterStatusHistory)
+		clusters.DELETE("/:cluster_id/status/:controller_name", h.DeleteClusterControllerStatus)
+		clusters.GET("/:cluster_id/controllers", h.ListClusterControllers)
+		clusters.GET("/:cluster_id/transitions", h.ListClusterTransitions)
+		clusters.GET("/:cluster_id/events", h.ListClusterEvents)
+		clusters.GET("/:cluster_id/full", h.GetClusterFullView)
+		clusters.PUT("/:cluster_id/reconciliation", h.SetReconciliationInterval)
+		clusters.POST("/:cluster_id/reconcile", h.ReconcileCluster)
+		clusters.GET("/:cluster_id/grants", h.ListClusterGrants)
+		clusters.POST("/:cluster_id/grants", h.CreateClusterGrant)
+		clusters.DELETE("/:cluster_id/grants/:grantee_email", h.DeleteClusterGrant)
+		clusters.PUT("/:cluster_id/:action", h.dispatchClusterPutAction)
+
+		// Colon-style custom methods (e.g. "reconciliation:pause") can't be registered
+		// as static routes, so they are dispatched from a single sub-resource route
+		clusters.POST("/:cluster_id/:action", h.dispatchClusterAction)
+
+		// Custom methods on the cluster itself (e.g. "{id}:restore") arrive in the ID segment
+		clusters.POST("/:cluster_id", h.dispatchClusterMethod)
 	}
 }
 
-// ListClusters lists all clusters with pagination
-func (h *ClusterHandler) ListClusters(c *gin.Context) {
-	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
-	defer cancel()
+// AddClusterAction registers a POST custom method owned by another handler, e.g.
+// "nodepools:batch", on the shared cluster sub-resource route
+func (h *ClusterHandler) AddClusterAction(action string, handler gin.HandlerFunc) {
+	h.actions[action] = handler
+}
 
-	// Parse query parameters
-	limit := 50 // default
-	if limitStr := c.Query("limit"); limitStr != "" {
-		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 && parsedLimit <= 100 {
-			limit = parsedLimit
+// dispatchClusterAction routes cluster custom methods to their handlers
+func (h *ClusterHandler) dispatchClusterAction(c *gin.Context) {
+	action := c.Param("action")
+	switch action {
+	case "reconciliation:pause":
+		h.setReconciliationPaused(c, true)
+	case "reconciliation:resume":
+		h.setReconciliationPaused(c, false)
+	case "errors:clear":
+		h.ClearClusterErrors(c)
+	case "spec:diff":
+		h.DiffClusterSpec(c)
+	default:
+		if handler, ok := h.actions[action]; ok {
+			handler(c)
+			return
 		}
+		c.JSON(http.StatusNotFound, utils.NewAPIError(
+			utils.ErrCodeNotFound,
+			"Resource not found",
+			"",
+		))
 	}
+}
 
-	offset := 0
-	if offsetStr := c.Query("offset"); offsetStr != "" {
-		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
-			offset = parsedOffset
-		}
+// dispatchClusterPutAction routes PUT cluster custom methods to their handlers
+func (h *ClusterHandler) dispatchClusterPutAction(c *gin.Context) {
+	switch c.Param("action") {
+	case "status:batch":
+		h.BatchUpdateClusterStatus(c)
+	default:
+		c.JSON(http.StatusNotFound, utils.NewAPIError(
+			utils.ErrCodeNotFound,
+			"Resource not found",
+			"",
+		))
 	}
+}
 
-	// Check for created_by filter (for future authorization)
-	createdBy := c.Query("created_by")
+// dispatchClusterMethod routes "{cluster_id}:{method}" custom methods to their handlers
+func (h *ClusterHandler) dispatchClusterMethod(c *gin.Context) {
+	clusterIDStr, method, _ := strings.Cut(c.Param("cluster_id"), ":")
+	switch method {
+	case "restore":
+		h.RestoreCluster(c, clusterIDStr)
+	default:
+		c.JSON(http.StatusNotFound, utils.NewAPIError(
+			utils.ErrCodeNotFound,
+			"Resource not found",
+			"",
+		))
+	}
+}
+
+// RestoreCluster restores a cluster the caller soft-deleted within the retention window
+func (h *ClusterHandler) RestoreCluster(c *gin.Context, clusterIDStr string) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	clusterID, err := uuid.Parse(clusterIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid cluster ID format"})
+		return
+	}
 
 	// Get user context from middleware
 	userCtx, exists := middleware.GetUserContext(c)
 	if !exists {
-		h.logger.Error("No user context found")
+		h.log(c).Error("No user context found")
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
 		return
 	}
 
-	h.logger.Info("Listing clusters",
+	h.log(c).Info("Restoring cluster",
+		zap.String("cluster_id", clusterIDStr),
 		zap.String("user_email", userCtx.Email),
-		zap.Bool("is_controller", userCtx.IsController),
-		zap.Int("limit", limit),
-		zap.Int("offset", offset),
-		zap.String("created_by_filter", createdBy),
 	)
 
-	// Use access-level aware listing
-	var clusters []*models.Cluster
-	var total int64
-	var err error
-
-	if userCtx.IsController {
-		// Controllers get system-wide access
-		clusters, total, err = h.clusterService.ListAllClusters(ctx, limit, offset)
-	} else {
-		// Users get scoped access
-		clusters, total, err = h.clusterService.ListClusters(ctx, userCtx.Email, limit, offset)
-	}
-
+	cluster, err := h.clusterService.RestoreClusterWithAccessControl(ctx, clusterID, userCtx)
 	if err != nil {
-		h.logger.Error("Failed to list clusters", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list clusters"})
+		if errors.Is(err, models.ErrClusterNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "no deleted cluster found within the restore window"})
+			return
+		}
+
+		h.log(c).Error("Failed to restore cluster",
+			zap.String("cluster_id", clusterIDStr),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to restore cluster"})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"clusters": clusters,
-		"total":    total,
-		"limit":    limit,
-		"offset":   offset,
-	})
+	c.JSON(http.StatusOK, cluster)
 }
 
-// CreateCluster creates a new cluster
-func (h *ClusterHandler) CreateCluster(c *gin.Context) {
-	ctx, cancel := context.WithTimeout(c.Request.Context(), 60*time.Second)
+// setReconciliationPaused pauses or resumes periodic reconciliation for a cluster
+func (h *ClusterHandler) setReconciliationPaused(c *gin.Context, paused bool) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
 	defer cancel()
 
-	// Parse request body
-	var req models.ClusterCreateRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid request: %v", err)})
+	clusterIDStr := c.Param("cluster_id")
+	clusterID, err := uuid.Parse(clusterIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid cluster ID format",
+			err.Error(),
+		))
 		return
 	}
 
-	// Validate required fields
-	if req.Name == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "cluster name is required"})
+	// Get user context from middleware
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
 		return
 	}
 
-	// Validate infraID for GCP resource naming constraints
-	if err := req.ValidateGCPInfraID(); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := h.clusterService.SetReconciliationPausedWithAccessControl(ctx, clusterID, paused, userCtx); err != nil {
+		if err.Error() == "cluster not found" {
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"Cluster not found",
+				"",
+			))
+			return
+		}
+		h.log(c).Error("Failed to set cluster reconciliation pause state",
+			zap.String("cluster_id", clusterIDStr),
+			zap.Bool("paused", paused),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to update reconciliation state",
+			err.Error(),
+		))
 		return
 	}
 
-	// Apply defaults before validation
-	h.clusterService.ApplyDefaults(&req)
+	c.JSON(http.StatusOK, gin.H{
+		"cluster_id":            clusterIDStr,
+		"reconciliation_paused": paused,
+	})
+}
 
-	// Validate release spec (version, channelGroup required)
-	if err := req.ValidateRelease(); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+// ReconcileCluster publishes a reconcile event for the cluster immediately, bypassing the schedule
+func (h *ClusterHandler) ReconcileCluster(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	clusterIDStr := c.Param("cluster_id")
+	clusterID, err := uuid.Parse(clusterIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid cluster ID format",
+			err.Error(),
+		))
 		return
 	}
 
 	// Get user context from middleware
 	userCtx, exists := middleware.GetUserContext(c)
 	if !exists {
-		h.logger.Error("No user context found")
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
-		return
-	}
-
-	// Check if user can create clusters
-	if !auth.CanCreateCluster(userCtx) {
-		h.logger.Warn("User not authorized to create clusters",
-			zap.String("user_email", userCtx.Email),
-			zap.Bool("is_controller", userCtx.IsController),
-		)
-		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized to create clusters"})
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
 		return
 	}
 
-	h.logger.Info("Creating cluster",
-		zap.String("cluster_name", req.Name),
-		zap.String("user_email", userCtx.Email),
-		zap.Bool("is_controller", userCtx.IsController),
-	)
-
-	// Create cluster
-	cluster, err := h.clusterService.CreateCluster(ctx, &req, userCtx.Email)
+	event, err := h.clusterService.TriggerReconciliationWithAccessControl(ctx, clusterID, userCtx)
 	if err != nil {
-		h.logger.Error("Failed to create cluster",
-			zap.String("cluster_name", req.Name),
-			zap.String("user_email", userCtx.Email),
-			zap.Error(err),
-		)
-
-		// Convert database errors to appropriate API errors
-		apiErr := utils.ConvertDBError(err)
-		if apiErr.Code != "" {
-			c.JSON(apiErr.HTTPStatus(), gin.H{"error": apiErr})
-		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create cluster"})
+		switch {
+		case err.Error() == "cluster not found":
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"Cluster not found",
+				"",
+			))
+		case errors.Is(err, models.ErrReconcilePublisherUnavailable):
+			c.JSON(http.StatusServiceUnavailable, utils.NewAPIError(
+				utils.ErrCodeUnavailable,
+				"Reconciliation unavailable",
+				err.Error(),
+			))
+		default:
+			h.log(c).Error("Failed to trigger cluster reconciliation",
+				zap.String("cluster_id", clusterIDStr),
+				zap.Error(err),
+			)
+			c.JSON(http.StatusBadGateway, utils.NewAPIError(
+				utils.ErrCodeExternal,
+				"Failed to publish reconciliation event",
+				err.Error(),
+			))
 		}
 		return
 	}
 
-	c.JSON(http.StatusCreated, cluster)
+	c.JSON(http.StatusAccepted, gin.H{
+		"cluster_id": clusterIDStr,
+		"reason":     event.Reason,
+		"generation": event.Generation,
+		"timestamp":  event.Timestamp,
+	})
 }
 
-// GetCluster gets a specific cluster
-func (h *ClusterHandler) GetCluster(c *gin.Context) {
+// SetReconciliationInterval sets or clears the per-cluster reconciliation interval override
+func (h *ClusterHandler) SetReconciliationInterval(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
 	defer cancel()
 
-	// Extract cluster ID
 	clusterIDStr := c.Param("cluster_id")
-	if clusterIDStr == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "cluster ID is required"})
-		return
-	}
-
 	clusterID, err := uuid.Parse(clusterIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid cluster ID format"})
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid cluster ID format",
+			err.Error(),
+		))
 		return
 	}
 
 	// Get user context from middleware
 	userCtx, exists := middleware.GetUserContext(c)
 	if !exists {
-		h.logger.Error("No user context found")
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
 		return
 	}
 
-	h.logger.Info("Getting cluster",
-		zap.String("cluster_id", clusterIDStr),
-		zap.String("user_email", userCtx.Email),
-		zap.Bool("is_controller", userCtx.IsController),
-	)
+	var req models.ReconciliationIntervalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid reconciliation request format",
+			err.Error(),
+		))
+		return
+	}
 
-	// Get cluster with access control
-	cluster, err := h.clusterService.GetClusterWithAccessControl(ctx, clusterID, userCtx)
+	interval, err := req.ParseInterval()
 	if err != nil {
-		h.logger.Error("Failed to get cluster",
-			zap.String("cluster_id", clusterIDStr),
-			zap.Error(err),
-		)
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid reconciliation interval",
+			err.Error(),
+		))
+		return
+	}
 
+	if err := h.clusterService.SetReconciliationIntervalWithAccessControl(ctx, clusterID, interval, userCtx); err != nil {
 		if err.Error() == "cluster not found" {
-			c.JSON(http.StatusNotFound, gin.H{"error": "cluster not found"})
-		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get cluster"})
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"Cluster not found",
+				"",
+			))
+			return
 		}
+		h.log(c).Error("Failed to set cluster reconciliation interval",
+			zap.String("cluster_id", clusterIDStr),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to update reconciliation interval",
+			err.Error(),
+		))
 		return
 	}
 
-	c.JSON(http.StatusOK, cluster)
+	var intervalStr *string
+	if interval != nil {
+		str := interval.String()
+		intervalStr = &str
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"cluster_id":              clusterIDStr,
+		"reconciliation_interval": intervalStr,
+	})
 }
 
-// UpdateCluster updates a cluster
-func (h *ClusterHandler) UpdateCluster(c *gin.Context) {
-	ctx, cancel := context.WithTimeout(c.Request.Context(), 60*time.Second)
+// ListClusters lists all clusters with pagination
+func (h *ClusterHandler) ListClusters(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
 	defer cancel()
 
-	// Extract cluster ID
-	clusterIDStr := c.Param("cluster_id")
-	if clusterIDStr == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "cluster ID is required"})
+	opts, ok := parseClusterListOptions(c)
+	if !ok {
 		return
 	}
+	limit := opts.Limit
 
-	clusterID, err := uuid.Parse(clusterIDStr)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid cluster ID format"})
-		return
-	}
+	// Check for created_by filter (for future authorization)
+	createdBy := c.Query("created_by")
 
-	// Parse request body
-	var req models.ClusterUpdateRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid request: %v", err)})
-		return
-	}
+	// Soft-deleted clusters are only listed for controllers; the flag is ignored for users
+	includeDeleted := c.Query("include_deleted") == "true"
 
 	// Get user context from middleware
 	userCtx, exists := middleware.GetUserContext(c)
 	if !exists {
-		h.logger.Error("No user context found")
+		h.log(c).Error("No user context found")
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
 		return
 	}
 
-	h.logger.Info("Updating cluster",
-		zap.String("cluster_id", clusterIDStr),
+	h.log(c).Info("Listing clusters",
 		zap.String("user_email", userCtx.Email),
 		zap.Bool("is_controller", userCtx.IsController),
+		zap.Int("limit", limit),
+		zap.Int("offset", opts.Offset),
+		zap.Bool("cursor", opts.Cursor != nil),
+		zap.Strings("status_filter", opts.Status),
+		zap.String("created_by_filter", createdBy),
+		zap.Bool("include_deleted", includeDeleted),
 	)
 
-	// Update cluster with access control
-	cluster, err := h.clusterService.UpdateClusterWithAccessControl(ctx, clusterID, &req, userCtx)
+	// Use access-level aware listing
+	var clusters []*models.Cluster
+	var total int64
+	var err error
+
+	if userCtx.IsController && includeDeleted {
+		clusters, total, err = h.clusterService.ListAllClustersIncludingDeletedWithAccessControl(ctx, opts, userCtx)
+	} else if userCtx.IsController {
+		// Controllers get system-wide access
+		clusters, total, err = h.clusterService.ListAllClusters(ctx, opts)
+	} else {
+		// Users get scoped access
+		clusters, total, err = h.clusterService.ListClusters(ctx, userCtx.Email, opts)
+	}
+
 	if err != nil {
-		h.logger.Error("Failed to update cluster",
-			zap.String("cluster_id", clusterIDStr),
+		h.log(c).Error("Failed to list clusters", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list clusters"})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.ListClustersResponse{
+		Clusters:   clusters,
+		Total:      total,
+		Limit:      limit,
+		Offset:     opts.Offset,
+		NextCursor: models.NextClusterCursor(clusters, limit),
+		PageInfo:   models.NewClusterPageInfo(clusters, total, opts),
+	})
+}
+
+// ListClusterStatuses returns the compact aggregated status of every cluster visible to the
+// caller, so dashboards don't need one status call per cluster
+func (h *ClusterHandler) ListClusterStatuses(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	opts, ok := parseClusterListOptions(c)
+	if !ok {
+		return
+	}
+
+	// Get user context from middleware
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		return
+	}
+
+	h.log(c).Info("Listing cluster statuses",
+		zap.String("user_email", userCtx.Email),
+		zap.Bool("is_controller", userCtx.IsController),
+		zap.Int("limit", opts.Limit),
+		zap.Int("offset", opts.Offset),
+		zap.Bool("cursor", opts.Cursor != nil),
+		zap.Strings("status_filter", opts.Status),
+	)
+
+	// Listing enriches the whole page with aggregated status in one pass
+	var clusters []*models.Cluster
+	var total int64
+	var err error
+
+	if userCtx.IsController {
+		clusters, total, err = h.clusterService.ListAllClusters(ctx, opts)
+	} else {
+		clusters, total, err = h.clusterService.ListClusters(ctx, userCtx.Email, opts)
+	}
+
+	if err != nil {
+		h.log(c).Error("Failed to list clusters for statuses", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list cluster statuses"})
+		return
+	}
+
+	clusterIDs := make([]uuid.UUID, len(clusters))
+	for i, cluster := range clusters {
+		clusterIDs[i] = cluster.ID
+	}
+
+	counts, err := h.statusRepository.CountClusterControllers(ctx, clusterIDs)
+	if err != nil {
+		h.log(c).Error("Failed to count cluster controllers", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list cluster statuses"})
+		return
+	}
+
+	statuses := make([]*models.CompactClusterStatus, len(clusters))
+	for i, cluster := range clusters {
+		statuses[i] = models.NewCompactClusterStatus(cluster, counts[cluster.ID])
+	}
+
+	c.JSON(http.StatusOK, models.ListClusterStatusesResponse{
+		Statuses:   statuses,
+		Total:      total,
+		Limit:      opts.Limit,
+		Offset:     opts.Offset,
+		NextCursor: models.NextClusterCursor(clusters, opts.Limit),
+		PageInfo:   models.NewClusterPageInfo(clusters, total, opts),
+	})
+}
+
+// ListClustersByReleaseImage lists every cluster whose spec references a release image, so a
+// vulnerable image can be traced to the clusters running it (controllers only)
+func (h *ClusterHandler) ListClustersByReleaseImage(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	image := c.Query("image")
+	if image == "" {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid release image",
+			"image query parameter is required",
+		))
+		return
+	}
+
+	opts, ok := parseClusterListOptions(c)
+	if !ok {
+		return
+	}
+
+	// Get user context from middleware
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	clusters, total, err := h.clusterService.ListClustersByReleaseImageWithAccessControl(ctx, image, opts, userCtx)
+	if err != nil {
+		if err.Error() == "access denied" {
+			c.JSON(http.StatusForbidden, utils.NewAPIError(
+				utils.ErrCodeForbidden,
+				"Access denied",
+				"only system controllers can list clusters by release image",
+			))
+			return
+		}
+		h.log(c).Error("Failed to list clusters by release image",
+			zap.String("image", image),
 			zap.Error(err),
 		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to list clusters by release image",
+			err.Error(),
+		))
+		return
+	}
 
-		if err.Error() == "cluster not found" {
-			c.JSON(http.StatusNotFound, gin.H{"error": "cluster not found"})
+	c.JSON(http.StatusOK, models.ListClustersResponse{
+		Clusters:   clusters,
+		Total:      total,
+		Limit:      opts.Limit,
+		Offset:     opts.Offset,
+		NextCursor: models.NextClusterCursor(clusters, opts.Limit),
+		PageInfo:   models.NewClusterPageInfo(clusters, total, opts),
+	})
+}
+
+// parseClusterListOptions reads the pagination and filter query parameters shared by the
+// cluster list endpoints. It writes a 400 response and returns false when they are invalid.
+func parseClusterListOptions(c *gin.Context) (*models.ListOptions, bool) {
+	limit := 50 // default
+	if limitStr := c.Query("limit"); limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 && parsedLimit <= 100 {
+			limit = parsedLimit
+		}
+	}
+
+	offset := 0
+	if offsetStr := c.Query("offset"); offsetStr != "" {
+		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
+			offset = parsedOffset
+		}
+	}
+
+	// An opaque cursor selects keyset pagination and takes precedence over offset
+	opts := &models.ListOptions{Limit: limit, Offset: offset}
+	if cursorStr := c.Query("cursor"); cursorStr != "" {
+		cursor, err := models.DecodeListCursor(cursorStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid cursor"})
+			return nil, false
+		}
+		opts.Cursor = cursor
+		opts.Offset = 0
+	}
+
+	// Filter by aggregated status phase; a comma-separated list matches any of the phases and
+	// "phase" is accepted as an alias of "status"
+	status := c.Query("status")
+	if status == "" {
+		status = c.Query("phase")
+	}
+	for _, phase := range models.SplitFilterValues(status) {
+		if !models.IsValidClusterPhase(phase) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid status %q, must be one of: %s",
+				phase, strings.Join(models.ClusterPhases, ", "))})
+			return nil, false
+		}
+		opts.Status = append(opts.Status, phase)
+	}
+
+	// Restrict to a creation window: created_after is inclusive, created_before exclusive
+	for _, bound := range []struct {
+		param  string
+		target **time.Time
+	}{
+		{"created_after", &opts.CreatedAfter},
+		{"created_before", &opts.CreatedBefore},
+	} {
+		if value := c.Query(bound.param); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid %s, must be an RFC3339 timestamp", bound.param)})
+				return nil, false
+			}
+			*bound.target = &parsed
+		}
+	}
+	if opts.CreatedAfter != nil && opts.CreatedBefore != nil && !opts.CreatedAfter.Before(*opts.CreatedBefore) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "created_after must be before created_before"})
+		return nil, false
+	}
+
+	// Restrict to clusters targeting a single GCP project
+	opts.TargetProjectID = strings.TrimSpace(c.Query("target_project_id"))
+
+	return opts, true
+}
+
+// respondValidationFailed answers a request that failed validation with status and the failing
+// fields as a field_errors array, so clients can map each failure to a form field. Spec values
+// that parsed but are invalid use 422, to tell them apart from malformed JSON (400).
+func respondValidationFailed(c *gin.Context, status int, err error) {
+	var fieldErrors utils.ValidationErrors
+	if !errors.As(err, &fieldErrors) {
+		fieldErrors = utils.NewValidationErrors(utils.ValidationDetails{Message: err.Error()})
+	}
+	c.JSON(status, gin.H{
+		"error":        err.Error(),
+		"field_errors": fieldErrors,
+	})
+}
+
+// CreateCluster creates a new cluster
+func (h *ClusterHandler) CreateCluster(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 60*time.Second)
+	defer cancel()
+
+	// Parse request body
+	var req models.ClusterCreateRequest
+	if err := bindJSONWithLimit(c, h.maxBodyBytes, &req); err != nil {
+		if respondIfBodyTooLarge(c, err) {
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid request: %v", err)})
+		return
+	}
+
+	// Cluster names become Kubernetes resource names downstream
+	if err := req.ValidateName(); err != nil {
+		respondValidationFailed(c, http.StatusBadRequest, err)
+		return
+	}
+
+	// Optional Idempotency-Key makes retried creates safe
+	idempotencyKey := c.GetHeader(idempotencyKeyHeader)
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s must be at most %d characters", idempotencyKeyHeader, maxIdempotencyKeyLength)})
+		return
+	}
+
+	// Validate infraID for GCP resource naming constraints
+	if err := req.ValidateGCPInfraID(); err != nil {
+		respondValidationFailed(c, http.StatusBadRequest, err)
+		return
+	}
+
+	// Validate networking CIDRs and endpoint access
+	if err := req.Spec.Validate(); err != nil {
+		respondValidationFailed(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Apply defaults before validation
+	h.clusterService.ApplyDefaults(&req)
+
+	// Validate release spec (version, channelGroup required)
+	if err := req.ValidateRelease(); err != nil {
+		respondValidationFailed(c, http.StatusBadRequest, err)
+		return
+	}
+	if err := h.clusterService.ValidateImageRegistry(&req.Spec); err != nil {
+		respondValidationFailed(c, http.StatusBadRequest, err)
+		return
+	}
+
+	// Get user context from middleware
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		return
+	}
+
+	// Check if user can create clusters
+	if !auth.CanCreateCluster(userCtx) {
+		h.log(c).Warn("User not authorized to create clusters",
+			zap.String("user_email", userCtx.Email),
+			zap.Bool("is_controller", userCtx.IsController),
+		)
+		c.JSON(http.StatusForbidden, gin.H{"error": "not authorized to create clusters"})
+		return
+	}
+
+	h.log(c).Info("Creating cluster",
+		zap.String("cluster_name", req.Name),
+		zap.String("user_email", userCtx.Email),
+		zap.Bool("is_controller", userCtx.IsController),
+	)
+
+	// With dry_run the request is only validated and the would-be cluster returned; otherwise
+	// create the cluster, or return the one already created with this idempotency key
+	dryRun := c.Query("dry_run") == "true"
+	var (
+		cluster  *models.Cluster
+		replayed bool
+		err      error
+	)
+	if dryRun {
+		cluster, err = h.clusterService.CreateClusterDryRun(ctx, &req, userCtx.Email)
+	} else if idempotencyKey != "" {
+		cluster, replayed, err = h.clusterService.CreateClusterWithIdempotencyKey(ctx, &req, userCtx.Email, idempotencyKey)
+	} else {
+		cluster, err = h.clusterService.CreateCluster(ctx, &req, userCtx.Email)
+	}
+	if err != nil {
+		h.log(c).Error("Failed to create cluster",
+			zap.String("cluster_name", req.Name),
+			zap.String("user_email", userCtx.Email),
+			zap.Error(err),
+		)
+
+		switch {
+		case errors.Is(err, models.ErrClusterNameExists):
+			c.JSON(http.StatusConflict, gin.H{"error": utils.NewConflictError("CLUSTER_NAME_EXISTS", "A cluster with this name already exists")})
+			return
+		case errors.Is(err, models.ErrIdempotencyKeyMismatch):
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": fmt.Sprintf("%s was already used for a different cluster", idempotencyKeyHeader)})
+			return
+		case errors.Is(err, models.ErrConflict):
+			c.JSON(http.StatusConflict, gin.H{"error": fmt.Sprintf("%s was already used for a cluster that has since been deleted", idempotencyKeyHeader)})
+			return
+		}
+
+		// Convert database errors to appropriate API errors
+		apiErr := utils.ConvertDBError(err)
+		if apiErr.Code != "" {
+			c.JSON(apiErr.HTTPStatus(), gin.H{"error": apiErr})
 		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update cluster"})
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create cluster"})
 		}
 		return
 	}
 
-	c.JSON(http.StatusOK, cluster)
+	if dryRun {
+		c.JSON(http.StatusOK, cluster)
+		return
+	}
+	if replayed {
+		c.Header(idempotentReplayedHeader, "true")
+	}
+	c.JSON(http.StatusCreated, cluster)
 }
 
-// DeleteCluster deletes a cluster
-func (h *ClusterHandler) DeleteCluster(c *gin.Context) {
-	ctx, cancel := context.WithTimeout(c.Request.Context(), 60*time.Second)
+// GetCluster gets a specific cluster
+func (h *ClusterHandler) GetCluster(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
 	defer cancel()
 
 	// Extract cluster ID
@@ -320,54 +843,1122 @@ func (h *ClusterHandler) DeleteCluster(c *gin.Context) {
 		return
 	}
 
-	force := c.Query("force") == "true"
-
 	// Get user context from middleware
 	userCtx, exists := middleware.GetUserContext(c)
 	if !exists {
-		h.logger.Error("No user context found")
+		h.log(c).Error("No user context found")
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
 		return
 	}
 
-	h.logger.Info("Deleting cluster",
+	h.log(c).Info("Getting cluster",
 		zap.String("cluster_id", clusterIDStr),
 		zap.String("user_email", userCtx.Email),
 		zap.Bool("is_controller", userCtx.IsController),
-		zap.Bool("force", force),
 	)
 
-	// Delete cluster with access control
-	err = h.clusterService.DeleteClusterWithAccessControl(ctx, clusterID, force, userCtx)
+	// Get cluster with access control
+	cluster, err := h.clusterService.GetClusterWithAccessControl(ctx, clusterID, userCtx)
 	if err != nil {
-		h.logger.Error("Failed to delete cluster",
+		h.log(c).Error("Failed to get cluster",
 			zap.String("cluster_id", clusterIDStr),
 			zap.Error(err),
 		)
 
-		if err.Error() == "cluster not found" {
+		switch err.Error() {
+		case "cluster not found":
 			c.JSON(http.StatusNotFound, gin.H{"error": "cluster not found"})
-		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete cluster"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get cluster"})
 		}
 		return
 	}
 
-	c.JSON(http.StatusAccepted, gin.H{
-		"message":    "cluster deletion initiated",
-		"cluster_id": clusterIDStr,
+	// Reconciliation timing is informational, so a failed lookup leaves it null
+	timing, err := h.clusterService.GetReconciliationTiming(ctx, clusterID)
+	if err != nil {
+		h.log(c).Warn("Failed to get cluster reconciliation timing",
+			zap.String("cluster_id", clusterIDStr),
+			zap.Error(err),
+		)
+		timing = &models.ReconciliationTiming{}
+	}
+
+	// The cluster has already been enriched, so the ETag follows live status transitions
+	etag := clusterETag(cluster, timing)
+	c.Header("ETag", etag)
+	if etagMatches(c.GetHeader(ifNoneMatchHeader), etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.JSON(http.StatusOK, &models.ClusterWithReconciliation{
+		Cluster:              *cluster,
+		ReconciliationTiming: *timing,
 	})
 }
 
-// GetClusterStatus retrieves cluster status information
-func (h *ClusterHandler) GetClusterStatus(c *gin.Context) {
-	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+// clusterETag derives a strong ETag from the cluster's resource version, the time its status
+// was last calculated and its reconciliation timing, so spec changes, status transitions and
+// reconciliations all change it
+func clusterETag(cluster *models.Cluster, timing *models.ReconciliationTiming) string {
+	var statusUpdated time.Time
+	if cluster.Status != nil {
+		statusUpdated = cluster.Status.LastUpdateTime
+	}
+
+	parts := []string{cluster.ResourceVersion, strconv.FormatInt(statusUpdated.UnixNano(), 10)}
+	for _, t := range []*time.Time{timing.LastReconciledAt, timing.NextReconcileAt} {
+		if t != nil {
+			parts = append(parts, strconv.FormatInt(t.UnixNano(), 10))
+		} else {
+			parts = append(parts, "")
+		}
+	}
+	// A stale status must not revalidate a response cached while it was fresh
+	if cluster.StatusStale {
+		parts = append(parts, "stale")
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(parts, "/")))
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches reports whether an If-None-Match header value matches etag. The header may
+// list several ETags or be "*"; weak validators compare equal to their strong form.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateCluster updates a cluster
+func (h *ClusterHandler) UpdateCluster(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 60*time.Second)
 	defer cancel()
 
 	// Extract cluster ID
 	clusterIDStr := c.Param("cluster_id")
 	if clusterIDStr == "" {
-		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+		c.JSON(http.StatusBadRequest, gin.H{"error": "cluster ID is required"})
+		return
+	}
+
+	clusterID, err := uuid.Parse(clusterIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid cluster ID format"})
+		return
+	}
+
+	// Parse request body
+	var req models.ClusterUpdateRequest
+	if err := bindJSONWithLimit(c, h.maxBodyBytes, &req); err != nil {
+		if respondIfBodyTooLarge(c, err) {
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("invalid request: %v", err)})
+		return
+	}
+
+	// Validate networking CIDRs and endpoint access
+	if err := req.Spec.Validate(); err != nil {
+		respondValidationFailed(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := h.clusterService.ValidateImageRegistry(&req.Spec); err != nil {
+		respondValidationFailed(c, http.StatusBadRequest, err)
+		return
+	}
+
+	// Get user context from middleware
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		return
+	}
+
+	h.log(c).Info("Updating cluster",
+		zap.String("cluster_id", clusterIDStr),
+		zap.String("user_email", userCtx.Email),
+		zap.Bool("is_controller", userCtx.IsController),
+	)
+
+	// Update cluster with access control
+	cluster, err := h.clusterService.UpdateClusterWithAccessControl(ctx, clusterID, &req, userCtx)
+	if err != nil {
+		h.log(c).Error("Failed to update cluster",
+			zap.String("cluster_id", clusterIDStr),
+			zap.Error(err),
+		)
+
+		if err.Error() == "cluster not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "cluster not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update cluster"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, cluster)
+}
+
+// DiffClusterSpec previews the field-level changes a spec update would make to a cluster
+func (h *ClusterHandler) DiffClusterSpec(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	clusterIDStr := c.Param("cluster_id")
+	clusterID, err := uuid.Parse(clusterIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid cluster ID format",
+			err.Error(),
+		))
+		return
+	}
+
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	// The candidate is given exactly as it would be sent to PUT /clusters/{id}
+	var req models.ClusterUpdateRequest
+	if err := bindJSONWithLimit(c, h.maxBodyBytes, &req); err != nil {
+		if respondIfBodyTooLarge(c, err) {
+			return
+		}
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid spec diff request format",
+			err.Error(),
+		))
+		return
+	}
+
+	if err := req.Spec.Validate(); err != nil {
+		respondValidationFailed(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	diff, err := h.clusterService.DiffClusterSpecWithAccessControl(ctx, clusterID, &req.Spec, userCtx)
+	if err != nil {
+		if err.Error() == "cluster not found" {
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"Cluster not found",
+				"",
+			))
+			return
+		}
+		h.log(c).Error("Failed to diff cluster spec",
+			zap.String("cluster_id", clusterIDStr),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to diff cluster spec",
+			err.Error(),
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, diff)
+}
+
+// DeleteCluster deletes a cluster
+func (h *ClusterHandler) DeleteCluster(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 60*time.Second)
+	defer cancel()
+
+	// Extract cluster ID
+	clusterIDStr := c.Param("cluster_id")
+	if clusterIDStr == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "cluster ID is required"})
+		return
+	}
+
+	clusterID, err := uuid.Parse(clusterIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid cluster ID format"})
+		return
+	}
+
+	force := c.Query("force") == "true"
+
+	// Get user context from middleware
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "authentication required"})
+		return
+	}
+
+	if c.Query("purge") == "true" {
+		h.purgeCluster(ctx, c, clusterID, userCtx)
+		return
+	}
+
+	h.log(c).Info("Deleting cluster",
+		zap.String("cluster_id", clusterIDStr),
+		zap.String("user_email", userCtx.Email),
+		zap.Bool("is_controller", userCtx.IsController),
+		zap.Bool("force", force),
+	)
+
+	// Delete cluster with access control
+	err = h.clusterService.DeleteClusterWithAccessControl(ctx, clusterID, force, userCtx)
+	if err != nil {
+		h.log(c).Error("Failed to delete cluster",
+			zap.String("cluster_id", clusterIDStr),
+			zap.Error(err),
+		)
+
+		var nodePoolsErr *models.ClusterHasNodePoolsError
+		if err.Error() == "cluster not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "cluster not found"})
+		} else if errors.As(err, &nodePoolsErr) {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":          err.Error(),
+				"nodepool_count": nodePoolsErr.NodePoolCount,
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete cluster"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message":    "cluster deletion initiated",
+		"cluster_id": clusterIDStr,
+	})
+}
+
+// purgeCluster permanently removes a soft-deleted cluster (controllers only)
+func (h *ClusterHandler) purgeCluster(ctx context.Context, c *gin.Context, clusterID uuid.UUID, userCtx *auth.UserContext) {
+	if !auth.CanPurgeCluster(userCtx) {
+		h.log(c).Warn("User not authorized to purge clusters",
+			zap.String("cluster_id", clusterID.String()),
+			zap.String("user_email", userCtx.Email),
+		)
+		c.JSON(http.StatusForbidden, gin.H{"error": "only system controllers can purge clusters"})
+		return
+	}
+
+	h.log(c).Info("Purging cluster",
+		zap.String("cluster_id", clusterID.String()),
+		zap.String("user_email", userCtx.Email),
+	)
+
+	err := h.clusterService.PurgeClusterWithAccessControl(ctx, clusterID, userCtx)
+	if err != nil {
+		h.log(c).Error("Failed to purge cluster",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+
+		switch {
+		case errors.Is(err, models.ErrClusterNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "cluster not found"})
+		case errors.Is(err, models.ErrClusterNotDeleted):
+			c.JSON(http.StatusConflict, gin.H{"error": "cluster must be deleted before it can be purged"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to purge cluster"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "cluster purged",
+		"cluster_id": clusterID.String(),
+	})
+}
+
+// Verbosity levels selected by the fields query parameter of the cluster status endpoint
+const (
+	statusFieldsSummary = "summary" // Aggregated status and counts only
+	statusFieldsFull    = "full"    // Also every controller report and error (default)
+)
+
+// GetClusterStatus retrieves cluster status information
+func (h *ClusterHandler) GetClusterStatus(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	// Extract cluster ID
+	clusterIDStr := c.Param("cluster_id")
+	if clusterIDStr == "" {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Cluster ID is required",
+			"",
+		))
+		return
+	}
+
+	clusterID, err := uuid.Parse(clusterIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid cluster ID format",
+			err.Error(),
+		))
+		return
+	}
+
+	fields := c.DefaultQuery("fields", statusFieldsFull)
+	if fields != statusFieldsSummary && fields != statusFieldsFull {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid fields",
+			fmt.Sprintf("fields must be %q or %q", statusFieldsSummary, statusFieldsFull),
+		))
+		return
+	}
+
+	// Get user context from middleware
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	h.log(c).Info("Getting cluster status",
+		zap.String("cluster_id", clusterIDStr),
+		zap.String("user_email", userCtx.Email),
+		zap.Bool("is_controller", userCtx.IsController),
+	)
+
+	// Get cluster first to verify it exists and user has access
+	cluster, err := h.clusterService.GetClusterWithAccessControl(ctx, clusterID, userCtx)
+	if err != nil {
+		h.log(c).Error("Failed to get cluster for status",
+			zap.String("cluster_id", clusterIDStr),
+			zap.Error(err),
+		)
+
+		if err.Error() == "cluster not found" {
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"Cluster not found",
+				"",
+			))
+		} else {
+			c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+				utils.ErrCodeInternal,
+				"Failed to get cluster",
+				err.Error(),
+			))
+		}
+		return
+	}
+
+	// Get individual controller status reports
+	controllerStatuses, err := h.statusRepository.ListClusterControllerStatus(ctx, clusterID)
+	if err != nil {
+		h.log(c).Error("Failed to get controller status reports",
+			zap.String("cluster_id", clusterIDStr),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to get controller status reports",
+			err.Error(),
+		))
+		return
+	}
+
+	h.log(c).Info("Retrieved controller status reports",
+		zap.String("cluster_id", clusterIDStr),
+		zap.Int("controller_count", len(controllerStatuses)),
+	)
+
+	// Get errors reported by the cluster and nodepool controllers
+	clusterErrors, err := h.statusRepository.GetClusterErrors(ctx, clusterID)
+	if err != nil {
+		h.log(c).Error("Failed to get cluster errors",
+			zap.String("cluster_id", clusterIDStr),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to get cluster errors",
+			err.Error(),
+		))
+		return
+	}
+
+	// Controllers that have not yet observed the current generation
+	staleControllers, err := h.statusRepository.ListStaleClusterControllers(ctx, clusterID)
+	if err != nil {
+		h.log(c).Error("Failed to get stale controllers",
+			zap.String("cluster_id", clusterIDStr),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to get stale controllers",
+			err.Error(),
+		))
+		return
+	}
+
+	// Readiness of the cluster's nodepools, also folded into the NodePoolsReady condition
+	nodepools, err := h.statusRepository.GetNodePoolRollup(ctx, clusterID)
+	if err != nil {
+		h.log(c).Error("Failed to get nodepool rollup",
+			zap.String("cluster_id", clusterIDStr),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to get nodepool rollup",
+			err.Error(),
+		))
+		return
+	}
+
+	// The summary replaces the per-controller lists, which can be large, with their counts
+	if fields == statusFieldsSummary {
+		c.JSON(http.StatusOK, gin.H{
+			"cluster_id":             clusterIDStr,
+			"generation":             cluster.Generation,
+			"status":                 cluster.Status,
+			"controller_count":       len(controllerStatuses),
+			"error_count":            len(clusterErrors),
+			"stale_controller_count": len(staleControllers),
+			"reconciling":            len(staleControllers) > 0,
+			"nodepools_total":        nodepools.Total,
+			"nodepools_ready":        nodepools.Ready,
+			"nodepools_worst_phase":  nodepools.WorstPhase,
+		})
+		return
+	}
+
+	response := gin.H{
+		"cluster_id":            clusterIDStr,
+		"generation":            cluster.Generation,
+		"status":                cluster.Status,     // K8s-like aggregated status
+		"controller_status":     controllerStatuses, // Individual controller reports
+		"errors":                clusterErrors,      // Controller errors, collapsed when enabled
+		"stale_controllers":     staleControllers,   // Controllers behind the current generation
+		"reconciling":           len(staleControllers) > 0,
+		"nodepools_total":       nodepools.Total,
+		"nodepools_ready":       nodepools.Ready,
+		"nodepools_worst_phase": nodepools.WorstPhase,
+	}
+
+	// Every controller's raw conditions in one list, for debugging without cross-referencing
+	if includeRequested(c, "all_conditions") {
+		response["all_conditions"] = models.FlattenControllerConditions(controllerStatuses)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListClusterStatusHistory lists the condition status changes reported by a cluster's
+// controllers, newest first, optionally for a single controller
+func (h *ClusterHandler) ListClusterStatusHistory(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	clusterIDStr := c.Param("cluster_id")
+	clusterID, err := uuid.Parse(clusterIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid cluster ID format",
+			err.Error(),
+		))
+		return
+	}
+
+	limit := 100
+	if limitStr := c.Query("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil || parsedLimit <= 0 || parsedLimit > 1000 {
+			c.JSON(http.StatusBadRequest, utils.NewAPIError(
+				utils.ErrCodeValidation,
+				"Invalid limit",
+				"limit must be between 1 and 1000",
+			))
+			return
+		}
+		limit = parsedLimit
+	}
+	controllerName := strings.TrimSpace(c.Query("controller_name"))
+
+	// Get user context from middleware
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	// Verify the cluster exists and the user has access
+	if _, err := h.clusterService.GetClusterWithAccessControl(ctx, clusterID, userCtx); err != nil {
+		if err.Error() == "cluster not found" {
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"Cluster not found",
+				"",
+			))
+		} else {
+			h.log(c).Error("Failed to get cluster for status history",
+				zap.String("cluster_id", clusterIDStr),
+				zap.Error(err),
+			)
+			c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+				utils.ErrCodeInternal,
+				"Failed to get cluster",
+				err.Error(),
+			))
+		}
+		return
+	}
+
+	history, err := h.statusRepository.ListClusterStatusHistory(ctx, clusterID, controllerName, limit)
+	if err != nil {
+		h.log(c).Error("Failed to list cluster status history",
+			zap.String("cluster_id", clusterIDStr),
+			zap.String("controller_name", controllerName),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to list cluster status history",
+			err.Error(),
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"cluster_id": clusterIDStr,
+		"history":    history,
+		"limit":      limit,
+	})
+}
+
+// includeRequested reports whether the comma-separated include query parameter lists name
+func includeRequested(c *gin.Context, name string) bool {
+	for _, include := range strings.Split(c.Query("include"), ",") {
+		if strings.TrimSpace(include) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GetClusterFullView returns a cluster with its nodepools, controller status reports and
+// aggregated status in one response (controllers only)
+func (h *ClusterHandler) GetClusterFullView(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	clusterIDStr := c.Param("cluster_id")
+	clusterID, err := uuid.Parse(clusterIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid cluster ID format",
+			err.Error(),
+		))
+		return
+	}
+
+	// Get user context from middleware
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	view, err := h.clusterService.GetClusterFullViewWithAccessControl(ctx, clusterID, userCtx)
+	if err != nil {
+		switch err.Error() {
+		case "access denied":
+			c.JSON(http.StatusForbidden, utils.NewAPIError(
+				utils.ErrCodeForbidden,
+				"Access denied",
+				"only system controllers can fetch the full cluster view",
+			))
+		case "cluster not found":
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"Cluster not found",
+				"",
+			))
+		default:
+			h.log(c).Error("Failed to get full cluster view",
+				zap.String("cluster_id", clusterIDStr),
+				zap.Error(err),
+			)
+			c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+				utils.ErrCodeInternal,
+				"Failed to get full cluster view",
+				err.Error(),
+			))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, view)
+}
+
+// DeleteClusterControllerStatus removes a controller's status report for a cluster (controllers only)
+func (h *ClusterHandler) DeleteClusterControllerStatus(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	clusterIDStr := c.Param("cluster_id")
+	clusterID, err := uuid.Parse(clusterIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid cluster ID format",
+			err.Error(),
+		))
+		return
+	}
+
+	controllerName := c.Param("controller_name")
+
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	err = h.clusterService.DeleteClusterControllerStatusWithAccessControl(ctx, clusterID, controllerName, userCtx)
+	if err != nil {
+		switch {
+		case err.Error() == "access denied":
+			c.JSON(http.StatusForbidden, utils.NewAPIError(
+				utils.ErrCodeForbidden,
+				"Access denied",
+				"only system controllers can delete controller status",
+			))
+		case err.Error() == "cluster not found":
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"Cluster not found",
+				"",
+			))
+		case errors.Is(err, models.ErrControllerStatusNotFound):
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"Controller status not found",
+				fmt.Sprintf("controller %s has not reported status for this cluster", controllerName),
+			))
+		default:
+			h.log(c).Error("Failed to delete cluster controller status",
+				zap.String("cluster_id", clusterIDStr),
+				zap.String("controller_name", controllerName),
+				zap.Error(err),
+			)
+			c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+				utils.ErrCodeInternal,
+				"Failed to delete controller status",
+				err.Error(),
+			))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":         "controller status deleted",
+		"cluster_id":      clusterIDStr,
+		"controller_name": controllerName,
+	})
+}
+
+// ClearClusterErrors clears the errors reported by a cluster's controllers
+func (h *ClusterHandler) ClearClusterErrors(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	clusterIDStr := c.Param("cluster_id")
+	clusterID, err := uuid.Parse(clusterIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid cluster ID format",
+			err.Error(),
+		))
+		return
+	}
+
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	var req models.ClearErrorsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid clear errors request format",
+			err.Error(),
+		))
+		return
+	}
+
+	req.Reason = strings.TrimSpace(req.Reason)
+	if req.Reason == "" {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Reason is required",
+			"a reason for clearing the errors is recorded in the audit log",
+		))
+		return
+	}
+
+	result, err := h.clusterService.ClearClusterErrorsWithAccessControl(ctx, clusterID, &req, userCtx)
+	if err != nil {
+		switch err.Error() {
+		case "access denied":
+			c.JSON(http.StatusForbidden, utils.NewAPIError(
+				utils.ErrCodeForbidden,
+				"Access denied",
+				"only system controllers can clear controller errors",
+			))
+		case "cluster not found":
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"Cluster not found",
+				"",
+			))
+		default:
+			h.log(c).Error("Failed to clear cluster controller errors",
+				zap.String("cluster_id", clusterIDStr),
+				zap.Error(err),
+			)
+			c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+				utils.ErrCodeInternal,
+				"Failed to clear controller errors",
+				err.Error(),
+			))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":                 "controller errors cleared",
+		"cluster_id":              clusterIDStr,
+		"cluster_errors_cleared":  result.ClusterErrorsCleared,
+		"nodepool_errors_cleared": result.NodePoolErrorsCleared,
+	})
+}
+
+// ListClusterControllers lists the controllers reporting status for a cluster
+func (h *ClusterHandler) ListClusterControllers(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	clusterIDStr := c.Param("cluster_id")
+	clusterID, err := uuid.Parse(clusterIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid cluster ID format",
+			err.Error(),
+		))
+		return
+	}
+
+	// Get user context from middleware
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	// Verify the cluster exists and the user has access
+	if _, err := h.clusterService.GetClusterWithAccessControl(ctx, clusterID, userCtx); err != nil {
+		if err.Error() == "cluster not found" {
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"Cluster not found",
+				"",
+			))
+		} else {
+			h.log(c).Error("Failed to get cluster for controllers",
+				zap.String("cluster_id", clusterIDStr),
+				zap.Error(err),
+			)
+			c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+				utils.ErrCodeInternal,
+				"Failed to get cluster",
+				err.Error(),
+			))
+		}
+		return
+	}
+
+	controllers, err := h.statusRepository.ListClusterControllers(ctx, clusterID)
+	if err != nil {
+		h.log(c).Error("Failed to list cluster controllers",
+			zap.String("cluster_id", clusterIDStr),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to list cluster controllers",
+			err.Error(),
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"cluster_id":  clusterIDStr,
+		"controllers": controllers,
+	})
+}
+
+// ListClusterTransitions lists the changes of a cluster's aggregated phase, oldest first
+func (h *ClusterHandler) ListClusterTransitions(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	clusterIDStr := c.Param("cluster_id")
+	clusterID, err := uuid.Parse(clusterIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid cluster ID format",
+			err.Error(),
+		))
+		return
+	}
+
+	limit := 100
+	if limitStr := c.Query("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil || parsedLimit <= 0 || parsedLimit > 1000 {
+			c.JSON(http.StatusBadRequest, utils.NewAPIError(
+				utils.ErrCodeValidation,
+				"Invalid limit",
+				"limit must be between 1 and 1000",
+			))
+			return
+		}
+		limit = parsedLimit
+	}
+
+	// Get user context from middleware
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	// Verify the cluster exists and the user has access. Loading the cluster also
+	// brings its status up to date, recording any pending phase transition.
+	if _, err := h.clusterService.GetClusterWithAccessControl(ctx, clusterID, userCtx); err != nil {
+		if err.Error() == "cluster not found" {
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"Cluster not found",
+				"",
+			))
+		} else {
+			h.log(c).Error("Failed to get cluster for transitions",
+				zap.String("cluster_id", clusterIDStr),
+				zap.Error(err),
+			)
+			c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+				utils.ErrCodeInternal,
+				"Failed to get cluster",
+				err.Error(),
+			))
+		}
+		return
+	}
+
+	transitions, err := h.statusRepository.ListPhaseTransitions(ctx, clusterID, limit)
+	if err != nil {
+		h.log(c).Error("Failed to list cluster phase transitions",
+			zap.String("cluster_id", clusterIDStr),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to list cluster phase transitions",
+			err.Error(),
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"cluster_id":  clusterIDStr,
+		"transitions": transitions,
+		"limit":       limit,
+	})
+}
+
+// ListClusterEvents lists a page of a cluster's events, newest first, optionally filtered by type
+func (h *ClusterHandler) ListClusterEvents(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	clusterIDStr := c.Param("cluster_id")
+	clusterID, err := uuid.Parse(clusterIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid cluster ID format",
+			err.Error(),
+		))
+		return
+	}
+
+	opts := &models.ClusterEventListOptions{
+		EventType: strings.TrimSpace(c.Query("event_type")),
+		Limit:     50,
+	}
+	if limitStr := c.Query("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil || parsedLimit <= 0 || parsedLimit > 1000 {
+			c.JSON(http.StatusBadRequest, utils.NewAPIError(
+				utils.ErrCodeValidation,
+				"Invalid limit",
+				"limit must be between 1 and 1000",
+			))
+			return
+		}
+		opts.Limit = parsedLimit
+	}
+	if offsetStr := c.Query("offset"); offsetStr != "" {
+		parsedOffset, err := strconv.Atoi(offsetStr)
+		if err != nil || parsedOffset < 0 {
+			c.JSON(http.StatusBadRequest, utils.NewAPIError(
+				utils.ErrCodeValidation,
+				"Invalid offset",
+				"offset must be 0 or greater",
+			))
+			return
+		}
+		opts.Offset = parsedOffset
+	}
+
+	// Get user context from middleware
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	// Verify the cluster exists and the user has access
+	if _, err := h.clusterService.GetClusterWithAccessControl(ctx, clusterID, userCtx); err != nil {
+		if err.Error() == "cluster not found" {
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"Cluster not found",
+				"",
+			))
+		} else {
+			h.log(c).Error("Failed to get cluster for events",
+				zap.String("cluster_id", clusterIDStr),
+				zap.Error(err),
+			)
+			c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+				utils.ErrCodeInternal,
+				"Failed to get cluster",
+				err.Error(),
+			))
+		}
+		return
+	}
+
+	events, total, err := h.statusRepository.ListClusterEvents(ctx, clusterID, opts)
+	if err != nil {
+		h.log(c).Error("Failed to list cluster events",
+			zap.String("cluster_id", clusterIDStr),
+			zap.String("event_type", opts.EventType),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to list cluster events",
+			err.Error(),
+		))
+		return
+	}
+
+	page := models.NewPageInfo(total, opts.Limit, opts.Offset, len(events))
+	c.JSON(http.StatusOK, gin.H{
+		"cluster_id":  clusterIDStr,
+		"events":      events,
+		"total":       total,
+		"limit":       opts.Limit,
+		"offset":      opts.Offset,
+		"has_more":    page.HasMore,
+		"total_pages": page.TotalPages,
+	})
+}
+
+// UpdateClusterStatus handles controller status updates
+func (h *ClusterHandler) UpdateClusterStatus(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	// Extract cluster ID
+	clusterIDStr := c.Param("cluster_id")
+	if clusterIDStr == "" {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
 			utils.ErrCodeValidation,
 			"Cluster ID is required",
 			"",
@@ -375,11 +1966,43 @@ func (h *ClusterHandler) GetClusterStatus(c *gin.Context) {
 		return
 	}
 
-	clusterID, err := uuid.Parse(clusterIDStr)
-	if err != nil {
+	clusterID, err := uuid.Parse(clusterIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid cluster ID format",
+			err.Error(),
+		))
+		return
+	}
+
+	var statusUpdate models.ClusterControllerStatus
+	if err := bindJSONWithLimit(c, h.maxBodyBytes, &statusUpdate); err != nil {
+		if respondIfBodyTooLarge(c, err) {
+			return
+		}
+		h.log(c).Error("Invalid status update request", zap.Error(err))
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid status update format",
+			err.Error(),
+		))
+		return
+	}
+
+	if err := statusUpdate.Conditions.ValidateTransitionTimes(time.Now()); err != nil {
 		c.JSON(http.StatusBadRequest, utils.NewAPIError(
 			utils.ErrCodeValidation,
-			"Invalid cluster ID format",
+			"Invalid condition transition time",
+			err.Error(),
+		))
+		return
+	}
+
+	if err := models.ValidateStatusMetadataSize(statusUpdate.Metadata); err != nil {
+		c.JSON(http.StatusRequestEntityTooLarge, utils.NewAPIError(
+			utils.ErrCodeTooLarge,
+			"Status metadata too large",
 			err.Error(),
 		))
 		return
@@ -388,7 +2011,7 @@ func (h *ClusterHandler) GetClusterStatus(c *gin.Context) {
 	// Get user context from middleware
 	userCtx, exists := middleware.GetUserContext(c)
 	if !exists {
-		h.logger.Error("No user context found")
+		h.log(c).Error("No user context found")
 		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
 			utils.ErrCodeUnauthorized,
 			"Authentication required",
@@ -397,16 +2020,31 @@ func (h *ClusterHandler) GetClusterStatus(c *gin.Context) {
 		return
 	}
 
-	h.logger.Info("Getting cluster status",
+	// Only controllers can report status
+	if !auth.CanReportStatus(userCtx) {
+		h.log(c).Warn("User not authorized to report status",
+			zap.String("user_email", userCtx.Email),
+			zap.Bool("is_controller", userCtx.IsController),
+		)
+		c.JSON(http.StatusForbidden, utils.NewAPIError(
+			utils.ErrCodeForbidden,
+			"Only system controllers can report status",
+			"",
+		))
+		return
+	}
+
+	h.log(c).Info("Updating cluster status",
 		zap.String("cluster_id", clusterIDStr),
+		zap.String("controller_name", statusUpdate.ControllerName),
 		zap.String("user_email", userCtx.Email),
 		zap.Bool("is_controller", userCtx.IsController),
 	)
 
-	// Get cluster first to verify it exists and user has access
-	cluster, err := h.clusterService.GetClusterWithAccessControl(ctx, clusterID, userCtx)
+	// Controllers can access any cluster for status reporting
+	_, err = h.clusterService.GetClusterWithAccessControl(ctx, clusterID, userCtx)
 	if err != nil {
-		h.logger.Error("Failed to get cluster for status",
+		h.log(c).Error("Failed to verify cluster for status update",
 			zap.String("cluster_id", clusterIDStr),
 			zap.Error(err),
 		)
@@ -420,58 +2058,77 @@ func (h *ClusterHandler) GetClusterStatus(c *gin.Context) {
 		} else {
 			c.JSON(http.StatusInternalServerError, utils.NewAPIError(
 				utils.ErrCodeInternal,
-				"Failed to get cluster",
+				"Failed to verify cluster",
 				err.Error(),
 			))
 		}
 		return
 	}
 
-	// Get individual controller status reports
-	controllerStatuses, err := h.statusRepository.ListClusterControllerStatus(ctx, clusterID)
+	// Set cluster ID in status update
+	statusUpdate.ClusterID = clusterID
+	statusUpdate.LastUpdated = time.Now()
+
+	// Ensure metadata is not nil to satisfy database NOT NULL constraint
+	if statusUpdate.Metadata == nil {
+		statusUpdate.Metadata = make(models.JSONB)
+	}
+
+	// Store the status update in the database. Reports for an older generation than the stored
+	// one are rejected unless force is set, which lets an operator recover from a bad report.
+	if c.Query("force") == "true" {
+		err = h.statusRepository.ForceUpsertClusterControllerStatus(ctx, &statusUpdate)
+	} else {
+		err = h.statusRepository.UpsertClusterControllerStatus(ctx, &statusUpdate)
+	}
+	if errors.Is(err, models.ErrStaleGeneration) {
+		h.log(c).Warn("Rejected cluster status update for an older generation",
+			zap.String("cluster_id", clusterIDStr),
+			zap.String("controller_name", statusUpdate.ControllerName),
+			zap.Int64("observed_generation", statusUpdate.ObservedGeneration),
+		)
+		c.JSON(http.StatusConflict, utils.NewAPIError(
+			utils.ErrCodeConflict,
+			"Stale status update",
+			fmt.Sprintf("observed generation %d is older than the stored status for controller %s",
+				statusUpdate.ObservedGeneration, statusUpdate.ControllerName),
+		))
+		return
+	}
 	if err != nil {
-		h.logger.Error("Failed to get controller status reports",
+		h.log(c).Error("Failed to store cluster status update",
 			zap.String("cluster_id", clusterIDStr),
+			zap.String("controller_name", statusUpdate.ControllerName),
 			zap.Error(err),
 		)
 		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
 			utils.ErrCodeInternal,
-			"Failed to get controller status reports",
+			"Failed to store status update",
 			err.Error(),
 		))
 		return
 	}
 
-	h.logger.Info("Retrieved controller status reports",
+	h.log(c).Info("Successfully stored cluster status update",
 		zap.String("cluster_id", clusterIDStr),
-		zap.Int("controller_count", len(controllerStatuses)),
+		zap.String("controller_name", statusUpdate.ControllerName),
+		zap.Int64("observed_generation", statusUpdate.ObservedGeneration),
 	)
 
-	response := gin.H{
-		"cluster_id":        clusterIDStr,
-		"status":            cluster.Status,     // K8s-like aggregated status
-		"controller_status": controllerStatuses, // Individual controller reports
-	}
-
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, gin.H{
+		"message":         "Status update stored successfully",
+		"cluster_id":      clusterIDStr,
+		"controller_name": statusUpdate.ControllerName,
+	})
 }
 
-// UpdateClusterStatus handles controller status updates
-func (h *ClusterHandler) UpdateClusterStatus(c *gin.Context) {
+// BatchUpdateClusterStatus stores several controllers' status reports for a cluster in one
+// transaction. Every report is validated up front and either all of them are stored or none is.
+func (h *ClusterHandler) BatchUpdateClusterStatus(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
 	defer cancel()
 
-	// Extract cluster ID
 	clusterIDStr := c.Param("cluster_id")
-	if clusterIDStr == "" {
-		c.JSON(http.StatusBadRequest, utils.NewAPIError(
-			utils.ErrCodeValidation,
-			"Cluster ID is required",
-			"",
-		))
-		return
-	}
-
 	clusterID, err := uuid.Parse(clusterIDStr)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, utils.NewAPIError(
@@ -482,9 +2139,12 @@ func (h *ClusterHandler) UpdateClusterStatus(c *gin.Context) {
 		return
 	}
 
-	var statusUpdate models.ClusterControllerStatus
-	if err := c.ShouldBindJSON(&statusUpdate); err != nil {
-		h.logger.Error("Invalid status update request", zap.Error(err))
+	var req models.ClusterStatusBatchRequest
+	if err := bindJSONWithLimit(c, h.maxBodyBytes, &req); err != nil {
+		if respondIfBodyTooLarge(c, err) {
+			return
+		}
+		h.log(c).Error("Invalid batch status update request", zap.Error(err))
 		c.JSON(http.StatusBadRequest, utils.NewAPIError(
 			utils.ErrCodeValidation,
 			"Invalid status update format",
@@ -493,10 +2153,57 @@ func (h *ClusterHandler) UpdateClusterStatus(c *gin.Context) {
 		return
 	}
 
+	if len(req.Statuses) == 0 {
+		respondValidationFailed(c, http.StatusBadRequest, utils.NewValidationErrors(utils.ValidationDetails{
+			Field:   "statuses",
+			Message: "at least one status is required",
+		}))
+		return
+	}
+
+	// Validate every report up front so a bad entry never opens a transaction
+	now := time.Now()
+	seen := make(map[string]bool, len(req.Statuses))
+	statuses := make([]*models.ClusterControllerStatus, 0, len(req.Statuses))
+	for i := range req.Statuses {
+		status := &req.Statuses[i]
+		entryInvalid := func(code int, field, message string, value any) {
+			fieldErrors := utils.NewValidationErrors(utils.ValidationDetails{Field: field, Value: value, Message: message})
+			respondValidationFailed(c, code, fieldErrors.Prefixed(fmt.Sprintf("statuses[%d]", i)))
+		}
+
+		if status.ControllerName == "" {
+			entryInvalid(http.StatusBadRequest, "controller_name", "controller_name is required", nil)
+			return
+		}
+		if seen[status.ControllerName] {
+			entryInvalid(http.StatusBadRequest, "controller_name",
+				fmt.Sprintf("duplicate controller '%s' in request", status.ControllerName), status.ControllerName)
+			return
+		}
+		seen[status.ControllerName] = true
+
+		if err := status.Conditions.ValidateTransitionTimes(now); err != nil {
+			entryInvalid(http.StatusBadRequest, "conditions", err.Error(), nil)
+			return
+		}
+		if err := models.ValidateStatusMetadataSize(status.Metadata); err != nil {
+			entryInvalid(http.StatusRequestEntityTooLarge, "metadata", err.Error(), nil)
+			return
+		}
+
+		status.ClusterID = clusterID
+		// Ensure metadata is not nil to satisfy database NOT NULL constraint
+		if status.Metadata == nil {
+			status.Metadata = make(models.JSONB)
+		}
+		statuses = append(statuses, status)
+	}
+
 	// Get user context from middleware
 	userCtx, exists := middleware.GetUserContext(c)
 	if !exists {
-		h.logger.Error("No user context found")
+		h.log(c).Error("No user context found")
 		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
 			utils.ErrCodeUnauthorized,
 			"Authentication required",
@@ -507,7 +2214,7 @@ func (h *ClusterHandler) UpdateClusterStatus(c *gin.Context) {
 
 	// Only controllers can report status
 	if !auth.CanReportStatus(userCtx) {
-		h.logger.Warn("User not authorized to report status",
+		h.log(c).Warn("User not authorized to report status",
 			zap.String("user_email", userCtx.Email),
 			zap.Bool("is_controller", userCtx.IsController),
 		)
@@ -519,21 +2226,8 @@ func (h *ClusterHandler) UpdateClusterStatus(c *gin.Context) {
 		return
 	}
 
-	h.logger.Info("Updating cluster status",
-		zap.String("cluster_id", clusterIDStr),
-		zap.String("controller_name", statusUpdate.ControllerName),
-		zap.String("user_email", userCtx.Email),
-		zap.Bool("is_controller", userCtx.IsController),
-	)
-
-	// Controllers can access any cluster for status reporting
-	_, err = h.clusterService.GetClusterWithAccessControl(ctx, clusterID, userCtx)
-	if err != nil {
-		h.logger.Error("Failed to verify cluster for status update",
-			zap.String("cluster_id", clusterIDStr),
-			zap.Error(err),
-		)
-
+	// The cluster is checked once for the whole batch
+	if _, err := h.clusterService.GetClusterWithAccessControl(ctx, clusterID, userCtx); err != nil {
 		if err.Error() == "cluster not found" {
 			c.JSON(http.StatusNotFound, utils.NewAPIError(
 				utils.ErrCodeNotFound,
@@ -541,6 +2235,10 @@ func (h *ClusterHandler) UpdateClusterStatus(c *gin.Context) {
 				"",
 			))
 		} else {
+			h.log(c).Error("Failed to verify cluster for batch status update",
+				zap.String("cluster_id", clusterIDStr),
+				zap.Error(err),
+			)
 			c.JSON(http.StatusInternalServerError, utils.NewAPIError(
 				utils.ErrCodeInternal,
 				"Failed to verify cluster",
@@ -550,21 +2248,31 @@ func (h *ClusterHandler) UpdateClusterStatus(c *gin.Context) {
 		return
 	}
 
-	// Set cluster ID in status update
-	statusUpdate.ClusterID = clusterID
-	statusUpdate.LastUpdated = time.Now()
-
-	// Ensure metadata is not nil to satisfy database NOT NULL constraint
-	if statusUpdate.Metadata == nil {
-		statusUpdate.Metadata = make(models.JSONB)
+	err = h.clusterService.UpsertClusterControllerStatuses(ctx, statuses, c.Query("force") == "true")
+	if errors.Is(err, models.ErrStaleGeneration) {
+		h.log(c).Warn("Rejected batch cluster status update for an older generation",
+			zap.String("cluster_id", clusterIDStr),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusConflict, utils.NewAPIError(
+			utils.ErrCodeConflict,
+			"Stale status update",
+			err.Error(),
+		))
+		return
+	}
+	if errors.Is(err, models.ErrInvalidInput) {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid status update",
+			err.Error(),
+		))
+		return
 	}
-
-	// Store the status update in the database
-	err = h.statusRepository.UpsertClusterControllerStatus(ctx, &statusUpdate)
 	if err != nil {
-		h.logger.Error("Failed to store cluster status update",
+		h.log(c).Error("Failed to store batch cluster status update",
 			zap.String("cluster_id", clusterIDStr),
-			zap.String("controller_name", statusUpdate.ControllerName),
+			zap.Int("count", len(statuses)),
 			zap.Error(err),
 		)
 		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
@@ -575,15 +2283,186 @@ func (h *ClusterHandler) UpdateClusterStatus(c *gin.Context) {
 		return
 	}
 
-	h.logger.Info("Successfully stored cluster status update",
+	controllerNames := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		controllerNames = append(controllerNames, status.ControllerName)
+	}
+
+	h.log(c).Info("Successfully stored batch cluster status update",
 		zap.String("cluster_id", clusterIDStr),
-		zap.String("controller_name", statusUpdate.ControllerName),
-		zap.Int64("observed_generation", statusUpdate.ObservedGeneration),
+		zap.Strings("controller_names", controllerNames),
 	)
 
 	c.JSON(http.StatusOK, gin.H{
-		"message":         "Status update stored successfully",
-		"cluster_id":      clusterIDStr,
-		"controller_name": statusUpdate.ControllerName,
+		"message":          "Status updates stored successfully",
+		"cluster_id":       clusterIDStr,
+		"controller_names": controllerNames,
+	})
+}
+
+// respondClusterGrantError writes the response for a failed grant operation
+func (h *ClusterHandler) respondClusterGrantError(c *gin.Context, clusterIDStr string, err error) {
+	switch {
+	case err.Error() == "cluster not found":
+		c.JSON(http.StatusNotFound, utils.NewAPIError(
+			utils.ErrCodeNotFound,
+			"Cluster not found",
+			"",
+		))
+	case err.Error() == "access denied":
+		c.JSON(http.StatusForbidden, utils.NewAPIError(
+			utils.ErrCodeForbidden,
+			"Access denied",
+			"only the cluster owner can manage grants",
+		))
+	case errors.Is(err, models.ErrClusterGrantNotFound):
+		c.JSON(http.StatusNotFound, utils.NewAPIError(
+			utils.ErrCodeNotFound,
+			"Grant not found",
+			"",
+		))
+	case errors.Is(err, models.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid grant",
+			err.Error(),
+		))
+	default:
+		h.log(c).Error("Failed to manage cluster grants",
+			zap.String("cluster_id", clusterIDStr),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to manage cluster grants",
+			err.Error(),
+		))
+	}
+}
+
+// ListClusterGrants lists the users a cluster is shared with. Only the owner can list them.
+func (h *ClusterHandler) ListClusterGrants(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	clusterIDStr := c.Param("cluster_id")
+	clusterID, err := uuid.Parse(clusterIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid cluster ID format",
+			err.Error(),
+		))
+		return
+	}
+
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	grants, err := h.clusterService.ListClusterGrantsWithAccessControl(ctx, clusterID, userCtx)
+	if err != nil {
+		h.respondClusterGrantError(c, clusterIDStr, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"cluster_id": clusterIDStr,
+		"grants":     grants,
+		"total":      len(grants),
+	})
+}
+
+// CreateClusterGrant shares read access to a cluster with another user. Granting a user who
+// already holds a grant replaces its role. Only the owner can grant access.
+func (h *ClusterHandler) CreateClusterGrant(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	clusterIDStr := c.Param("cluster_id")
+	clusterID, err := uuid.Parse(clusterIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid cluster ID format",
+			err.Error(),
+		))
+		return
+	}
+
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	var req models.ClusterGrantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid grant request format",
+			err.Error(),
+		))
+		return
+	}
+
+	grant, err := h.clusterService.GrantClusterAccessWithAccessControl(ctx, clusterID, &req, userCtx)
+	if err != nil {
+		h.respondClusterGrantError(c, clusterIDStr, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, grant)
+}
+
+// DeleteClusterGrant revokes a user's grant on a cluster. Only the owner can revoke access.
+func (h *ClusterHandler) DeleteClusterGrant(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	clusterIDStr := c.Param("cluster_id")
+	clusterID, err := uuid.Parse(clusterIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid cluster ID format",
+			err.Error(),
+		))
+		return
+	}
+
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	granteeEmail := c.Param("grantee_email")
+	if err := h.clusterService.RevokeClusterAccessWithAccessControl(ctx, clusterID, granteeEmail, userCtx); err != nil {
+		h.respondClusterGrantError(c, clusterIDStr, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":       "grant revoked",
+		"cluster_id":    clusterIDStr,
+		"grantee_email": granteeEmail,
 	})
 }
@@ -0,0 +1,2773 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/auth"
+	"github.com/apahim/cls-backend/internal/config"
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/services"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func TestClusterHandler_ListClusterControllersValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters/not-a-uuid/controllers", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid cluster ID should be rejected")
+}
+
+func TestClusterHandler_ListClusterControllers(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "controllers-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	reports := map[string]string{
+		"dns-controller":     "True",
+		"network-controller": "False",
+	}
+	for name, ready := range reports {
+		err = repo.Status.UpsertClusterControllerStatus(ctx, &models.ClusterControllerStatus{
+			ClusterID:          cluster.ID,
+			ControllerName:     name,
+			ObservedGeneration: 1,
+			Conditions: models.ConditionList{
+				{Type: "Ready", Status: ready, Reason: "Reported"},
+				{Type: "Available", Status: ready, Reason: "Reported"},
+			},
+		})
+		utils.AssertError(t, err, false, "Should upsert controller status", name)
+	}
+
+	path := "/api/v1/clusters/" + cluster.ID.String() + "/controllers"
+
+	w := doRequest(router, http.MethodGet, path, owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should list controllers")
+
+	var response struct {
+		ClusterID   string                     `json:"cluster_id"`
+		Controllers []models.ControllerSummary `json:"controllers"`
+	}
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 2, len(response.Controllers), "Should return each reporting controller once")
+	utils.AssertEqual(t, "dns-controller", response.Controllers[0].ControllerName, "Controllers should be sorted by name")
+	utils.AssertTrue(t, response.Controllers[0].Ready, "dns-controller should be ready")
+	utils.AssertTrue(t, response.Controllers[0].Available, "dns-controller should be available")
+	utils.AssertEqual(t, "network-controller", response.Controllers[1].ControllerName, "Second controller")
+	utils.AssertFalse(t, response.Controllers[1].Ready, "network-controller should not be ready")
+
+	// Other users cannot see the cluster's controllers
+	w = doRequest(router, http.MethodGet, path, "other@example.com", "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Other users should get 404")
+
+	// Unknown cluster
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters/"+uuid.New().String()+"/controllers", owner, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Unknown cluster should return 404")
+}
+
+func TestClusterHandler_ReconciliationPauseValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	w := doRequest(router, http.MethodPost, "/api/v1/clusters/not-a-uuid/reconciliation:pause", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid cluster ID should be rejected")
+
+	w = doRequest(router, http.MethodPost, "/api/v1/clusters/"+uuid.New().String()+"/reconciliation:restart", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Unknown custom method should return 404")
+}
+
+func TestClusterHandler_ReconciliationIntervalValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+	path := "/api/v1/clusters/" + uuid.New().String() + "/reconciliation"
+
+	w := doRequest(router, http.MethodPut, "/api/v1/clusters/not-a-uuid/reconciliation", "user@example.com", `{"reconciliation_interval":"15s"}`)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid cluster ID should be rejected")
+
+	w = doRequest(router, http.MethodPut, path, "user@example.com", `{"reconciliation_interval":"5s"}`)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Intervals below the minimum should be rejected")
+	utils.AssertContains(t, w.Body.String(), "at least 10s", "Error should name the minimum")
+
+	w = doRequest(router, http.MethodPut, path, "user@example.com", `{"reconciliation_interval":"abc"}`)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Unparseable intervals should be rejected")
+}
+
+func TestClusterHandler_ReconciliationPauseResume(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "pause-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	basePath := "/api/v1/clusters/" + cluster.ID.String() + "/reconciliation:"
+
+	// Other users cannot pause the cluster
+	w := doRequest(router, http.MethodPost, basePath+"pause", "other@example.com", "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Other users should get 404")
+
+	w = doRequest(router, http.MethodPost, basePath+"pause", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should pause reconciliation")
+	utils.AssertContains(t, w.Body.String(), `"reconciliation_paused":true`, "Response should report paused state")
+
+	paused, err := repo.Reconciliation.CountPausedClusters(ctx)
+	utils.AssertError(t, err, false, "Should count paused clusters")
+	utils.AssertEqual(t, 1, paused, "Cluster should be paused")
+
+	// Controllers can resume any cluster
+	w = doRequest(router, http.MethodPost, basePath+"resume", "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Controller should resume reconciliation")
+
+	paused, err = repo.Reconciliation.CountPausedClusters(ctx)
+	utils.AssertError(t, err, false, "Should count paused clusters")
+	utils.AssertEqual(t, 0, paused, "Cluster should be resumed")
+}
+
+func TestClusterHandler_ReconcileClusterValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	w := doRequest(router, http.MethodPost, "/api/v1/clusters/not-a-uuid/reconcile", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid cluster ID should be rejected")
+}
+
+func TestClusterHandler_ReconcileCluster(t *testing.T) {
+	repo := setupTestRepository(t)
+	publisher := &mockReconcilePublisher{}
+	router := setupTestRouterWithPublisher(repo, publisher)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "reconcile-cluster",
+		CreatedBy:  owner,
+		Generation: 2,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	path := "/api/v1/clusters/" + cluster.ID.String() + "/reconcile"
+
+	// Other users cannot reconcile the cluster
+	w := doRequest(router, http.MethodPost, path, "other@example.com", "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Other users should get 404")
+	utils.AssertEqual(t, 0, len(publisher.clusterEvents), "No event should be published for other users")
+
+	w = doRequest(router, http.MethodPost, path, owner, "")
+	utils.AssertEqual(t, http.StatusAccepted, w.Code, "Owner should trigger reconciliation")
+	utils.AssertContains(t, w.Body.String(), `"reason":"manual"`, "Response should report the reason")
+
+	utils.AssertEqual(t, 1, len(publisher.clusterEvents), "Owner's request should publish one event")
+	event := publisher.clusterEvents[0]
+	utils.AssertEqual(t, models.EventTypeClusterReconcile, event.Type, "Event should be a cluster reconcile event")
+	utils.AssertEqual(t, cluster.ID.String(), event.ClusterID, "Event should target the cluster")
+	utils.AssertEqual(t, models.ReconcileReasonManual, event.Reason, "Event should carry the manual reason")
+	utils.AssertEqual(t, int64(2), event.Generation, "Event should carry the cluster generation")
+
+	// The schedule is advanced like after a scheduled reconcile
+	schedule, err := repo.Reconciliation.GetReconciliationSchedule(ctx, cluster.ID)
+	utils.AssertError(t, err, false, "Should get reconciliation schedule")
+	utils.AssertNotNil(t, schedule.LastReconciledAt, "Schedule should record the reconciliation")
+
+	// Controllers can reconcile any cluster
+	w = doRequest(router, http.MethodPost, path, "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusAccepted, w.Code, "Controller should trigger reconciliation")
+	utils.AssertEqual(t, 2, len(publisher.clusterEvents), "Controller's request should publish an event")
+
+	w = doRequest(router, http.MethodPost, "/api/v1/clusters/"+uuid.New().String()+"/reconcile", owner, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Unknown cluster should return 404")
+}
+
+func TestClusterHandler_ClusterGrantsValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	w := doRequest(router, http.MethodPost, "/api/v1/clusters/not-a-uuid/grants", "owner@example.com", `{"grantee_email":"grantee@example.com"}`)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid cluster ID should be rejected")
+
+	w = doRequest(router, http.MethodPost, "/api/v1/clusters/"+uuid.New().String()+"/grants", "owner@example.com", `{}`)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Missing grantee should be rejected")
+}
+
+func TestClusterHandler_ClusterGrants(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	grantee := "grantee@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "shared-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	clusterPath := "/api/v1/clusters/" + cluster.ID.String()
+	grantsPath := clusterPath + "/grants"
+
+	// Before the grant the cluster is invisible to the grantee
+	w := doRequest(router, http.MethodGet, clusterPath, grantee, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Users without a grant should get 404")
+
+	// Only the owner can grant access, and only to someone else
+	w = doRequest(router, http.MethodPost, grantsPath, grantee, `{"grantee_email":"`+grantee+`"}`)
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Other users cannot grant access")
+	w = doRequest(router, http.MethodPost, grantsPath, owner, `{"grantee_email":"`+owner+`"}`)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Owner cannot grant access to themselves")
+	w = doRequest(router, http.MethodPost, grantsPath, owner, `{"grantee_email":"`+grantee+`","role":"admin"}`)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Unsupported role should be rejected")
+
+	w = doRequest(router, http.MethodPost, grantsPath, owner, `{"grantee_email":"`+grantee+`"}`)
+	utils.AssertEqual(t, http.StatusCreated, w.Code, "Owner should grant access")
+	var grant models.ClusterGrant
+	err = json.Unmarshal(w.Body.Bytes(), &grant)
+	utils.AssertError(t, err, false, "Should decode grant")
+	utils.AssertEqual(t, grantee, grant.GranteeEmail, "Grant should name the grantee")
+	utils.AssertEqual(t, models.ClusterGrantRoleViewer, grant.Role, "Role should default to viewer")
+
+	// The grantee reads the cluster they didn't create
+	w = doRequest(router, http.MethodGet, clusterPath, grantee, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Grantee should read the shared cluster")
+	utils.AssertContains(t, w.Body.String(), `"created_by":"`+owner+`"`, "Cluster should still belong to its owner")
+
+	w = doRequest(router, http.MethodGet, clusterPath+"/status", grantee, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Grantee should read the shared cluster's status")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters", grantee, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Grantee should list clusters")
+	utils.AssertContains(t, w.Body.String(), cluster.ID.String(), "Shared cluster should be listed")
+
+	// Read access does not extend to changes or to managing grants
+	w = doRequest(router, http.MethodDelete, clusterPath+"?force=true", grantee, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Grantee cannot delete the cluster")
+	w = doRequest(router, http.MethodPost, clusterPath+"/nodepools", grantee, `{"name":"workers","spec":{}}`)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Grantee cannot add nodepools")
+	w = doRequest(router, http.MethodGet, grantsPath, grantee, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Grantee cannot list grants")
+	w = doRequest(router, http.MethodDelete, grantsPath+"/"+grantee, grantee, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Grantee cannot revoke grants")
+
+	w = doRequest(router, http.MethodGet, grantsPath, owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should list grants")
+	utils.AssertContains(t, w.Body.String(), `"total":1`, "Grant should be listed")
+
+	// A revoked grantee loses access
+	w = doRequest(router, http.MethodDelete, grantsPath+"/"+grantee, owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should revoke access")
+
+	w = doRequest(router, http.MethodGet, clusterPath, grantee, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Revoked grantee should get 404")
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters", grantee, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Revoked grantee should list clusters")
+	utils.AssertFalse(t, strings.Contains(w.Body.String(), cluster.ID.String()), "Revoked cluster should not be listed")
+
+	w = doRequest(router, http.MethodDelete, grantsPath+"/"+grantee, owner, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Revoking a missing grant should return 404")
+}
+
+func TestClusterHandler_GetClusterStatusStaleControllers(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "stale-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	// Two spec updates later the cluster is at generation 3
+	cluster.Generation = 3
+	err = repo.Clusters.Update(ctx, cluster, owner)
+	utils.AssertError(t, err, false, "Should update cluster to generation 3")
+
+	observed := map[string]int64{
+		"dns-controller":     3,
+		"network-controller": 2,
+	}
+	for name, generation := range observed {
+		err = repo.Status.UpsertClusterControllerStatus(ctx, &models.ClusterControllerStatus{
+			ClusterID:          cluster.ID,
+			ControllerName:     name,
+			ObservedGeneration: generation,
+			Conditions: models.ConditionList{
+				{Type: "Ready", Status: "True", Reason: "Reported"},
+			},
+		})
+		utils.AssertError(t, err, false, "Should upsert controller status", name)
+	}
+
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters/"+cluster.ID.String()+"/status", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should get cluster status")
+
+	var response struct {
+		StaleControllers []string `json:"stale_controllers"`
+		Reconciling      bool     `json:"reconciling"`
+	}
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 1, len(response.StaleControllers), "Only the controller behind generation 3 should be stale")
+	utils.AssertEqual(t, "network-controller", response.StaleControllers[0], "Controller reporting generation 2 should be stale")
+	utils.AssertTrue(t, response.Reconciling, "Cluster with stale controllers should be reconciling")
+
+	// Once the controller catches up the cluster is no longer reconciling
+	err = repo.Status.UpsertClusterControllerStatus(ctx, &models.ClusterControllerStatus{
+		ClusterID:          cluster.ID,
+		ControllerName:     "network-controller",
+		ObservedGeneration: 3,
+		Conditions: models.ConditionList{
+			{Type: "Ready", Status: "True", Reason: "Reported"},
+		},
+	})
+	utils.AssertError(t, err, false, "Should upsert caught-up controller status")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters/"+cluster.ID.String()+"/status", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should get cluster status")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 0, len(response.StaleControllers), "No controllers should be stale")
+	utils.AssertFalse(t, response.Reconciling, "Cluster should not be reconciling")
+}
+
+func TestClusterHandler_GetClusterStatusErrors(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "erroring-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	now := time.Now().UTC().Truncate(time.Second)
+	reported := []struct {
+		controller string
+		message    string
+		timestamp  time.Time
+	}{
+		{"dns-controller", "DNS zone not found", now.Add(-time.Hour)},
+		{"network-controller", "GCP API quota exceeded", now},
+	}
+	for _, r := range reported {
+		err = repo.Status.UpsertClusterControllerStatus(ctx, &models.ClusterControllerStatus{
+			ClusterID:          cluster.ID,
+			ControllerName:     r.controller,
+			ObservedGeneration: 1,
+			Conditions: models.ConditionList{
+				{Type: "Ready", Status: "False", Reason: "Error"},
+			},
+			LastError: &models.ErrorInfo{
+				ErrorType: models.ErrorTypeTransient,
+				ErrorCode: "TEST_ERROR",
+				Message:   r.message,
+				Timestamp: r.timestamp,
+			},
+		})
+		utils.AssertError(t, err, false, "Should upsert erroring controller status", r.controller)
+	}
+
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters/"+cluster.ID.String()+"/status", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should get cluster status")
+
+	var response struct {
+		Errors []models.ErrorInfo `json:"errors"`
+	}
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 2, len(response.Errors), "Both controller errors should be reported")
+	utils.AssertEqual(t, "network-controller", response.Errors[0].ControllerName, "Newest error should be first")
+	utils.AssertEqual(t, "GCP API quota exceeded", response.Errors[0].Message, "Newest error message should be reported")
+	utils.AssertEqual(t, "dns-controller", response.Errors[1].ControllerName, "Older error should be last")
+}
+
+func TestClusterHandler_GetClusterStatusDedupesErrors(t *testing.T) {
+	repo := setupTestRepository(t)
+	repo.Status.SetCollapseErrors(false)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "repeating-errors-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	// One nodepool controller fails the same way on two nodepools
+	now := time.Now().UTC().Truncate(time.Second)
+	for i, name := range []string{"workers-a", "workers-b"} {
+		nodepool := &models.NodePool{
+			ID:              uuid.New(),
+			ClusterID:       cluster.ID,
+			Name:            name,
+			CreatedBy:       owner,
+			Generation:      1,
+			ResourceVersion: uuid.New().String(),
+		}
+		err = repo.NodePools.Create(ctx, nodepool)
+		utils.AssertError(t, err, false, "Should create nodepool", name)
+
+		err = repo.Status.UpsertNodePoolControllerStatus(ctx, &models.NodePoolControllerStatus{
+			NodePoolID:         nodepool.ID,
+			ControllerName:     "machine-controller",
+			ObservedGeneration: 1,
+			Conditions: models.ConditionList{
+				{Type: "Ready", Status: "False", Reason: "Error"},
+			},
+			LastError: &models.ErrorInfo{
+				ErrorType: models.ErrorTypeTransient,
+				ErrorCode: "QUOTA_EXCEEDED",
+				Message:   "GCP API quota exceeded",
+				Timestamp: now.Add(time.Duration(i) * time.Minute),
+			},
+		})
+		utils.AssertError(t, err, false, "Should upsert erroring nodepool controller status", name)
+	}
+
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters/"+cluster.ID.String()+"/status", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should get cluster status")
+
+	var response struct {
+		Errors []models.ErrorInfo `json:"errors"`
+	}
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 1, len(response.Errors), "Repeated controller error should be reported once without collapsing")
+	utils.AssertEqual(t, "machine-controller", response.Errors[0].ControllerName, "Error should name its controller")
+	utils.AssertTrue(t, now.Add(time.Minute).Equal(response.Errors[0].Timestamp), "Error should keep the latest timestamp")
+}
+
+func TestClusterHandler_NodePoolReportsMarkClusterDirty(t *testing.T) {
+	repo := setupTestRepository(t)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "nodepool-dirty-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	nodepool := &models.NodePool{
+		ID:              uuid.New(),
+		ClusterID:       cluster.ID,
+		Name:            "workers",
+		CreatedBy:       owner,
+		Generation:      1,
+		ResourceVersion: uuid.New().String(),
+	}
+	err = repo.NodePools.Create(ctx, nodepool)
+	utils.AssertError(t, err, false, "Should create nodepool")
+
+	report := func(available string) {
+		err := repo.Status.UpsertNodePoolControllerStatus(ctx, &models.NodePoolControllerStatus{
+			NodePoolID:         nodepool.ID,
+			ControllerName:     "machine-controller",
+			ObservedGeneration: 1,
+			Conditions: models.ConditionList{
+				{Type: "Available", Status: available, Reason: "Reported"},
+			},
+		})
+		utils.AssertError(t, err, false, "Should upsert nodepool controller status", available)
+	}
+	markClean := func() time.Time {
+		var updatedAt time.Time
+		err := repo.GetClient().QueryRowContext(ctx,
+			`UPDATE clusters SET status_dirty = FALSE WHERE id = $1 RETURNING updated_at`, cluster.ID).Scan(&updatedAt)
+		utils.AssertError(t, err, false, "Should mark cluster clean")
+		return updatedAt
+	}
+	clusterState := func() (bool, time.Time) {
+		var dirty bool
+		var updatedAt time.Time
+		err := repo.GetClient().QueryRowContext(ctx,
+			`SELECT status_dirty, updated_at FROM clusters WHERE id = $1`, cluster.ID).Scan(&dirty, &updatedAt)
+		utils.AssertError(t, err, false, "Should read cluster")
+		return dirty, updatedAt
+	}
+
+	report("True")
+	dirty, _ := clusterState()
+	utils.AssertTrue(t, dirty, "First nodepool report should mark the cluster dirty")
+
+	// Repeating the stored state leaves the cluster row alone
+	cleanAt := markClean()
+	report("True")
+	dirty, updatedAt := clusterState()
+	utils.AssertFalse(t, dirty, "Repeated nodepool report should not mark the cluster dirty")
+	utils.AssertTrue(t, cleanAt.Equal(updatedAt), "Repeated nodepool report should not bump the cluster's updated_at")
+
+	// A change the rollup counts marks the cluster dirty
+	report("False")
+	dirty, _ = clusterState()
+	utils.AssertTrue(t, dirty, "Nodepool becoming unavailable should mark the cluster dirty")
+}
+
+func TestClusterHandler_GetClusterStatusTypedLastError(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "typed-error-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	// The reported error omits the controller name, which is filled in from the report
+	err = repo.Status.UpsertClusterControllerStatus(ctx, &models.ClusterControllerStatus{
+		ClusterID:          cluster.ID,
+		ControllerName:     "dns-controller",
+		ObservedGeneration: 1,
+		Conditions: models.ConditionList{
+			{Type: "Ready", Status: "False", Reason: "Error"},
+		},
+		LastError: &models.ErrorInfo{
+			ErrorType:      models.ErrorTypeConfiguration,
+			ErrorCode:      "ZONE_NOT_FOUND",
+			Message:        "DNS zone not found",
+			UserActionable: true,
+			Suggestions:    []string{"Create the zone", "Check spec.dns.baseDomain"},
+			Details:        map[string]string{"zone": "example-com"},
+			Timestamp:      time.Now().UTC().Truncate(time.Second),
+		},
+	})
+	utils.AssertError(t, err, false, "Should upsert controller status")
+
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters/"+cluster.ID.String()+"/status", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should get cluster status")
+
+	var response struct {
+		ControllerStatus []struct {
+			ControllerName string                     `json:"controller_name"`
+			LastError      map[string]json.RawMessage `json:"last_error"`
+		} `json:"controller_status"`
+	}
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 1, len(response.ControllerStatus), "Controller status should be reported")
+
+	lastError := response.ControllerStatus[0].LastError
+	utils.AssertEqual(t, `"Configuration"`, string(lastError["errorType"]), "Error type should be a typed field")
+	utils.AssertEqual(t, `true`, string(lastError["userActionable"]), "User actionable should be a typed field")
+	utils.AssertEqual(t, `["Create the zone","Check spec.dns.baseDomain"]`, string(lastError["suggestions"]), "Suggestions should be a typed list")
+	utils.AssertEqual(t, `{"zone":"example-com"}`, string(lastError["details"]), "Details should be a typed map")
+	utils.AssertEqual(t, `"dns-controller"`, string(lastError["controllerName"]), "Controller name should be filled in")
+}
+
+func TestClusterHandler_GetClusterStatusAllConditions(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "conditions-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	reported := map[string]models.ConditionList{
+		"dns-controller": {
+			{Type: "Ready", Status: "True", Reason: "ZoneCreated"},
+			{Type: "Available", Status: "True", Reason: "Resolving"},
+		},
+		"network-controller": {
+			{Type: "Ready", Status: "False", Reason: "QuotaExceeded"},
+		},
+	}
+	for name, conditions := range reported {
+		err = repo.Status.UpsertClusterControllerStatus(ctx, &models.ClusterControllerStatus{
+			ClusterID:          cluster.ID,
+			ControllerName:     name,
+			ObservedGeneration: 1,
+			Conditions:         conditions,
+		})
+		utils.AssertError(t, err, false, "Should upsert controller status", name)
+	}
+
+	statusPath := "/api/v1/clusters/" + cluster.ID.String() + "/status"
+	var response struct {
+		AllConditions []models.ControllerCondition `json:"all_conditions"`
+	}
+
+	w := doRequest(router, http.MethodGet, statusPath, owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should get cluster status")
+	utils.AssertFalse(t, strings.Contains(w.Body.String(), `"all_conditions"`), "Flattened conditions should be opt-in")
+
+	w = doRequest(router, http.MethodGet, statusPath+"?include=all_conditions", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should get cluster status")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 3, len(response.AllConditions), "Every controller condition should be flattened")
+	utils.AssertEqual(t, "dns-controller", response.AllConditions[0].ControllerName, "Conditions should be tagged with their controller")
+	utils.AssertEqual(t, "Ready", response.AllConditions[0].Type, "First dns-controller condition")
+	utils.AssertEqual(t, "Available", response.AllConditions[1].Type, "Second dns-controller condition")
+	utils.AssertEqual(t, "network-controller", response.AllConditions[2].ControllerName, "Conditions should be tagged with their controller")
+	utils.AssertEqual(t, "QuotaExceeded", response.AllConditions[2].Reason, "network-controller condition reason")
+}
+
+func TestClusterHandler_GetClusterStatusFieldsValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters/"+uuid.New().String()+"/status?fields=verbose", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Unknown fields value should be rejected")
+	utils.AssertContains(t, w.Body.String(), `fields must be \"summary\" or \"full\"`, "Error should list the accepted values")
+}
+
+func TestClusterHandler_GetClusterStatusFields(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "fields-cluster",
+		CreatedBy:  owner,
+		Generation: 2,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	for name, generation := range map[string]int64{"dns-controller": 2, "network-controller": 1} {
+		err = repo.Status.UpsertClusterControllerStatus(ctx, &models.ClusterControllerStatus{
+			ClusterID:          cluster.ID,
+			ControllerName:     name,
+			ObservedGeneration: generation,
+			Conditions:         models.ConditionList{{Type: "Ready", Status: "True", Reason: "Reconciled"}},
+			Metadata:           models.JSONB{"region": "us-central1"},
+		})
+		utils.AssertError(t, err, false, "Should upsert controller status", name)
+	}
+
+	statusPath := "/api/v1/clusters/" + cluster.ID.String() + "/status"
+
+	// The summary carries the aggregated status and counts but no per-controller detail
+	w := doRequest(router, http.MethodGet, statusPath+"?fields=summary&include=all_conditions", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should get the status summary")
+	var summary map[string]interface{}
+	err = json.Unmarshal(w.Body.Bytes(), &summary)
+	utils.AssertError(t, err, false, "Should decode summary")
+	for _, omitted := range []string{"controller_status", "errors", "stale_controllers", "all_conditions"} {
+		_, ok := summary[omitted]
+		utils.AssertFalse(t, ok, "Summary should omit per-controller detail", omitted)
+	}
+	utils.AssertNotNil(t, summary["status"], "Summary should include the aggregated status")
+	utils.AssertEqual(t, float64(2), summary["controller_count"], "Summary should count the controllers")
+	utils.AssertEqual(t, float64(1), summary["stale_controller_count"], "Summary should count the stale controllers")
+	utils.AssertEqual(t, true, summary["reconciling"], "Summary should report reconciling")
+
+	// Full is the default and includes every controller report with its metadata
+	for _, query := range []string{"", "?fields=full"} {
+		w = doRequest(router, http.MethodGet, statusPath+query, owner, "")
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should get the full status", query)
+		var full struct {
+			ControllerStatus []models.ClusterControllerStatus `json:"controller_status"`
+			ControllerCount  *int                             `json:"controller_count"`
+		}
+		err = json.Unmarshal(w.Body.Bytes(), &full)
+		utils.AssertError(t, err, false, "Should decode full status", query)
+		utils.AssertEqual(t, 2, len(full.ControllerStatus), "Full status should include every controller", query)
+		utils.AssertEqual(t, "us-central1", full.ControllerStatus[0].Metadata["region"], "Full status should include controller metadata", query)
+		utils.AssertNil(t, full.ControllerCount, "Full status should not add summary counts", query)
+	}
+}
+func TestClusterHandler_ListClusterTransitions(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "transitioning-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	transitionsPath := "/api/v1/clusters/" + cluster.ID.String() + "/transitions"
+	var response struct {
+		Transitions []models.PhaseTransition `json:"transitions"`
+	}
+
+	// The first aggregation records the initial phase
+	w := doRequest(router, http.MethodGet, transitionsPath, owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should list transitions")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 1, len(response.Transitions), "Initial phase should be recorded")
+	utils.AssertEqual(t, "", response.Transitions[0].FromPhase, "Initial transition has no previous phase")
+	utils.AssertEqual(t, "Pending", response.Transitions[0].ToPhase, "Cluster without controllers should be pending")
+
+	// Recomputing the same phase records nothing
+	err = repo.Clusters.MarkDirtyStatus(ctx, cluster.ID)
+	utils.AssertError(t, err, false, "Should mark status dirty")
+	w = doRequest(router, http.MethodGet, transitionsPath, owner, "")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 1, len(response.Transitions), "Unchanged phase should not be recorded")
+
+	err = repo.Status.UpsertClusterControllerStatus(ctx, &models.ClusterControllerStatus{
+		ClusterID:          cluster.ID,
+		ControllerName:     "dns-controller",
+		ObservedGeneration: 1,
+		Conditions: models.ConditionList{
+			{Type: "Available", Status: "True", Reason: "Reported"},
+		},
+	})
+	utils.AssertError(t, err, false, "Should upsert controller status")
+	err = repo.Clusters.MarkDirtyStatus(ctx, cluster.ID)
+	utils.AssertError(t, err, false, "Should mark status dirty")
+
+	w = doRequest(router, http.MethodGet, transitionsPath, owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should list transitions")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 2, len(response.Transitions), "Phase change should be recorded")
+	utils.AssertEqual(t, "Pending", response.Transitions[1].FromPhase, "Transition should record the old phase")
+	utils.AssertEqual(t, "Ready", response.Transitions[1].ToPhase, "Transition should record the new phase")
+	utils.AssertFalse(t, response.Transitions[1].TransitionedAt.Before(response.Transitions[0].TransitionedAt), "Transitions should be chronological")
+
+	// Other users cannot read the timeline
+	w = doRequest(router, http.MethodGet, transitionsPath, "other@example.com", "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Other users' clusters should look missing")
+}
+
+func TestClusterHandler_ListClusterTransitionsValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters/not-a-uuid/transitions", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid cluster ID should be rejected")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters/"+uuid.New().String()+"/transitions?limit=0", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid limit should be rejected")
+}
+
+func TestClusterHandler_ListClusterEvents(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "events-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	// Seed a mix of event types, one minute apart so the newest-first order is deterministic
+	base := time.Now().Add(-time.Hour)
+	eventTypes := []string{"created", "updated", "reconcile", "updated", "reconcile", "updated", "deleted"}
+	for i, eventType := range eventTypes {
+		err := repo.Status.CreateClusterEvent(ctx, &models.ClusterEvent{
+			ClusterID:   cluster.ID,
+			EventType:   eventType,
+			PublishedAt: base.Add(time.Duration(i) * time.Minute),
+		})
+		utils.AssertError(t, err, false, "Should create cluster event")
+	}
+
+	type eventsResponse struct {
+		Events  []models.ClusterEvent `json:"events"`
+		Total   int64                 `json:"total"`
+		Limit   int                   `json:"limit"`
+		Offset  int                   `json:"offset"`
+		HasMore bool                  `json:"has_more"`
+	}
+	list := func(query string) eventsResponse {
+		w := doRequest(router, http.MethodGet, "/api/v1/clusters/"+cluster.ID.String()+"/events"+query, owner, "")
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Should list events", query)
+
+		var response eventsResponse
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		utils.AssertError(t, err, false, "Should decode response", query)
+		return response
+	}
+
+	// Listing aggregates the cluster's status first, which records its initial phase as the newest event
+	response := list("")
+	utils.AssertEqual(t, int64(len(eventTypes)+1), response.Total, "Total should count every event")
+	utils.AssertEqual(t, len(eventTypes)+1, len(response.Events), "Default page should hold every event")
+	utils.AssertEqual(t, models.EventTypePhaseTransition, response.Events[0].EventType, "Newest event should be first")
+	utils.AssertEqual(t, "deleted", response.Events[1].EventType, "Seeded events should follow newest first")
+
+	// The type filter applies to both the page and the total
+	response = list("?event_type=updated")
+	utils.AssertEqual(t, int64(3), response.Total, "Total should count matching events only")
+	utils.AssertEqual(t, 3, len(response.Events), "Only matching events should be listed")
+	for _, event := range response.Events {
+		utils.AssertEqual(t, "updated", event.EventType, "Filtered events should have the requested type")
+	}
+
+	// Pages follow each other without overlap
+	first := list("?limit=3")
+	utils.AssertEqual(t, 3, len(first.Events), "First page should be full")
+	utils.AssertTrue(t, first.HasMore, "First page should have more")
+
+	last := list("?limit=3&offset=6")
+	utils.AssertEqual(t, 2, len(last.Events), "Last page should hold the remainder")
+	utils.AssertFalse(t, last.HasMore, "Last page should not have more")
+	utils.AssertEqual(t, "created", last.Events[1].EventType, "Oldest event should be last")
+
+	filtered := list("?event_type=reconcile&limit=1&offset=1")
+	utils.AssertEqual(t, int64(2), filtered.Total, "Filtered total should ignore pagination")
+	utils.AssertEqual(t, 1, len(filtered.Events), "Filtered page should hold one event")
+	utils.AssertEqual(t, "reconcile", filtered.Events[0].EventType, "Filtered page should keep the type")
+
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters/"+cluster.ID.String()+"/events", "other@example.com", "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Other users cannot list the cluster's events")
+}
+
+func TestClusterHandler_ConcurrentStatusReports(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "concurrent-status-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	statusPath := "/api/v1/clusters/" + cluster.ID.String() + "/status"
+	controller := "controller@system.local"
+	body := func(status string) string {
+		return `{"controller_name":"dns-controller","observed_generation":1,` +
+			`"conditions":[{"type":"Ready","status":"` + status + `","reason":"Reported"}]}`
+	}
+
+	w := doRequest(router, http.MethodPut, statusPath, controller, body("False"))
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Initial status report should be stored")
+
+	// Concurrent reports of the same transition merge one after another, so only the first
+	// records it
+	const reports = 5
+	var wg sync.WaitGroup
+	codes := make([]int, reports)
+	for i := range codes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			codes[i] = doRequest(router, http.MethodPut, statusPath, controller, body("True")).Code
+		}(i)
+	}
+	wg.Wait()
+	for _, code := range codes {
+		utils.AssertEqual(t, http.StatusOK, code, "Concurrent status report should be stored")
+	}
+
+	entries, err := repo.Status.ListClusterStatusHistory(ctx, cluster.ID, "dns-controller", 100)
+	utils.AssertError(t, err, false, "Should list status history")
+	utils.AssertEqual(t, 2, len(entries), "The transition should be recorded once")
+}
+
+func TestClusterHandler_ListClusterStatusHistoryValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+	path := "/api/v1/clusters/" + uuid.New().String() + "/status/history"
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "invalid cluster ID", path: "/api/v1/clusters/not-a-uuid/status/history"},
+		{name: "non-numeric limit", path: path + "?limit=abc"},
+		{name: "zero limit", path: path + "?limit=0"},
+		{name: "limit too large", path: path + "?limit=1001"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := doRequest(router, http.MethodGet, tt.path, "user@example.com", "")
+			utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid history request should be rejected")
+		})
+	}
+}
+
+func TestClusterHandler_ListClusterStatusHistory(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "history-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	statusPath := "/api/v1/clusters/" + cluster.ID.String() + "/status"
+	controller := "controller@system.local"
+	report := func(controllerName, status, reason string) {
+		body := fmt.Sprintf(`{"controller_name":"%s","observed_generation":1,`+
+			`"conditions":[{"type":"Ready","status":"%s","reason":"%s"}]}`, controllerName, status, reason)
+		w := doRequest(router, http.MethodPut, statusPath, controller, body)
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Status report should be stored", controllerName, status)
+	}
+	history := func(query string) []models.ControllerStatusHistoryEntry {
+		w := doRequest(router, http.MethodGet, statusPath+"/history"+query, owner, "")
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should list status history", query)
+		var resp struct {
+			History []models.ControllerStatusHistoryEntry `json:"history"`
+		}
+		err := json.Unmarshal(w.Body.Bytes(), &resp)
+		utils.AssertError(t, err, false, "Should decode status history")
+		return resp.History
+	}
+
+	// The first report of a condition is its first history entry
+	report("dns-controller", "False", "Provisioning")
+	entries := history("")
+	utils.AssertEqual(t, 1, len(entries), "First report should record a history entry")
+	utils.AssertEqual(t, "", entries[0].FromStatus, "First entry has no previous status")
+	utils.AssertEqual(t, "False", entries[0].ToStatus, "First entry should record the reported status")
+
+	// An identical report, or one changing only the reason, does not append history
+	report("dns-controller", "False", "Provisioning")
+	report("dns-controller", "False", "StillProvisioning")
+	utils.AssertEqual(t, 1, len(history("")), "Reports without a status change should not record history")
+
+	// A status change appends an entry
+	report("dns-controller", "True", "Provisioned")
+	entries = history("")
+	utils.AssertEqual(t, 2, len(entries), "Status change should record a history entry")
+	utils.AssertEqual(t, "dns-controller", entries[0].ControllerName, "Entry should name the controller")
+	utils.AssertEqual(t, "Ready", entries[0].ConditionType, "Entry should name the condition")
+	utils.AssertEqual(t, "False", entries[0].FromStatus, "Entry should record the previous status")
+	utils.AssertEqual(t, "True", entries[0].ToStatus, "Entry should record the new status")
+	utils.AssertEqual(t, "Provisioned", entries[0].Reason, "Entry should record the new reason")
+	utils.AssertEqual(t, int64(1), entries[0].ObservedGeneration, "Entry should record the observed generation")
+
+	// History can be narrowed to one controller
+	report("network-controller", "False", "QuotaExceeded")
+	utils.AssertEqual(t, 3, len(history("")), "History should cover every controller")
+	entries = history("?controller_name=network-controller")
+	utils.AssertEqual(t, 1, len(entries), "Filtered history should only hold the controller's entries")
+	utils.AssertEqual(t, "network-controller", entries[0].ControllerName, "Filtered entry should name the controller")
+
+	// History is trimmed to the newest entries per controller
+	repo.Status.SetStatusHistoryLimit(2)
+	report("dns-controller", "False", "Degraded")
+	entries = history("?controller_name=dns-controller")
+	utils.AssertEqual(t, 2, len(entries), "History should be trimmed to the limit")
+	utils.AssertEqual(t, "False", entries[0].ToStatus, "Newest entry should be kept")
+	utils.AssertEqual(t, "True", entries[1].ToStatus, "Oldest entry should be trimmed")
+	utils.AssertEqual(t, 1, len(history("?controller_name=network-controller")), "Other controllers should not be trimmed")
+
+	w := doRequest(router, http.MethodGet, statusPath+"/history", "other@example.com", "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Other users cannot list the cluster's status history")
+}
+
+func TestClusterHandler_ListClusterEventsValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+	eventsPath := "/api/v1/clusters/" + uuid.New().String() + "/events"
+
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters/not-a-uuid/events", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid cluster ID should be rejected")
+
+	for _, query := range []string{"?limit=0", "?limit=1001", "?limit=abc", "?offset=-1", "?offset=abc"} {
+		w = doRequest(router, http.MethodGet, eventsPath+query, "user@example.com", "")
+		utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid pagination should be rejected", query)
+	}
+}
+
+func TestClusterHandler_GetClusterFullViewValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters/not-a-uuid/full", "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid cluster ID should be rejected")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters/"+uuid.New().String()+"/full", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusForbidden, w.Code, "Regular users cannot fetch the full cluster view")
+}
+
+func TestClusterHandler_GetClusterFullView(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	controller := "controller@system.local"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "full-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	nodepool := &models.NodePool{
+		ID:              uuid.New(),
+		ClusterID:       cluster.ID,
+		Name:            "workers",
+		CreatedBy:       owner,
+		Generation:      1,
+		ResourceVersion: uuid.New().String(),
+	}
+	err = repo.NodePools.Create(ctx, nodepool)
+	utils.AssertError(t, err, false, "Should create nodepool")
+
+	err = repo.Status.UpsertClusterControllerStatus(ctx, &models.ClusterControllerStatus{
+		ClusterID:          cluster.ID,
+		ControllerName:     "dns-controller",
+		ObservedGeneration: 1,
+		Conditions: models.ConditionList{
+			{Type: "Ready", Status: "True", Reason: "Reported"},
+		},
+	})
+	utils.AssertError(t, err, false, "Should upsert cluster controller status")
+
+	err = repo.Status.UpsertNodePoolControllerStatus(ctx, &models.NodePoolControllerStatus{
+		NodePoolID:         nodepool.ID,
+		ControllerName:     "machine-controller",
+		ObservedGeneration: 1,
+		Conditions: models.ConditionList{
+			{Type: "Ready", Status: "True", Reason: "Reported"},
+		},
+	})
+	utils.AssertError(t, err, false, "Should upsert nodepool controller status")
+
+	path := "/api/v1/clusters/" + cluster.ID.String() + "/full"
+
+	w := doRequest(router, http.MethodGet, path, controller, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Controller should fetch the full cluster view")
+
+	var view models.ClusterFullView
+	err = json.Unmarshal(w.Body.Bytes(), &view)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertNotNil(t, view.Cluster, "Response should include the cluster")
+	utils.AssertEqual(t, cluster.ID, view.Cluster.ID, "Response should include the requested cluster")
+	utils.AssertNotNil(t, view.Status, "Response should include the aggregated status")
+	utils.AssertEqual(t, 1, len(view.NodePools), "Response should include the cluster's nodepools")
+	utils.AssertEqual(t, nodepool.ID, view.NodePools[0].ID, "Response should include the created nodepool")
+	utils.AssertEqual(t, 1, len(view.ControllerStatus), "Response should include cluster controller status")
+	utils.AssertEqual(t, "dns-controller", view.ControllerStatus[0].ControllerName, "Cluster controller status")
+	utils.AssertEqual(t, 1, len(view.NodePoolControllerStatus), "Response should include nodepool controller status")
+	utils.AssertEqual(t, "machine-controller", view.NodePoolControllerStatus[0].ControllerName, "Nodepool controller status")
+
+	// Even the owner cannot use the controller-only endpoint
+	w = doRequest(router, http.MethodGet, path, owner, "")
+	utils.AssertEqual(t, http.StatusForbidden, w.Code, "Owner should be denied")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters/"+uuid.New().String()+"/full", controller, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Unknown cluster should return 404")
+}
+
+// doCreateCluster posts a cluster create request with an optional Idempotency-Key header
+func doCreateCluster(router *gin.Engine, userEmail, idempotencyKey, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/clusters", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-User-Email", userEmail)
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestClusterHandler_ImageRegistryAllowlist(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	clusterService := services.NewClusterService(nil, nil, "4.16.0", "stable")
+	clusterService.SetAllowedImageRegistries([]string{"quay.io"})
+	cfg := &config.Config{Auth: config.AuthConfig{Enabled: true}}
+	router := setupRouter(cfg, auth.NewHeaderAuthenticator(), NewClusterHandler(clusterService, nil), NewNodePoolHandler(nil, nil), NewFailedEventHandler(nil, nil), NewReconcileTargetHandler(nil), NewAuditHandler(nil), NewDatabasePoolHandler(nil))
+
+	body := `{"name":"registry-cluster","spec":{"platform":{"type":"AWS"},"release":{"image":"docker.io/library/ocp-release:4.16.0"}}}`
+	w := doCreateCluster(router, "owner@example.com", "", body)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Create from a disallowed registry should be rejected")
+	utils.AssertContains(t, w.Body.String(), `"field":"release.image"`, "Error should list the release image field")
+
+	w = doRequest(router, http.MethodPut, "/api/v1/clusters/"+uuid.New().String(), "owner@example.com", `{"spec":{"platform":{"type":"AWS"},"release":{"image":"docker.io/library/ocp-release:4.16.0"}}}`)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Update to a disallowed registry should be rejected")
+	utils.AssertContains(t, w.Body.String(), `"field":"release.image"`, "Error should list the release image field")
+}
+
+func TestClusterHandler_CreateClusterDryRunValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	// Validation errors surface under dry-run just as for a real create
+	w := doRequest(router, http.MethodPost, "/api/v1/clusters?dry_run=true", "user@example.com",
+		`{"name":"dry-run-cluster","spec":{"networking":{"podCIDR":"not-a-cidr"}}}`)
+	utils.AssertEqual(t, http.StatusUnprocessableEntity, w.Code, "Invalid spec should be rejected under dry-run")
+	utils.AssertContains(t, w.Body.String(), "networking.podCIDR", "Error should name the invalid field")
+
+	w = doRequest(router, http.MethodPost, "/api/v1/clusters?dry_run=true", "user@example.com", `{"spec":{}}`)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Missing name should be rejected under dry-run")
+}
+
+func TestClusterHandler_SpecValidationStatusCodes(t *testing.T) {
+	router := setupTestRouter(nil)
+	updatePath := "/api/v1/clusters/" + uuid.New().String()
+
+	// Malformed JSON is a client serializer bug
+	w := doRequest(router, http.MethodPost, "/api/v1/clusters", "user@example.com", `{"name":"bad-json","spec":{`)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Malformed JSON should be rejected on create")
+
+	w = doRequest(router, http.MethodPut, updatePath, "user@example.com", `{"spec":{"networking":{"podCIDR":10}}}`)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Mistyped JSON should be rejected on update")
+
+	// A parseable spec with bad values lists every failing field
+	var response struct {
+		Error       string                 `json:"error"`
+		FieldErrors utils.ValidationErrors `json:"field_errors"`
+	}
+	w = doRequest(router, http.MethodPost, "/api/v1/clusters", "user@example.com",
+		`{"name":"bad-spec","spec":{"release":{"image":"ocp-release"},"networking":{"podCIDR":"not-a-cidr"}}}`)
+	utils.AssertEqual(t, http.StatusUnprocessableEntity, w.Code, "Invalid spec should be unprocessable on create")
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 2, len(response.FieldErrors), "Both invalid fields should be listed")
+	utils.AssertEqual(t, "release.image", response.FieldErrors[0].Field, "Release image should be listed")
+	utils.AssertEqual(t, "networking.podCIDR", response.FieldErrors[1].Field, "Pod CIDR should be listed")
+	utils.AssertContains(t, response.Error, "networking.podCIDR", "Error should name the invalid field")
+
+	w = doRequest(router, http.MethodPut, updatePath, "user@example.com", `{"spec":{"networking":{"podCIDR":"not-a-cidr"}}}`)
+	utils.AssertEqual(t, http.StatusUnprocessableEntity, w.Code, "Invalid spec should be unprocessable on update")
+	utils.AssertContains(t, w.Body.String(), `"field":"networking.podCIDR"`, "Update should list the invalid field")
+
+	// Request-level validation failures use the same field_errors array
+	for _, name := range []string{"Prod", "prod_cluster", "-prod", strings.Repeat("a", 64)} {
+		w = doRequest(router, http.MethodPost, "/api/v1/clusters", "user@example.com", `{"name":"`+name+`","spec":{}}`)
+		utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid cluster name should be rejected", name)
+		utils.AssertContains(t, w.Body.String(), `"field":"name"`, "Invalid name should be listed", name)
+	}
+
+	w = doRequest(router, http.MethodPost, "/api/v1/clusters", "user@example.com",
+		`{"name":"bad-infra","spec":{"infraID":"Bad_Infra","platform":{"type":"GCP"}}}`)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid infraID should be rejected")
+	utils.AssertContains(t, w.Body.String(), `"field":"infraID"`, "Invalid infraID should be listed")
+}
+
+func TestClusterHandler_CreateClusterDryRun(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	body := `{"name":"dry-run-cluster","spec":{"platform":{"type":"AWS"},"release":{"version":"4.16.0","channelGroup":"stable"}}}`
+
+	w := doRequest(router, http.MethodPost, "/api/v1/clusters?dry_run=true", owner, body)
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Dry-run should return 200")
+
+	var preview models.Cluster
+	err := json.Unmarshal(w.Body.Bytes(), &preview)
+	utils.AssertError(t, err, false, "Should decode would-be cluster")
+	utils.AssertEqual(t, "dry-run-cluster", preview.Name, "Would-be cluster should carry the name")
+	utils.AssertEqual(t, owner, preview.CreatedBy, "Would-be cluster should belong to the caller")
+	utils.AssertEqual(t, int64(1), preview.Generation, "Would-be cluster should start at generation 1")
+
+	// Nothing was stored
+	_, err = repo.Clusters.GetByIDWithoutFilter(ctx, preview.ID)
+	utils.AssertEqual(t, models.ErrClusterNotFound, err, "Dry-run should not create the cluster")
+	exists, err := repo.Clusters.NameExists(ctx, "dry-run-cluster", owner)
+	utils.AssertError(t, err, false, "Should check cluster name")
+	utils.AssertFalse(t, exists, "Dry-run should not claim the name")
+
+	// Once the name is taken, dry-run reports the conflict a real create would hit
+	w = doCreateCluster(router, owner, "", body)
+	utils.AssertEqual(t, http.StatusCreated, w.Code, "Real create should succeed")
+
+	w = doRequest(router, http.MethodPost, "/api/v1/clusters?dry_run=true", owner, body)
+	utils.AssertEqual(t, http.StatusConflict, w.Code, "Dry-run with a taken name should conflict")
+	utils.AssertContains(t, w.Body.String(), "CLUSTER_NAME_EXISTS", "Conflict should name the cause")
+
+	// Names are unique per user
+	w = doRequest(router, http.MethodPost, "/api/v1/clusters?dry_run=true", "other@example.com", body)
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Another user's dry-run with the same name should pass")
+}
+
+func TestClusterHandler_CreateClusterIdempotencyKeyValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	w := doCreateCluster(router, "user@example.com", strings.Repeat("k", 256), `{"name":"idem-cluster","spec":{}}`)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Overlong idempotency key should be rejected")
+}
+
+func TestClusterHandler_CreateClusterIdempotencyKey(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	body := `{"name":"idem-cluster","spec":{"platform":{"type":"AWS"},"release":{"version":"4.16.0","channelGroup":"stable"}}}`
+
+	w := doCreateCluster(router, owner, "retry-key-1", body)
+	utils.AssertEqual(t, http.StatusCreated, w.Code, "First create should succeed")
+	utils.AssertEqual(t, "", w.Header().Get("Idempotent-Replayed"), "First create should not be a replay")
+
+	var first models.Cluster
+	err := json.Unmarshal(w.Body.Bytes(), &first)
+	utils.AssertError(t, err, false, "Should decode created cluster")
+
+	// Retrying with the same key returns the original cluster instead of a 409
+	w = doCreateCluster(router, owner, "retry-key-1", body)
+	utils.AssertEqual(t, http.StatusCreated, w.Code, "Repeated create should return the original response")
+	utils.AssertEqual(t, "true", w.Header().Get("Idempotent-Replayed"), "Repeated create should be marked as a replay")
+
+	var second models.Cluster
+	err = json.Unmarshal(w.Body.Bytes(), &second)
+	utils.AssertError(t, err, false, "Should decode replayed cluster")
+	utils.AssertEqual(t, first.ID, second.ID, "Repeated create should return the same cluster")
+
+	count, err := repo.Clusters.Count(ctx, owner)
+	utils.AssertError(t, err, false, "Should count clusters")
+	utils.AssertEqual(t, int64(1), count, "Only one cluster should be created")
+
+	// Reusing the key for a different cluster is rejected
+	w = doCreateCluster(router, owner, "retry-key-1", strings.Replace(body, "idem-cluster", "other-cluster", 1))
+	utils.AssertEqual(t, http.StatusUnprocessableEntity, w.Code, "Key reuse for a different cluster should be rejected")
+
+	// Without a key a repeat is a plain duplicate
+	w = doCreateCluster(router, owner, "", body)
+	utils.AssertEqual(t, http.StatusConflict, w.Code, "Repeat without a key should conflict")
+
+	// Keys are scoped to the user who sent them
+	w = doCreateCluster(router, "other@example.com", "retry-key-1", strings.Replace(body, "idem-cluster", "other-users-cluster", 1))
+	utils.AssertEqual(t, http.StatusCreated, w.Code, "Another user's key should not collide")
+}
+
+func TestClusterHandler_CreateClusterIdempotencyKeyConcurrent(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	body := `{"name":"idem-race-cluster","spec":{"platform":{"type":"AWS"},"release":{"version":"4.16.0","channelGroup":"stable"}}}`
+
+	// Concurrent retries with the same key all get the one cluster that was created
+	const attempts = 5
+	responses := make([]*httptest.ResponseRecorder, attempts)
+	var wg sync.WaitGroup
+	for i := range responses {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			responses[i] = doCreateCluster(router, owner, "race-key", body)
+		}(i)
+	}
+	wg.Wait()
+
+	var ids []uuid.UUID
+	originals := 0
+	for _, w := range responses {
+		utils.AssertEqual(t, http.StatusCreated, w.Code, "Concurrent create should succeed")
+		if w.Header().Get("Idempotent-Replayed") == "" {
+			originals++
+		}
+
+		var cluster models.Cluster
+		err := json.Unmarshal(w.Body.Bytes(), &cluster)
+		utils.AssertError(t, err, false, "Should decode cluster")
+		ids = append(ids, cluster.ID)
+	}
+	utils.AssertEqual(t, 1, originals, "Exactly one create should not be a replay")
+	for _, id := range ids {
+		utils.AssertEqual(t, ids[0], id, "Concurrent creates should return the same cluster")
+	}
+
+	count, err := repo.Clusters.Count(ctx, owner)
+	utils.AssertError(t, err, false, "Should count clusters")
+	utils.AssertEqual(t, int64(1), count, "Only one cluster should be created")
+}
+
+func TestClusterHandler_RestoreClusterValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	w := doRequest(router, http.MethodPost, "/api/v1/clusters/not-a-uuid:restore", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid cluster ID should be rejected")
+
+	w = doRequest(router, http.MethodPost, "/api/v1/clusters/"+uuid.New().String()+":unknown", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Unknown custom method should return 404")
+}
+
+func TestClusterHandler_RestoreCluster(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "restore-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	restorePath := "/api/v1/clusters/" + cluster.ID.String() + ":restore"
+
+	// Live clusters cannot be restored
+	w := doRequest(router, http.MethodPost, restorePath, owner, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Live cluster should not be restorable")
+
+	err = repo.Clusters.Delete(ctx, cluster.ID, owner)
+	utils.AssertError(t, err, false, "Should soft-delete cluster")
+
+	// Other users cannot restore the cluster
+	w = doRequest(router, http.MethodPost, restorePath, "other@example.com", "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Other users should get 404")
+
+	w = doRequest(router, http.MethodPost, restorePath, owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should restore a recently deleted cluster")
+
+	restored, err := repo.Clusters.GetByIDWithoutFilter(ctx, cluster.ID)
+	utils.AssertError(t, err, false, "Restored cluster should be visible again")
+	utils.AssertNil(t, restored.DeletedAt, "Restored cluster should not be deleted")
+
+	// Clusters deleted before the retention window cannot be restored
+	err = repo.Clusters.Delete(ctx, cluster.ID, owner)
+	utils.AssertError(t, err, false, "Should soft-delete cluster again")
+	_, err = repo.GetClient().ExecContext(ctx, `UPDATE clusters SET deleted_at = NOW() - INTERVAL '30 days' WHERE id = $1`, cluster.ID)
+	utils.AssertError(t, err, false, "Should backdate deletion")
+
+	w = doRequest(router, http.MethodPost, restorePath, owner, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Cluster past the retention window should not be restorable")
+}
+
+func TestClusterHandler_DeleteClusterCascades(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "cascade-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	nodepool := &models.NodePool{
+		ID:              uuid.New(),
+		ClusterID:       cluster.ID,
+		Name:            "workers",
+		CreatedBy:       owner,
+		Generation:      1,
+		ResourceVersion: uuid.New().String(),
+	}
+	err = repo.NodePools.Create(ctx, nodepool)
+	utils.AssertError(t, err, false, "Should create nodepool")
+
+	err = repo.Status.UpsertClusterControllerStatus(ctx, &models.ClusterControllerStatus{
+		ClusterID:          cluster.ID,
+		ControllerName:     "dns-controller",
+		ObservedGeneration: 1,
+	})
+	utils.AssertError(t, err, false, "Should upsert cluster controller status")
+	err = repo.Status.UpsertNodePoolControllerStatus(ctx, &models.NodePoolControllerStatus{
+		NodePoolID:         nodepool.ID,
+		ControllerName:     "nodepool-controller",
+		ObservedGeneration: 1,
+	})
+	utils.AssertError(t, err, false, "Should upsert nodepool controller status")
+
+	w := doRequest(router, http.MethodDelete, "/api/v1/clusters/"+cluster.ID.String()+"?force=true", owner, "")
+	utils.AssertEqual(t, http.StatusAccepted, w.Code, "Owner should delete the cluster")
+
+	clusterStatuses, err := repo.Status.ListClusterControllerStatus(ctx, cluster.ID)
+	utils.AssertError(t, err, false, "Should list cluster controller status")
+	utils.AssertEqual(t, 0, len(clusterStatuses), "Deleted cluster should have no controller status")
+
+	nodepoolStatuses, err := repo.Status.ListNodePoolControllerStatusByCluster(ctx, cluster.ID)
+	utils.AssertError(t, err, false, "Should list nodepool controller status")
+	utils.AssertEqual(t, 0, len(nodepoolStatuses), "Deleted cluster's nodepools should have no controller status")
+
+	nodepools, err := repo.NodePools.ListByClusterInternal(ctx, cluster.ID)
+	utils.AssertError(t, err, false, "Should list nodepools")
+	utils.AssertEqual(t, 0, len(nodepools), "Nodepools should be soft-deleted with the cluster")
+
+	// Each nodepool deleted with the cluster records a deleted event that stays readable
+	w = doRequest(router, http.MethodGet, "/api/v1/nodepools/"+nodepool.ID.String()+"/events", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should list a deleted nodepool's events")
+	var eventsResp struct {
+		Events []models.NodePoolEvent `json:"events"`
+	}
+	err = json.Unmarshal(w.Body.Bytes(), &eventsResp)
+	utils.AssertError(t, err, false, "Should decode events")
+	utils.AssertEqual(t, 1, len(eventsResp.Events), "Cascade delete should record one event per nodepool")
+	utils.AssertEqual(t, models.NodePoolEventDeleted, eventsResp.Events[0].EventType, "Cascade delete should record a deleted event")
+
+	// Restoring the cluster brings back the nodepools deleted with it
+	w = doRequest(router, http.MethodPost, "/api/v1/clusters/"+cluster.ID.String()+":restore", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should restore the cluster")
+
+	nodepools, err = repo.NodePools.ListByClusterInternal(ctx, cluster.ID)
+	utils.AssertError(t, err, false, "Should list nodepools")
+	utils.AssertEqual(t, 1, len(nodepools), "Nodepools deleted with the cluster should be restored")
+}
+
+func TestClusterHandler_DeleteClusterWithNodePools(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "cluster-with-nodepools",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	for _, name := range []string{"workers", "infra"} {
+		err = repo.NodePools.Create(ctx, &models.NodePool{
+			ID:              uuid.New(),
+			ClusterID:       cluster.ID,
+			Name:            name,
+			CreatedBy:       owner,
+			Generation:      1,
+			ResourceVersion: uuid.New().String(),
+		})
+		utils.AssertError(t, err, false, "Should create nodepool")
+	}
+
+	clusterPath := "/api/v1/clusters/" + cluster.ID.String()
+
+	w := doRequest(router, http.MethodDelete, clusterPath, owner, "")
+	utils.AssertEqual(t, http.StatusConflict, w.Code, "Cluster with nodepools should not be deleted without force")
+	var conflict struct {
+		NodePoolCount int64 `json:"nodepool_count"`
+	}
+	err = json.Unmarshal(w.Body.Bytes(), &conflict)
+	utils.AssertError(t, err, false, "Should decode conflict response")
+	utils.AssertEqual(t, int64(2), conflict.NodePoolCount, "Response should count the active nodepools")
+
+	w = doRequest(router, http.MethodGet, clusterPath, owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Refused cluster should not be deleted")
+	nodepools, err := repo.NodePools.ListByClusterInternal(ctx, cluster.ID)
+	utils.AssertError(t, err, false, "Should list nodepools")
+	utils.AssertEqual(t, 2, len(nodepools), "Nodepools should be left alone")
+
+	w = doRequest(router, http.MethodDelete, clusterPath+"?force=true", owner, "")
+	utils.AssertEqual(t, http.StatusAccepted, w.Code, "Forced delete should cascade to nodepools")
+
+	w = doRequest(router, http.MethodGet, clusterPath, owner, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Cluster should be deleted")
+	nodepools, err = repo.NodePools.ListByClusterInternal(ctx, cluster.ID)
+	utils.AssertError(t, err, false, "Should list nodepools")
+	utils.AssertEqual(t, 0, len(nodepools), "Nodepools should be soft-deleted with the cluster")
+}
+
+func TestClusterHandler_ListClustersStatusFilterValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters?status=Running", "owner@example.com", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Unknown status phase should be rejected")
+	utils.AssertContains(t, w.Body.String(), "Degraded", "Error should list the valid phases")
+
+	// One unknown value rejects the whole multi-value filter
+	for _, query := range []string{"status=Ready,Running", "phase=Progressing,failed"} {
+		w = doRequest(router, http.MethodGet, "/api/v1/clusters?"+query, "owner@example.com", "")
+		utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Unknown phase in a list should be rejected", query)
+		utils.AssertContains(t, w.Body.String(), "invalid status", "Error should name the unknown phase", query)
+	}
+}
+
+func TestClusterHandler_ListClustersStatusFilter(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	for i, name := range []string{"ready-1", "ready-2", "pending-1"} {
+		cluster := &models.Cluster{
+			ID:         uuid.New(),
+			Name:       name,
+			CreatedBy:  owner,
+			Generation: 1,
+			Spec: models.ClusterSpec{
+				Platform: models.PlatformSpec{Type: "GCP"},
+			},
+		}
+		err := repo.Clusters.Create(ctx, cluster)
+		utils.AssertError(t, err, false, "Should create cluster", name)
+
+		if i < 2 {
+			err = repo.Status.UpsertClusterControllerStatus(ctx, &models.ClusterControllerStatus{
+				ClusterID:          cluster.ID,
+				ControllerName:     "dns-controller",
+				ObservedGeneration: 1,
+				Conditions: models.ConditionList{
+					{Type: "Available", Status: "True", Reason: "Reported"},
+				},
+			})
+			utils.AssertError(t, err, false, "Should upsert controller status", name)
+		}
+		err = repo.Clusters.MarkDirtyStatus(ctx, cluster.ID)
+		utils.AssertError(t, err, false, "Should mark status dirty", name)
+	}
+
+	// Another user's ready cluster is never visible to the owner
+	other := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "other-ready",
+		CreatedBy:  "other@example.com",
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, other)
+	utils.AssertError(t, err, false, "Should create other user's cluster")
+
+	var response models.ListClustersResponse
+
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters?status=Ready", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should list ready clusters")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 2, len(response.Clusters), "Only ready clusters should be returned")
+	utils.AssertEqual(t, int64(2), response.Total, "Total should reflect the filtered count")
+	for _, cluster := range response.Clusters {
+		utils.AssertEqual(t, "Ready", cluster.Status.Phase, "Returned cluster should be ready", cluster.Name)
+	}
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters?status=Ready&limit=1", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should page through ready clusters")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 1, len(response.Clusters), "Limit should apply to the filtered list")
+	utils.AssertEqual(t, int64(2), response.Total, "Total should not depend on the page size")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters?status=Pending", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should list pending clusters")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 1, len(response.Clusters), "Only the pending cluster should be returned")
+	utils.AssertEqual(t, "pending-1", response.Clusters[0].Name, "Cluster without controllers should be pending")
+	utils.AssertEqual(t, int64(1), response.Total, "Total should reflect the filtered count")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters?status=Failed", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should list failed clusters")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 0, len(response.Clusters), "No clusters should be failed")
+	utils.AssertEqual(t, int64(0), response.Total, "Total should be zero")
+
+	// Multiple phases match clusters in any of them
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters?status=Pending,Ready", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should list pending and ready clusters")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 3, len(response.Clusters), "Clusters in either phase should be returned")
+	utils.AssertEqual(t, int64(3), response.Total, "Total should count every listed phase")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters?phase=Progressing,Failed,Pending", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should filter with the phase alias")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 1, len(response.Clusters), "Only the pending cluster should match")
+	utils.AssertEqual(t, "pending-1", response.Clusters[0].Name, "Pending cluster should be returned")
+	utils.AssertEqual(t, int64(1), response.Total, "Total should reflect the filtered count")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should list all clusters")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, int64(3), response.Total, "Unfiltered total should include every phase")
+}
+
+func TestClusterHandler_ListClustersPageInfo(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	for i := 0; i < 5; i++ {
+		cluster := &models.Cluster{
+			ID:         uuid.New(),
+			Name:       fmt.Sprintf("page-%d", i),
+			CreatedBy:  owner,
+			Generation: 1,
+			Spec: models.ClusterSpec{
+				Platform: models.PlatformSpec{Type: "GCP"},
+			},
+		}
+		err := repo.Clusters.Create(ctx, cluster)
+		utils.AssertError(t, err, false, "Should create cluster", cluster.Name)
+	}
+
+	for _, page := range []struct {
+		offset  int
+		count   int
+		hasMore bool
+	}{
+		{offset: 0, count: 2, hasMore: true},
+		{offset: 2, count: 2, hasMore: true},
+		{offset: 4, count: 1, hasMore: false},
+	} {
+		w := doRequest(router, http.MethodGet, fmt.Sprintf("/api/v1/clusters?limit=2&offset=%d", page.offset), owner, "")
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should list clusters", page.offset)
+
+		var response models.ListClustersResponse
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		utils.AssertError(t, err, false, "Should decode response")
+		utils.AssertEqual(t, page.count, len(response.Clusters), "Unexpected page size", page.offset)
+		utils.AssertEqual(t, page.hasMore, response.HasMore, "Unexpected has_more", page.offset)
+		utils.AssertEqual(t, 3, response.TotalPages, "Five clusters should span three pages", page.offset)
+	}
+}
+
+func TestClusterHandler_ListClusterStatusesValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters/statuses?status=Running", "owner@example.com", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Unknown status phase should be rejected")
+	utils.AssertContains(t, w.Body.String(), "invalid status", "Statuses should not be routed as a cluster ID")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters/statuses?cursor=not-a-cursor", "owner@example.com", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid cursor should be rejected")
+}
+
+func TestClusterHandler_ListClusterStatuses(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	controllers := map[string][]string{
+		"status-ready":   {"dns-controller", "network-controller"},
+		"status-partial": {"dns-controller"},
+		"status-new":     nil,
+	}
+	clusterIDs := map[string]uuid.UUID{}
+	for name, controllerNames := range controllers {
+		cluster := &models.Cluster{
+			ID:         uuid.New(),
+			Name:       name,
+			CreatedBy:  owner,
+			Generation: 1,
+			Spec: models.ClusterSpec{
+				Platform: models.PlatformSpec{Type: "GCP"},
+			},
+		}
+		err := repo.Clusters.Create(ctx, cluster)
+		utils.AssertError(t, err, false, "Should create cluster", name)
+		clusterIDs[name] = cluster.ID
+
+		for _, controllerName := range controllerNames {
+			available := "True"
+			if name == "status-partial" {
+				available = "False"
+			}
+			err = repo.Status.UpsertClusterControllerStatus(ctx, &models.ClusterControllerStatus{
+				ClusterID:          cluster.ID,
+				ControllerName:     controllerName,
+				ObservedGeneration: 1,
+				Conditions: models.ConditionList{
+					{Type: "Available", Status: available, Reason: "Reported"},
+				},
+			})
+			utils.AssertError(t, err, false, "Should upsert controller status", name, controllerName)
+		}
+		err = repo.Clusters.MarkDirtyStatus(ctx, cluster.ID)
+		utils.AssertError(t, err, false, "Should mark status dirty", name)
+	}
+
+	// Another user's cluster is only visible to controllers
+	other := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "status-other",
+		CreatedBy:  "other@example.com",
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, other)
+	utils.AssertError(t, err, false, "Should create other user's cluster")
+
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters/statuses", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should list cluster statuses")
+
+	var response models.ListClusterStatusesResponse
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 3, len(response.Statuses), "Owner should see only their clusters")
+	utils.AssertEqual(t, int64(3), response.Total, "Total should count the owner's clusters")
+
+	byName := map[string]*models.CompactClusterStatus{}
+	for _, status := range response.Statuses {
+		byName[status.Name] = status
+	}
+	utils.AssertEqual(t, clusterIDs["status-ready"], byName["status-ready"].ClusterID, "Status should identify its cluster")
+	utils.AssertEqual(t, "Ready", byName["status-ready"].Phase, "Cluster with available controllers should be ready")
+	utils.AssertEqual(t, 2, byName["status-ready"].Controllers.Total, "Ready cluster should count both controllers")
+	utils.AssertEqual(t, 2, byName["status-ready"].Controllers.Ready, "Ready cluster should count both ready controllers")
+	utils.AssertEqual(t, 1, byName["status-partial"].Controllers.Total, "Partial cluster should count its controller")
+	utils.AssertEqual(t, 0, byName["status-partial"].Controllers.Ready, "Unavailable controller should not be ready")
+	utils.AssertEqual(t, "Pending", byName["status-new"].Phase, "Cluster without controllers should be pending")
+	utils.AssertEqual(t, 0, byName["status-new"].Controllers.Total, "Cluster without controllers should have zero counts")
+	utils.AssertNil(t, byName["status-other"], "Other user's cluster should not be listed")
+
+	// Pagination applies to the status list
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters/statuses?limit=2", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should page through cluster statuses")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 2, len(response.Statuses), "Limit should apply")
+	utils.AssertTrue(t, response.NextCursor != "", "A full page should return a next cursor")
+
+	// Controllers see every cluster
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters/statuses", "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Controller should list all cluster statuses")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, int64(4), response.Total, "Controller should see clusters of every user")
+}
+
+func TestClusterHandler_ListClustersTargetProjectFilter(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	for _, c := range []struct {
+		name      string
+		project   string
+		createdBy string
+	}{
+		{"alpha-1", "project-alpha", owner},
+		{"alpha-2", "project-alpha", owner},
+		{"beta-1", "project-beta", owner},
+		{"other-alpha", "project-alpha", "other@example.com"},
+	} {
+		cluster := &models.Cluster{
+			ID:              uuid.New(),
+			Name:            c.name,
+			TargetProjectID: c.project,
+			CreatedBy:       c.createdBy,
+			Generation:      1,
+			Spec: models.ClusterSpec{
+				Platform: models.PlatformSpec{Type: "GCP"},
+			},
+		}
+		err := repo.Clusters.Create(ctx, cluster)
+		utils.AssertError(t, err, false, "Should create cluster", c.name)
+	}
+
+	var response models.ListClustersResponse
+
+	// Another user's cluster in the same project stays hidden
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters?target_project_id=project-alpha", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should list clusters in a project")
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 2, len(response.Clusters), "Only the owner's clusters in the project should be returned")
+	utils.AssertEqual(t, int64(2), response.Total, "Total should reflect the project filter")
+	for _, cluster := range response.Clusters {
+		utils.AssertEqual(t, "project-alpha", cluster.TargetProjectID, "Returned cluster should target the project", cluster.Name)
+	}
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters?target_project_id=project-beta", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should list clusters in the other project")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 1, len(response.Clusters), "Only the cluster in the other project should be returned")
+	utils.AssertEqual(t, "beta-1", response.Clusters[0].Name, "Cluster in the other project should be returned")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters?target_project_id=project-alpha", "other@example.com", "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Other user should list their clusters in a project")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 1, len(response.Clusters), "Other user should only see their own cluster")
+	utils.AssertEqual(t, int64(1), response.Total, "Other user's total should only count their own cluster")
+}
+
+func TestClusterHandler_ListClustersIncludeDeleted(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	controller := "controller@system.local"
+	var deletedID uuid.UUID
+	for _, name := range []string{"kept", "removed"} {
+		cluster := &models.Cluster{
+			ID:              uuid.New(),
+			Name:            name,
+			TargetProjectID: "test-project",
+			CreatedBy:       owner,
+			Generation:      1,
+			Spec: models.ClusterSpec{
+				Platform: models.PlatformSpec{Type: "GCP"},
+			},
+		}
+		err := repo.Clusters.Create(ctx, cluster)
+		utils.AssertError(t, err, false, "Should create cluster", name)
+		if name == "removed" {
+			deletedID = cluster.ID
+		}
+	}
+	err := repo.Clusters.Delete(ctx, deletedID, owner)
+	utils.AssertError(t, err, false, "Should soft-delete cluster")
+
+	listed := func(response models.ListClustersResponse) *models.Cluster {
+		for _, cluster := range response.Clusters {
+			if cluster.ID == deletedID {
+				return cluster
+			}
+		}
+		return nil
+	}
+
+	var response models.ListClustersResponse
+
+	// Regular users never see deleted clusters, whatever the flag says
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters?include_deleted=true", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should list clusters")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertTrue(t, listed(response) == nil, "Flag should be ignored for regular users")
+	utils.AssertEqual(t, int64(1), response.Total, "Owner's total should not count the deleted cluster")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters", controller, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Controller should list clusters")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertTrue(t, listed(response) == nil, "Deleted clusters should be hidden without the flag")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters?include_deleted=true", controller, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Controller should list clusters including deleted")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	deleted := listed(response)
+	utils.AssertTrue(t, deleted != nil, "Controller should see the deleted cluster with the flag")
+	utils.AssertTrue(t, deleted.DeletedAt != nil, "Deleted cluster should carry deleted_at")
+}
+
+func TestClusterHandler_GetClusterStaleStatus(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "stale-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	var response models.Cluster
+	w := doGetCluster(router, owner, cluster.ID, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Should get cluster")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertFalse(t, response.StatusStale, "Freshly calculated status should not be stale")
+	freshETag := w.Header().Get("ETag")
+
+	// Mark the status dirty and break the controller stats query so recalculation fails
+	err = repo.Clusters.MarkDirtyStatus(ctx, cluster.ID)
+	utils.AssertError(t, err, false, "Should mark status dirty")
+	_, err = repo.GetClient().ExecContext(ctx, `ALTER TABLE controller_status RENAME TO controller_status_unavailable`)
+	utils.AssertError(t, err, false, "Should rename controller_status")
+	t.Cleanup(func() {
+		repo.GetClient().ExecContext(context.Background(), `ALTER TABLE controller_status_unavailable RENAME TO controller_status`)
+	})
+
+	w = doGetCluster(router, owner, cluster.ID, freshETag)
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Stale status should still be returned, not revalidated")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertTrue(t, response.StatusStale, "Response should flag the status as stale")
+	utils.AssertEqual(t, models.StatusRecalculationFailed, response.StatusError, "Response should say the recalculation failed")
+	utils.AssertFalse(t, strings.Contains(w.Body.String(), "controller_status"), "Response should not expose the database error")
+}
+
+func TestClusterHandler_ListClustersCreatedWindowValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+	owner := "owner@example.com"
+
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters?created_after=yesterday", owner, "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Non-RFC3339 created_after should be rejected")
+	utils.AssertContains(t, w.Body.String(), "created_after", "Error should name the invalid parameter")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters?created_before=2025-10-01", owner, "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Date without time should be rejected")
+
+	w = doRequest(router, http.MethodGet,
+		"/api/v1/clusters?created_after=2025-10-02T00:00:00Z&created_before=2025-10-01T00:00:00Z", owner, "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Inverted window should be rejected")
+
+	w = doRequest(router, http.MethodGet,
+		"/api/v1/clusters?created_after=2025-10-01T00:00:00Z&created_before=2025-10-01T00:00:00Z", owner, "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Empty window should be rejected")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters/statuses?created_after=yesterday", owner, "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Status list should validate the window too")
+}
+
+// doGetCluster fetches a cluster with an optional If-None-Match header
+func doGetCluster(router *gin.Engine, userEmail string, clusterID uuid.UUID, ifNoneMatch string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/clusters/"+clusterID.String(), nil)
+	req.Header.Set("X-User-Email", userEmail)
+	if ifNoneMatch != "" {
+		req.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestClusterETag(t *testing.T) {
+	updated := time.Date(2025, 10, 17, 12, 0, 0, 0, time.UTC)
+	cluster := &models.Cluster{
+		ResourceVersion: "rv-1",
+		Status:          &models.ClusterStatusInfo{LastUpdateTime: updated},
+	}
+
+	timing := &models.ReconciliationTiming{}
+
+	etag := clusterETag(cluster, timing)
+	utils.AssertTrue(t, strings.HasPrefix(etag, `"`) && strings.HasSuffix(etag, `"`), "ETag should be quoted")
+	utils.AssertEqual(t, etag, clusterETag(cluster, timing), "ETag should be stable")
+
+	reconciled := updated.Add(time.Minute)
+	utils.AssertNotEqual(t, etag, clusterETag(cluster, &models.ReconciliationTiming{LastReconciledAt: &reconciled}),
+		"Reconciliations should change the ETag")
+
+	cluster.Status.LastUpdateTime = updated.Add(time.Second)
+	utils.AssertNotEqual(t, etag, clusterETag(cluster, timing), "Status transitions should change the ETag")
+
+	cluster.Status = nil
+	utils.AssertNotEqual(t, etag, clusterETag(cluster, timing), "Clusters without status should still get an ETag")
+
+	utils.AssertTrue(t, etagMatches(etag, etag), "Exact ETag should match")
+	utils.AssertTrue(t, etagMatches(`"other", `+etag, etag), "ETag lists should match")
+	utils.AssertTrue(t, etagMatches("W/"+etag, etag), "Weak validators should match")
+	utils.AssertTrue(t, etagMatches("*", etag), "Wildcard should match")
+	utils.AssertFalse(t, etagMatches(`"other"`, etag), "Different ETag should not match")
+	utils.AssertFalse(t, etagMatches("", etag), "Missing header should not match")
+}
+
+func TestClusterHandler_GetClusterETag(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+
+	owner := "owner@example.com"
+	w := doCreateCluster(router, owner, "", `{"name":"etag-cluster","spec":{"platform":{"type":"AWS"},"release":{"version":"4.16.0","channelGroup":"stable"}}}`)
+	utils.AssertEqual(t, http.StatusCreated, w.Code, "Should create cluster")
+
+	var cluster models.Cluster
+	err := json.Unmarshal(w.Body.Bytes(), &cluster)
+	utils.AssertError(t, err, false, "Should decode created cluster")
+
+	w = doGetCluster(router, owner, cluster.ID, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Should get cluster")
+	etag := w.Header().Get("ETag")
+	utils.AssertTrue(t, etag != "", "Response should carry an ETag")
+
+	// Unchanged clusters are not sent again
+	w = doGetCluster(router, owner, cluster.ID, etag)
+	utils.AssertEqual(t, http.StatusNotModified, w.Code, "Matching If-None-Match should return 304")
+	utils.AssertEqual(t, 0, w.Body.Len(), "304 response should have no body")
+	utils.AssertEqual(t, etag, w.Header().Get("ETag"), "304 response should repeat the ETag")
+
+	// A spec update changes the ETag
+	w = doRequest(router, http.MethodPut, "/api/v1/clusters/"+cluster.ID.String(), owner,
+		`{"spec":{"platform":{"type":"AWS"},"release":{"version":"4.17.0","channelGroup":"stable"}}}`)
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Should update cluster")
+
+	w = doGetCluster(router, owner, cluster.ID, etag)
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Stale If-None-Match should return the cluster")
+	utils.AssertNotEqual(t, etag, w.Header().Get("ETag"), "Spec update should change the ETag")
+}
+
+func TestClusterHandler_GetClusterReconciliationTiming(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+
+	owner := "owner@example.com"
+	w := doCreateCluster(router, owner, "", `{"name":"timing-cluster","spec":{"platform":{"type":"AWS"},"release":{"version":"4.16.0","channelGroup":"stable"}}}`)
+	utils.AssertEqual(t, http.StatusCreated, w.Code, "Should create cluster")
+
+	var cluster models.Cluster
+	err := json.Unmarshal(w.Body.Bytes(), &cluster)
+	utils.AssertError(t, err, false, "Should decode created cluster")
+
+	getTiming := func() (models.ClusterWithReconciliation, map[string]interface{}) {
+		w := doGetCluster(router, owner, cluster.ID, "")
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Should get cluster")
+
+		var got models.ClusterWithReconciliation
+		err := json.Unmarshal(w.Body.Bytes(), &got)
+		utils.AssertError(t, err, false, "Should decode cluster")
+
+		var raw map[string]interface{}
+		err = json.Unmarshal(w.Body.Bytes(), &raw)
+		utils.AssertError(t, err, false, "Should decode cluster")
+		return got, raw
+	}
+
+	// A cluster that has never been reconciled reports explicit nulls
+	got, raw := getTiming()
+	utils.AssertEqual(t, cluster.ID, got.ID, "Cluster fields should be flattened into the response")
+	for _, field := range []string{"last_reconciled_at", "next_reconcile_at"} {
+		value, ok := raw[field]
+		utils.AssertTrue(t, ok, "Response should include the field", field)
+		utils.AssertNil(t, value, "Field should be null before the first reconciliation", field)
+	}
+
+	// A scheduler cycle records the reconciliation
+	err = repo.Reconciliation.UpdateReconciliationSchedule(context.Background(), cluster.ID)
+	utils.AssertError(t, err, false, "Should update reconciliation schedule")
+
+	got, _ = getTiming()
+	utils.AssertNotNil(t, got.LastReconciledAt, "last_reconciled_at should be set after a scheduler cycle")
+	utils.AssertNotNil(t, got.NextReconcileAt, "next_reconcile_at should be set after a scheduler cycle")
+	utils.AssertTrue(t, got.NextReconcileAt.After(*got.LastReconciledAt), "Next reconciliation should follow the last one")
+
+	// Paused clusters are not due again
+	w = doRequest(router, http.MethodPost, "/api/v1/clusters/"+cluster.ID.String()+"/reconciliation:pause", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Should pause reconciliation")
+
+	got, _ = getTiming()
+	utils.AssertNotNil(t, got.LastReconciledAt, "last_reconciled_at should survive a pause")
+	utils.AssertNil(t, got.NextReconcileAt, "next_reconcile_at should be null while paused")
+}
+
+func TestClusterHandler_UpdateClusterNoOp(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+
+	owner := "owner@example.com"
+	w := doCreateCluster(router, owner, "", `{"name":"noop-cluster","spec":{"platform":{"type":"AWS"},"release":{"version":"4.16.0","channelGroup":"stable"}}}`)
+	utils.AssertEqual(t, http.StatusCreated, w.Code, "Should create cluster")
+
+	var created models.Cluster
+	err := json.Unmarshal(w.Body.Bytes(), &created)
+	utils.AssertError(t, err, false, "Should decode created cluster")
+
+	update := func(spec models.ClusterSpec) models.Cluster {
+		body, err := json.Marshal(map[string]interface{}{"spec": spec})
+		utils.AssertError(t, err, false, "Should encode update")
+
+		w := doRequest(router, http.MethodPut, "/api/v1/clusters/"+created.ID.String(), owner, string(body))
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Should update cluster")
+
+		var updated models.Cluster
+		err = json.Unmarshal(w.Body.Bytes(), &updated)
+		utils.AssertError(t, err, false, "Should decode updated cluster")
+		return updated
+	}
+
+	// Resubmitting the stored spec changes nothing
+	updated := update(created.Spec)
+	utils.AssertEqual(t, created.Generation, updated.Generation, "Identical spec should not bump the generation")
+	utils.AssertEqual(t, created.ResourceVersion, updated.ResourceVersion, "Identical spec should keep the resource version")
+
+	stored, err := repo.Clusters.GetByID(context.Background(), created.ID, owner, false)
+	utils.AssertError(t, err, false, "Should get stored cluster")
+	utils.AssertEqual(t, created.Generation, stored.Generation, "Identical spec should not be written")
+
+	// A genuine change bumps the generation
+	changed := created.Spec
+	changed.Release.Version = "4.17.0"
+	updated = update(changed)
+	utils.AssertEqual(t, created.Generation+1, updated.Generation, "Changed spec should bump the generation")
+	utils.AssertNotEqual(t, created.ResourceVersion, updated.ResourceVersion, "Changed spec should get a new resource version")
+	utils.AssertEqual(t, "4.17.0", updated.Spec.Release.Version, "Changed spec should be stored")
+}
+
+func TestClusterHandler_GetClusterNotFoundVersusAccessDenied(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+
+	owner := "owner@example.com"
+	w := doCreateCluster(router, owner, "", `{"name":"access-cluster","spec":{"platform":{"type":"AWS"},"release":{"version":"4.16.0","channelGroup":"stable"}}}`)
+	utils.AssertEqual(t, http.StatusCreated, w.Code, "Should create cluster")
+
+	var cluster models.Cluster
+	err := json.Unmarshal(w.Body.Bytes(), &cluster)
+	utils.AssertError(t, err, false, "Should decode created cluster")
+
+	controller := "controller@system.local"
+	w = doGetCluster(router, controller, cluster.ID, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Controllers can get any cluster")
+
+	w = doGetCluster(router, controller, uuid.New(), "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Controllers get 404 for a missing cluster")
+
+	// Controllers are told apart where they are denied: only the owner manages grants
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters/"+cluster.ID.String()+"/grants", controller, "")
+	utils.AssertEqual(t, http.StatusForbidden, w.Code, "Controllers get 403 for owner-only operations")
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters/"+cluster.ID.String()+"/grants", "other@example.com", "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Other users get 404 for owner-only operations")
+
+	// Regular users cannot tell a missing cluster from another user's cluster
+	w = doGetCluster(router, "other@example.com", cluster.ID, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Other users' clusters should look missing")
+
+	w = doGetCluster(router, "other@example.com", uuid.New(), "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Missing clusters should return 404")
+}
+
+func TestClusterHandler_ListClustersByReleaseImageValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters/by-release-image", "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Missing image should be rejected")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters/by-release-image?image=quay.io/openshift-release-dev/ocp-release:4.16.0", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusForbidden, w.Code, "Regular users cannot search all clusters")
+}
+
+func TestClusterHandler_ListClustersByReleaseImage(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	vulnerable := "quay.io/openshift-release-dev/ocp-release:4.16.0-x86_64"
+	patched := "quay.io/openshift-release-dev/ocp-release:4.16.1-x86_64"
+
+	// Matches span owners; deleted clusters are excluded
+	images := []struct {
+		name    string
+		owner   string
+		image   string
+		deleted bool
+	}{
+		{"vulnerable-a", "alice@example.com", vulnerable, false},
+		{"vulnerable-b", "bob@example.com", vulnerable, false},
+		{"vulnerable-deleted", "bob@example.com", vulnerable, true},
+		{"patched", "alice@example.com", patched, false},
+	}
+	expected := map[uuid.UUID]bool{}
+	for _, tc := range images {
+		cluster := &models.Cluster{
+			ID:         uuid.New(),
+			Name:       tc.name,
+			CreatedBy:  tc.owner,
+			Generation: 1,
+			Spec: models.ClusterSpec{
+				Platform: models.PlatformSpec{Type: "GCP"},
+				Release:  models.ReleaseSpec{Image: tc.image, Version: "4.16.0"},
+			},
+		}
+		err := repo.Clusters.Create(ctx, cluster)
+		utils.AssertError(t, err, false, "Should create cluster", tc.name)
+
+		if tc.deleted {
+			err = repo.Clusters.Delete(ctx, cluster.ID, tc.owner)
+			utils.AssertError(t, err, false, "Should delete cluster", tc.name)
+		} else if tc.image == vulnerable {
+			expected[cluster.ID] = true
+		}
+	}
+
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters/by-release-image?image="+vulnerable, "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Controller should list clusters by release image")
+
+	var response models.ListClustersResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode list response")
+	utils.AssertEqual(t, int64(2), response.Total, "Only active clusters on the image should be counted")
+	utils.AssertEqual(t, 2, len(response.Clusters), "Only active clusters on the image should be listed")
+	for _, cluster := range response.Clusters {
+		utils.AssertTrue(t, expected[cluster.ID], "Listed cluster should use the image", cluster.Name)
+	}
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters/by-release-image?image=quay.io/unknown:1.0", "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Unknown image should not be an error")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode list response")
+	utils.AssertEqual(t, int64(0), response.Total, "Unknown image should match nothing")
+}
+
+func TestClusterHandler_DeleteClusterControllerStatusValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+	path := "/api/v1/clusters/" + uuid.New().String() + "/status/dns-controller"
+
+	w := doRequest(router, http.MethodDelete, path, "user@example.com", "")
+	utils.AssertEqual(t, http.StatusForbidden, w.Code, "Regular users cannot delete controller status")
+
+	w = doRequest(router, http.MethodDelete, "/api/v1/clusters/not-a-uuid/status/dns-controller", "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid cluster ID should be rejected")
+}
+
+func TestClusterHandler_DeleteClusterControllerStatus(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	controller := "controller@system.local"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "stale-controller-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	reported := map[string]string{
+		"dns-controller":     "True",
+		"retired-controller": "False",
+	}
+	for name, available := range reported {
+		err = repo.Status.UpsertClusterControllerStatus(ctx, &models.ClusterControllerStatus{
+			ClusterID:          cluster.ID,
+			ControllerName:     name,
+			ObservedGeneration: 1,
+			Conditions: models.ConditionList{
+				{Type: "Available", Status: available, Reason: "Reported"},
+			},
+		})
+		utils.AssertError(t, err, false, "Should upsert controller status", name)
+	}
+
+	phase := func() string {
+		w := doGetCluster(router, owner, cluster.ID, "")
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should get cluster")
+		var got models.Cluster
+		err := json.Unmarshal(w.Body.Bytes(), &got)
+		utils.AssertError(t, err, false, "Should decode cluster")
+		utils.AssertNotNil(t, got.Status, "Cluster should have status")
+		return got.Status.Phase
+	}
+	utils.AssertTrue(t, phase() != "Ready", "Cluster with a non-ready controller should not be ready")
+
+	deletePath := "/api/v1/clusters/" + cluster.ID.String() + "/status/retired-controller"
+
+	w := doRequest(router, http.MethodDelete, deletePath, owner, "")
+	utils.AssertEqual(t, http.StatusForbidden, w.Code, "Owners cannot delete controller status")
+
+	w = doRequest(router, http.MethodDelete, deletePath, controller, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Controller should delete controller status")
+	utils.AssertEqual(t, "Ready", phase(), "Removing the only non-ready controller should make the cluster ready")
+
+	w = doRequest(router, http.MethodDelete, deletePath, controller, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Deleting a missing controller status should return 404")
+	utils.AssertContains(t, w.Body.String(), "Controller status not found", "Error should name the missing controller status")
+
+	w = doRequest(router, http.MethodDelete, "/api/v1/clusters/"+uuid.New().String()+"/status/dns-controller", controller, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Unknown cluster should return 404")
+}
+
+func TestClusterHandler_ClearClusterErrorsValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+	path := "/api/v1/clusters/" + uuid.New().String() + "/errors:clear"
+
+	w := doRequest(router, http.MethodPost, path, "user@example.com", `{"reason":"acknowledged"}`)
+	utils.AssertEqual(t, http.StatusForbidden, w.Code, "Regular users cannot clear controller errors")
+
+	w = doRequest(router, http.MethodPost, "/api/v1/clusters/not-a-uuid/errors:clear", "controller@system.local", `{"reason":"acknowledged"}`)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid cluster ID should be rejected")
+
+	for _, body := range []string{"", `{}`, `{"reason":"   "}`} {
+		w = doRequest(router, http.MethodPost, path, "controller@system.local", body)
+		utils.AssertEqual(t, http.StatusBadRequest, w.Code, "A reason should be required", body)
+	}
+}
+
+func TestClusterHandler_ClearClusterErrors(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	controller := "controller@system.local"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "erroring-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	nodepool := &models.NodePool{
+		ID:              uuid.New(),
+		ClusterID:       cluster.ID,
+		Name:            "workers",
+		CreatedBy:       owner,
+		Generation:      1,
+		ResourceVersion: uuid.New().String(),
+	}
+	err = repo.NodePools.Create(ctx, nodepool)
+	utils.AssertError(t, err, false, "Should create nodepool")
+
+	staleError := &models.ErrorInfo{
+		ErrorType: models.ErrorTypeTransient,
+		ErrorCode: "TEST_ERROR",
+		Message:   "GCP API quota exceeded",
+		Timestamp: time.Now().UTC(),
+	}
+	err = repo.Status.UpsertClusterControllerStatus(ctx, &models.ClusterControllerStatus{
+		ClusterID:          cluster.ID,
+		ControllerName:     "network-controller",
+		ObservedGeneration: 1,
+		Conditions: models.ConditionList{
+			{Type: "Available", Status: "True", Reason: "Reported"},
+		},
+		LastError: staleError,
+	})
+	utils.AssertError(t, err, false, "Should upsert cluster controller status")
+
+	err = repo.Status.UpsertNodePoolControllerStatus(ctx, &models.NodePoolControllerStatus{
+		NodePoolID:         nodepool.ID,
+		ControllerName:     "machine-controller",
+		ObservedGeneration: 1,
+		Conditions: models.ConditionList{
+			{Type: "Available", Status: "True", Reason: "Reported"},
+		},
+		LastError: staleError,
+	})
+	utils.AssertError(t, err, false, "Should upsert nodepool controller status")
+
+	phase := func() string {
+		w := doGetCluster(router, owner, cluster.ID, "")
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should get cluster")
+		var got models.Cluster
+		err := json.Unmarshal(w.Body.Bytes(), &got)
+		utils.AssertError(t, err, false, "Should decode cluster")
+		utils.AssertNotNil(t, got.Status, "Cluster should have status")
+		return got.Status.Phase
+	}
+	utils.AssertEqual(t, string(models.HealthDegraded), phase(), "Ready controller with an error should degrade the cluster")
+
+	clearPath := "/api/v1/clusters/" + cluster.ID.String() + "/errors:clear"
+	body := `{"reason":"quota raised, errors are stale","include_nodepools":true}`
+
+	w := doRequest(router, http.MethodPost, clearPath, owner, body)
+	utils.AssertEqual(t, http.StatusForbidden, w.Code, "Owners cannot clear controller errors")
+
+	w = doRequest(router, http.MethodPost, clearPath, controller, body)
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Controller should clear controller errors")
+
+	var response struct {
+		ClusterErrorsCleared  int64 `json:"cluster_errors_cleared"`
+		NodePoolErrorsCleared int64 `json:"nodepool_errors_cleared"`
+	}
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode clear response")
+	utils.AssertEqual(t, int64(1), response.ClusterErrorsCleared, "Cluster controller error should be cleared")
+	utils.AssertEqual(t, int64(1), response.NodePoolErrorsCleared, "Nodepool controller error should be cleared")
+
+	remaining, err := repo.Status.GetClusterErrors(ctx, cluster.ID)
+	utils.AssertError(t, err, false, "Should get cluster errors")
+	utils.AssertEqual(t, 0, len(remaining), "No errors should remain")
+	utils.AssertEqual(t, "Ready", phase(), "Phase should be recomputed without the cleared error")
+
+	entries, err := repo.Audit.ListByResource(ctx, cluster.ID, 0)
+	utils.AssertError(t, err, false, "Should list audit entries")
+	utils.AssertEqual(t, 1, len(entries), "Clearing errors should be audited")
+	utils.AssertEqual(t, models.AuditActionClearErrors, entries[0].Action, "Entry should record the clear")
+	utils.AssertEqual(t, controller, entries[0].ActorEmail, "Entry should record the actor")
+	utils.AssertEqual(t, "quota raised, errors are stale", entries[0].Reason, "Entry should record the reason")
+
+	w = doRequest(router, http.MethodPost, "/api/v1/clusters/"+uuid.New().String()+"/errors:clear", controller, body)
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Unknown cluster should return 404")
+}
+
+func TestClusterHandler_DiffClusterSpecValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+	path := "/api/v1/clusters/" + uuid.New().String() + "/spec:diff"
+
+	w := doRequest(router, http.MethodPost, "/api/v1/clusters/not-a-uuid/spec:diff", "user@example.com", `{"spec":{}}`)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid cluster ID should be rejected")
+
+	w = doRequest(router, http.MethodPost, path, "user@example.com", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "A candidate spec should be required")
+
+	w = doRequest(router, http.MethodPost, path, "user@example.com", `{"spec":{"networking":{"podCIDR":"not-a-cidr"}}}`)
+	utils.AssertEqual(t, http.StatusUnprocessableEntity, w.Code, "An invalid candidate spec should be rejected as for an update")
+	utils.AssertContains(t, w.Body.String(), `"field":"networking.podCIDR"`, "Error should list the invalid field")
+}
+
+func TestClusterHandler_DiffClusterSpec(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+
+	owner := "owner@example.com"
+	w := doCreateCluster(router, owner, "", `{"name":"diff-cluster","spec":{"platform":{"type":"GCP"},`+
+		`"release":{"image":"quay.io/openshift-release-dev/ocp-release:4.16.0-x86_64","version":"4.16.0"},`+
+		`"networking":{"clusterNetwork":[{"cidr":"10.128.0.0/14","hostPrefix":23}],"serviceNetwork":["172.30.0.0/16"]}}}`)
+	utils.AssertEqual(t, http.StatusCreated, w.Code, "Should create cluster")
+
+	var cluster models.Cluster
+	err := json.Unmarshal(w.Body.Bytes(), &cluster)
+	utils.AssertError(t, err, false, "Should decode created cluster")
+
+	path := "/api/v1/clusters/" + cluster.ID.String() + "/spec:diff"
+	candidate := `{"spec":{"platform":{"type":"GCP"},` +
+		`"release":{"image":"quay.io/openshift-release-dev/ocp-release:4.17.0-x86_64","version":"4.16.0"},` +
+		`"networking":{"clusterNetwork":[{"cidr":"10.132.0.0/14","hostPrefix":23}],"serviceNetwork":["172.30.0.0/16"],"serviceCIDR":"172.30.0.0/16"}}}`
+
+	w = doRequest(router, http.MethodPost, path, "other@example.com", candidate)
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Other users cannot diff the cluster")
+
+	w = doRequest(router, http.MethodPost, path, owner, candidate)
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should diff the cluster spec")
+
+	var diff models.ClusterSpecDiff
+	err = json.Unmarshal(w.Body.Bytes(), &diff)
+	utils.AssertError(t, err, false, "Should decode spec diff")
+	utils.AssertEqual(t, cluster.ID, diff.ClusterID, "Diff should be for the cluster")
+	utils.AssertEqual(t, cluster.Generation, diff.Generation, "Diff should name the compared generation")
+
+	operations := map[string]string{}
+	for _, change := range diff.Changes {
+		operations[change.Path] = change.Operation
+	}
+	utils.AssertEqual(t, map[string]string{
+		"networking.clusterNetwork": models.SpecChangeChanged,
+		"networking.serviceCIDR":    models.SpecChangeAdded,
+		"release.image":             models.SpecChangeChanged,
+	}, operations, "Diff should list the changed paths")
+
+	// Nothing is persisted
+	w = doGetCluster(router, owner, cluster.ID, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should get cluster")
+	var current models.Cluster
+	err = json.Unmarshal(w.Body.Bytes(), &current)
+	utils.AssertError(t, err, false, "Should decode cluster")
+	utils.AssertEqual(t, cluster.Generation, current.Generation, "Diff should not bump the generation")
+	utils.AssertEqual(t, "quay.io/openshift-release-dev/ocp-release:4.16.0-x86_64", current.Spec.Release.Image, "Diff should not change the spec")
+
+	w = doRequest(router, http.MethodPost, "/api/v1/clusters/"+uuid.New().String()+"/spec:diff", owner, candidate)
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Unknown cluster should return 404")
+}
+
+func TestClusterHandler_BatchUpdateClusterStatusValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+	controller := "controller@system.local"
+	batchPath := "/api/v1/clusters/" + uuid.New().String() + "/status:batch"
+
+	w := doRequest(router, http.MethodPut, "/api/v1/clusters/not-a-uuid/status:batch", controller, `{"statuses":[{"controller_name":"a"}]}`)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid cluster ID should be rejected")
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantDetail string
+		wantField  string
+	}{
+		{name: "empty batch", body: `{"statuses":[]}`, wantStatus: http.StatusBadRequest, wantDetail: "at least one status", wantField: "statuses"},
+		{
+			name:       "missing controller name",
+			body:       `{"statuses":[{"controller_name":"dns-controller"},{"observed_generation":1}]}`,
+			wantStatus: http.StatusBadRequest,
+			wantDetail: "statuses[1]: controller_name is required",
+			wantField:  "statuses[1].controller_name",
+		},
+		{
+			name:       "duplicate controller",
+			body:       `{"statuses":[{"controller_name":"dns-controller"},{"controller_name":"dns-controller"}]}`,
+			wantStatus: http.StatusBadRequest,
+			wantDetail: "statuses[1]: duplicate controller",
+			wantField:  "statuses[1].controller_name",
+		},
+		{
+			name: "future transition time",
+			body: `{"statuses":[{"controller_name":"dns-controller"},{"controller_name":"network-controller","conditions":[` +
+				`{"type":"Available","status":"True","lastTransitionTime":"2999-01-01T00:00:00Z"}]}]}`,
+			wantStatus: http.StatusBadRequest,
+			wantDetail: "statuses[1]: condition Available",
+			wantField:  "statuses[1].conditions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := doRequest(router, http.MethodPut, batchPath, controller, tt.body)
+			utils.AssertEqual(t, tt.wantStatus, w.Code, "Invalid batch should be rejected")
+			utils.AssertContains(t, w.Body.String(), tt.wantDetail, "Error should name the invalid entry")
+			utils.AssertContains(t, w.Body.String(), `"field":"`+tt.wantField+`"`, "Error should list the failing field")
+		})
+	}
+
+	w = doRequest(router, http.MethodPut, "/api/v1/clusters/"+uuid.New().String()+"/status:unknown", controller, `{}`)
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Unknown PUT methods should return 404")
+}
+
+func TestClusterHandler_BatchUpdateClusterStatus(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	controller := "controller@system.local"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "batch-status-cluster",
+		CreatedBy:  owner,
+		Generation: 2,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	batchPath := "/api/v1/clusters/" + cluster.ID.String() + "/status:batch"
+	body := `{"statuses":[
+		{"controller_name":"dns-controller","observed_generation":2,"conditions":[{"type":"Available","status":"True","reason":"Reported"}]},
+		{"controller_name":"network-controller","observed_generation":2,"conditions":[{"type":"Available","status":"True","reason":"Reported"}]}
+	]}`
+
+	w := doRequest(router, http.MethodPut, batchPath, owner, body)
+	utils.AssertEqual(t, http.StatusForbidden, w.Code, "Only controllers can report status")
+
+	w = doRequest(router, http.MethodPut, batchPath, controller, body)
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Controller should store the batch")
+
+	statuses, err := repo.Status.ListClusterControllerStatus(ctx, cluster.ID)
+	utils.AssertError(t, err, false, "Should list controller statuses")
+	utils.AssertEqual(t, 2, len(statuses), "Every controller in the batch should be stored")
+
+	w = doGetCluster(router, owner, cluster.ID, "")
+	var got models.Cluster
+	err = json.Unmarshal(w.Body.Bytes(), &got)
+	utils.AssertError(t, err, false, "Should decode cluster")
+	utils.AssertEqual(t, "Ready", got.Status.Phase, "Batch reports should be aggregated together")
+
+	// A stale entry rejects the whole batch, including the valid entry before it
+	body = `{"statuses":[
+		{"controller_name":"storage-controller","observed_generation":2,"conditions":[{"type":"Available","status":"True","reason":"Reported"}]},
+		{"controller_name":"dns-controller","observed_generation":1,"conditions":[{"type":"Available","status":"False","reason":"Reported"}]}
+	]}`
+	w = doRequest(router, http.MethodPut, batchPath, controller, body)
+	utils.AssertEqual(t, http.StatusConflict, w.Code, "Stale entry should reject the batch")
+	utils.AssertContains(t, w.Body.String(), "dns-controller", "Conflict should name the stale controller")
+
+	statuses, err = repo.Status.ListClusterControllerStatus(ctx, cluster.ID)
+	utils.AssertError(t, err, false, "Should list controller statuses")
+	utils.AssertEqual(t, 2, len(statuses), "No entry of a rejected batch should be stored")
+
+	// An invalid entry rejects the batch before anything is written
+	body = `{"statuses":[
+		{"controller_name":"storage-controller","observed_generation":2},
+		{"observed_generation":2}
+	]}`
+	w = doRequest(router, http.MethodPut, batchPath, controller, body)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid entry should reject the batch")
+
+	_, err = repo.Status.GetClusterControllerStatus(ctx, cluster.ID, "storage-controller")
+	utils.AssertError(t, err, true, "Valid entries of an invalid batch should not be stored")
+}
+
+func TestClusterHandler_UpdateClusterStatusGeneration(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "generation-cluster",
+		CreatedBy:  "owner@example.com",
+		Generation: 3,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	statusPath := "/api/v1/clusters/" + cluster.ID.String() + "/status"
+	controller := "controller@system.local"
+	report := func(generation int, reason string) string {
+		return fmt.Sprintf(`{"controller_name":"dns-controller","observed_generation":%d,`+
+			`"conditions":[{"type":"Ready","status":"True","reason":"%s"}]}`, generation, reason)
+	}
+	storedReason := func() (int64, string) {
+		status, err := repo.Status.GetClusterControllerStatus(ctx, cluster.ID, "dns-controller")
+		utils.AssertError(t, err, false, "Should get controller status")
+		return status.ObservedGeneration, status.Conditions[0].Reason
+	}
+
+	w := doRequest(router, http.MethodPut, statusPath, controller, report(2, "First"))
+	utils.AssertEqual(t, http.StatusOK, w.Code, "First report should be stored")
+
+	w = doRequest(router, http.MethodPut, statusPath, controller, report(3, "Newer"))
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Report for a newer generation should be stored")
+
+	// A late report for an older generation does not overwrite newer status
+	w = doRequest(router, http.MethodPut, statusPath, controller, report(2, "Late"))
+	utils.AssertEqual(t, http.StatusConflict, w.Code, "Report for an older generation should be rejected")
+	generation, reason := storedReason()
+	utils.AssertEqual(t, int64(3), generation, "Stored generation should be unchanged")
+	utils.AssertEqual(t, "Newer", reason, "Stored status should be unchanged")
+
+	// Reporting the same generation again is an idempotent update
+	w = doRequest(router, http.MethodPut, statusPath, controller, report(3, "Repeated"))
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Report for the same generation should be stored")
+	_, reason = storedReason()
+	utils.AssertEqual(t, "Repeated", reason, "Same-generation report should update the status")
+
+	// Force stores an older generation for recovery
+	w = doRequest(router, http.MethodPut, statusPath+"?force=true", controller, report(1, "Recovered"))
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Forced report should be stored")
+	generation, reason = storedReason()
+	utils.AssertEqual(t, int64(1), generation, "Forced report should replace the generation")
+	utils.AssertEqual(t, "Recovered", reason, "Forced report should replace the status")
+}
+
+func TestClusterHandler_OversizedBody(t *testing.T) {
+	router := setupTestRouter(nil)
+	oversizedKey := strings.Repeat("A", config.DefaultMaxRequestBodyBytes)
+	body := `{"name":"oversized","spec":{"platform":{"type":"GCP"},"serviceAccountSigningKey":"` + oversizedKey + `"}}`
+	clusterPath := "/api/v1/clusters/" + uuid.New().String()
+
+	w := doRequest(router, http.MethodPost, "/api/v1/clusters", "user@example.com", body)
+	utils.AssertEqual(t, http.StatusRequestEntityTooLarge, w.Code, "Oversized create should be rejected")
+	utils.AssertContains(t, w.Body.String(), "at most 262144 bytes", "Error should name the limit")
+
+	w = doRequest(router, http.MethodPut, clusterPath, "user@example.com", body)
+	utils.AssertEqual(t, http.StatusRequestEntityTooLarge, w.Code, "Oversized update should be rejected")
+
+	w = doRequest(router, http.MethodPost, clusterPath+"/spec:diff", "user@example.com", body)
+	utils.AssertEqual(t, http.StatusRequestEntityTooLarge, w.Code, "Oversized spec diff should be rejected")
+
+	w = doRequest(router, http.MethodPut, clusterPath+"/status", "controller@system.local",
+		`{"controller_name":"dns-controller","metadata":{"blob":"`+oversizedKey+`"}}`)
+	utils.AssertEqual(t, http.StatusRequestEntityTooLarge, w.Code, "Oversized status report should be rejected")
+
+	// Metadata is capped even when the whole body is within the limit
+	largeMetadata := strings.Repeat("A", models.MaxStatusMetadataBytes)
+	w = doRequest(router, http.MethodPut, clusterPath+"/status", "controller@system.local",
+		`{"controller_name":"dns-controller","metadata":{"blob":"`+largeMetadata+`"}}`)
+	utils.AssertEqual(t, http.StatusRequestEntityTooLarge, w.Code, "Oversized status metadata should be rejected")
+	utils.AssertContains(t, w.Body.String(), "Status metadata too large", "Error should name the metadata")
+}
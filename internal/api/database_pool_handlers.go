@@ -0,0 +1,144 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/apahim/cls-backend/internal/auth"
+	"github.com/apahim/cls-backend/internal/database"
+	"github.com/apahim/cls-backend/internal/middleware"
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// DatabasePool is the part of *database.Client used to report and resize the connection pool
+type DatabasePool interface {
+	PoolStats() database.PoolStats
+	SetPoolLimits(maxOpenConns, maxIdleConns *int)
+}
+
+// DatabasePoolHandler exposes the database connection pool for diagnostics and tuning
+type DatabasePoolHandler struct {
+	pool   DatabasePool
+	logger *zap.Logger
+}
+
+// NewDatabasePoolHandler creates a new database pool handler
+func NewDatabasePoolHandler(pool DatabasePool) *DatabasePoolHandler {
+	return &DatabasePoolHandler{
+		pool:   pool,
+		logger: zap.L().Named("database_pool_handler"),
+	}
+}
+
+// RegisterRoutes registers the database pool admin routes
+func (h *DatabasePoolHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/admin/db-pool", h.requireAdmin, h.GetPoolStats)
+	router.PUT("/admin/db-pool", h.requireAdmin, h.TunePool)
+}
+
+// requireAdmin rejects callers that are not allowed to manage the pool, and answers 503
+// when this instance has no database pool to report
+func (h *DatabasePoolHandler) requireAdmin(c *gin.Context) {
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	if !auth.CanManageDatabasePool(userCtx) {
+		c.AbortWithStatusJSON(http.StatusForbidden, utils.NewAPIError(
+			utils.ErrCodeForbidden,
+			"Access denied",
+			"only system controllers can manage the database pool",
+		))
+		return
+	}
+
+	if h.pool == nil {
+		c.AbortWithStatusJSON(http.StatusServiceUnavailable, utils.NewAPIError(
+			utils.ErrCodeUnavailable,
+			"Database pool unavailable",
+			"this instance has no database connection pool",
+		))
+		return
+	}
+
+	c.Next()
+}
+
+// GetPoolStats returns the connection pool's limits and current usage
+func (h *DatabasePoolHandler) GetPoolStats(c *gin.Context) {
+	c.JSON(http.StatusOK, h.pool.PoolStats())
+}
+
+// TunePool resizes the connection pool until the next restart and returns the updated stats
+func (h *DatabasePoolHandler) TunePool(c *gin.Context) {
+	var req models.DatabasePoolTuneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid pool tuning request format",
+			err.Error(),
+		))
+		return
+	}
+
+	if err := req.Validate(h.pool.PoolStats().MaxOpenConnections); err != nil {
+		respondValidationFailed(c, http.StatusBadRequest, err)
+		return
+	}
+
+	userCtx, _ := middleware.GetUserContext(c)
+	h.logger.Info("Resizing database connection pool",
+		zap.String("user_email", userCtx.Email),
+		zap.Any("max_open_conns", req.MaxOpenConns),
+		zap.Any("max_idle_conns", req.MaxIdleConns),
+	)
+	h.pool.SetPoolLimits(req.MaxOpenConns, req.MaxIdleConns)
+
+	c.JSON(http.StatusOK, h.pool.PoolStats())
+}
+
+// poolMetric is a single Prometheus sample derived from the pool stats
+type poolMetric struct {
+	name  string
+	kind  string // gauge or counter
+	help  string
+	value float64
+}
+
+// Metrics serves the pool stats in the Prometheus text exposition format
+func (h *DatabasePoolHandler) Metrics(c *gin.Context) {
+	if h.pool == nil {
+		c.Status(http.StatusServiceUnavailable)
+		return
+	}
+
+	stats := h.pool.PoolStats()
+	metrics := []poolMetric{
+		{"cls_db_pool_max_open_connections", "gauge", "Maximum number of open connections, 0 for unlimited.", float64(stats.MaxOpenConnections)},
+		{"cls_db_pool_open_connections", "gauge", "Number of established connections, in use or idle.", float64(stats.OpenConnections)},
+		{"cls_db_pool_in_use_connections", "gauge", "Number of connections currently in use.", float64(stats.InUse)},
+		{"cls_db_pool_idle_connections", "gauge", "Number of idle connections.", float64(stats.Idle)},
+		{"cls_db_pool_wait_count_total", "counter", "Total number of connections waited for.", float64(stats.WaitCount)},
+		{"cls_db_pool_wait_duration_seconds_total", "counter", "Total time blocked waiting for a connection.", stats.WaitDurationSeconds},
+		{"cls_db_pool_max_idle_closed_total", "counter", "Total connections closed due to the idle connection limit.", float64(stats.MaxIdleClosed)},
+		{"cls_db_pool_max_idle_time_closed_total", "counter", "Total connections closed due to the idle time limit.", float64(stats.MaxIdleTimeClosed)},
+		{"cls_db_pool_max_lifetime_closed_total", "counter", "Total connections closed due to the connection lifetime limit.", float64(stats.MaxLifetimeClosed)},
+	}
+
+	c.Header("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	c.Status(http.StatusOK)
+	for _, m := range metrics {
+		fmt.Fprintf(c.Writer, "# HELP %s %s\n# TYPE %s %s\n%s %s\n",
+			m.name, m.help, m.name, m.kind, m.name, strconv.FormatFloat(m.value, 'g', -1, 64))
+	}
+}
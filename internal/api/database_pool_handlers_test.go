@@ -0,0 +1,132 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/apahim/cls-backend/internal/auth"
+	"github.com/apahim/cls-backend/internal/config"
+	"github.com/apahim/cls-backend/internal/database"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// fakeDatabasePool serves fixed pool stats and records resizes
+type fakeDatabasePool struct {
+	stats database.PoolStats
+}
+
+func (f *fakeDatabasePool) PoolStats() database.PoolStats {
+	return f.stats
+}
+
+func (f *fakeDatabasePool) SetPoolLimits(maxOpenConns, maxIdleConns *int) {
+	if maxOpenConns != nil {
+		f.stats.MaxOpenConnections = *maxOpenConns
+	}
+}
+
+func setupDatabasePoolRouter(pool DatabasePool) (*gin.Engine, *DatabasePoolHandler) {
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{Auth: config.AuthConfig{Enabled: true}}
+	handler := NewDatabasePoolHandler(pool)
+	router := setupRouter(cfg, auth.NewHeaderAuthenticator(), NewClusterHandler(nil, nil), NewNodePoolHandler(nil, nil), NewFailedEventHandler(nil, nil), NewReconcileTargetHandler(nil), NewAuditHandler(nil), handler)
+	return router, handler
+}
+
+func TestDatabasePoolHandler_AdminOnly(t *testing.T) {
+	router, _ := setupDatabasePoolRouter(&fakeDatabasePool{})
+
+	w := doRequest(router, http.MethodGet, "/api/v1/admin/db-pool", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusForbidden, w.Code, "Regular users cannot view the database pool")
+
+	w = doRequest(router, http.MethodPut, "/api/v1/admin/db-pool", "user@example.com", `{"max_open_conns":10}`)
+	utils.AssertEqual(t, http.StatusForbidden, w.Code, "Regular users cannot resize the database pool")
+
+	router, _ = setupDatabasePoolRouter(nil)
+	w = doRequest(router, http.MethodGet, "/api/v1/admin/db-pool", "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusServiceUnavailable, w.Code, "Stats need a database pool")
+}
+
+func TestDatabasePoolHandler_GetPoolStats(t *testing.T) {
+	router, _ := setupDatabasePoolRouter(&fakeDatabasePool{stats: database.PoolStats{
+		MaxOpenConnections:  25,
+		OpenConnections:     12,
+		InUse:               10,
+		Idle:                2,
+		WaitCount:           42,
+		WaitDurationSeconds: 1.5,
+	}})
+
+	w := doRequest(router, http.MethodGet, "/api/v1/admin/db-pool", "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Controller should get pool stats")
+
+	var stats map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &stats)
+	utils.AssertError(t, err, false, "Should decode pool stats")
+	for _, field := range []string{
+		"max_open_connections", "open_connections", "in_use", "idle", "wait_count",
+		"wait_duration_seconds", "max_idle_closed", "max_idle_time_closed", "max_lifetime_closed",
+	} {
+		_, numeric := stats[field].(float64)
+		utils.AssertTrue(t, numeric, "Pool field should be numeric", field)
+	}
+	utils.AssertEqual(t, float64(10), stats["in_use"], "In-use connections")
+	utils.AssertEqual(t, float64(42), stats["wait_count"], "Wait count")
+	utils.AssertEqual(t, 1.5, stats["wait_duration_seconds"], "Wait duration")
+}
+
+func TestDatabasePoolHandler_TunePool(t *testing.T) {
+	pool := &fakeDatabasePool{stats: database.PoolStats{MaxOpenConnections: 25}}
+	router, _ := setupDatabasePoolRouter(pool)
+	controller := "controller@system.local"
+
+	tests := []struct {
+		name  string
+		body  string
+		field string
+	}{
+		{name: "no limits", body: `{}`},
+		{name: "negative max open", body: `{"max_open_conns":-1}`, field: "max_open_conns"},
+		{name: "max idle above current max open", body: `{"max_idle_conns":30}`, field: "max_idle_conns"},
+		{name: "max idle above requested max open", body: `{"max_open_conns":5,"max_idle_conns":10}`, field: "max_idle_conns"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := doRequest(router, http.MethodPut, "/api/v1/admin/db-pool", controller, tt.body)
+			utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid limits should be rejected")
+			utils.AssertContains(t, w.Body.String(), `"field":"`+tt.field+`"`, "Failing field should be listed")
+		})
+	}
+	utils.AssertEqual(t, 25, pool.stats.MaxOpenConnections, "Rejected requests should not resize the pool")
+
+	w := doRequest(router, http.MethodPut, "/api/v1/admin/db-pool", controller, `{"max_open_conns":50,"max_idle_conns":10}`)
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Controller should resize the pool")
+	utils.AssertContains(t, w.Body.String(), `"max_open_connections":50`, "Updated stats should be returned")
+	utils.AssertEqual(t, 50, pool.stats.MaxOpenConnections, "Pool should be resized")
+}
+
+func TestDatabasePoolHandler_Metrics(t *testing.T) {
+	_, handler := setupDatabasePoolRouter(&fakeDatabasePool{stats: database.PoolStats{
+		MaxOpenConnections:  25,
+		InUse:               10,
+		WaitCount:           42,
+		WaitDurationSeconds: 1.5,
+	}})
+	router := gin.New()
+	router.GET("/metrics", handler.Metrics)
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Metrics should be served")
+	utils.AssertContains(t, w.Header().Get("Content-Type"), "text/plain", "Metrics use the Prometheus text format")
+	body := w.Body.String()
+	utils.AssertContains(t, body, "# TYPE cls_db_pool_in_use_connections gauge\ncls_db_pool_in_use_connections 10\n", "In-use gauge")
+	utils.AssertContains(t, body, "cls_db_pool_max_open_connections 25\n", "Max open gauge")
+	utils.AssertContains(t, body, "# TYPE cls_db_pool_wait_count_total counter\ncls_db_pool_wait_count_total 42\n", "Wait count counter")
+	utils.AssertContains(t, body, "cls_db_pool_wait_duration_seconds_total 1.5\n", "Wait duration counter")
+}
@@ -0,0 +1,202 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/auth"
+	"github.com/apahim/cls-backend/internal/database"
+	"github.com/apahim/cls-backend/internal/middleware"
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/pubsub"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// FailedEventHandler handles admin operations on failed reconciliation events
+type FailedEventHandler struct {
+	repository *database.Repository
+	publisher  pubsub.ReconcileEventPublisher
+	logger     *zap.Logger
+}
+
+// NewFailedEventHandler creates a new failed event handler
+func NewFailedEventHandler(repository *database.Repository, publisher pubsub.ReconcileEventPublisher) *FailedEventHandler {
+	return &FailedEventHandler{
+		repository: repository,
+		publisher:  publisher,
+		logger:     zap.L().Named("failed_event_handler"),
+	}
+}
+
+// RegisterRoutes registers failed event admin routes
+func (h *FailedEventHandler) RegisterRoutes(router *gin.RouterGroup) {
+	failedEvents := router.Group("/admin/failed-events")
+	failedEvents.Use(h.requireAdmin)
+	{
+		failedEvents.GET("", h.ListFailedEvents)
+		failedEvents.POST("/:event_id/replay", h.ReplayFailedEvent)
+	}
+}
+
+// requireAdmin rejects callers that are not allowed to manage failed events
+func (h *FailedEventHandler) requireAdmin(c *gin.Context) {
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	if !auth.CanManageFailedEvents(userCtx) {
+		c.AbortWithStatusJSON(http.StatusForbidden, utils.NewAPIError(
+			utils.ErrCodeForbidden,
+			"Access denied",
+			"only system controllers can manage failed events",
+		))
+		return
+	}
+
+	c.Next()
+}
+
+// ListFailedEvents lists failed reconciliation events
+func (h *FailedEventHandler) ListFailedEvents(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	limit := 50
+	if limitStr := c.Query("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil || parsedLimit <= 0 || parsedLimit > 1000 {
+			c.JSON(http.StatusBadRequest, utils.NewAPIError(
+				utils.ErrCodeValidation,
+				"Invalid limit",
+				"limit must be between 1 and 1000",
+			))
+			return
+		}
+		limit = parsedLimit
+	}
+	includeReplayed := c.Query("include_replayed") == "true"
+
+	events, err := h.repository.Status.ListFailedEvents(ctx, includeReplayed, limit)
+	if err != nil {
+		h.logger.Error("Failed to list failed events", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to list failed events",
+			err.Error(),
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"failed_events": events,
+		"limit":         limit,
+	})
+}
+
+// ReplayFailedEvent re-publishes a failed reconciliation event
+func (h *FailedEventHandler) ReplayFailedEvent(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	eventID, err := uuid.Parse(c.Param("event_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid event ID format",
+			err.Error(),
+		))
+		return
+	}
+
+	event, err := h.repository.Status.GetFailedEvent(ctx, eventID)
+	if err != nil {
+		if err == models.ErrFailedEventNotFound {
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"Failed event not found",
+				"",
+			))
+			return
+		}
+		h.logger.Error("Failed to get failed event", zap.String("event_id", eventID.String()), zap.Error(err))
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to get failed event",
+			err.Error(),
+		))
+		return
+	}
+
+	if event.ReplayedAt != nil {
+		c.JSON(http.StatusConflict, utils.NewAPIError(
+			utils.ErrCodeConflict,
+			"Failed event already replayed",
+			"",
+		))
+		return
+	}
+
+	if err := h.publish(ctx, event); err != nil {
+		h.logger.Error("Failed to replay failed event", zap.String("event_id", eventID.String()), zap.Error(err))
+		c.JSON(http.StatusBadGateway, utils.NewAPIError(
+			utils.ErrCodeExternal,
+			"Failed to replay event",
+			err.Error(),
+		))
+		return
+	}
+
+	if err := h.repository.Status.MarkFailedEventReplayed(ctx, eventID); err != nil {
+		h.logger.Error("Failed to mark failed event replayed", zap.String("event_id", eventID.String()), zap.Error(err))
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Event replayed but could not be marked as replayed",
+			err.Error(),
+		))
+		return
+	}
+
+	h.logger.Info("Replayed failed event",
+		zap.String("event_id", eventID.String()),
+		zap.String("event_type", event.EventType),
+		zap.String("cluster_id", event.ClusterID.String()),
+	)
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":  "event replayed",
+		"event_id": eventID,
+	})
+}
+
+// publish decodes the stored payload by event type and publishes it again
+func (h *FailedEventHandler) publish(ctx context.Context, event *models.FailedEvent) error {
+	switch event.EventType {
+	case models.EventTypeClusterReconcile:
+		var reconcileEvent models.ReconciliationEvent
+		if err := json.Unmarshal(event.Payload, &reconcileEvent); err != nil {
+			return fmt.Errorf("failed to decode reconciliation event: %w", err)
+		}
+		return h.publisher.PublishReconciliationEvent(ctx, &reconcileEvent)
+	case models.EventTypeNodePoolReconcile:
+		var reconcileEvent models.NodePoolReconciliationEvent
+		if err := json.Unmarshal(event.Payload, &reconcileEvent); err != nil {
+			return fmt.Errorf("failed to decode nodepool reconciliation event: %w", err)
+		}
+		return h.publisher.PublishNodePoolReconciliationEvent(ctx, &reconcileEvent)
+	default:
+		return fmt.Errorf("unsupported event type %q", event.EventType)
+	}
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/google/uuid"
+)
+
+// mockReconcilePublisher records the reconcile events it is asked to publish
+type mockReconcilePublisher struct {
+	clusterEvents  []*models.ReconciliationEvent
+	nodepoolEvents []*models.NodePoolReconciliationEvent
+}
+
+func (m *mockReconcilePublisher) PublishReconciliationEvent(ctx context.Context, event *models.ReconciliationEvent) error {
+	m.clusterEvents = append(m.clusterEvents, event)
+	return nil
+}
+
+func (m *mockReconcilePublisher) PublishNodePoolReconciliationEvent(ctx context.Context, event *models.NodePoolReconciliationEvent) error {
+	m.nodepoolEvents = append(m.nodepoolEvents, event)
+	return nil
+}
+
+func TestFailedEventHandler_AdminOnly(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	w := doRequest(router, http.MethodGet, "/api/v1/admin/failed-events", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusForbidden, w.Code, "Regular users cannot list failed events")
+
+	w = doRequest(router, http.MethodPost, "/api/v1/admin/failed-events/"+uuid.New().String()+"/replay", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusForbidden, w.Code, "Regular users cannot replay failed events")
+
+	w = doRequest(router, http.MethodPost, "/api/v1/admin/failed-events/not-a-uuid/replay", "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid event ID should be rejected")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/admin/failed-events?limit=0", "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid limit should be rejected")
+}
+
+func TestFailedEventHandler_ListAndReplay(t *testing.T) {
+	repo := setupTestRepository(t)
+	publisher := &mockReconcilePublisher{}
+	router := setupTestRouterWithPublisher(repo, publisher)
+	ctx := context.Background()
+	admin := "controller@system.local"
+
+	clusterID := uuid.New()
+	payload, err := json.Marshal(&models.ReconciliationEvent{
+		Type:       models.EventTypeClusterReconcile,
+		ClusterID:  clusterID.String(),
+		Reason:     "periodic",
+		Generation: 2,
+	})
+	utils.AssertError(t, err, false, "Should serialize event")
+
+	failed := &models.FailedEvent{
+		EventType:    models.EventTypeClusterReconcile,
+		ClusterID:    clusterID,
+		Payload:      payload,
+		ErrorMessage: "failed to publish message: unavailable",
+	}
+	err = repo.Status.RecordFailedEvent(ctx, failed)
+	utils.AssertError(t, err, false, "Should record failed event")
+
+	w := doRequest(router, http.MethodGet, "/api/v1/admin/failed-events", admin, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Admin should list failed events")
+
+	var list struct {
+		FailedEvents []models.FailedEvent `json:"failed_events"`
+	}
+	err = json.Unmarshal(w.Body.Bytes(), &list)
+	utils.AssertError(t, err, false, "Should decode list response")
+	utils.AssertEqual(t, 1, len(list.FailedEvents), "Should list the failed event")
+	utils.AssertEqual(t, failed.ID, list.FailedEvents[0].ID, "Should list the recorded event")
+
+	replayPath := "/api/v1/admin/failed-events/" + failed.ID.String() + "/replay"
+	w = doRequest(router, http.MethodPost, replayPath, admin, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Admin should replay failed event")
+	utils.AssertEqual(t, 1, len(publisher.clusterEvents), "Replay should publish the event")
+	utils.AssertEqual(t, clusterID.String(), publisher.clusterEvents[0].ClusterID, "Replayed event should match the stored payload")
+	utils.AssertEqual(t, int64(2), publisher.clusterEvents[0].Generation, "Replayed event should keep its generation")
+
+	// A replayed event cannot be replayed twice
+	w = doRequest(router, http.MethodPost, replayPath, admin, "")
+	utils.AssertEqual(t, http.StatusConflict, w.Code, "Replayed event should not be replayed again")
+
+	w = doRequest(router, http.MethodPost, "/api/v1/admin/failed-events/"+uuid.New().String()+"/replay", admin, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Unknown event should return 404")
+}
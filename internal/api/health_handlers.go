@@ -0,0 +1,113 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// readinessPingTimeout bounds the database ping so a hung database fails the probe instead of blocking it
+const readinessPingTimeout = 2 * time.Second
+
+// databasePinger is the part of *sql.DB used by the readiness probe
+type databasePinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// runningChecker is the part of *pubsub.Service used by the readiness probe
+type runningChecker interface {
+	IsRunning() bool
+}
+
+// HealthHandler serves liveness and readiness probes
+type HealthHandler struct {
+	database databasePinger
+	pubsub   runningChecker
+	logger   *zap.Logger
+
+	// reconciliationEnabled is asked on every readiness probe so monitoring can alert when
+	// neither the scheduler nor the reactive reconciler is running. The reactive reconciler can
+	// be toggled in the database, so the answer comes from the live reconcilers rather than the
+	// startup config. It does not affect readiness.
+	reconciliationEnabled func() bool
+}
+
+// NewHealthHandler creates a new health handler. A nil pubsub is not checked, for webhook
+// delivery where Pub/Sub is optional. reconciliationEnabled may be nil until the
+// reconcilers are started, in which case reconciliation is reported as disabled.
+func NewHealthHandler(database databasePinger, pubsub runningChecker, reconciliationEnabled func() bool) *HealthHandler {
+	return &HealthHandler{
+		database:              database,
+		pubsub:                pubsub,
+		logger:                zap.L().Named("health_handler"),
+		reconciliationEnabled: reconciliationEnabled,
+	}
+}
+
+// SetReconciliationStatus sets how the readiness probe learns whether any reconciler is running
+func (h *HealthHandler) SetReconciliationStatus(reconciliationEnabled func() bool) {
+	h.reconciliationEnabled = reconciliationEnabled
+}
+
+// RegisterRoutes registers the probe routes outside the authenticated API group
+func (h *HealthHandler) RegisterRoutes(router gin.IRoutes) {
+	router.GET("/healthz", h.Liveness)
+	router.GET("/readyz", h.Readiness)
+}
+
+// Liveness reports that the process is up. It never checks dependencies so a
+// dependency outage does not get the pod restarted.
+func (h *HealthHandler) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"status": "ok",
+	})
+}
+
+// Readiness reports whether the server's dependencies are usable, returning 503
+// with the failing dependencies so traffic is routed elsewhere
+func (h *HealthHandler) Readiness(c *gin.Context) {
+	checks := gin.H{}
+	var failed []string
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), readinessPingTimeout)
+	defer cancel()
+
+	if err := h.database.PingContext(ctx); err != nil {
+		h.logger.Warn("Readiness check failed: database ping", zap.Error(err))
+		checks["database"] = err.Error()
+		failed = append(failed, "database")
+	} else {
+		checks["database"] = "ok"
+	}
+
+	if h.pubsub == nil {
+		checks["pubsub"] = "not required"
+	} else if !h.pubsub.IsRunning() {
+		h.logger.Warn("Readiness check failed: pubsub service is not running")
+		checks["pubsub"] = "pubsub service is not running"
+		failed = append(failed, "pubsub")
+	} else {
+		checks["pubsub"] = "ok"
+	}
+
+	reconciliationEnabled := h.reconciliationEnabled != nil && h.reconciliationEnabled()
+
+	if len(failed) > 0 {
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"status":                 "not_ready",
+			"failed":                 failed,
+			"checks":                 checks,
+			"reconciliation_enabled": reconciliationEnabled,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":                 "ready",
+		"checks":                 checks,
+		"reconciliation_enabled": reconciliationEnabled,
+	})
+}
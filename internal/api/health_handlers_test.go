@@ -0,0 +1,169 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/gin-gonic/gin"
+)
+
+type fakePinger struct {
+	err error
+}
+
+func (f *fakePinger) PingContext(ctx context.Context) error {
+	return f.err
+}
+
+type fakeRunningChecker struct {
+	running bool
+}
+
+func (f *fakeRunningChecker) IsRunning() bool {
+	return f.running
+}
+
+func doProbe(handler *HealthHandler, path string) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handler.RegisterRoutes(router)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	return w
+}
+
+func TestHealthHandler_Readiness(t *testing.T) {
+	tests := []struct {
+		name           string
+		pingErr        error
+		pubsubRunning  bool
+		expectedStatus int
+		expectedFailed []string
+	}{
+		{
+			name:           "all dependencies healthy",
+			pubsubRunning:  true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "pubsub stopped",
+			pubsubRunning:  false,
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedFailed: []string{"pubsub"},
+		},
+		{
+			name:           "database unreachable",
+			pingErr:        errors.New("connection refused"),
+			pubsubRunning:  true,
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedFailed: []string{"database"},
+		},
+		{
+			name:           "both dependencies failing",
+			pingErr:        context.DeadlineExceeded,
+			pubsubRunning:  false,
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedFailed: []string{"database", "pubsub"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewHealthHandler(&fakePinger{err: tt.pingErr}, &fakeRunningChecker{running: tt.pubsubRunning}, nil)
+
+			w := doProbe(handler, "/readyz")
+			utils.AssertEqual(t, tt.expectedStatus, w.Code, "Readiness status code")
+
+			var response struct {
+				Status string            `json:"status"`
+				Failed []string          `json:"failed"`
+				Checks map[string]string `json:"checks"`
+			}
+			err := json.Unmarshal(w.Body.Bytes(), &response)
+			utils.AssertError(t, err, false, "Should decode readiness response")
+			utils.AssertEqual(t, len(tt.expectedFailed), len(response.Failed), "Failed dependency count")
+			for i, dependency := range tt.expectedFailed {
+				utils.AssertEqual(t, dependency, response.Failed[i], "Failed dependency")
+				utils.AssertNotEqual(t, "ok", response.Checks[dependency], "Failed check should carry the reason")
+			}
+		})
+	}
+}
+
+func TestHealthHandler_LivenessIgnoresDependencies(t *testing.T) {
+	handler := NewHealthHandler(&fakePinger{err: errors.New("connection refused")}, &fakeRunningChecker{running: false}, nil)
+
+	w := doProbe(handler, "/healthz")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Liveness should pass while dependencies are down")
+}
+
+func TestHealthHandler_ReadinessReportsReconciliationDisabled(t *testing.T) {
+	for _, enabled := range []bool{true, false} {
+		handler := NewHealthHandler(&fakePinger{}, &fakeRunningChecker{running: true}, func() bool { return enabled })
+
+		w := doProbe(handler, "/readyz")
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Disabled reconciliation should not fail readiness")
+
+		var response struct {
+			ReconciliationEnabled *bool `json:"reconciliation_enabled"`
+		}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		utils.AssertError(t, err, false, "Should decode readiness response")
+		utils.AssertNotNil(t, response.ReconciliationEnabled, "Readiness should report reconciliation_enabled")
+		utils.AssertEqual(t, enabled, *response.ReconciliationEnabled, "reconciliation_enabled indicator")
+	}
+}
+
+func TestHealthHandler_ReadinessFollowsLiveReconcilerState(t *testing.T) {
+	// The reactive reconciler can be toggled in the database after startup
+	enabled := false
+	handler := NewHealthHandler(&fakePinger{}, &fakeRunningChecker{running: true}, func() bool { return enabled })
+
+	var response struct {
+		ReconciliationEnabled bool `json:"reconciliation_enabled"`
+	}
+	w := doProbe(handler, "/readyz")
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode readiness response")
+	utils.AssertFalse(t, response.ReconciliationEnabled, "Reconciliation should be reported disabled")
+
+	enabled = true
+	w = doProbe(handler, "/readyz")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode readiness response")
+	utils.AssertTrue(t, response.ReconciliationEnabled, "Readiness should pick up a reconciler enabled after startup")
+}
+
+func TestHealthHandler_ReadinessWithoutReconcilerStatus(t *testing.T) {
+	handler := NewHealthHandler(&fakePinger{}, &fakeRunningChecker{running: true}, nil)
+
+	var response struct {
+		ReconciliationEnabled *bool `json:"reconciliation_enabled"`
+	}
+	w := doProbe(handler, "/readyz")
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode readiness response")
+	utils.AssertNotNil(t, response.ReconciliationEnabled, "Readiness should report reconciliation_enabled")
+	utils.AssertFalse(t, *response.ReconciliationEnabled, "Reconciliation should be reported disabled before the reconcilers start")
+}
+
+func TestHealthHandler_ReadinessWithoutPubSub(t *testing.T) {
+	// Webhook delivery doesn't need Pub/Sub, so no checker is given
+	handler := NewHealthHandler(&fakePinger{}, nil, nil)
+
+	w := doProbe(handler, "/readyz")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Readiness should not require Pub/Sub")
+
+	var response struct {
+		Checks map[string]string `json:"checks"`
+	}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode readiness response")
+	utils.AssertEqual(t, "not required", response.Checks["pubsub"], "Pub/Sub check should be reported as not required")
+}
@@ -8,25 +8,15 @@ import (
 	"time"
 
 	"github.com/apahim/cls-backend/internal/config"
+	"github.com/apahim/cls-backend/internal/middleware"
 	"github.com/apahim/cls-backend/internal/utils"
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
 // RequestIDMiddleware adds a unique request ID to each request
 func RequestIDMiddleware() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		requestID := c.GetHeader("X-Request-ID")
-		if requestID == "" {
-			requestID = uuid.New().String()
-		}
-
-		c.Header("X-Request-ID", requestID)
-		c.Set("request_id", requestID)
-
-		c.Next()
-	}
+	return middleware.RequestID()
 }
 
 // LoggingMiddleware logs HTTP requests
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -11,6 +12,8 @@ import (
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/apahim/cls-backend/internal/auth"
+	"github.com/apahim/cls-backend/internal/config"
 	"github.com/apahim/cls-backend/internal/database"
 	"github.com/apahim/cls-backend/internal/middleware"
 	"github.com/apahim/cls-backend/internal/models"
@@ -20,20 +23,33 @@ import (
 
 // NodePoolHandler handles nodepool-related HTTP requests
 type NodePoolHandler struct {
-	repository *database.Repository
-	pubsub     *pubsub.Service
-	logger     *utils.Logger
+	repository   *database.Repository
+	pubsub       *pubsub.Service
+	maxBodyBytes int64
+	logger       *utils.Logger
 }
 
 // NewNodePoolHandler creates a new nodepool handler
 func NewNodePoolHandler(repository *database.Repository, pubsubService *pubsub.Service) *NodePoolHandler {
 	return &NodePoolHandler{
-		repository: repository,
-		pubsub:     pubsubService,
-		logger:     utils.NewLogger("nodepool_handler"),
+		repository:   repository,
+		pubsub:       pubsubService,
+		maxBodyBytes: config.DefaultMaxRequestBodyBytes,
+		logger:       utils.NewLogger("nodepool_handler"),
 	}
 }
 
+// SetMaxBodyBytes sets the largest request body accepted by the create, update and status
+// report handlers
+func (h *NodePoolHandler) SetMaxBodyBytes(limit int64) {
+	h.maxBodyBytes = limit
+}
+
+// log returns the handler's logger tagged with the request's ID
+func (h *NodePoolHandler) log(c *gin.Context) *utils.Logger {
+	return h.logger.WithContext(c.Request.Context())
+}
+
 // RegisterRoutes registers nodepool routes with the router
 func (h *NodePoolHandler) RegisterRoutes(r *gin.RouterGroup) {
 	nodepools := r.Group("/nodepools")
@@ -42,50 +58,161 @@ func (h *NodePoolHandler) RegisterRoutes(r *gin.RouterGroup) {
 		nodepools.GET("", h.ListNodePools)
 		nodepools.GET("/:id", h.GetNodePool)
 		nodepools.PUT("/:id", h.UpdateNodePool)
+		nodepools.POST("/:id/scale", h.ScaleNodePool)
 		nodepools.DELETE("/:id", h.DeleteNodePool)
+		nodepools.GET("/:id/events", h.ListNodePoolEvents)
 		nodepools.GET("/:id/status", h.GetNodePoolStatus)
 		nodepools.PUT("/:id/status", h.UpdateNodePoolStatus)
+		nodepools.DELETE("/:id/status/:controller_name", h.DeleteNodePoolControllerStatus)
+
+		// Colon-style custom methods (e.g. "reconciliation:pause"), as for clusters
+		nodepools.POST("/:id/:action", h.dispatchNodePoolAction)
 	}
+
+	// Nested nodepool routes; the nodepool must belong to the path cluster
+	r.POST("/clusters/:cluster_id/nodepools", h.CreateNodePool)
+	r.GET("/clusters/:cluster_id/nodepools/status", h.ListClusterNodePoolStatuses)
+	r.GET("/clusters/:cluster_id/nodepools/by-name/:name", h.GetNodePoolByName)
+	r.GET("/clusters/:cluster_id/nodepools/:id/status", h.GetNodePoolStatus)
+	r.PUT("/clusters/:cluster_id/nodepools/:id/status", h.UpdateNodePoolStatus)
+	r.DELETE("/clusters/:cluster_id/nodepools/:id/status/:controller_name", h.DeleteNodePoolControllerStatus)
+
+	// Data-integrity diagnostics across all users' nodepools
+	r.GET("/admin/orphaned-nodepools", h.ListOrphanedNodePools)
+
+	// Colon-style custom methods (e.g. "nodepools:by-name") can't be registered as
+	// static routes, so they are dispatched from a single cluster sub-resource route
+	r.GET("/clusters/:cluster_id/:action", h.dispatchClusterAction)
 }
 
-// CreateNodePool creates a new nodepool
-func (h *NodePoolHandler) CreateNodePool(c *gin.Context) {
-	var req models.NodePool
-	if err := c.ShouldBindJSON(&req); err != nil {
-		h.logger.Error("Invalid request body", zap.Error(err))
+// dispatchClusterAction routes cluster-scoped custom methods to their handlers
+func (h *NodePoolHandler) dispatchClusterAction(c *gin.Context) {
+	switch c.Param("action") {
+	case "nodepools:by-name":
+		h.GetNodePoolByName(c)
+	default:
+		c.JSON(http.StatusNotFound, utils.NewAPIError(
+			utils.ErrCodeNotFound,
+			"Resource not found",
+			"",
+		))
+	}
+}
+
+// dispatchNodePoolAction routes nodepool custom methods to their handlers
+func (h *NodePoolHandler) dispatchNodePoolAction(c *gin.Context) {
+	switch c.Param("action") {
+	case "reconciliation:pause":
+		h.setReconciliationPaused(c, true)
+	case "reconciliation:resume":
+		h.setReconciliationPaused(c, false)
+	default:
+		c.JSON(http.StatusNotFound, utils.NewAPIError(
+			utils.ErrCodeNotFound,
+			"Resource not found",
+			"",
+		))
+	}
+}
+
+// setReconciliationPaused pauses or resumes periodic reconciliation for a single nodepool,
+// leaving its cluster and sibling nodepools untouched
+func (h *NodePoolHandler) setReconciliationPaused(c *gin.Context, paused bool) {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
 		c.JSON(http.StatusBadRequest, utils.NewAPIError(
 			utils.ErrCodeValidation,
-			"Invalid request body",
+			"Invalid nodepool ID",
 			err.Error(),
 		))
 		return
 	}
 
-	// Validate required fields
-	if req.Name == "" {
+	ctx := c.Request.Context()
+
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	// Only the owner of the nodepool's cluster, or a controller, can pause it
+	if userCtx.IsController {
+		_, err = h.repository.NodePools.GetByIDInternal(ctx, id)
+	} else {
+		_, err = h.repository.NodePools.GetByID(ctx, id, userCtx.Email)
+	}
+	if err == nil {
+		err = h.repository.Reconciliation.SetNodePoolReconciliationPaused(ctx, id, paused)
+	}
+	if err != nil {
+		if err == models.ErrNodePoolNotFound {
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"NodePool not found",
+				"",
+			))
+			return
+		}
+
+		h.log(c).Error("Failed to set nodepool reconciliation pause state",
+			zap.String("nodepool_id", id.String()),
+			zap.Bool("paused", paused),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to update reconciliation state",
+			err.Error(),
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"nodepool_id":           id.String(),
+		"reconciliation_paused": paused,
+	})
+}
+
+// GetNodePoolByName gets a nodepool by its cluster ID and name. The name comes from the path
+// of the by-name route or the name query parameter of the nodepools:by-name custom method.
+func (h *NodePoolHandler) GetNodePoolByName(c *gin.Context) {
+	clusterIDParam := c.Param("cluster_id")
+	clusterID, err := uuid.Parse(clusterIDParam)
+	if err != nil {
 		c.JSON(http.StatusBadRequest, utils.NewAPIError(
 			utils.ErrCodeValidation,
-			"Validation failed",
-			"nodepool name is required",
+			"Invalid cluster ID",
+			err.Error(),
 		))
 		return
 	}
 
-	if req.ClusterID == uuid.Nil {
+	name := c.Param("name")
+	if name == "" {
+		name = c.Query("name")
+	}
+	if name == "" {
 		c.JSON(http.StatusBadRequest, utils.NewAPIError(
 			utils.ErrCodeValidation,
 			"Validation failed",
-			"cluster ID is required",
+			"name query parameter is required",
 		))
 		return
 	}
 
 	ctx := c.Request.Context()
 
-	// Get user email from context for client isolation
-	userEmail := c.GetString("user_email")
-	if userEmail == "" {
-		h.logger.Error("No user email found in context")
+	// Get user context from middleware
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
 		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
 			utils.ErrCodeUnauthorized,
 			"Authentication required",
@@ -94,8 +221,127 @@ func (h *NodePoolHandler) CreateNodePool(c *gin.Context) {
 		return
 	}
 
-	// Verify cluster exists and get its spec
-	cluster, err := h.repository.Clusters.GetByID(ctx, req.ClusterID, userEmail)
+	h.log(c).Info("Getting nodepool by name",
+		zap.String("cluster_id", clusterIDParam),
+		zap.String("nodepool_name", name),
+		zap.String("user_email", userCtx.Email),
+		zap.Bool("is_controller", userCtx.IsController),
+	)
+
+	var nodepool *models.NodePool
+	if userCtx.IsController {
+		// Controllers can access any nodepool
+		nodepool, err = h.repository.NodePools.GetByClusterAndNameInternal(ctx, clusterID, name)
+	} else {
+		// Users can only access their own nodepools (via cluster ownership)
+		nodepool, err = h.repository.NodePools.GetByClusterAndName(ctx, clusterID, name, userCtx.Email)
+	}
+
+	if err != nil {
+		if err == models.ErrNodePoolNotFound {
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"NodePool not found",
+				"",
+			))
+			return
+		}
+
+		h.log(c).Error("Failed to get nodepool by name",
+			zap.String("cluster_id", clusterID.String()),
+			zap.String("nodepool_name", name),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to get nodepool",
+			err.Error(),
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, nodepool)
+}
+
+// CreateNodePool creates a new nodepool
+func (h *NodePoolHandler) CreateNodePool(c *gin.Context) {
+	var req models.NodePool
+	if err := bindJSONWithLimit(c, h.maxBodyBytes, &req); err != nil {
+		if respondIfBodyTooLarge(c, err) {
+			return
+		}
+		h.log(c).Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid request body",
+			err.Error(),
+		))
+		return
+	}
+
+	// Nodepool names become Kubernetes resource names downstream
+	if err := models.ValidateNodePoolName(&req.Name); err != nil {
+		respondValidationFailed(c, http.StatusBadRequest, err)
+		return
+	}
+
+	// Under the nested route the path names the cluster; a body cluster_id must agree with it
+	if clusterIDParam := c.Param("cluster_id"); clusterIDParam != "" {
+		pathClusterID, err := uuid.Parse(clusterIDParam)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, utils.NewAPIError(
+				utils.ErrCodeValidation,
+				"Invalid cluster ID",
+				err.Error(),
+			))
+			return
+		}
+		if req.ClusterID == uuid.Nil {
+			req.ClusterID = pathClusterID
+		} else if req.ClusterID != pathClusterID {
+			respondValidationFailed(c, http.StatusBadRequest, utils.NewValidationErrors(utils.ValidationDetails{
+				Field:   "cluster_id",
+				Value:   req.ClusterID,
+				Message: fmt.Sprintf("cluster_id %s does not match cluster %s in the path", req.ClusterID, pathClusterID),
+			}))
+			return
+		}
+	}
+
+	if req.ClusterID == uuid.Nil {
+		respondValidationFailed(c, http.StatusBadRequest, utils.NewValidationErrors(utils.ValidationDetails{
+			Field:   "cluster_id",
+			Message: "cluster ID is required",
+		}))
+		return
+	}
+
+	if err := req.Spec.ValidateAutoscaling(); err != nil {
+		respondValidationFailed(c, http.StatusBadRequest, err)
+		return
+	}
+	req.Spec.NormalizeReplicas()
+
+	ctx := c.Request.Context()
+
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+	userEmail := userCtx.Email
+
+	// Verify cluster exists and get its spec; grantees can read the cluster but not add
+	// nodepools to it
+	cluster, err := h.repository.Clusters.GetByID(ctx, req.ClusterID, userCtx.Email, userCtx.IsController)
+	if err == nil && !auth.CanUpdateCluster(userCtx, cluster) {
+		err = models.ErrClusterNotFound
+	}
 	if err != nil {
 		if err == models.ErrClusterNotFound {
 			c.JSON(http.StatusBadRequest, utils.NewAPIError(
@@ -106,7 +352,7 @@ func (h *NodePoolHandler) CreateNodePool(c *gin.Context) {
 			return
 		}
 
-		h.logger.Error("Failed to verify cluster",
+		h.log(c).Error("Failed to verify cluster",
 			zap.String("cluster_id", req.ClusterID.String()),
 			zap.Error(err),
 		)
@@ -129,12 +375,20 @@ func (h *NodePoolHandler) CreateNodePool(c *gin.Context) {
 	req.ResourceVersion = uuid.New().String()
 	req.CreatedBy = userEmail
 
-	// Create nodepool in database
-	err = h.repository.NodePools.Create(ctx, &req)
+	// Create nodepool in database, with its audit entry and event in the same transaction
+	err = h.repository.Transaction(ctx, func(txRepo *database.Repository) error {
+		if err := txRepo.NodePools.Create(ctx, &req); err != nil {
+			return err
+		}
+		if err := txRepo.Audit.RecordChange(ctx, userEmail, models.AuditActionCreate, models.AuditResourceNodePool, req.ID, nil, req.Spec); err != nil {
+			return err
+		}
+		return txRepo.Status.CreateNodePoolEvent(ctx, models.NewNodePoolEvent(models.NodePoolEventCreated, &req, nil))
+	})
 	if err != nil {
 		// Check for unique constraint violation (duplicate name in cluster)
 		if strings.Contains(err.Error(), "duplicate key") || strings.Contains(err.Error(), "UNIQUE constraint") {
-			h.logger.Warn("NodePool name already exists in cluster",
+			h.log(c).Warn("NodePool name already exists in cluster",
 				zap.String("nodepool_name", req.Name),
 				zap.String("cluster_id", req.ClusterID.String()),
 			)
@@ -146,7 +400,7 @@ func (h *NodePoolHandler) CreateNodePool(c *gin.Context) {
 			return
 		}
 
-		h.logger.Error("Failed to create nodepool",
+		h.log(c).Error("Failed to create nodepool",
 			zap.String("nodepool_name", req.Name),
 			zap.String("cluster_id", req.ClusterID.String()),
 			zap.Error(err),
@@ -162,14 +416,14 @@ func (h *NodePoolHandler) CreateNodePool(c *gin.Context) {
 	// Publish nodepool created event
 	if h.pubsub != nil && h.pubsub.IsRunning() {
 		if err := h.pubsub.GetPublisher().PublishNodePoolCreated(ctx, &req); err != nil {
-			h.logger.Warn("Failed to publish nodepool created event",
+			h.log(c).Warn("Failed to publish nodepool created event",
 				zap.String("nodepool_id", req.ID.String()),
 				zap.Error(err),
 			)
 		}
 	}
 
-	h.logger.Info("NodePool created successfully",
+	h.log(c).Info("NodePool created successfully",
 		zap.String("nodepool_id", req.ID.String()),
 		zap.String("nodepool_name", req.Name),
 		zap.String("cluster_id", req.ClusterID.String()),
@@ -178,12 +432,231 @@ func (h *NodePoolHandler) CreateNodePool(c *gin.Context) {
 	c.JSON(http.StatusCreated, req)
 }
 
+// errNodePoolNameConflict aborts a batch create transaction when any name is taken
+var errNodePoolNameConflict = errors.New("nodepool name conflict")
+
+// BatchCreateNodePools creates several nodepools under a cluster in one transaction.
+// Either every nodepool is created or none is.
+func (h *NodePoolHandler) BatchCreateNodePools(c *gin.Context) {
+	clusterID, err := uuid.Parse(c.Param("cluster_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid cluster ID",
+			err.Error(),
+		))
+		return
+	}
+
+	var req models.NodePoolBatchCreateRequest
+	if err := bindJSONWithLimit(c, h.maxBodyBytes, &req); err != nil {
+		if respondIfBodyTooLarge(c, err) {
+			return
+		}
+		h.log(c).Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid request body",
+			err.Error(),
+		))
+		return
+	}
+
+	if len(req.NodePools) == 0 {
+		respondValidationFailed(c, http.StatusBadRequest, utils.NewValidationErrors(utils.ValidationDetails{
+			Field:   "nodepools",
+			Message: "at least one nodepool is required",
+		}))
+		return
+	}
+
+	// Validate every item up front so a bad entry never opens a transaction
+	seen := make(map[string]bool, len(req.NodePools))
+	for i := range req.NodePools {
+		item := &req.NodePools[i]
+		if err := models.ValidateNodePoolName(&item.Name); err != nil {
+			var fieldErrors utils.ValidationErrors
+			errors.As(err, &fieldErrors)
+			respondValidationFailed(c, http.StatusBadRequest, fieldErrors.Prefixed(fmt.Sprintf("nodepools[%d]", i)))
+			return
+		}
+		if seen[item.Name] {
+			fieldErrors := utils.NewValidationErrors(utils.ValidationDetails{
+				Field:   "name",
+				Value:   item.Name,
+				Message: fmt.Sprintf("duplicate nodepool name '%s' in request", item.Name),
+			})
+			respondValidationFailed(c, http.StatusBadRequest, fieldErrors.Prefixed(fmt.Sprintf("nodepools[%d]", i)))
+			return
+		}
+		seen[item.Name] = true
+
+		if err := item.Spec.ValidateAutoscaling(); err != nil {
+			var fieldErrors utils.ValidationErrors
+			errors.As(err, &fieldErrors)
+			respondValidationFailed(c, http.StatusBadRequest, fieldErrors.Prefixed(fmt.Sprintf("nodepools[%d]", i)))
+			return
+		}
+		item.Spec.NormalizeReplicas()
+	}
+
+	ctx := c.Request.Context()
+
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+	userEmail := userCtx.Email
+
+	// Ownership is checked once for the whole batch; grantees can read the cluster but not
+	// add nodepools to it
+	cluster, err := h.repository.Clusters.GetByID(ctx, clusterID, userCtx.Email, userCtx.IsController)
+	if err == nil && !auth.CanUpdateCluster(userCtx, cluster) {
+		err = models.ErrClusterNotFound
+	}
+	if err != nil {
+		if err == models.ErrClusterNotFound {
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"Cluster not found",
+				"",
+			))
+			return
+		}
+
+		h.log(c).Error("Failed to verify cluster",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to verify cluster",
+			err.Error(),
+		))
+		return
+	}
+
+	nodepools := make([]*models.NodePool, 0, len(req.NodePools))
+	for _, item := range req.NodePools {
+		nodepool := &models.NodePool{
+			ID:              uuid.New(),
+			ClusterID:       clusterID,
+			Name:            item.Name,
+			CreatedBy:       userEmail,
+			Generation:      1,
+			ResourceVersion: uuid.New().String(),
+			Spec:            item.Spec,
+		}
+
+		// Inherit release version from parent cluster if not provided
+		if nodepool.Spec.Release.Version == "" {
+			nodepool.Spec.Release.Version = cluster.Spec.Release.Version
+		}
+		nodepools = append(nodepools, nodepool)
+	}
+
+	var conflicts []string
+	err = h.repository.Transaction(ctx, func(txRepo *database.Repository) error {
+		// Reset on every attempt, the transaction may be retried
+		conflicts = nil
+
+		// Look up every name first so the response reports all conflicts, not just the first
+		for _, nodepool := range nodepools {
+			_, err := txRepo.NodePools.GetByClusterAndNameInternal(ctx, clusterID, nodepool.Name)
+			if err == nil {
+				conflicts = append(conflicts, nodepool.Name)
+				continue
+			}
+			if err != models.ErrNodePoolNotFound {
+				return err
+			}
+		}
+		if len(conflicts) > 0 {
+			return errNodePoolNameConflict
+		}
+
+		for _, nodepool := range nodepools {
+			if err := txRepo.NodePools.Create(ctx, nodepool); err != nil {
+				// Soft-deleted nodepools still hold their name
+				if strings.Contains(err.Error(), "duplicate key") || strings.Contains(err.Error(), "UNIQUE constraint") {
+					conflicts = append(conflicts, nodepool.Name)
+					return errNodePoolNameConflict
+				}
+				return err
+			}
+			if err := txRepo.Audit.RecordChange(ctx, userEmail, models.AuditActionCreate, models.AuditResourceNodePool, nodepool.ID, nil, nodepool.Spec); err != nil {
+				return err
+			}
+			if err := txRepo.Status.CreateNodePoolEvent(ctx, models.NewNodePoolEvent(models.NodePoolEventCreated, nodepool, nil)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, errNodePoolNameConflict) {
+			h.log(c).Warn("NodePool names already exist in cluster",
+				zap.Strings("nodepool_names", conflicts),
+				zap.String("cluster_id", clusterID.String()),
+			)
+			apiErr := utils.NewAPIError(
+				utils.ErrCodeConflict,
+				"NodePool already exists",
+				fmt.Sprintf("nodepools with names '%s' already exist in this cluster", strings.Join(conflicts, "', '")),
+			)
+			apiErr.Details = gin.H{"names": conflicts}
+			c.JSON(http.StatusConflict, apiErr)
+			return
+		}
+
+		h.log(c).Error("Failed to create nodepools",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Int("count", len(nodepools)),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to create nodepools",
+			err.Error(),
+		))
+		return
+	}
+
+	// Events are only published once the whole batch has committed
+	if h.pubsub != nil && h.pubsub.IsRunning() {
+		for _, nodepool := range nodepools {
+			if err := h.pubsub.GetPublisher().PublishNodePoolCreated(ctx, nodepool); err != nil {
+				h.log(c).Warn("Failed to publish nodepool created event",
+					zap.String("nodepool_id", nodepool.ID.String()),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+
+	h.log(c).Info("NodePools created successfully",
+		zap.String("cluster_id", clusterID.String()),
+		zap.Int("count", len(nodepools)),
+	)
+
+	c.JSON(http.StatusCreated, gin.H{
+		"nodepools": nodepools,
+	})
+}
+
 // ListNodePools lists nodepools with optional cluster filtering
 func (h *NodePoolHandler) ListNodePools(c *gin.Context) {
 	// Parse query parameters
+	// Comma-separated filter values match any of the values
 	opts := &models.ListOptions{
-		Status: c.Query("status"),
-		Health: c.Query("health"),
+		Status: models.SplitFilterValues(c.Query("status")),
+		Health: models.SplitFilterValues(c.Query("health")),
 	}
 
 	if limit := c.Query("limit"); limit != "" {
@@ -208,12 +681,33 @@ func (h *NodePoolHandler) ListNodePools(c *gin.Context) {
 		return
 	}
 
+	// Reject unknown filter values instead of silently matching nothing
+	for _, status := range opts.Status {
+		if !models.IsValidNodePoolPhase(status) {
+			c.JSON(http.StatusBadRequest, utils.NewAPIError(
+				utils.ErrCodeValidation,
+				"Invalid status filter",
+				fmt.Sprintf("status %q must be one of: %s", status, strings.Join(models.NodePoolPhases, ", ")),
+			))
+			return
+		}
+	}
+	// Nodepools report no separate health, so a health filter could never be applied
+	if len(opts.Health) > 0 {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid health filter",
+			"nodepools do not support the health filter; filter by status instead",
+		))
+		return
+	}
+
 	ctx := c.Request.Context()
 
 	// Get user email from context (required for client isolation)
 	userEmail := c.GetString("user_email")
 	if userEmail == "" {
-		h.logger.Error("No user email found in context")
+		h.log(c).Error("No user email found in context")
 		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
 			utils.ErrCodeUnauthorized,
 			"Authentication required",
@@ -241,7 +735,7 @@ func (h *NodePoolHandler) ListNodePools(c *gin.Context) {
 
 		nodepools, err = h.repository.NodePools.ListByCluster(ctx, clusterID, userEmail, opts)
 		if err != nil {
-			h.logger.Error("Failed to list nodepools by cluster",
+			h.log(c).Error("Failed to list nodepools by cluster",
 				zap.String("cluster_id", clusterID.String()),
 				zap.Error(err))
 			c.JSON(http.StatusInternalServerError, utils.NewAPIError(
@@ -252,9 +746,9 @@ func (h *NodePoolHandler) ListNodePools(c *gin.Context) {
 			return
 		}
 
-		total, err = h.repository.NodePools.CountByCluster(ctx, clusterID)
+		total, err = h.repository.NodePools.CountByClusterWithOptions(ctx, clusterID, userEmail, opts)
 		if err != nil {
-			h.logger.Error("Failed to count nodepools by cluster",
+			h.log(c).Error("Failed to count nodepools by cluster",
 				zap.String("cluster_id", clusterID.String()),
 				zap.Error(err))
 			c.JSON(http.StatusInternalServerError, utils.NewAPIError(
@@ -268,7 +762,7 @@ func (h *NodePoolHandler) ListNodePools(c *gin.Context) {
 		// List all nodepools for user (across all clusters)
 		nodepools, err = h.repository.NodePools.List(ctx, userEmail, opts)
 		if err != nil {
-			h.logger.Error("Failed to list all nodepools",
+			h.log(c).Error("Failed to list all nodepools",
 				zap.String("user_email", userEmail),
 				zap.Error(err))
 			c.JSON(http.StatusInternalServerError, utils.NewAPIError(
@@ -281,7 +775,7 @@ func (h *NodePoolHandler) ListNodePools(c *gin.Context) {
 
 		total, err = h.repository.NodePools.Count(ctx, userEmail, opts)
 		if err != nil {
-			h.logger.Error("Failed to count all nodepools",
+			h.log(c).Error("Failed to count all nodepools",
 				zap.String("user_email", userEmail),
 				zap.Error(err))
 			c.JSON(http.StatusInternalServerError, utils.NewAPIError(
@@ -293,11 +787,14 @@ func (h *NodePoolHandler) ListNodePools(c *gin.Context) {
 		}
 	}
 
+	page := models.NewPageInfo(total, opts.Limit, opts.Offset, len(nodepools))
 	response := map[string]interface{}{
-		"nodepools": nodepools,
-		"total":     total,
-		"limit":     opts.Limit,
-		"offset":    opts.Offset,
+		"nodepools":   nodepools,
+		"total":       total,
+		"limit":       opts.Limit,
+		"offset":      opts.Offset,
+		"has_more":    page.HasMore,
+		"total_pages": page.TotalPages,
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -321,7 +818,7 @@ func (h *NodePoolHandler) GetNodePool(c *gin.Context) {
 	// Get user context from middleware
 	userCtx, exists := middleware.GetUserContext(c)
 	if !exists {
-		h.logger.Error("No user context found")
+		h.log(c).Error("No user context found")
 		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
 			utils.ErrCodeUnauthorized,
 			"Authentication required",
@@ -330,7 +827,7 @@ func (h *NodePoolHandler) GetNodePool(c *gin.Context) {
 		return
 	}
 
-	h.logger.Info("Getting nodepool",
+	h.log(c).Info("Getting nodepool",
 		zap.String("nodepool_id", idParam),
 		zap.String("user_email", userCtx.Email),
 		zap.Bool("is_controller", userCtx.IsController),
@@ -355,7 +852,7 @@ func (h *NodePoolHandler) GetNodePool(c *gin.Context) {
 			return
 		}
 
-		h.logger.Error("Failed to get nodepool",
+		h.log(c).Error("Failed to get nodepool",
 			zap.String("nodepool_id", id.String()),
 			zap.Error(err),
 		)
@@ -384,8 +881,11 @@ func (h *NodePoolHandler) UpdateNodePool(c *gin.Context) {
 	}
 
 	var req models.NodePoolUpdateRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		h.logger.Error("Invalid request body", zap.Error(err))
+	if err := bindJSONWithLimit(c, h.maxBodyBytes, &req); err != nil {
+		if respondIfBodyTooLarge(c, err) {
+			return
+		}
+		h.log(c).Error("Invalid request body", zap.Error(err))
 		c.JSON(http.StatusBadRequest, utils.NewAPIError(
 			utils.ErrCodeValidation,
 			"Invalid request body",
@@ -394,12 +894,18 @@ func (h *NodePoolHandler) UpdateNodePool(c *gin.Context) {
 		return
 	}
 
+	if err := req.Spec.ValidateAutoscaling(); err != nil {
+		respondValidationFailed(c, http.StatusBadRequest, err)
+		return
+	}
+	req.Spec.NormalizeReplicas()
+
 	ctx := c.Request.Context()
 
 	// Get user email from context for client isolation
 	userEmail := c.GetString("user_email")
 	if userEmail == "" {
-		h.logger.Error("No user email found in context")
+		h.log(c).Error("No user email found in context")
 		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
 			utils.ErrCodeUnauthorized,
 			"Authentication required",
@@ -420,7 +926,7 @@ func (h *NodePoolHandler) UpdateNodePool(c *gin.Context) {
 			return
 		}
 
-		h.logger.Error("Failed to get nodepool",
+		h.log(c).Error("Failed to get nodepool",
 			zap.String("nodepool_id", id.String()),
 			zap.Error(err),
 		)
@@ -437,20 +943,29 @@ func (h *NodePoolHandler) UpdateNodePool(c *gin.Context) {
 		req.Spec.Release.Version = existing.Spec.Release.Version
 	}
 
-	// Track changes for event publishing
-	hasChanges := existing.Spec != req.Spec
+	// Track changes for event publishing (normalized, so label ordering and nil versus empty fields don't count)
+	hasChanges := !models.SpecsEqual(existing.Spec, req.Spec)
 
 	// Update only mutable fields on existing object
 	// This preserves all immutable fields: name, created_by, cluster_id, id, created_at
+	previousSpec := existing.Spec
 	existing.Spec = req.Spec
 	existing.Generation++
 	existing.ResourceVersion = uuid.New().String()
 	existing.UpdatedAt = time.Now()
 
-	// Update nodepool in database
-	err = h.repository.NodePools.Update(ctx, existing, userEmail)
+	// Update nodepool in database, with its audit entry and event in the same transaction
+	err = h.repository.Transaction(ctx, func(txRepo *database.Repository) error {
+		if err := txRepo.NodePools.Update(ctx, existing, userEmail); err != nil {
+			return err
+		}
+		if err := txRepo.Audit.RecordChange(ctx, userEmail, models.AuditActionUpdate, models.AuditResourceNodePool, existing.ID, previousSpec, existing.Spec); err != nil {
+			return err
+		}
+		return txRepo.Status.CreateNodePoolEvent(ctx, models.NewNodePoolEvent(models.NodePoolEventUpdated, existing, nil))
+	})
 	if err != nil {
-		h.logger.Error("Failed to update nodepool",
+		h.log(c).Error("Failed to update nodepool",
 			zap.String("nodepool_id", id.String()),
 			zap.Error(err),
 		)
@@ -465,24 +980,261 @@ func (h *NodePoolHandler) UpdateNodePool(c *gin.Context) {
 	// Publish nodepool updated event if there were changes
 	if hasChanges && h.pubsub != nil && h.pubsub.IsRunning() {
 		if err := h.pubsub.GetPublisher().PublishNodePoolUpdated(ctx, existing); err != nil {
-			h.logger.Warn("Failed to publish nodepool updated event",
+			h.log(c).Warn("Failed to publish nodepool updated event",
+				zap.String("nodepool_id", existing.ID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+
+	h.log(c).Info("NodePool updated successfully",
+		zap.String("nodepool_id", existing.ID.String()),
+		zap.String("nodepool_name", existing.Name),
+		zap.Int64("generation", existing.Generation),
+	)
+
+	c.JSON(http.StatusOK, existing)
+}
+
+// ScaleNodePool changes only a nodepool's replica count
+func (h *NodePoolHandler) ScaleNodePool(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid nodepool ID",
+			err.Error(),
+		))
+		return
+	}
+
+	var req models.NodePoolScaleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log(c).Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid request body",
+			err.Error(),
+		))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		respondValidationFailed(c, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	// Get user email from context for client isolation
+	userEmail := c.GetString("user_email")
+	if userEmail == "" {
+		h.log(c).Error("No user email found in context")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	// Get existing nodepool
+	existing, err := h.repository.NodePools.GetByID(ctx, id, userEmail)
+	if err != nil {
+		if err == models.ErrNodePoolNotFound {
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"NodePool not found",
+				"",
+			))
+			return
+		}
+
+		h.log(c).Error("Failed to get nodepool",
+			zap.String("nodepool_id", id.String()),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to get nodepool",
+			err.Error(),
+		))
+		return
+	}
+
+	// A fixed replica count is ignored on autoscaled pools, so scaling one would be a no-op
+	if existing.Spec.Autoscaling != nil {
+		c.JSON(http.StatusConflict, utils.NewAPIError(
+			utils.ErrCodeConflict,
+			"NodePool is autoscaled",
+			"update spec.autoscaling to change the replica bounds",
+		))
+		return
+	}
+
+	// Scaling to the current count changes nothing, so it is not stored, audited or reconciled
+	if existing.Spec.Replicas != nil && *existing.Spec.Replicas == *req.Replicas {
+		c.JSON(http.StatusOK, existing)
+		return
+	}
+
+	// Only the replica count changes; the rest of the spec is kept as stored
+	previousSpec := existing.Spec
+	existing.Spec.Replicas = req.Replicas
+	existing.Generation++
+	existing.ResourceVersion = uuid.New().String()
+	existing.UpdatedAt = time.Now()
+
+	scaled := models.JSONB{"from_replicas": previousSpec.Replicas, "to_replicas": *req.Replicas}
+	err = h.repository.Transaction(ctx, func(txRepo *database.Repository) error {
+		if err := txRepo.NodePools.Update(ctx, existing, userEmail); err != nil {
+			return err
+		}
+		if err := txRepo.Audit.RecordChange(ctx, userEmail, models.AuditActionUpdate, models.AuditResourceNodePool, existing.ID, previousSpec, existing.Spec); err != nil {
+			return err
+		}
+		return txRepo.Status.CreateNodePoolEvent(ctx, models.NewNodePoolEvent(models.NodePoolEventScaled, existing, scaled))
+	})
+	if err != nil {
+		h.log(c).Error("Failed to scale nodepool",
+			zap.String("nodepool_id", id.String()),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to scale nodepool",
+			err.Error(),
+		))
+		return
+	}
+
+	// Publish nodepool scaled event
+	if h.pubsub != nil && h.pubsub.IsRunning() {
+		if err := h.pubsub.GetPublisher().PublishNodePoolScaled(ctx, existing); err != nil {
+			h.log(c).Warn("Failed to publish nodepool scaled event",
 				zap.String("nodepool_id", existing.ID.String()),
 				zap.Error(err),
 			)
 		}
 	}
 
-	h.logger.Info("NodePool updated successfully",
+	h.log(c).Info("NodePool scaled successfully",
 		zap.String("nodepool_id", existing.ID.String()),
 		zap.String("nodepool_name", existing.Name),
+		zap.Int32("replicas", *existing.Spec.Replicas),
 		zap.Int64("generation", existing.Generation),
 	)
 
 	c.JSON(http.StatusOK, existing)
 }
 
-// DeleteNodePool deletes a nodepool
-func (h *NodePoolHandler) DeleteNodePool(c *gin.Context) {
+// DeleteNodePool deletes a nodepool
+func (h *NodePoolHandler) DeleteNodePool(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid nodepool ID",
+			err.Error(),
+		))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	// Get user email from context for client isolation
+	userEmail := c.GetString("user_email")
+	if userEmail == "" {
+		h.log(c).Error("No user email found in context")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	// Get existing nodepool for event publishing
+	nodepool, err := h.repository.NodePools.GetByID(ctx, id, userEmail)
+	if err != nil {
+		if err == models.ErrNodePoolNotFound {
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"NodePool not found",
+				"",
+			))
+			return
+		}
+
+		h.log(c).Error("Failed to get nodepool",
+			zap.String("nodepool_id", id.String()),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to get nodepool",
+			err.Error(),
+		))
+		return
+	}
+
+	// Delete nodepool in database (soft delete), with its audit entry and event in the same transaction
+	err = h.repository.Transaction(ctx, func(txRepo *database.Repository) error {
+		if err := txRepo.NodePools.Delete(ctx, id, userEmail); err != nil {
+			return err
+		}
+		if err := txRepo.Audit.RecordChange(ctx, userEmail, models.AuditActionDelete, models.AuditResourceNodePool, id, nodepool.Spec, nil); err != nil {
+			return err
+		}
+		return txRepo.Status.CreateNodePoolEvent(ctx, models.NewNodePoolEvent(models.NodePoolEventDeleted, nodepool, nil))
+	})
+	if err != nil {
+		h.log(c).Error("Failed to delete nodepool",
+			zap.String("nodepool_id", id.String()),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to delete nodepool",
+			err.Error(),
+		))
+		return
+	}
+
+	// Delete controller status
+	if err := h.repository.Status.DeleteAllNodePoolControllerStatus(ctx, id); err != nil {
+		h.log(c).Warn("Failed to delete nodepool controller status",
+			zap.String("nodepool_id", id.String()),
+			zap.Error(err),
+		)
+	}
+
+	// Publish nodepool deleted event
+	if h.pubsub != nil && h.pubsub.IsRunning() {
+		if err := h.pubsub.GetPublisher().PublishNodePoolDeleted(ctx, nodepool); err != nil {
+			h.log(c).Warn("Failed to publish nodepool deleted event",
+				zap.String("nodepool_id", nodepool.ID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+
+	h.log(c).Info("NodePool deleted successfully",
+		zap.String("nodepool_id", id.String()),
+		zap.String("nodepool_name", nodepool.Name),
+	)
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message":     "nodepool deletion initiated",
+		"nodepool_id": id.String(),
+	})
+}
+
+// ListNodePoolEvents lists a page of a nodepool's events, newest first, optionally filtered
+// by type. Access follows the nodepool's cluster ownership.
+func (h *NodePoolHandler) ListNodePoolEvents(c *gin.Context) {
 	idParam := c.Param("id")
 	id, err := uuid.Parse(idParam)
 	if err != nil {
@@ -494,12 +1246,40 @@ func (h *NodePoolHandler) DeleteNodePool(c *gin.Context) {
 		return
 	}
 
+	opts := &models.NodePoolEventListOptions{
+		EventType: strings.TrimSpace(c.Query("event_type")),
+		Limit:     50,
+	}
+	if limitStr := c.Query("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil || parsedLimit <= 0 || parsedLimit > 1000 {
+			c.JSON(http.StatusBadRequest, utils.NewAPIError(
+				utils.ErrCodeValidation,
+				"Invalid limit",
+				"limit must be between 1 and 1000",
+			))
+			return
+		}
+		opts.Limit = parsedLimit
+	}
+	if offsetStr := c.Query("offset"); offsetStr != "" {
+		parsedOffset, err := strconv.Atoi(offsetStr)
+		if err != nil || parsedOffset < 0 {
+			c.JSON(http.StatusBadRequest, utils.NewAPIError(
+				utils.ErrCodeValidation,
+				"Invalid offset",
+				"offset must be 0 or greater",
+			))
+			return
+		}
+		opts.Offset = parsedOffset
+	}
+
 	ctx := c.Request.Context()
 
-	// Get user email from context for client isolation
-	userEmail := c.GetString("user_email")
-	if userEmail == "" {
-		h.logger.Error("No user email found in context")
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
 		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
 			utils.ErrCodeUnauthorized,
 			"Authentication required",
@@ -508,8 +1288,13 @@ func (h *NodePoolHandler) DeleteNodePool(c *gin.Context) {
 		return
 	}
 
-	// Get existing nodepool for event publishing
-	nodepool, err := h.repository.NodePools.GetByID(ctx, id, userEmail)
+	// Verify the nodepool exists and the user owns its cluster. Deleted nodepools are
+	// included so their history, ending with the deleted event, stays readable.
+	if userCtx.IsController {
+		err = h.repository.NodePools.ExistsIncludingDeletedInternal(ctx, id)
+	} else {
+		err = h.repository.NodePools.ExistsIncludingDeleted(ctx, id, userCtx.Email)
+	}
 	if err != nil {
 		if err == models.ErrNodePoolNotFound {
 			c.JSON(http.StatusNotFound, utils.NewAPIError(
@@ -520,7 +1305,7 @@ func (h *NodePoolHandler) DeleteNodePool(c *gin.Context) {
 			return
 		}
 
-		h.logger.Error("Failed to get nodepool",
+		h.log(c).Error("Failed to get nodepool for events",
 			zap.String("nodepool_id", id.String()),
 			zap.Error(err),
 		)
@@ -532,50 +1317,44 @@ func (h *NodePoolHandler) DeleteNodePool(c *gin.Context) {
 		return
 	}
 
-	// Delete nodepool in database (soft delete)
-	err = h.repository.NodePools.Delete(ctx, id, userEmail)
+	events, total, err := h.repository.Status.ListNodePoolEvents(ctx, id, opts)
 	if err != nil {
-		h.logger.Error("Failed to delete nodepool",
+		h.log(c).Error("Failed to list nodepool events",
 			zap.String("nodepool_id", id.String()),
+			zap.String("event_type", opts.EventType),
 			zap.Error(err),
 		)
 		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
 			utils.ErrCodeInternal,
-			"Failed to delete nodepool",
+			"Failed to list nodepool events",
 			err.Error(),
 		))
 		return
 	}
 
-	// Delete controller status
-	if err := h.repository.Status.DeleteAllNodePoolControllerStatus(ctx, id); err != nil {
-		h.logger.Warn("Failed to delete nodepool controller status",
-			zap.String("nodepool_id", id.String()),
-			zap.Error(err),
-		)
-	}
-
-	// Publish nodepool deleted event
-	if h.pubsub != nil && h.pubsub.IsRunning() {
-		if err := h.pubsub.GetPublisher().PublishNodePoolDeleted(ctx, nodepool); err != nil {
-			h.logger.Warn("Failed to publish nodepool deleted event",
-				zap.String("nodepool_id", nodepool.ID.String()),
-				zap.Error(err),
-			)
-		}
-	}
-
-	h.logger.Info("NodePool deleted successfully",
-		zap.String("nodepool_id", id.String()),
-		zap.String("nodepool_name", nodepool.Name),
-	)
-
-	c.JSON(http.StatusAccepted, gin.H{
-		"message":     "nodepool deletion initiated",
+	page := models.NewPageInfo(total, opts.Limit, opts.Offset, len(events))
+	c.JSON(http.StatusOK, gin.H{
 		"nodepool_id": id.String(),
+		"events":      events,
+		"total":       total,
+		"limit":       opts.Limit,
+		"offset":      opts.Offset,
+		"has_more":    page.HasMore,
+		"total_pages": page.TotalPages,
 	})
 }
 
+// matchesPathCluster reports whether the nodepool belongs to the cluster in the
+// request path. Routes without a cluster_id segment always match.
+func matchesPathCluster(c *gin.Context, nodepool *models.NodePool) bool {
+	clusterIDParam := c.Param("cluster_id")
+	if clusterIDParam == "" {
+		return true
+	}
+	clusterID, err := uuid.Parse(clusterIDParam)
+	return err == nil && clusterID == nodepool.ClusterID
+}
+
 // GetNodePoolStatus retrieves nodepool status information
 // Returns both aggregated K8s-like status and individual controller status reports
 func (h *NodePoolHandler) GetNodePoolStatus(c *gin.Context) {
@@ -595,7 +1374,7 @@ func (h *NodePoolHandler) GetNodePoolStatus(c *gin.Context) {
 	// Get user context for access control
 	userCtx, exists := middleware.GetUserContext(c)
 	if !exists {
-		h.logger.Error("No user context found")
+		h.log(c).Error("No user context found")
 		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
 			utils.ErrCodeUnauthorized,
 			"Authentication required",
@@ -604,7 +1383,7 @@ func (h *NodePoolHandler) GetNodePoolStatus(c *gin.Context) {
 		return
 	}
 
-	h.logger.Info("Getting nodepool status",
+	h.log(c).Info("Getting nodepool status",
 		zap.String("nodepool_id", id.String()),
 		zap.String("user_email", userCtx.Email),
 		zap.Bool("is_controller", userCtx.IsController),
@@ -613,7 +1392,7 @@ func (h *NodePoolHandler) GetNodePoolStatus(c *gin.Context) {
 	// Get nodepool to retrieve aggregated status
 	nodepool, err := h.repository.NodePools.GetByID(ctx, id, userCtx.Email)
 	if err != nil {
-		h.logger.Error("Failed to get nodepool",
+		h.log(c).Error("Failed to get nodepool",
 			zap.String("nodepool_id", id.String()),
 			zap.Error(err),
 		)
@@ -634,10 +1413,19 @@ func (h *NodePoolHandler) GetNodePoolStatus(c *gin.Context) {
 		return
 	}
 
+	if !matchesPathCluster(c, nodepool) {
+		c.JSON(http.StatusNotFound, utils.NewAPIError(
+			utils.ErrCodeNotFound,
+			"NodePool not found",
+			"",
+		))
+		return
+	}
+
 	// Get individual controller status reports
 	controllerStatuses, err := h.repository.Status.ListNodePoolControllerStatus(ctx, id)
 	if err != nil {
-		h.logger.Error("Failed to get nodepool controller status",
+		h.log(c).Error("Failed to get nodepool controller status",
 			zap.String("nodepool_id", id.String()),
 			zap.Error(err),
 		)
@@ -649,7 +1437,7 @@ func (h *NodePoolHandler) GetNodePoolStatus(c *gin.Context) {
 		return
 	}
 
-	h.logger.Info("Retrieved nodepool status",
+	h.log(c).Info("Retrieved nodepool status",
 		zap.String("nodepool_id", id.String()),
 		zap.Int("controller_count", len(controllerStatuses)),
 	)
@@ -665,6 +1453,112 @@ func (h *NodePoolHandler) GetNodePoolStatus(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// ListClusterNodePoolStatuses returns the aggregated status and controller reports of every
+// nodepool in a cluster, ordered by nodepool name
+func (h *NodePoolHandler) ListClusterNodePoolStatuses(c *gin.Context) {
+	clusterIDParam := c.Param("cluster_id")
+	clusterID, err := uuid.Parse(clusterIDParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid cluster ID",
+			err.Error(),
+		))
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	// Ownership is checked once on the cluster rather than per nodepool
+	if _, err := h.repository.Clusters.GetByID(ctx, clusterID, userCtx.Email, userCtx.IsController); err != nil {
+		if err == models.ErrClusterNotFound {
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"Cluster not found",
+				"",
+			))
+			return
+		}
+
+		h.log(c).Error("Failed to verify cluster",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to verify cluster",
+			err.Error(),
+		))
+		return
+	}
+
+	// Nodepools come back ordered by name with their status enriched in one batch
+	nodepools, err := h.repository.NodePools.ListByClusterInternal(ctx, clusterID)
+	if err != nil {
+		h.log(c).Error("Failed to list nodepools",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to list nodepools",
+			err.Error(),
+		))
+		return
+	}
+
+	// One query for every nodepool's reports rather than one per nodepool
+	controllerStatuses, err := h.repository.Status.ListNodePoolControllerStatusByCluster(ctx, clusterID)
+	if err != nil {
+		h.log(c).Error("Failed to list nodepool controller status",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to list nodepool controller status",
+			err.Error(),
+		))
+		return
+	}
+
+	statusesByNodePool := make(map[uuid.UUID][]*models.NodePoolControllerStatus)
+	for _, status := range controllerStatuses {
+		statusesByNodePool[status.NodePoolID] = append(statusesByNodePool[status.NodePoolID], status)
+	}
+
+	views := make([]*models.NodePoolStatusView, 0, len(nodepools))
+	for _, nodepool := range nodepools {
+		reports := statusesByNodePool[nodepool.ID]
+		if reports == nil {
+			reports = []*models.NodePoolControllerStatus{}
+		}
+		views = append(views, &models.NodePoolStatusView{
+			NodePoolID:       nodepool.ID,
+			Name:             nodepool.Name,
+			Status:           nodepool.Status,
+			ControllerStatus: reports,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"cluster_id": clusterID,
+		"nodepools":  views,
+		"total":      len(views),
+	})
+}
+
 // UpdateNodePoolStatus handles controller status updates for nodepools
 func (h *NodePoolHandler) UpdateNodePoolStatus(c *gin.Context) {
 	idParam := c.Param("id")
@@ -679,8 +1573,11 @@ func (h *NodePoolHandler) UpdateNodePoolStatus(c *gin.Context) {
 	}
 
 	var statusUpdate models.NodePoolControllerStatus
-	if err := c.ShouldBindJSON(&statusUpdate); err != nil {
-		h.logger.Error("Invalid status update request", zap.Error(err))
+	if err := bindJSONWithLimit(c, h.maxBodyBytes, &statusUpdate); err != nil {
+		if respondIfBodyTooLarge(c, err) {
+			return
+		}
+		h.log(c).Error("Invalid status update request", zap.Error(err))
 		c.JSON(http.StatusBadRequest, utils.NewAPIError(
 			utils.ErrCodeValidation,
 			"Invalid status update format",
@@ -689,6 +1586,24 @@ func (h *NodePoolHandler) UpdateNodePoolStatus(c *gin.Context) {
 		return
 	}
 
+	if err := statusUpdate.Conditions.ValidateTransitionTimes(time.Now()); err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid condition transition time",
+			err.Error(),
+		))
+		return
+	}
+
+	if err := models.ValidateStatusMetadataSize(statusUpdate.Metadata); err != nil {
+		c.JSON(http.StatusRequestEntityTooLarge, utils.NewAPIError(
+			utils.ErrCodeTooLarge,
+			"Status metadata too large",
+			err.Error(),
+		))
+		return
+	}
+
 	// Validate required fields
 	if statusUpdate.ControllerName == "" {
 		c.JSON(http.StatusBadRequest, utils.NewAPIError(
@@ -716,7 +1631,7 @@ func (h *NodePoolHandler) UpdateNodePoolStatus(c *gin.Context) {
 	// Get user context from middleware
 	userCtx, exists := middleware.GetUserContext(c)
 	if !exists {
-		h.logger.Error("No user context found")
+		h.log(c).Error("No user context found")
 		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
 			utils.ErrCodeUnauthorized,
 			"Authentication required",
@@ -744,7 +1659,7 @@ func (h *NodePoolHandler) UpdateNodePoolStatus(c *gin.Context) {
 			return
 		}
 
-		h.logger.Error("Failed to verify nodepool",
+		h.log(c).Error("Failed to verify nodepool",
 			zap.String("nodepool_id", id.String()),
 			zap.Error(err),
 		)
@@ -756,10 +1671,23 @@ func (h *NodePoolHandler) UpdateNodePoolStatus(c *gin.Context) {
 		return
 	}
 
+	if !matchesPathCluster(c, nodepool) {
+		h.log(c).Warn("NodePool does not belong to path cluster",
+			zap.String("nodepool_id", id.String()),
+			zap.String("path_cluster_id", c.Param("cluster_id")),
+		)
+		c.JSON(http.StatusNotFound, utils.NewAPIError(
+			utils.ErrCodeNotFound,
+			"NodePool not found",
+			"",
+		))
+		return
+	}
+
 	// Update controller status
 	err = h.repository.Status.UpsertNodePoolControllerStatus(ctx, &statusUpdate)
 	if err != nil {
-		h.logger.Error("Failed to update nodepool controller status",
+		h.log(c).Error("Failed to update nodepool controller status",
 			zap.String("nodepool_id", id.String()),
 			zap.String("controller_name", statusUpdate.ControllerName),
 			zap.Error(err),
@@ -774,7 +1702,7 @@ func (h *NodePoolHandler) UpdateNodePoolStatus(c *gin.Context) {
 
 	// Mark the cluster status as dirty to trigger recalculation on next GET
 	if err := h.repository.Clusters.MarkDirtyStatus(ctx, nodepool.ClusterID); err != nil {
-		h.logger.Warn("Failed to mark cluster status as dirty",
+		h.log(c).Warn("Failed to mark cluster status as dirty",
 			zap.String("cluster_id", nodepool.ClusterID.String()),
 			zap.Error(err),
 		)
@@ -784,7 +1712,7 @@ func (h *NodePoolHandler) UpdateNodePoolStatus(c *gin.Context) {
 	// Status update completed - cluster marked as dirty for recalculation
 	// No pub/sub events needed in simplified architecture (controllers report via API)
 
-	h.logger.Info("NodePool controller status updated",
+	h.log(c).Info("NodePool controller status updated",
 		zap.String("nodepool_id", id.String()),
 		zap.String("cluster_id", nodepool.ClusterID.String()),
 		zap.String("controller_name", statusUpdate.ControllerName),
@@ -799,3 +1727,163 @@ func (h *NodePoolHandler) UpdateNodePoolStatus(c *gin.Context) {
 		"observed_generation": statusUpdate.ObservedGeneration,
 	})
 }
+
+// DeleteNodePoolControllerStatus removes a controller's status report for a nodepool (controllers only)
+func (h *NodePoolHandler) DeleteNodePoolControllerStatus(c *gin.Context) {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewAPIError(
+			utils.ErrCodeValidation,
+			"Invalid nodepool ID",
+			err.Error(),
+		))
+		return
+	}
+
+	controllerName := c.Param("controller_name")
+	ctx := c.Request.Context()
+
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	if !auth.CanDeleteControllerStatus(userCtx) {
+		c.JSON(http.StatusForbidden, utils.NewAPIError(
+			utils.ErrCodeForbidden,
+			"Access denied",
+			"only system controllers can delete controller status",
+		))
+		return
+	}
+
+	nodepool, err := h.repository.NodePools.GetByIDInternal(ctx, id)
+	if err != nil {
+		if err == models.ErrNodePoolNotFound {
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"NodePool not found",
+				"",
+			))
+			return
+		}
+
+		h.log(c).Error("Failed to verify nodepool",
+			zap.String("nodepool_id", id.String()),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to verify nodepool",
+			err.Error(),
+		))
+		return
+	}
+
+	if !matchesPathCluster(c, nodepool) {
+		c.JSON(http.StatusNotFound, utils.NewAPIError(
+			utils.ErrCodeNotFound,
+			"NodePool not found",
+			"",
+		))
+		return
+	}
+
+	err = h.repository.Transaction(ctx, func(txRepo *database.Repository) error {
+		if err := txRepo.Status.DeleteNodePoolControllerStatus(ctx, id, controllerName); err != nil {
+			return err
+		}
+
+		// Deletes do not fire the status dirty triggers
+		return txRepo.NodePools.MarkDirtyStatus(ctx, id)
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrControllerStatusNotFound) {
+			c.JSON(http.StatusNotFound, utils.NewAPIError(
+				utils.ErrCodeNotFound,
+				"Controller status not found",
+				fmt.Sprintf("controller %s has not reported status for this nodepool", controllerName),
+			))
+			return
+		}
+
+		h.log(c).Error("Failed to delete nodepool controller status",
+			zap.String("nodepool_id", id.String()),
+			zap.String("controller_name", controllerName),
+			zap.Error(err),
+		)
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to delete controller status",
+			err.Error(),
+		))
+		return
+	}
+
+	h.log(c).Info("NodePool controller status deleted",
+		zap.String("nodepool_id", id.String()),
+		zap.String("cluster_id", nodepool.ClusterID.String()),
+		zap.String("controller_name", controllerName),
+	)
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":         "controller status deleted",
+		"nodepool_id":     id.String(),
+		"cluster_id":      nodepool.ClusterID.String(),
+		"controller_name": controllerName,
+	})
+}
+
+// ListOrphanedNodePools lists live nodepools whose cluster row is missing or soft-deleted.
+// These cannot be created through the API, so a non-empty list points at a data-integrity
+// problem to investigate.
+func (h *NodePoolHandler) ListOrphanedNodePools(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
+		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	if !auth.CanViewOrphanedNodePools(userCtx) {
+		c.JSON(http.StatusForbidden, utils.NewAPIError(
+			utils.ErrCodeForbidden,
+			"Access denied",
+			"only system controllers can view orphaned nodepools",
+		))
+		return
+	}
+
+	nodepools, err := h.repository.NodePools.FindOrphaned(ctx)
+	if err != nil {
+		h.log(c).Error("Failed to find orphaned nodepools", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to find orphaned nodepools",
+			err.Error(),
+		))
+		return
+	}
+
+	if len(nodepools) > 0 {
+		h.log(c).Warn("Found orphaned nodepools", zap.Int("count", len(nodepools)))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"nodepools": nodepools,
+		"total":     len(nodepools),
+	})
+}
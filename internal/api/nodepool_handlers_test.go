@@ -0,0 +1,1206 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/auth"
+	"github.com/apahim/cls-backend/internal/config"
+	"github.com/apahim/cls-backend/internal/database"
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/pubsub"
+	"github.com/apahim/cls-backend/internal/services"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// setupTestRouter builds the API router with authentication enabled so tests
+// can select the caller via the X-User-Email header
+func setupTestRouter(repo *database.Repository) *gin.Engine {
+	return setupTestRouterWithPublisher(repo, nil)
+}
+
+// setupTestRouterWithPublisher is setupTestRouter with a reconcile event
+// publisher for the failed event admin routes and manual reconciliation
+func setupTestRouterWithPublisher(repo *database.Repository, publisher pubsub.ReconcileEventPublisher) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{Auth: config.AuthConfig{Enabled: true}}
+	clusterHandler := NewClusterHandler(nil, nil)
+	if repo != nil {
+		clusterService := services.NewClusterService(repo, nil, "", "")
+		if publisher != nil {
+			clusterService.SetReconcilePublisher(publisher)
+		}
+		clusterHandler = NewClusterHandler(clusterService, repo.Status)
+	}
+	return setupRouter(cfg, auth.NewHeaderAuthenticator(), clusterHandler, NewNodePoolHandler(repo, nil), NewFailedEventHandler(repo, publisher), NewReconcileTargetHandler(repo), NewAuditHandler(repo), NewDatabasePoolHandler(nil))
+}
+
+// setupTestRepository creates a repository against a fresh test database with all migrations applied
+func setupTestRepository(t *testing.T) *database.Repository {
+	utils.SkipIfNoTestDB(t)
+
+	testDBURL := utils.SetupTestDB(t)
+	repo, err := database.NewRepository(config.DatabaseConfig{
+		URL:             testDBURL,
+		MaxOpenConns:    10,
+		MaxIdleConns:    5,
+		ConnMaxLifetime: 5 * time.Minute,
+		ConnMaxIdleTime: 1 * time.Minute,
+	})
+	utils.AssertError(t, err, false, "Should create repository")
+	t.Cleanup(func() { repo.Close() })
+
+	migrations, err := filepath.Glob("../database/migrations/*.sql")
+	utils.AssertError(t, err, false, "Should list migrations")
+	sort.Strings(migrations)
+
+	for _, migration := range migrations {
+		sqlBytes, err := os.ReadFile(migration)
+		utils.AssertError(t, err, false, "Should read migration", migration)
+		_, err = repo.GetClient().ExecContext(context.Background(), string(sqlBytes))
+		utils.AssertError(t, err, false, "Should apply migration", migration)
+	}
+
+	return repo
+}
+
+func doRequest(router *gin.Engine, method, path, userEmail, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	if userEmail != "" {
+		req.Header.Set("X-User-Email", userEmail)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestNodePoolHandler_AutoscalingValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	clusterID := uuid.New().String()
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		field  string
+	}{
+		{
+			name:   "create with inverted bounds",
+			method: http.MethodPost,
+			path:   "/api/v1/nodepools",
+			body:   `{"name":"np-1","cluster_id":"` + clusterID + `","spec":{"autoscaling":{"minReplicas":5,"maxReplicas":2}}}`,
+			field:  "autoscaling.minReplicas",
+		},
+		{
+			name:   "create with negative minimum",
+			method: http.MethodPost,
+			path:   "/api/v1/nodepools",
+			body:   `{"name":"np-1","cluster_id":"` + clusterID + `","spec":{"autoscaling":{"minReplicas":-1,"maxReplicas":2}}}`,
+			field:  "autoscaling.minReplicas",
+		},
+		{
+			name:   "batch create with inverted bounds",
+			method: http.MethodPost,
+			path:   "/api/v1/clusters/" + clusterID + "/nodepools:batch",
+			body:   `{"nodepools":[{"name":"np-1","spec":{}},{"name":"np-2","spec":{"autoscaling":{"minReplicas":5,"maxReplicas":2}}}]}`,
+			field:  "nodepools[1].autoscaling.minReplicas",
+		},
+		{
+			name:   "update with inverted bounds",
+			method: http.MethodPut,
+			path:   "/api/v1/nodepools/" + uuid.New().String(),
+			body:   `{"spec":{"replicas":3,"autoscaling":{"minReplicas":4,"maxReplicas":1}}}`,
+			field:  "autoscaling.minReplicas",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := doRequest(router, tt.method, tt.path, "user@example.com", tt.body)
+
+			utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid autoscaling bounds should be rejected")
+			utils.AssertContains(t, w.Body.String(), `"field":"`+tt.field+`"`, "Error should list the autoscaling field")
+		})
+	}
+}
+
+func TestNodePoolHandler_NameValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+	clusterID := uuid.New().String()
+
+	tests := []struct {
+		name  string
+		path  string
+		body  string
+		field string
+	}{
+		{
+			name:  "create with uppercase name",
+			path:  "/api/v1/nodepools",
+			body:  `{"name":"Workers","cluster_id":"` + clusterID + `","spec":{}}`,
+			field: "name",
+		},
+		{
+			name:  "nested create with underscore",
+			path:  "/api/v1/clusters/" + clusterID + "/nodepools",
+			body:  `{"name":"gpu_workers","spec":{}}`,
+			field: "name",
+		},
+		{
+			name:  "batch create with trailing hyphen",
+			path:  "/api/v1/clusters/" + clusterID + "/nodepools:batch",
+			body:  `{"nodepools":[{"name":"workers","spec":{}},{"name":"infra-","spec":{}}]}`,
+			field: "nodepools[1].name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := doRequest(router, http.MethodPost, tt.path, "user@example.com", tt.body)
+			utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid nodepool name should be rejected")
+			utils.AssertContains(t, w.Body.String(), `"field":"`+tt.field+`"`, "Error should list the name field")
+		})
+	}
+}
+
+func TestNodePoolHandler_ListFilterValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	tests := []struct {
+		name      string
+		query     string
+		wantValid string
+	}{
+		{name: "unknown status", query: "status=Runing", wantValid: "Pending, Progressing, Ready, Failed, Degraded"},
+		{name: "wrong case status", query: "status=ready", wantValid: "Pending, Progressing, Ready, Failed, Degraded"},
+		{name: "legacy status value", query: "status=Error", wantValid: "Pending, Progressing, Ready, Failed, Degraded"},
+		{name: "unknown status in a list", query: "status=Ready,Runing", wantValid: "Pending, Progressing, Ready, Failed, Degraded"},
+		{name: "health filter", query: "health=Healthy", wantValid: "filter by status instead"},
+		{name: "health filter with valid status", query: "status=Ready&health=Degraded", wantValid: "filter by status instead"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := doRequest(router, http.MethodGet, "/api/v1/nodepools?"+tt.query, "user@example.com", "")
+
+			utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Unknown filter values should be rejected")
+			utils.AssertContains(t, w.Body.String(), tt.wantValid, "Error should list the valid values")
+		})
+	}
+}
+
+func TestNodePoolHandler_ListValidFilters(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+
+	for _, query := range []string{"", "status=Ready", "status=Progressing", "status=Failed", "status=Pending,Ready", "status=Ready,%20Degraded"} {
+		w := doRequest(router, http.MethodGet, "/api/v1/nodepools?"+query, "user@example.com", "")
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Valid filter values should be accepted", query)
+	}
+}
+
+func TestNodePoolHandler_ListPageInfo(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "page-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	for i := 0; i < 5; i++ {
+		nodepool := &models.NodePool{
+			ID:              uuid.New(),
+			ClusterID:       cluster.ID,
+			Name:            "pool-" + strconv.Itoa(i),
+			CreatedBy:       owner,
+			Generation:      1,
+			ResourceVersion: uuid.New().String(),
+		}
+		err = repo.NodePools.Create(ctx, nodepool)
+		utils.AssertError(t, err, false, "Should create nodepool", nodepool.Name)
+	}
+
+	for _, page := range []struct {
+		offset  int
+		count   int
+		hasMore bool
+	}{
+		{offset: 0, count: 2, hasMore: true},
+		{offset: 2, count: 2, hasMore: true},
+		{offset: 4, count: 1, hasMore: false},
+	} {
+		for _, filter := range []string{"", "&clusterId=" + cluster.ID.String()} {
+			w := doRequest(router, http.MethodGet, "/api/v1/nodepools?limit=2&offset="+strconv.Itoa(page.offset)+filter, owner, "")
+			utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should list nodepools", page.offset, filter)
+
+			var response struct {
+				NodePools  []*models.NodePool `json:"nodepools"`
+				HasMore    bool               `json:"has_more"`
+				TotalPages int                `json:"total_pages"`
+			}
+			err = json.Unmarshal(w.Body.Bytes(), &response)
+			utils.AssertError(t, err, false, "Should decode response")
+			utils.AssertEqual(t, page.count, len(response.NodePools), "Unexpected page size", page.offset, filter)
+			utils.AssertEqual(t, page.hasMore, response.HasMore, "Unexpected has_more", page.offset, filter)
+			utils.AssertEqual(t, 3, response.TotalPages, "Five nodepools should span three pages", page.offset, filter)
+		}
+	}
+}
+
+func TestNodePoolHandler_ListStatusFilter(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "filter-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	for _, phase := range []string{"Ready", "Failed", "Progressing"} {
+		nodepool := &models.NodePool{
+			ID:              uuid.New(),
+			ClusterID:       cluster.ID,
+			Name:            strings.ToLower(phase) + "-pool",
+			CreatedBy:       owner,
+			Generation:      1,
+			ResourceVersion: uuid.New().String(),
+		}
+		err = repo.NodePools.Create(ctx, nodepool)
+		utils.AssertError(t, err, false, "Should create nodepool", nodepool.Name)
+
+		// Cache the phase directly so the filter does not depend on controller reports
+		_, err = repo.GetClient().ExecContext(ctx,
+			`UPDATE nodepools SET status = jsonb_build_object('phase', $2::text, 'last_update_time', NOW()), status_dirty = FALSE WHERE id = $1`,
+			nodepool.ID, phase)
+		utils.AssertError(t, err, false, "Should cache nodepool phase", phase)
+	}
+
+	for _, filter := range []string{"", "&clusterId=" + cluster.ID.String()} {
+		w := doRequest(router, http.MethodGet, "/api/v1/nodepools?status=Ready,%20Failed"+filter, owner, "")
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should list nodepools", filter)
+
+		var response struct {
+			NodePools []*models.NodePool `json:"nodepools"`
+			Total     int64              `json:"total"`
+		}
+		err = json.Unmarshal(w.Body.Bytes(), &response)
+		utils.AssertError(t, err, false, "Should decode response")
+		utils.AssertEqual(t, 2, len(response.NodePools), "Only Ready and Failed nodepools should match", filter)
+		utils.AssertEqual(t, int64(2), response.Total, "Total should count only matching nodepools", filter)
+		for _, nodepool := range response.NodePools {
+			utils.AssertNotEqual(t, "progressing-pool", nodepool.Name, "Progressing nodepool should be filtered out", filter)
+		}
+	}
+}
+
+func TestNodePoolHandler_GetNodePoolByNameValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	// Missing name query parameter
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters/"+uuid.New().String()+"/nodepools:by-name", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Missing name should be rejected")
+
+	// Invalid cluster ID
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters/not-a-uuid/nodepools:by-name?name=np-1", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid cluster ID should be rejected")
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters/not-a-uuid/nodepools/by-name/np-1", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid cluster ID should be rejected on the by-name route")
+
+	// Unknown custom method
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters/"+uuid.New().String()+"/unknown", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Unknown sub-resource should return 404")
+
+	// Nested status routes validate the nodepool ID
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters/"+uuid.New().String()+"/nodepools/not-a-uuid/status", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid nested nodepool ID should be rejected")
+}
+
+func TestNodePoolHandler_GetNodePoolByName(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "by-name-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	nodepool := &models.NodePool{
+		ID:              uuid.New(),
+		ClusterID:       cluster.ID,
+		Name:            "workers",
+		CreatedBy:       owner,
+		Generation:      1,
+		ResourceVersion: uuid.New().String(),
+	}
+	err = repo.NodePools.Create(ctx, nodepool)
+	utils.AssertError(t, err, false, "Should create nodepool")
+
+	// The custom method and the by-name route behave the same
+	for _, path := range []string{
+		"/api/v1/clusters/" + cluster.ID.String() + "/nodepools:by-name?name=",
+		"/api/v1/clusters/" + cluster.ID.String() + "/nodepools/by-name/",
+	} {
+		// Owner can fetch the nodepool by name
+		w := doRequest(router, http.MethodGet, path+"workers", owner, "")
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should get nodepool by name", path)
+
+		var got models.NodePool
+		err = json.Unmarshal(w.Body.Bytes(), &got)
+		utils.AssertError(t, err, false, "Should decode nodepool", path)
+		utils.AssertEqual(t, nodepool.ID, got.ID, "Should return the matching nodepool", path)
+		utils.AssertNotNil(t, got.Status, "Nodepool status should be enriched", path)
+
+		// Unknown name returns not found
+		w = doRequest(router, http.MethodGet, path+"missing", owner, "")
+		utils.AssertEqual(t, http.StatusNotFound, w.Code, "Unknown nodepool name should return 404", path)
+
+		// Other users cannot see the nodepool
+		w = doRequest(router, http.MethodGet, path+"workers", "other@example.com", "")
+		utils.AssertEqual(t, http.StatusNotFound, w.Code, "Other users should get 404", path)
+
+		// Controllers can access any nodepool
+		w = doRequest(router, http.MethodGet, path+"workers", "controller@system.local", "")
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Controllers should get nodepool by name", path)
+	}
+}
+
+func TestNodePoolHandler_NestedStatusClusterMismatch(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	var clusters []*models.Cluster
+	for _, name := range []string{"nested-a", "nested-b"} {
+		cluster := &models.Cluster{
+			ID:         uuid.New(),
+			Name:       name,
+			CreatedBy:  owner,
+			Generation: 1,
+			Spec: models.ClusterSpec{
+				Platform: models.PlatformSpec{Type: "GCP"},
+			},
+		}
+		err := repo.Clusters.Create(ctx, cluster)
+		utils.AssertError(t, err, false, "Should create cluster", name)
+		clusters = append(clusters, cluster)
+	}
+
+	nodepool := &models.NodePool{
+		ID:              uuid.New(),
+		ClusterID:       clusters[0].ID,
+		Name:            "workers",
+		CreatedBy:       owner,
+		Generation:      1,
+		ResourceVersion: uuid.New().String(),
+	}
+	err := repo.NodePools.Create(ctx, nodepool)
+	utils.AssertError(t, err, false, "Should create nodepool")
+
+	statusPath := func(cluster *models.Cluster) string {
+		return "/api/v1/clusters/" + cluster.ID.String() + "/nodepools/" + nodepool.ID.String() + "/status"
+	}
+	body := `{"controller_name":"np-controller","observed_generation":1,"conditions":[],"metadata":{"platform":"gcp"}}`
+
+	// Updating through the wrong cluster's path is rejected without writing status
+	w := doRequest(router, http.MethodPut, statusPath(clusters[1]), "controller@system.local", body)
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Mismatched cluster should return 404")
+
+	statuses, err := repo.Status.ListNodePoolControllerStatus(ctx, nodepool.ID)
+	utils.AssertError(t, err, false, "Should list nodepool controller status")
+	utils.AssertEqual(t, 0, len(statuses), "No status should be written through a mismatched path")
+
+	w = doRequest(router, http.MethodGet, statusPath(clusters[1]), owner, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Mismatched cluster should return 404 on read")
+
+	// The owning cluster's path works
+	w = doRequest(router, http.MethodPut, statusPath(clusters[0]), "controller@system.local", body)
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Matching cluster should accept status update")
+
+	w = doRequest(router, http.MethodGet, statusPath(clusters[0]), owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Matching cluster should return status")
+}
+
+func TestNodePoolHandler_NestedCreateValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+	pathClusterID := uuid.New().String()
+
+	tests := []struct {
+		name string
+		path string
+		body string
+	}{
+		{
+			name: "body cluster does not match path",
+			path: "/api/v1/clusters/" + pathClusterID + "/nodepools",
+			body: `{"name":"np-1","cluster_id":"` + uuid.New().String() + `"}`,
+		},
+		{
+			name: "invalid path cluster ID",
+			path: "/api/v1/clusters/not-a-uuid/nodepools",
+			body: `{"name":"np-1","cluster_id":"` + pathClusterID + `"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := doRequest(router, http.MethodPost, tt.path, "user@example.com", tt.body)
+			utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Nested create should be rejected")
+		})
+	}
+	w := doRequest(router, http.MethodPost, "/api/v1/clusters/"+pathClusterID+"/nodepools", "user@example.com", `{"name":"np-1","cluster_id":"`+uuid.New().String()+`"}`)
+	utils.AssertContains(t, w.Body.String(), `"field":"cluster_id"`, "Mismatch should list the cluster_id field")
+}
+
+func TestNodePoolHandler_NestedCreate(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	var clusters []*models.Cluster
+	for _, name := range []string{"nested-create-a", "nested-create-b"} {
+		cluster := &models.Cluster{
+			ID:         uuid.New(),
+			Name:       name,
+			CreatedBy:  owner,
+			Generation: 1,
+			Spec: models.ClusterSpec{
+				Platform: models.PlatformSpec{Type: "GCP"},
+			},
+		}
+		err := repo.Clusters.Create(ctx, cluster)
+		utils.AssertError(t, err, false, "Should create cluster", name)
+		clusters = append(clusters, cluster)
+	}
+	path := "/api/v1/clusters/" + clusters[0].ID.String() + "/nodepools"
+
+	// A body pointing at another cluster the caller owns is rejected without creating anything
+	w := doRequest(router, http.MethodPost, path, owner, `{"name":"mismatched","cluster_id":"`+clusters[1].ID.String()+`"}`)
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Mismatched cluster should return 400")
+	nodepools, err := repo.NodePools.ListByClusterInternal(ctx, clusters[1].ID)
+	utils.AssertError(t, err, false, "Should list nodepools")
+	utils.AssertEqual(t, 0, len(nodepools), "No nodepool should be created through a mismatched path")
+
+	// A matching body cluster is accepted
+	w = doRequest(router, http.MethodPost, path, owner, `{"name":"matching","cluster_id":"`+clusters[0].ID.String()+`"}`)
+	utils.AssertEqual(t, http.StatusCreated, w.Code, "Matching cluster should create the nodepool")
+
+	var created models.NodePool
+	err = json.Unmarshal(w.Body.Bytes(), &created)
+	utils.AssertError(t, err, false, "Should decode nodepool")
+	utils.AssertEqual(t, clusters[0].ID, created.ClusterID, "Nodepool should belong to the path cluster")
+
+	// Without a body cluster the path cluster is used
+	w = doRequest(router, http.MethodPost, path, owner, `{"name":"from-path"}`)
+	utils.AssertEqual(t, http.StatusCreated, w.Code, "Path cluster should fill in a missing body cluster")
+	err = json.Unmarshal(w.Body.Bytes(), &created)
+	utils.AssertError(t, err, false, "Should decode nodepool")
+	utils.AssertEqual(t, clusters[0].ID, created.ClusterID, "Nodepool should belong to the path cluster")
+}
+
+func TestNodePoolHandler_BatchCreateValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+	path := "/api/v1/clusters/" + uuid.New().String() + "/nodepools:batch"
+
+	tests := []struct {
+		name string
+		path string
+		body string
+	}{
+		{name: "empty list", path: path, body: `{"nodepools":[]}`},
+		{name: "missing list", path: path, body: `{}`},
+		{name: "missing name", path: path, body: `{"nodepools":[{"name":"np-1"},{"spec":{}}]}`},
+		{name: "duplicate name in request", path: path, body: `{"nodepools":[{"name":"np-1"},{"name":"np-1"}]}`},
+		{name: "inverted autoscaling bounds", path: path, body: `{"nodepools":[{"name":"np-1","spec":{"autoscaling":{"minReplicas":5,"maxReplicas":2}}}]}`},
+		{name: "invalid cluster ID", path: "/api/v1/clusters/not-a-uuid/nodepools:batch", body: `{"nodepools":[{"name":"np-1"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := doRequest(router, http.MethodPost, tt.path, "user@example.com", tt.body)
+			utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid batch should be rejected")
+		})
+	}
+}
+
+func TestNodePoolHandler_BatchCreate(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "batch-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+			Release:  models.ReleaseSpec{Version: "4.16.0"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	path := "/api/v1/clusters/" + cluster.ID.String() + "/nodepools:batch"
+
+	// All nodepools are created together
+	w := doRequest(router, http.MethodPost, path, owner, `{"nodepools":[{"name":"workers-a","spec":{"replicas":2}},{"name":"workers-b","spec":{"replicas":3}}]}`)
+	utils.AssertEqual(t, http.StatusCreated, w.Code, "Batch should be created")
+
+	var created struct {
+		NodePools []models.NodePool `json:"nodepools"`
+	}
+	err = json.Unmarshal(w.Body.Bytes(), &created)
+	utils.AssertError(t, err, false, "Should decode batch response")
+	utils.AssertEqual(t, 2, len(created.NodePools), "Should return both nodepools")
+	utils.AssertEqual(t, "4.16.0", created.NodePools[0].Spec.Release.Version, "Release version should be inherited from the cluster")
+
+	nodepools, err := repo.NodePools.ListByClusterInternal(ctx, cluster.ID)
+	utils.AssertError(t, err, false, "Should list nodepools")
+	utils.AssertEqual(t, 2, len(nodepools), "Both nodepools should be stored")
+
+	// One colliding name rolls back the whole batch
+	w = doRequest(router, http.MethodPost, path, owner, `{"nodepools":[{"name":"workers-c"},{"name":"workers-a"}]}`)
+	utils.AssertEqual(t, http.StatusConflict, w.Code, "Colliding batch should be rejected")
+	utils.AssertContains(t, w.Body.String(), "workers-a", "Conflict should name the colliding nodepool")
+
+	nodepools, err = repo.NodePools.ListByClusterInternal(ctx, cluster.ID)
+	utils.AssertError(t, err, false, "Should list nodepools")
+	utils.AssertEqual(t, 2, len(nodepools), "Rolled back batch should not create any nodepool")
+
+	_, err = repo.NodePools.GetByClusterAndNameInternal(ctx, cluster.ID, "workers-c")
+	utils.AssertEqual(t, models.ErrNodePoolNotFound, err, "Non-colliding nodepool should be rolled back")
+
+	// Other users cannot create nodepools in the cluster
+	w = doRequest(router, http.MethodPost, path, "other@example.com", `{"nodepools":[{"name":"workers-d"}]}`)
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Other users should get 404")
+}
+
+func TestNodePoolHandler_ScaleValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+	path := "/api/v1/nodepools/" + uuid.New().String() + "/scale"
+
+	tests := []struct {
+		name string
+		path string
+		body string
+	}{
+		{name: "negative replicas", path: path, body: `{"replicas":-1}`},
+		{name: "missing replicas", path: path, body: `{}`},
+		{name: "invalid nodepool ID", path: "/api/v1/nodepools/not-a-uuid/scale", body: `{"replicas":1}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := doRequest(router, http.MethodPost, tt.path, "user@example.com", tt.body)
+			utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid scale request should be rejected")
+		})
+	}
+
+	w := doRequest(router, http.MethodPost, path, "user@example.com", `{"replicas":-3}`)
+	utils.AssertContains(t, w.Body.String(), "replicas must be 0 or greater", "Error should explain the replica bound")
+	utils.AssertContains(t, w.Body.String(), `"field":"replicas"`, "Error should list the replicas field")
+}
+
+func TestNodePoolHandler_Scale(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "scale-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	replicas := int32(2)
+	nodepool := &models.NodePool{
+		ID:              uuid.New(),
+		ClusterID:       cluster.ID,
+		Name:            "workers",
+		CreatedBy:       owner,
+		Generation:      1,
+		ResourceVersion: uuid.New().String(),
+		Spec: models.NodePoolSpec{
+			Replicas:         &replicas,
+			NodeDrainTimeout: "10m",
+		},
+	}
+	err = repo.NodePools.Create(ctx, nodepool)
+	utils.AssertError(t, err, false, "Should create nodepool")
+
+	path := "/api/v1/nodepools/" + nodepool.ID.String() + "/scale"
+	scale := func(body string) models.NodePool {
+		w := doRequest(router, http.MethodPost, path, owner, body)
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should scale nodepool", body)
+
+		var got models.NodePool
+		err := json.Unmarshal(w.Body.Bytes(), &got)
+		utils.AssertError(t, err, false, "Should decode nodepool")
+		return got
+	}
+
+	// Scaling up changes only the replica count
+	got := scale(`{"replicas":5}`)
+	utils.AssertNotNil(t, got.Spec.Replicas, "Replicas should be set")
+	utils.AssertEqual(t, int32(5), *got.Spec.Replicas, "Replicas should be scaled up")
+	utils.AssertEqual(t, int64(2), got.Generation, "Scaling should bump the generation")
+	utils.AssertEqual(t, "10m", got.Spec.NodeDrainTimeout, "Other spec fields should be kept")
+
+	// Scaling to zero is allowed
+	got = scale(`{"replicas":0}`)
+	utils.AssertNotNil(t, got.Spec.Replicas, "Zero replicas should be kept")
+	utils.AssertEqual(t, int32(0), *got.Spec.Replicas, "Replicas should be scaled to zero")
+	utils.AssertEqual(t, int64(3), got.Generation, "Scaling should bump the generation")
+
+	stored, err := repo.NodePools.GetByID(ctx, nodepool.ID, owner)
+	utils.AssertError(t, err, false, "Should get nodepool")
+	utils.AssertEqual(t, int32(0), *stored.Spec.Replicas, "Scaled replicas should be stored")
+
+	// Scaling to the current count is a no-op
+	got = scale(`{"replicas":0}`)
+	utils.AssertEqual(t, int64(3), got.Generation, "Unchanged replicas should not bump the generation")
+	utils.AssertEqual(t, stored.ResourceVersion, got.ResourceVersion, "Unchanged replicas should keep the resource version")
+	_, scaledEvents, err := repo.Status.ListNodePoolEvents(ctx, nodepool.ID, &models.NodePoolEventListOptions{EventType: models.NodePoolEventScaled})
+	utils.AssertError(t, err, false, "Should list nodepool events")
+	utils.AssertEqual(t, int64(2), scaledEvents, "Unchanged replicas should not record a scaled event")
+
+	// Other users cannot scale the nodepool
+	w := doRequest(router, http.MethodPost, path, "other@example.com", `{"replicas":1}`)
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Other users should get 404")
+
+	// Autoscaled nodepools are scaled through their bounds instead
+	autoscaled := &models.NodePool{
+		ID:              uuid.New(),
+		ClusterID:       cluster.ID,
+		Name:            "autoscaled",
+		CreatedBy:       owner,
+		Generation:      1,
+		ResourceVersion: uuid.New().String(),
+		Spec: models.NodePoolSpec{
+			Autoscaling: &models.NodePoolAutoscaling{MinReplicas: 1, MaxReplicas: 3},
+		},
+	}
+	err = repo.NodePools.Create(ctx, autoscaled)
+	utils.AssertError(t, err, false, "Should create autoscaled nodepool")
+
+	w = doRequest(router, http.MethodPost, "/api/v1/nodepools/"+autoscaled.ID.String()+"/scale", owner, `{"replicas":2}`)
+	utils.AssertEqual(t, http.StatusConflict, w.Code, "Autoscaled nodepool should not be scaled")
+}
+
+func TestNodePoolHandler_ListNodePoolEventsValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+	path := "/api/v1/nodepools/" + uuid.New().String() + "/events"
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "invalid nodepool ID", path: "/api/v1/nodepools/not-a-uuid/events"},
+		{name: "non-numeric limit", path: path + "?limit=abc"},
+		{name: "zero limit", path: path + "?limit=0"},
+		{name: "limit too large", path: path + "?limit=1001"},
+		{name: "negative offset", path: path + "?offset=-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := doRequest(router, http.MethodGet, tt.path, "user@example.com", "")
+			utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid events request should be rejected")
+		})
+	}
+}
+
+func TestNodePoolHandler_NodePoolEvents(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "events-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	// Every lifecycle operation records an event
+	w := doRequest(router, http.MethodPost, "/api/v1/clusters/"+cluster.ID.String()+"/nodepools", owner, `{"name":"workers","spec":{"replicas":2}}`)
+	utils.AssertEqual(t, http.StatusCreated, w.Code, "Should create nodepool")
+	var nodepool models.NodePool
+	err = json.Unmarshal(w.Body.Bytes(), &nodepool)
+	utils.AssertError(t, err, false, "Should decode nodepool")
+	path := "/api/v1/nodepools/" + nodepool.ID.String()
+
+	w = doRequest(router, http.MethodPut, path, owner, `{"spec":{"replicas":2,"nodeDrainTimeout":"10m"}}`)
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Should update nodepool")
+
+	w = doRequest(router, http.MethodPost, path+"/scale", owner, `{"replicas":4}`)
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Should scale nodepool")
+
+	listEvents := func(query string) (int, []models.NodePoolEvent, int64) {
+		w := doRequest(router, http.MethodGet, path+"/events"+query, owner, "")
+		var resp struct {
+			Events []models.NodePoolEvent `json:"events"`
+			Total  int64                  `json:"total"`
+		}
+		if w.Code == http.StatusOK {
+			err := json.Unmarshal(w.Body.Bytes(), &resp)
+			utils.AssertError(t, err, false, "Should decode events")
+		}
+		return w.Code, resp.Events, resp.Total
+	}
+
+	code, events, total := listEvents("")
+	utils.AssertEqual(t, http.StatusOK, code, "Owner should list nodepool events")
+	utils.AssertEqual(t, int64(3), total, "Create, update and scale should each record an event")
+	utils.AssertEqual(t, 3, len(events), "Every event should be returned")
+	wantTypes := []string{models.NodePoolEventScaled, models.NodePoolEventUpdated, models.NodePoolEventCreated}
+	for i, want := range wantTypes {
+		utils.AssertEqual(t, want, events[i].EventType, "Events should be listed newest first", i)
+		utils.AssertEqual(t, nodepool.ID, events[i].NodePoolID, "Event should reference the nodepool", i)
+		utils.AssertEqual(t, cluster.ID, events[i].ClusterID, "Event should reference the cluster", i)
+		utils.AssertEqual(t, int64(3-i), events[i].Generation, "Event should carry the resulting generation", i)
+	}
+	utils.AssertEqual(t, float64(2), events[0].Changes["from_replicas"], "Scale event should record the old replica count")
+	utils.AssertEqual(t, float64(4), events[0].Changes["to_replicas"], "Scale event should record the new replica count")
+
+	// Events can be filtered by type and paged
+	code, events, total = listEvents("?event_type=" + models.NodePoolEventCreated)
+	utils.AssertEqual(t, http.StatusOK, code, "Filtered list should succeed")
+	utils.AssertEqual(t, int64(1), total, "Only the created event should match")
+	utils.AssertEqual(t, models.NodePoolEventCreated, events[0].EventType, "Filter should match the event type")
+
+	code, events, total = listEvents("?limit=2&offset=2")
+	utils.AssertEqual(t, http.StatusOK, code, "Paged list should succeed")
+	utils.AssertEqual(t, int64(3), total, "Total should ignore paging")
+	utils.AssertEqual(t, 1, len(events), "Last page should hold the remaining event")
+
+	// Access follows the nodepool's cluster ownership
+	w = doRequest(router, http.MethodGet, path+"/events", "other@example.com", "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Other users should get 404")
+	w = doRequest(router, http.MethodGet, path+"/events", "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Controllers should list any nodepool's events")
+
+	// Deleting records a final event
+	w = doRequest(router, http.MethodDelete, path, owner, "")
+	utils.AssertEqual(t, http.StatusAccepted, w.Code, "Should delete nodepool")
+
+	// The history stays readable after the nodepool is deleted
+	code, events, total = listEvents("")
+	utils.AssertEqual(t, http.StatusOK, code, "Owner should list a deleted nodepool's events")
+	utils.AssertEqual(t, int64(4), total, "Delete should record an event")
+	utils.AssertEqual(t, models.NodePoolEventDeleted, events[0].EventType, "Delete event should be the newest")
+	w = doRequest(router, http.MethodGet, path+"/events", "other@example.com", "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Other users should still get 404")
+}
+
+func TestNodePoolHandler_ReconciliationPauseValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	w := doRequest(router, http.MethodPost, "/api/v1/nodepools/not-a-uuid/reconciliation:pause", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid nodepool ID should be rejected")
+
+	w = doRequest(router, http.MethodPost, "/api/v1/nodepools/"+uuid.New().String()+"/reconciliation:restart", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Unknown custom method should return 404")
+}
+
+func TestNodePoolHandler_ReconciliationPauseResume(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "pause-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	nodepool := &models.NodePool{
+		ID:              uuid.New(),
+		ClusterID:       cluster.ID,
+		Name:            "workers",
+		CreatedBy:       owner,
+		Generation:      1,
+		ResourceVersion: uuid.New().String(),
+	}
+	err = repo.NodePools.Create(ctx, nodepool)
+	utils.AssertError(t, err, false, "Should create nodepool")
+
+	basePath := "/api/v1/nodepools/" + nodepool.ID.String() + "/reconciliation:"
+
+	// Other users cannot pause the nodepool
+	w := doRequest(router, http.MethodPost, basePath+"pause", "other@example.com", "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Other users should get 404")
+
+	w = doRequest(router, http.MethodPost, basePath+"pause", owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should pause reconciliation")
+	utils.AssertContains(t, w.Body.String(), `"reconciliation_paused":true`, "Response should report paused state")
+
+	paused, err := repo.Reconciliation.CountPausedNodePools(ctx)
+	utils.AssertError(t, err, false, "Should count paused nodepools")
+	utils.AssertEqual(t, 1, paused, "Nodepool should be paused")
+
+	// Pausing a nodepool leaves its cluster alone
+	paused, err = repo.Reconciliation.CountPausedClusters(ctx)
+	utils.AssertError(t, err, false, "Should count paused clusters")
+	utils.AssertEqual(t, 0, paused, "Cluster should not be paused")
+
+	// Controllers can resume any nodepool
+	w = doRequest(router, http.MethodPost, basePath+"resume", "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Controller should resume reconciliation")
+
+	paused, err = repo.Reconciliation.CountPausedNodePools(ctx)
+	utils.AssertError(t, err, false, "Should count paused nodepools")
+	utils.AssertEqual(t, 0, paused, "Nodepool should be resumed")
+
+	w = doRequest(router, http.MethodPost, "/api/v1/nodepools/"+uuid.New().String()+"/reconciliation:pause", owner, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Unknown nodepool should return 404")
+}
+
+func TestNodePoolHandler_DeleteNodePoolControllerStatusValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+	path := "/api/v1/nodepools/" + uuid.New().String() + "/status/np-controller"
+
+	w := doRequest(router, http.MethodDelete, path, "user@example.com", "")
+	utils.AssertEqual(t, http.StatusForbidden, w.Code, "Regular users cannot delete controller status")
+
+	w = doRequest(router, http.MethodDelete, "/api/v1/nodepools/not-a-uuid/status/np-controller", "controller@system.local", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid nodepool ID should be rejected")
+}
+
+func TestNodePoolHandler_DeleteNodePoolControllerStatus(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	controller := "controller@system.local"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "stale-np-controller-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	nodepool := &models.NodePool{
+		ID:              uuid.New(),
+		ClusterID:       cluster.ID,
+		Name:            "workers",
+		CreatedBy:       owner,
+		Generation:      1,
+		ResourceVersion: uuid.New().String(),
+	}
+	err = repo.NodePools.Create(ctx, nodepool)
+	utils.AssertError(t, err, false, "Should create nodepool")
+
+	reported := map[string]string{
+		"np-controller":      "True",
+		"retired-controller": "False",
+	}
+	for name, available := range reported {
+		err = repo.Status.UpsertNodePoolControllerStatus(ctx, &models.NodePoolControllerStatus{
+			NodePoolID:         nodepool.ID,
+			ControllerName:     name,
+			ObservedGeneration: 1,
+			Conditions: models.ConditionList{
+				{Type: "Available", Status: available, Reason: "Reported"},
+			},
+		})
+		utils.AssertError(t, err, false, "Should upsert nodepool controller status", name)
+	}
+
+	statusPath := "/api/v1/nodepools/" + nodepool.ID.String() + "/status"
+	phase := func() string {
+		w := doRequest(router, http.MethodGet, statusPath, owner, "")
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should get nodepool status")
+		var response struct {
+			Status *models.NodePoolStatusInfo `json:"status"`
+		}
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		utils.AssertError(t, err, false, "Should decode response")
+		utils.AssertNotNil(t, response.Status, "NodePool should have status")
+		return response.Status.Phase
+	}
+	utils.AssertTrue(t, phase() != "Ready", "NodePool with a non-ready controller should not be ready")
+
+	// The nested path must name the owning cluster
+	w := doRequest(router, http.MethodDelete, "/api/v1/clusters/"+uuid.New().String()+"/nodepools/"+nodepool.ID.String()+"/status/retired-controller", controller, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Mismatched cluster should return 404")
+
+	deletePath := "/api/v1/clusters/" + cluster.ID.String() + "/nodepools/" + nodepool.ID.String() + "/status/retired-controller"
+	w = doRequest(router, http.MethodDelete, deletePath, controller, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Controller should delete nodepool controller status")
+	utils.AssertEqual(t, "Ready", phase(), "Removing the only non-ready controller should make the nodepool ready")
+
+	w = doRequest(router, http.MethodDelete, deletePath, controller, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Deleting a missing controller status should return 404")
+	utils.AssertContains(t, w.Body.String(), "Controller status not found", "Error should name the missing controller status")
+}
+
+func TestNodePoolHandler_OversizedBody(t *testing.T) {
+	router := setupTestRouter(nil)
+	oversized := strings.Repeat("A", config.DefaultMaxRequestBodyBytes)
+	nodepoolPath := "/api/v1/nodepools/" + uuid.New().String()
+
+	w := doRequest(router, http.MethodPost, "/api/v1/nodepools", "user@example.com",
+		`{"cluster_id":"`+uuid.New().String()+`","name":"workers","spec":{"nodeDrainTimeout":"`+oversized+`"}}`)
+	utils.AssertEqual(t, http.StatusRequestEntityTooLarge, w.Code, "Oversized create should be rejected")
+
+	w = doRequest(router, http.MethodPut, nodepoolPath, "user@example.com", `{"spec":{"nodeDrainTimeout":"`+oversized+`"}}`)
+	utils.AssertEqual(t, http.StatusRequestEntityTooLarge, w.Code, "Oversized update should be rejected")
+
+	largeMetadata := strings.Repeat("A", models.MaxStatusMetadataBytes)
+	w = doRequest(router, http.MethodPut, nodepoolPath+"/status", "controller@system.local",
+		`{"controller_name":"machine-controller","metadata":{"blob":"`+largeMetadata+`"}}`)
+	utils.AssertEqual(t, http.StatusRequestEntityTooLarge, w.Code, "Oversized status metadata should be rejected")
+}
+
+func TestNodePoolHandler_ListClusterNodePoolStatusesValidation(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	w := doRequest(router, http.MethodGet, "/api/v1/clusters/not-a-uuid/nodepools/status", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusBadRequest, w.Code, "Invalid cluster ID should be rejected")
+	utils.AssertContains(t, w.Body.String(), "Invalid cluster ID", "Rollup route should not be taken for a nodepool ID")
+}
+
+func TestNodePoolHandler_ListClusterNodePoolStatuses(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "rollup-cluster",
+		CreatedBy:  owner,
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	// Controller availability per nodepool; created out of name order
+	reports := []struct {
+		name      string
+		available []string
+	}{
+		{name: "zeta", available: []string{"True"}},
+		{name: "alpha"},
+		{name: "mid", available: []string{"True", "False"}},
+	}
+	for _, r := range reports {
+		nodepool := &models.NodePool{
+			ID:              uuid.New(),
+			ClusterID:       cluster.ID,
+			Name:            r.name,
+			CreatedBy:       owner,
+			Generation:      1,
+			ResourceVersion: uuid.New().String(),
+		}
+		err = repo.NodePools.Create(ctx, nodepool)
+		utils.AssertError(t, err, false, "Should create nodepool", r.name)
+
+		for i, available := range r.available {
+			err = repo.Status.UpsertNodePoolControllerStatus(ctx, &models.NodePoolControllerStatus{
+				NodePoolID:         nodepool.ID,
+				ControllerName:     fmt.Sprintf("controller-%d", i),
+				ObservedGeneration: 1,
+				Conditions: models.ConditionList{
+					{Type: "Available", Status: available, Reason: "Reported"},
+				},
+			})
+			utils.AssertError(t, err, false, "Should upsert nodepool controller status", r.name)
+		}
+	}
+
+	path := "/api/v1/clusters/" + cluster.ID.String() + "/nodepools/status"
+
+	w := doRequest(router, http.MethodGet, path, "other@example.com", "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Other users cannot read the cluster's nodepool statuses")
+
+	w = doRequest(router, http.MethodGet, path, owner, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Owner should read the cluster's nodepool statuses")
+
+	var response struct {
+		Nodepools []models.NodePoolStatusView `json:"nodepools"`
+		Total     int                         `json:"total"`
+	}
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 3, response.Total, "Every nodepool should be listed")
+
+	want := []struct {
+		name        string
+		phase       string
+		controllers int
+	}{
+		{name: "alpha", phase: "Pending", controllers: 0},
+		{name: "mid", phase: "Progressing", controllers: 2},
+		{name: "zeta", phase: "Ready", controllers: 1},
+	}
+	utils.AssertEqual(t, len(want), len(response.Nodepools), "Every nodepool should be returned")
+	for i, view := range response.Nodepools {
+		utils.AssertEqual(t, want[i].name, view.Name, "Nodepools should be ordered by name", i)
+		utils.AssertNotNil(t, view.Status, "Nodepool should have status", view.Name)
+		utils.AssertEqual(t, want[i].phase, view.Status.Phase, "Nodepool should have its own phase", view.Name)
+		utils.AssertEqual(t, want[i].controllers, len(view.ControllerStatus), "Nodepool should carry its own reports", view.Name)
+	}
+
+	w = doRequest(router, http.MethodGet, "/api/v1/clusters/"+uuid.New().String()+"/nodepools/status", owner, "")
+	utils.AssertEqual(t, http.StatusNotFound, w.Code, "Unknown cluster should return 404")
+}
+
+func TestNodePoolHandler_ListOrphanedNodePoolsAdminOnly(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	w := doRequest(router, http.MethodGet, "/api/v1/admin/orphaned-nodepools", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusForbidden, w.Code, "Regular users cannot view orphaned nodepools")
+}
+
+func TestNodePoolHandler_ListOrphanedNodePools(t *testing.T) {
+	repo := setupTestRepository(t)
+	router := setupTestRouter(repo)
+	ctx := context.Background()
+	admin := "controller@system.local"
+
+	owner := "owner@example.com"
+	nodepools := map[string]*models.NodePool{}
+	for _, name := range []string{"live", "purged", "soft-deleted"} {
+		cluster := &models.Cluster{
+			ID:         uuid.New(),
+			Name:       name + "-cluster",
+			CreatedBy:  owner,
+			Generation: 1,
+			Spec: models.ClusterSpec{
+				Platform: models.PlatformSpec{Type: "GCP"},
+			},
+		}
+		err := repo.Clusters.Create(ctx, cluster)
+		utils.AssertError(t, err, false, "Should create cluster", name)
+
+		nodepool := &models.NodePool{
+			ID:              uuid.New(),
+			ClusterID:       cluster.ID,
+			Name:            "workers",
+			CreatedBy:       owner,
+			Generation:      1,
+			ResourceVersion: uuid.New().String(),
+		}
+		err = repo.NodePools.Create(ctx, nodepool)
+		utils.AssertError(t, err, false, "Should create nodepool", name)
+		nodepools[name] = nodepool
+	}
+
+	// A healthy tree has no orphans
+	orphans, err := repo.NodePools.FindOrphaned(ctx)
+	utils.AssertError(t, err, false, "Should find orphaned nodepools")
+	utils.AssertEqual(t, 0, len(orphans), "Nodepools of live clusters are not orphaned")
+
+	// Hard-delete one parent without cascading to its nodepools, and soft-delete another
+	// without soft-deleting its nodepools
+	_, err = repo.GetClient().ExecContext(ctx, `ALTER TABLE nodepools DROP CONSTRAINT nodepools_cluster_id_fkey`)
+	utils.AssertError(t, err, false, "Should drop the nodepool cluster foreign key")
+	_, err = repo.GetClient().ExecContext(ctx, `DELETE FROM clusters WHERE id = $1`, nodepools["purged"].ClusterID)
+	utils.AssertError(t, err, false, "Should hard-delete cluster")
+	_, err = repo.GetClient().ExecContext(ctx, `UPDATE clusters SET deleted_at = NOW() WHERE id = $1`, nodepools["soft-deleted"].ClusterID)
+	utils.AssertError(t, err, false, "Should soft-delete cluster")
+
+	w := doRequest(router, http.MethodGet, "/api/v1/admin/orphaned-nodepools", admin, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Admin should list orphaned nodepools")
+
+	var response struct {
+		NodePools []models.NodePool `json:"nodepools"`
+		Total     int               `json:"total"`
+	}
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	utils.AssertEqual(t, 2, response.Total, "Both orphans should be counted")
+
+	listed := map[uuid.UUID]bool{}
+	for _, nodepool := range response.NodePools {
+		listed[nodepool.ID] = true
+	}
+	utils.AssertTrue(t, listed[nodepools["purged"].ID], "Nodepool of a hard-deleted cluster should be listed")
+	utils.AssertTrue(t, listed[nodepools["soft-deleted"].ID], "Nodepool of a soft-deleted cluster should be listed")
+	utils.AssertFalse(t, listed[nodepools["live"].ID], "Nodepool of a live cluster should not be listed")
+
+	// Soft-deleted nodepools are not orphans
+	_, err = repo.NodePools.DeleteByCluster(ctx, nodepools["soft-deleted"].ClusterID)
+	utils.AssertError(t, err, false, "Should soft-delete nodepools")
+	orphans, err = repo.NodePools.FindOrphaned(ctx)
+	utils.AssertError(t, err, false, "Should find orphaned nodepools")
+	utils.AssertEqual(t, 1, len(orphans), "Only the live orphan should remain")
+	utils.AssertEqual(t, nodepools["purged"].ID, orphans[0].ID, "Remaining orphan")
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/auth"
+	"github.com/apahim/cls-backend/internal/database"
+	"github.com/apahim/cls-backend/internal/middleware"
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/reconciliation"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// SchedulerStatsSource reports the outcome of the reconciliation scheduler's most recent check
+type SchedulerStatsSource interface {
+	Stats() reconciliation.SchedulerSnapshot
+}
+
+// ReconcileTargetHandler exposes the reconciliation scheduler's pending targets for diagnostics
+type ReconcileTargetHandler struct {
+	repository *database.Repository
+	scheduler  SchedulerStatsSource
+	logger     *zap.Logger
+}
+
+// NewReconcileTargetHandler creates a new reconcile target handler
+func NewReconcileTargetHandler(repository *database.Repository) *ReconcileTargetHandler {
+	return &ReconcileTargetHandler{
+		repository: repository,
+		logger:     zap.L().Named("reconcile_target_handler"),
+	}
+}
+
+// SetScheduler sets the scheduler whose stats are served by GetSchedulerStats
+func (h *ReconcileTargetHandler) SetScheduler(scheduler SchedulerStatsSource) {
+	h.scheduler = scheduler
+}
+
+// RegisterRoutes registers reconcile target admin routes
+func (h *ReconcileTargetHandler) RegisterRoutes(router *gin.RouterGroup) {
+	router.GET("/admin/reconcile-targets", h.requireAdmin, h.ListReconcileTargets)
+	router.GET("/reconciliation/stats", h.requireAdmin, h.GetSchedulerStats)
+}
+
+// requireAdmin rejects callers that are not allowed to inspect reconcile targets
+func (h *ReconcileTargetHandler) requireAdmin(c *gin.Context) {
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, utils.NewAPIError(
+			utils.ErrCodeUnauthorized,
+			"Authentication required",
+			"",
+		))
+		return
+	}
+
+	if !auth.CanViewReconcileTargets(userCtx) {
+		c.AbortWithStatusJSON(http.StatusForbidden, utils.NewAPIError(
+			utils.ErrCodeForbidden,
+			"Access denied",
+			"only system controllers can view reconcile targets",
+		))
+		return
+	}
+
+	c.Next()
+}
+
+// ListReconcileTargets lists the clusters and nodepools the scheduler would reconcile
+// on its next tick. Nothing is published and no schedules are updated.
+func (h *ReconcileTargetHandler) ListReconcileTargets(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	clusterTargets, err := h.repository.Reconciliation.FindClustersNeedingReconciliation(ctx)
+	if err != nil {
+		h.logger.Error("Failed to find cluster reconcile targets", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to find cluster reconcile targets",
+			err.Error(),
+		))
+		return
+	}
+
+	nodepoolTargets, err := h.repository.Reconciliation.FindNodePoolsNeedingReconciliation(ctx)
+	if err != nil {
+		h.logger.Error("Failed to find nodepool reconcile targets", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
+			utils.ErrCodeInternal,
+			"Failed to find nodepool reconcile targets",
+			err.Error(),
+		))
+		return
+	}
+
+	if clusterTargets == nil {
+		clusterTargets = []*models.ReconciliationTarget{}
+	}
+	if nodepoolTargets == nil {
+		nodepoolTargets = []*models.NodePoolReconciliationTarget{}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"clusters":  clusterTargets,
+		"nodepools": nodepoolTargets,
+	})
+}
+
+// GetSchedulerStats returns when the scheduler last checked for reconcile targets and how
+// many events that check published, to diagnose stuck scheduling
+func (h *ReconcileTargetHandler) GetSchedulerStats(c *gin.Context) {
+	if h.scheduler == nil {
+		c.JSON(http.StatusServiceUnavailable, utils.NewAPIError(
+			utils.ErrCodeUnavailable,
+			"Reconciliation scheduler unavailable",
+			"this instance does not run the reconciliation scheduler",
+		))
+		return
+	}
+
+	c.JSON(http.StatusOK, h.scheduler.Stats())
+}
@@ -0,0 +1,159 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/auth"
+	"github.com/apahim/cls-backend/internal/config"
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/reconciliation"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func TestReconcileTargetHandler_AdminOnly(t *testing.T) {
+	router := setupTestRouter(nil)
+
+	w := doRequest(router, http.MethodGet, "/api/v1/admin/reconcile-targets", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusForbidden, w.Code, "Regular users cannot view reconcile targets")
+}
+
+func TestReconcileTargetHandler_ListReconcileTargets(t *testing.T) {
+	repo := setupTestRepository(t)
+	publisher := &mockReconcilePublisher{}
+	router := setupTestRouterWithPublisher(repo, publisher)
+	ctx := context.Background()
+	admin := "controller@system.local"
+
+	owner := "owner@example.com"
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "targets-cluster",
+		CreatedBy:  owner,
+		Generation: 2,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	nodepool := &models.NodePool{
+		ID:              uuid.New(),
+		ClusterID:       cluster.ID,
+		Name:            "workers",
+		CreatedBy:       owner,
+		Generation:      1,
+		ResourceVersion: uuid.New().String(),
+	}
+	err = repo.NodePools.Create(ctx, nodepool)
+	utils.AssertError(t, err, false, "Should create nodepool")
+
+	var response struct {
+		Clusters  []models.ReconciliationTarget         `json:"clusters"`
+		NodePools []models.NodePoolReconciliationTarget `json:"nodepools"`
+	}
+
+	// Never-reconciled resources are due immediately
+	w := doRequest(router, http.MethodGet, "/api/v1/admin/reconcile-targets", admin, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Admin should list reconcile targets")
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+
+	var clusterTarget *models.ReconciliationTarget
+	for i := range response.Clusters {
+		if response.Clusters[i].ClusterID == cluster.ID {
+			clusterTarget = &response.Clusters[i]
+		}
+	}
+	utils.AssertNotNil(t, clusterTarget, "Due cluster should be listed")
+	utils.AssertEqual(t, int64(2), clusterTarget.ClusterGeneration, "Cluster target should carry its generation")
+	utils.AssertNotEqual(t, "", clusterTarget.Reason, "Cluster target should carry a reason")
+
+	var nodepoolListed bool
+	for _, target := range response.NodePools {
+		if target.NodePoolID == nodepool.ID {
+			nodepoolListed = true
+		}
+	}
+	utils.AssertTrue(t, nodepoolListed, "Due nodepool should be listed")
+
+	// Listing targets must not publish anything
+	utils.AssertEqual(t, 0, len(publisher.clusterEvents), "Listing targets should not publish cluster events")
+	utils.AssertEqual(t, 0, len(publisher.nodepoolEvents), "Listing targets should not publish nodepool events")
+
+	// Once a controller has observed the current generation and the cluster has
+	// been reconciled it is no longer due
+	err = repo.Status.UpsertClusterControllerStatus(ctx, &models.ClusterControllerStatus{
+		ClusterID:          cluster.ID,
+		ControllerName:     "dns-controller",
+		ObservedGeneration: 2,
+		Conditions: models.ConditionList{
+			{Type: "Ready", Status: "True", Reason: "Reported"},
+		},
+	})
+	utils.AssertError(t, err, false, "Should upsert controller status")
+
+	err = repo.Reconciliation.UpdateReconciliationSchedule(ctx, cluster.ID)
+	utils.AssertError(t, err, false, "Should update reconciliation schedule")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/admin/reconcile-targets", admin, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Admin should list reconcile targets")
+	response.Clusters = nil
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	utils.AssertError(t, err, false, "Should decode response")
+	for _, target := range response.Clusters {
+		utils.AssertNotEqual(t, cluster.ID, target.ClusterID, "Reconciled cluster should not be listed")
+	}
+}
+
+// fakeSchedulerStats serves a fixed scheduler snapshot
+type fakeSchedulerStats struct {
+	snapshot reconciliation.SchedulerSnapshot
+}
+
+func (f *fakeSchedulerStats) Stats() reconciliation.SchedulerSnapshot {
+	return f.snapshot
+}
+
+func TestReconcileTargetHandler_GetSchedulerStats(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{Auth: config.AuthConfig{Enabled: true}}
+	targets := NewReconcileTargetHandler(nil)
+	router := setupRouter(cfg, auth.NewHeaderAuthenticator(), NewClusterHandler(nil, nil), NewNodePoolHandler(nil, nil), NewFailedEventHandler(nil, nil), targets, NewAuditHandler(nil), NewDatabasePoolHandler(nil))
+	admin := "controller@system.local"
+
+	w := doRequest(router, http.MethodGet, "/api/v1/reconciliation/stats", "user@example.com", "")
+	utils.AssertEqual(t, http.StatusForbidden, w.Code, "Regular users cannot view scheduler stats")
+
+	w = doRequest(router, http.MethodGet, "/api/v1/reconciliation/stats", admin, "")
+	utils.AssertEqual(t, http.StatusServiceUnavailable, w.Code, "Stats need a scheduler")
+
+	lastRun := time.Date(2025, 10, 17, 12, 0, 0, 0, time.UTC)
+	targets.SetScheduler(&fakeSchedulerStats{snapshot: reconciliation.SchedulerSnapshot{
+		Running:            true,
+		LastRunTime:        lastRun,
+		LastClusterEvents:  3,
+		LastNodePoolEvents: 5,
+		LastErrors:         1,
+		TotalErrors:        2,
+	}})
+
+	w = doRequest(router, http.MethodGet, "/api/v1/reconciliation/stats", admin, "")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Admin should get scheduler stats")
+
+	var stats reconciliation.SchedulerSnapshot
+	err := json.Unmarshal(w.Body.Bytes(), &stats)
+	utils.AssertError(t, err, false, "Should decode scheduler stats")
+	utils.AssertTrue(t, stats.Running, "Scheduler should be running")
+	utils.AssertTrue(t, lastRun.Equal(stats.LastRunTime), "Last run time should be returned")
+	utils.AssertEqual(t, 3, stats.LastClusterEvents, "Last cluster events")
+	utils.AssertEqual(t, 5, stats.LastNodePoolEvents, "Last nodepool events")
+	utils.AssertEqual(t, 1, stats.LastErrors, "Last check errors")
+	utils.AssertEqual(t, int64(2), stats.TotalErrors, "Total publish errors")
+}
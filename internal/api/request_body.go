@@ -0,0 +1,33 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// bindJSONWithLimit binds the JSON request body into obj, failing once more than limit bytes
+// have been read. Pass the error to respondIfBodyTooLarge to turn an oversized body into a 413.
+func bindJSONWithLimit(c *gin.Context, limit int64, obj interface{}) error {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
+	return c.ShouldBindJSON(obj)
+}
+
+// respondIfBodyTooLarge writes a 413 response and returns true if err came from a request body
+// exceeding its limit
+func respondIfBodyTooLarge(c *gin.Context, err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	if !errors.As(err, &maxBytesErr) {
+		return false
+	}
+
+	c.JSON(http.StatusRequestEntityTooLarge, utils.NewAPIError(
+		utils.ErrCodeTooLarge,
+		"Request body too large",
+		fmt.Sprintf("request body must be at most %d bytes", maxBytesErr.Limit),
+	))
+	return true
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/apahim/cls-backend/internal/auth"
 	"github.com/apahim/cls-backend/internal/config"
 	"github.com/apahim/cls-backend/internal/database"
 	"github.com/apahim/cls-backend/internal/middleware"
@@ -17,15 +18,28 @@ import (
 
 // Server represents the HTTP server
 type Server struct {
-	config          *config.Config
-	router          *gin.Engine
-	logger          *zap.Logger
-	repository      *database.Repository
-	pubsub          *pubsub.Service
-	clusterService  *services.ClusterService
-	clusterHandler  *ClusterHandler
-	nodepoolHandler *NodePoolHandler
-	httpServer      *http.Server
+	config           *config.Config
+	router           *gin.Engine
+	logger           *zap.Logger
+	repository       *database.Repository
+	pubsub           *pubsub.Service
+	clusterService   *services.ClusterService
+	clusterHandler   *ClusterHandler
+	nodepoolHandler  *NodePoolHandler
+	failedEvents     *FailedEventHandler
+	reconcileTargets *ReconcileTargetHandler
+	audit            *AuditHandler
+	health           *HealthHandler
+	httpServer       *http.Server
+	metricsServer    *http.Server // Serves Prometheus metrics on METRICS_PORT, nil when disabled
+	shutdownTimeout  time.Duration
+}
+
+// BuildInfo identifies the running build, as injected via ldflags
+type BuildInfo struct {
+	Version   string `json:"version"`
+	GitCommit string `json:"git_commit"`
+	BuildTime string `json:"build_time"`
 }
 
 // NewServer creates a new HTTP server
@@ -33,28 +47,58 @@ func NewServer(
 	cfg *config.Config,
 	repository *database.Repository,
 	pubsubService *pubsub.Service,
+	authenticator auth.Authenticator,
+	buildInfo BuildInfo,
 ) *Server {
 	logger := zap.L().Named("api_server")
 
 	// Initialize services
 	clusterService := services.NewClusterService(repository, pubsubService, cfg.Cluster.DefaultVersion, cfg.Cluster.DefaultChannelGroup)
+	clusterService.SetRestoreRetention(cfg.Cluster.RestoreRetention)
+	clusterService.SetAllowedImageRegistries(cfg.Cluster.AllowedImageRegistries)
+	reconcilePublisher := pubsub.NewReconcileEventPublisher(cfg.Reconciliation, pubsubService)
+	clusterService.SetReconcilePublisher(reconcilePublisher)
 
 	// Initialize handlers
 	clusterHandler := NewClusterHandler(clusterService, repository.Status)
 	nodepoolHandler := NewNodePoolHandler(repository, pubsubService)
+	failedEventHandler := NewFailedEventHandler(repository, reconcilePublisher)
+	reconcileTargetHandler := NewReconcileTargetHandler(repository)
+	auditHandler := NewAuditHandler(repository)
+	databasePoolHandler := NewDatabasePoolHandler(repository.GetClient())
+
+	clusterHandler.SetMaxBodyBytes(int64(cfg.Server.MaxRequestBodyBytes))
+	nodepoolHandler.SetMaxBodyBytes(int64(cfg.Server.MaxRequestBodyBytes))
 
 	// Setup router
-	router := setupRouter(cfg, clusterHandler, nodepoolHandler)
+	router := setupRouter(cfg, authenticator, clusterHandler, nodepoolHandler, failedEventHandler, reconcileTargetHandler, auditHandler, databasePoolHandler)
+
+	// Liveness and readiness probes
+	// Webhook delivery runs without Pub/Sub, so it doesn't gate readiness there
+	var pubsubChecker runningChecker
+	if cfg.Reconciliation.Delivery != config.DeliveryWebhook {
+		pubsubChecker = pubsubService
+	}
+	healthHandler := NewHealthHandler(repository.GetClient().DB(), pubsubChecker, nil)
+	healthHandler.RegisterRoutes(router)
+
+	// Build info, unauthenticated so ops tooling and the UI can show the running build
+	router.GET("/version", versionHandler(buildInfo))
 
 	server := &Server{
-		config:          cfg,
-		router:          router,
-		logger:          logger,
-		repository:      repository,
-		pubsub:          pubsubService,
-		clusterService:  clusterService,
-		clusterHandler:  clusterHandler,
-		nodepoolHandler: nodepoolHandler,
+		config:           cfg,
+		router:           router,
+		logger:           logger,
+		repository:       repository,
+		pubsub:           pubsubService,
+		clusterService:   clusterService,
+		clusterHandler:   clusterHandler,
+		nodepoolHandler:  nodepoolHandler,
+		failedEvents:     failedEventHandler,
+		reconcileTargets: reconcileTargetHandler,
+		audit:            auditHandler,
+		health:           healthHandler,
+		shutdownTimeout:  time.Duration(cfg.Server.ShutdownTimeoutSeconds) * time.Second,
 	}
 
 	// Create HTTP server
@@ -67,11 +111,22 @@ func NewServer(
 		MaxHeaderBytes: cfg.Server.MaxHeaderBytes,
 	}
 
+	if cfg.Metrics.Enabled {
+		metricsRouter := gin.New()
+		metricsRouter.Use(gin.Recovery())
+		metricsRouter.GET("/metrics", databasePoolHandler.Metrics)
+		server.metricsServer = &http.Server{
+			Addr:              fmt.Sprintf(":%d", cfg.Metrics.Port),
+			Handler:           metricsRouter,
+			ReadHeaderTimeout: time.Duration(cfg.Server.ReadTimeoutSeconds) * time.Second,
+		}
+	}
+
 	return server
 }
 
 // setupRouter configures the Gin router with all routes and middleware
-func setupRouter(cfg *config.Config, clusterHandler *ClusterHandler, nodepoolHandler *NodePoolHandler) *gin.Engine {
+func setupRouter(cfg *config.Config, authenticator auth.Authenticator, clusterHandler *ClusterHandler, nodepoolHandler *NodePoolHandler, failedEventHandler *FailedEventHandler, reconcileTargetHandler *ReconcileTargetHandler, auditHandler *AuditHandler, databasePoolHandler *DatabasePoolHandler) *gin.Engine {
 	// Set Gin mode based on environment
 	if cfg.Server.Environment == "production" {
 		gin.SetMode(gin.ReleaseMode)
@@ -82,8 +137,9 @@ func setupRouter(cfg *config.Config, clusterHandler *ClusterHandler, nodepoolHan
 	// Global middleware
 	router.Use(gin.Logger())
 	router.Use(gin.Recovery())
-	router.Use(middleware.CORS())
+	router.Use(middleware.CORS(cfg.Server))
 	router.Use(middleware.RequestID())
+	router.Use(middleware.Gzip(cfg.Server.CompressionMinBytes))
 
 	// Health check endpoint
 	router.GET("/health", func(c *gin.Context) {
@@ -99,21 +155,51 @@ func setupRouter(cfg *config.Config, clusterHandler *ClusterHandler, nodepoolHan
 
 	// Authentication middleware for API routes
 	if cfg.Auth.Enabled {
-		v1.Use(middleware.AuthRequired(cfg))
+		v1.Use(middleware.AuthRequired(authenticator))
 	} else {
 		// For development - use mock user context
 		v1.Use(middleware.MockUserContext())
 	}
 
+	// Per-user rate limiting needs the user context set by the auth middleware
+	if cfg.RateLimit.Enabled {
+		v1.Use(middleware.RateLimit(cfg.RateLimit))
+	}
+
 	// Register cluster routes
 	clusterHandler.RegisterRoutes(v1)
 
 	// Register nodepool routes
 	nodepoolHandler.RegisterRoutes(v1)
+	clusterHandler.AddClusterAction("nodepools:batch", nodepoolHandler.BatchCreateNodePools)
+
+	// Register admin routes for failed reconciliation events
+	failedEventHandler.RegisterRoutes(v1)
+
+	// Register admin diagnostics for the reconciliation scheduler
+	reconcileTargetHandler.RegisterRoutes(v1)
+
+	// Register the controller-only audit log
+	auditHandler.RegisterRoutes(v1)
+
+	// Register the controller-only database pool diagnostics and tuning
+	databasePoolHandler.RegisterRoutes(v1)
 
 	return router
 }
 
+// versionHandler reports the running build
+func versionHandler(info BuildInfo) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{
+			"service":    "cls-backend",
+			"version":    info.Version,
+			"git_commit": info.GitCommit,
+			"build_time": info.BuildTime,
+		})
+	}
+}
+
 // Start starts the HTTP server
 func (s *Server) Start(ctx context.Context) error {
 	s.logger.Info("Starting HTTP server",
@@ -128,6 +214,15 @@ func (s *Server) Start(ctx context.Context) error {
 		}
 	}()
 
+	if s.metricsServer != nil {
+		s.logger.Info("Starting metrics server", zap.String("address", s.metricsServer.Addr))
+		go func() {
+			if err := s.metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				s.logger.Error("Failed to start metrics server", zap.Error(err))
+			}
+		}()
+	}
+
 	// Wait for context cancellation
 	<-ctx.Done()
 
@@ -135,13 +230,21 @@ func (s *Server) Start(ctx context.Context) error {
 	return s.Stop()
 }
 
-// Stop gracefully shuts down the HTTP server
+// Stop gracefully shuts down the HTTP server. New connections are refused while in-flight
+// requests are given the shutdown grace period to complete.
 func (s *Server) Stop() error {
-	s.logger.Info("Shutting down HTTP server")
+	s.logger.Info("Shutting down HTTP server", zap.Duration("grace_period", s.shutdownTimeout))
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
 	defer cancel()
 
+	// Metrics stop first so scrapes don't report a pool that is being drained
+	if s.metricsServer != nil {
+		if err := s.metricsServer.Shutdown(ctx); err != nil {
+			s.logger.Warn("Failed to shutdown metrics server gracefully", zap.Error(err))
+		}
+	}
+
 	if err := s.httpServer.Shutdown(ctx); err != nil {
 		s.logger.Error("Failed to shutdown server gracefully", zap.Error(err))
 		return err
@@ -151,6 +254,16 @@ func (s *Server) Stop() error {
 	return nil
 }
 
+// SetScheduler exposes the reconciliation scheduler's stats on the admin routes
+func (s *Server) SetScheduler(scheduler SchedulerStatsSource) {
+	s.reconcileTargets.SetScheduler(scheduler)
+}
+
+// SetReconciliationStatus reports the live reconciler state as reconciliation_enabled on /readyz
+func (s *Server) SetReconciliationStatus(reconciliationEnabled func() bool) {
+	s.health.SetReconciliationStatus(reconciliationEnabled)
+}
+
 // GetRouter returns the Gin router (useful for testing)
 func (s *Server) GetRouter() *gin.Engine {
 	return s.router
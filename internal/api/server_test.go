@@ -0,0 +1,116 @@
+package api
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// startSlowServer serves a handler that blocks until release is closed, and reports on
+// entered once a request is in flight
+func startSlowServer(t *testing.T, shutdownTimeout time.Duration) (*Server, string, chan struct{}, chan struct{}) {
+	gin.SetMode(gin.TestMode)
+	entered := make(chan struct{})
+	release := make(chan struct{})
+
+	router := gin.New()
+	router.GET("/slow", func(c *gin.Context) {
+		close(entered)
+		<-release
+		c.JSON(http.StatusOK, gin.H{"status": "done"})
+	})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	utils.AssertError(t, err, false, "Should listen on a free port")
+
+	server := &Server{
+		router:          router,
+		logger:          zap.NewNop(),
+		httpServer:      &http.Server{Handler: router},
+		shutdownTimeout: shutdownTimeout,
+	}
+	go server.httpServer.Serve(listener)
+
+	return server, "http://" + listener.Addr().String(), entered, release
+}
+
+func TestServer_StopDrainsInFlightRequests(t *testing.T) {
+	server, baseURL, entered, release := startSlowServer(t, 5*time.Second)
+
+	type result struct {
+		status int
+		err    error
+	}
+	done := make(chan result, 1)
+	go func() {
+		resp, err := http.Get(baseURL + "/slow")
+		if err != nil {
+			done <- result{err: err}
+			return
+		}
+		resp.Body.Close()
+		done <- result{status: resp.StatusCode}
+	}()
+	<-entered
+
+	stopped := make(chan error, 1)
+	go func() { stopped <- server.Stop() }()
+
+	// Shutdown waits for the in-flight request instead of cutting it off
+	select {
+	case err := <-stopped:
+		t.Fatalf("Stop returned before the in-flight request completed: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(release)
+
+	r := <-done
+	utils.AssertError(t, r.err, false, "In-flight request should not fail")
+	utils.AssertEqual(t, http.StatusOK, r.status, "In-flight request should complete")
+	utils.AssertError(t, <-stopped, false, "Stop should succeed once requests drain")
+}
+
+func TestServer_StopGivesUpAfterGracePeriod(t *testing.T) {
+	server, baseURL, entered, release := startSlowServer(t, 50*time.Millisecond)
+	defer close(release)
+
+	go func() {
+		if resp, err := http.Get(baseURL + "/slow"); err == nil {
+			resp.Body.Close()
+		}
+	}()
+	<-entered
+
+	err := server.Stop()
+	utils.AssertError(t, err, true, "Stop should fail when requests outlive the grace period")
+}
+
+func TestServer_VersionEndpoint(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/version", versionHandler(BuildInfo{
+		Version:   "v1.2.3",
+		GitCommit: "a1b2c3d",
+		BuildTime: "2025-10-17T00:00:00Z",
+	}))
+
+	// No user email header: the endpoint is unauthenticated
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/version", nil))
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Version should be served")
+
+	var body map[string]string
+	err := json.Unmarshal(w.Body.Bytes(), &body)
+	utils.AssertError(t, err, false, "Should decode version response")
+	utils.AssertEqual(t, "cls-backend", body["service"], "Service name")
+	utils.AssertEqual(t, "v1.2.3", body["version"], "Injected version")
+	utils.AssertEqual(t, "a1b2c3d", body["git_commit"], "Injected commit")
+	utils.AssertEqual(t, "2025-10-17T00:00:00Z", body["build_time"], "Injected build time")
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/apahim/cls-backend/internal/database"
+	"github.com/apahim/cls-backend/internal/middleware"
 	"github.com/apahim/cls-backend/internal/models"
 	"github.com/apahim/cls-backend/internal/utils"
 	"github.com/gin-gonic/gin"
@@ -26,6 +27,11 @@ func NewStatusHandler(repository *database.Repository) *StatusHandler {
 	}
 }
 
+// log returns the handler's logger tagged with the request's ID
+func (h *StatusHandler) log(c *gin.Context) *utils.Logger {
+	return h.logger.WithContext(c.Request.Context())
+}
+
 // RegisterRoutes registers status routes with the router
 func (h *StatusHandler) RegisterRoutes(r *gin.RouterGroup) {
 	status := r.Group("/status")
@@ -56,10 +62,9 @@ func (h *StatusHandler) TriggerStatusAggregation(c *gin.Context) {
 
 	ctx := c.Request.Context()
 
-	// Get user email from context for client isolation
-	userEmail := c.GetString("user_email")
-	if userEmail == "" {
-		h.logger.Error("No user email found in context")
+	userCtx, exists := middleware.GetUserContext(c)
+	if !exists {
+		h.log(c).Error("No user context found")
 		c.JSON(http.StatusUnauthorized, utils.NewAPIError(
 			utils.ErrCodeUnauthorized,
 			"Authentication required",
@@ -69,7 +74,7 @@ func (h *StatusHandler) TriggerStatusAggregation(c *gin.Context) {
 	}
 
 	// Verify cluster exists
-	_, err = h.repository.Clusters.GetByID(ctx, id, userEmail)
+	_, err = h.repository.Clusters.GetByID(ctx, id, userCtx.Email, userCtx.IsController)
 	if err != nil {
 		if err == models.ErrClusterNotFound {
 			c.JSON(http.StatusNotFound, utils.NewAPIError(
@@ -80,7 +85,7 @@ func (h *StatusHandler) TriggerStatusAggregation(c *gin.Context) {
 			return
 		}
 
-		h.logger.Error("Failed to verify cluster",
+		h.log(c).Error("Failed to verify cluster",
 			zap.String("cluster_id", id.String()),
 			zap.Error(err),
 		)
@@ -95,7 +100,7 @@ func (h *StatusHandler) TriggerStatusAggregation(c *gin.Context) {
 	// Mark cluster as dirty to force recalculation on next GET
 	err = h.repository.Clusters.MarkDirtyStatus(ctx, id)
 	if err != nil {
-		h.logger.Error("Failed to mark cluster status as dirty",
+		h.log(c).Error("Failed to mark cluster status as dirty",
 			zap.String("cluster_id", id.String()),
 			zap.Error(err),
 		)
@@ -108,9 +113,9 @@ func (h *StatusHandler) TriggerStatusAggregation(c *gin.Context) {
 	}
 
 	// Get the cluster to trigger status calculation
-	cluster, err := h.repository.Clusters.GetByID(ctx, id, userEmail)
+	cluster, err := h.repository.Clusters.GetByID(ctx, id, userCtx.Email, userCtx.IsController)
 	if err != nil {
-		h.logger.Error("Failed to get cluster after marking dirty",
+		h.log(c).Error("Failed to get cluster after marking dirty",
 			zap.String("cluster_id", id.String()),
 			zap.Error(err),
 		)
@@ -130,7 +135,7 @@ func (h *StatusHandler) TriggerStatusAggregation(c *gin.Context) {
 		"last_updated": cluster.UpdatedAt,
 	}
 
-	h.logger.Info("Status aggregation completed",
+	h.log(c).Info("Status aggregation completed",
 		zap.String("cluster_id", id.String()),
 		zap.String("status_phase", cluster.Status.Phase),
 	)
@@ -145,7 +150,7 @@ func (h *StatusHandler) GetHealthStatus(c *gin.Context) {
 	// Check database health
 	dbHealth, err := h.repository.Health(ctx)
 	if err != nil {
-		h.logger.Error("Database health check failed", zap.Error(err))
+		h.log(c).Error("Database health check failed", zap.Error(err))
 		c.JSON(http.StatusServiceUnavailable, map[string]interface{}{
 			"status": "unhealthy",
 			"checks": map[string]interface{}{
@@ -259,7 +264,7 @@ func (h *StatusHandler) GetErrors(c *gin.Context) {
 
 	rows, err := h.repository.GetClient().QueryContext(ctx, query, args...)
 	if err != nil {
-		h.logger.Error("Failed to query error summary", zap.Error(err))
+		h.log(c).Error("Failed to query error summary", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
 			utils.ErrCodeInternal,
 			"Failed to get error summary",
@@ -282,7 +287,7 @@ func (h *StatusHandler) GetErrors(c *gin.Context) {
 			&errorType, &errorCode, &errorMessage, &userActionable, &errorTime,
 		)
 		if err != nil {
-			h.logger.Error("Failed to scan error row", zap.Error(err))
+			h.log(c).Error("Failed to scan error row", zap.Error(err))
 			continue
 		}
 
@@ -300,7 +305,7 @@ func (h *StatusHandler) GetErrors(c *gin.Context) {
 	}
 
 	if err = rows.Err(); err != nil {
-		h.logger.Error("Error iterating error rows", zap.Error(err))
+		h.log(c).Error("Error iterating error rows", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, utils.NewAPIError(
 			utils.ErrCodeInternal,
 			"Error processing error summary",
@@ -28,12 +28,27 @@ func GetAccessLevel(userCtx *UserContext) AccessLevel {
 	return UserAccess
 }
 
-// CanAccessCluster determines if a user can access a specific cluster
-func CanAccessCluster(userCtx *UserContext, cluster *models.Cluster) bool {
+// CanAccessCluster determines if a user can read a specific cluster. Besides its creator,
+// users holding one of the cluster's grants can read it.
+func CanAccessCluster(userCtx *UserContext, cluster *models.Cluster, grants []*models.ClusterGrant) bool {
 	if userCtx.IsController {
 		return true // System-wide access for controllers
 	}
-	return cluster.CreatedBy == userCtx.Email // User-scoped access
+	if cluster.CreatedBy == userCtx.Email {
+		return true // User-scoped access
+	}
+	for _, grant := range grants {
+		if grant.ClusterID == cluster.ID && grant.GranteeEmail == userCtx.Email {
+			return true // Shared read access
+		}
+	}
+	return false
+}
+
+// CanManageClusterGrants determines if a user can share a cluster with other users or revoke
+// its grants
+func CanManageClusterGrants(userCtx *UserContext, cluster *models.Cluster) bool {
+	return cluster.CreatedBy == userCtx.Email // Only the owner shares a cluster
 }
 
 // CanReportStatus determines if a user can report status for clusters
@@ -64,6 +79,70 @@ func CanDeleteCluster(userCtx *UserContext, cluster *models.Cluster) bool {
 	return cluster.CreatedBy == userCtx.Email // Users can only delete their own clusters
 }
 
+// CanPauseReconciliation determines if a user can pause or resume reconciliation for a cluster
+func CanPauseReconciliation(userCtx *UserContext, cluster *models.Cluster) bool {
+	if userCtx.IsController {
+		return true // Controllers can pause any cluster
+	}
+	return cluster.CreatedBy == userCtx.Email // Users can only pause their own clusters
+}
+
+// CanSetReconciliationInterval determines if a user can override the reconciliation interval of a cluster
+func CanSetReconciliationInterval(userCtx *UserContext, cluster *models.Cluster) bool {
+	if userCtx.IsController {
+		return true // Controllers can tune any cluster
+	}
+	return cluster.CreatedBy == userCtx.Email // Users can only tune their own clusters
+}
+
+// CanTriggerReconciliation determines if a user can request an immediate reconciliation of a cluster
+func CanTriggerReconciliation(userCtx *UserContext, cluster *models.Cluster) bool {
+	if userCtx.IsController {
+		return true // Controllers can reconcile any cluster
+	}
+	return cluster.CreatedBy == userCtx.Email // Users can only reconcile their own clusters
+}
+
+// CanPurgeCluster determines if a user can permanently remove a soft-deleted cluster
+func CanPurgeCluster(userCtx *UserContext) bool {
+	return userCtx.IsController // Only controllers can purge clusters
+}
+
+// CanManageFailedEvents determines if a user can list and replay failed reconciliation events
+func CanManageFailedEvents(userCtx *UserContext) bool {
+	return userCtx.IsController // Failed events are an admin-only recovery tool
+}
+
+// CanGetClusterFullView determines if a user can fetch a cluster bundled with its nodepools and statuses
+func CanGetClusterFullView(userCtx *UserContext) bool {
+	return userCtx.IsController // Internal reconcile API for controllers
+}
+
+// CanViewReconcileTargets determines if a user can inspect the reconciliation scheduler's pending targets
+func CanViewReconcileTargets(userCtx *UserContext) bool {
+	return userCtx.IsController // Targets span all users' clusters
+}
+
+// CanListClustersByReleaseImage determines if a user can look up every cluster using a release image
+func CanListClustersByReleaseImage(userCtx *UserContext) bool {
+	return userCtx.IsController // Matches span all users' clusters
+}
+
+// CanViewAuditLog determines if a user can read the audit trail of write operations
+func CanViewAuditLog(userCtx *UserContext) bool {
+	return userCtx.IsController // Audit entries span all users' resources
+}
+
+// CanDeleteControllerStatus determines if a user can remove a decommissioned controller's status
+func CanDeleteControllerStatus(userCtx *UserContext) bool {
+	return userCtx.IsController // Only controllers report, and so remove, controller status
+}
+
+// CanClearControllerErrors determines if a user can clear the errors reported by a cluster's controllers
+func CanClearControllerErrors(userCtx *UserContext) bool {
+	return userCtx.IsController // Acknowledging errors is an operator action, not an owner one
+}
+
 // IsSystemUser checks if the user is a system user (controller)
 func IsSystemUser(email string) bool {
 	return email == "controller@system.local"
@@ -76,3 +155,18 @@ func NewUserContext(email string) *UserContext {
 		IsController: IsSystemUser(email),
 	}
 }
+
+// CanListDeletedClusters determines if a user can list soft-deleted clusters
+func CanListDeletedClusters(userCtx *UserContext) bool {
+	return userCtx.IsController // Deleted clusters are only listed for operator audits
+}
+
+// CanViewOrphanedNodePools determines if a user can list nodepools left without a live cluster
+func CanViewOrphanedNodePools(userCtx *UserContext) bool {
+	return userCtx.IsController // Orphans span all users' clusters
+}
+
+// CanManageDatabasePool determines if a user can view and resize the database connection pool
+func CanManageDatabasePool(userCtx *UserContext) bool {
+	return userCtx.IsController // The pool is shared by every user's requests
+}
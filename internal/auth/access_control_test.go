@@ -47,11 +47,16 @@ func TestCanAccessCluster(t *testing.T) {
 		CreatedBy: "user@example.com",
 		Name:      "test-cluster",
 	}
+	grants := []*models.ClusterGrant{
+		{ClusterID: cluster.ID, GranteeEmail: "grantee@example.com", Role: models.ClusterGrantRoleViewer},
+		{ClusterID: uuid.New(), GranteeEmail: "elsewhere@example.com", Role: models.ClusterGrantRoleViewer},
+	}
 
 	tests := []struct {
 		name     string
 		userCtx  *UserContext
 		cluster  *models.Cluster
+		grants   []*models.ClusterGrant
 		expected bool
 	}{
 		{
@@ -81,11 +86,41 @@ func TestCanAccessCluster(t *testing.T) {
 			cluster:  cluster,
 			expected: false,
 		},
+		{
+			name: "grantee can access shared cluster",
+			userCtx: &UserContext{
+				Email:        "grantee@example.com",
+				IsController: false,
+			},
+			cluster:  cluster,
+			grants:   grants,
+			expected: true,
+		},
+		{
+			name: "grant on another cluster does not give access",
+			userCtx: &UserContext{
+				Email:        "elsewhere@example.com",
+				IsController: false,
+			},
+			cluster:  cluster,
+			grants:   grants,
+			expected: false,
+		},
+		{
+			name: "user without a grant cannot access shared cluster",
+			userCtx: &UserContext{
+				Email:        "other@example.com",
+				IsController: false,
+			},
+			cluster:  cluster,
+			grants:   grants,
+			expected: false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := CanAccessCluster(tt.userCtx, tt.cluster)
+			result := CanAccessCluster(tt.userCtx, tt.cluster, tt.grants)
 			if result != tt.expected {
 				t.Errorf("CanAccessCluster() = %v, want %v", result, tt.expected)
 			}
@@ -127,6 +162,179 @@ func TestCanReportStatus(t *testing.T) {
 	}
 }
 
+func TestCanPauseReconciliation(t *testing.T) {
+	cluster := &models.Cluster{CreatedBy: "owner@example.com"}
+
+	tests := []struct {
+		name     string
+		userCtx  *UserContext
+		expected bool
+	}{
+		{
+			name:     "controller can pause any cluster",
+			userCtx:  &UserContext{Email: "controller@system.local", IsController: true},
+			expected: true,
+		},
+		{
+			name:     "owner can pause own cluster",
+			userCtx:  &UserContext{Email: "owner@example.com"},
+			expected: true,
+		},
+		{
+			name:     "other user cannot pause cluster",
+			userCtx:  &UserContext{Email: "other@example.com"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanPauseReconciliation(tt.userCtx, cluster)
+			if result != tt.expected {
+				t.Errorf("CanPauseReconciliation() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCanSetReconciliationInterval(t *testing.T) {
+	cluster := &models.Cluster{CreatedBy: "owner@example.com"}
+
+	tests := []struct {
+		name     string
+		userCtx  *UserContext
+		expected bool
+	}{
+		{
+			name:     "controller can set interval on any cluster",
+			userCtx:  &UserContext{Email: "controller@system.local", IsController: true},
+			expected: true,
+		},
+		{
+			name:     "owner can set interval on own cluster",
+			userCtx:  &UserContext{Email: "owner@example.com"},
+			expected: true,
+		},
+		{
+			name:     "other user cannot set interval",
+			userCtx:  &UserContext{Email: "other@example.com"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanSetReconciliationInterval(tt.userCtx, cluster)
+			if result != tt.expected {
+				t.Errorf("CanSetReconciliationInterval() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCanTriggerReconciliation(t *testing.T) {
+	cluster := &models.Cluster{CreatedBy: "owner@example.com"}
+
+	tests := []struct {
+		name     string
+		userCtx  *UserContext
+		expected bool
+	}{
+		{
+			name:     "controller can reconcile any cluster",
+			userCtx:  &UserContext{Email: "controller@system.local", IsController: true},
+			expected: true,
+		},
+		{
+			name:     "owner can reconcile own cluster",
+			userCtx:  &UserContext{Email: "owner@example.com"},
+			expected: true,
+		},
+		{
+			name:     "other user cannot reconcile",
+			userCtx:  &UserContext{Email: "other@example.com"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanTriggerReconciliation(tt.userCtx, cluster)
+			if result != tt.expected {
+				t.Errorf("CanTriggerReconciliation() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCanPurgeCluster(t *testing.T) {
+	tests := []struct {
+		name     string
+		userCtx  *UserContext
+		expected bool
+	}{
+		{
+			name: "controller can purge clusters",
+			userCtx: &UserContext{
+				Email:        "controller@system.local",
+				IsController: true,
+			},
+			expected: true,
+		},
+		{
+			name: "regular user cannot purge clusters",
+			userCtx: &UserContext{
+				Email:        "user@example.com",
+				IsController: false,
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanPurgeCluster(tt.userCtx)
+			if result != tt.expected {
+				t.Errorf("CanPurgeCluster() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCanManageFailedEvents(t *testing.T) {
+	tests := []struct {
+		name     string
+		userCtx  *UserContext
+		expected bool
+	}{
+		{
+			name: "controller can manage failed events",
+			userCtx: &UserContext{
+				Email:        "controller@system.local",
+				IsController: true,
+			},
+			expected: true,
+		},
+		{
+			name: "regular user cannot manage failed events",
+			userCtx: &UserContext{
+				Email:        "user@example.com",
+				IsController: false,
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanManageFailedEvents(tt.userCtx)
+			if result != tt.expected {
+				t.Errorf("CanManageFailedEvents() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestIsSystemUser(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -193,3 +401,282 @@ func TestNewUserContext(t *testing.T) {
 		})
 	}
 }
+
+func TestCanListClustersByReleaseImage(t *testing.T) {
+	tests := []struct {
+		name     string
+		userCtx  *UserContext
+		expected bool
+	}{
+		{
+			name: "controller can list clusters by release image",
+			userCtx: &UserContext{
+				Email:        "controller@system.local",
+				IsController: true,
+			},
+			expected: true,
+		},
+		{
+			name: "regular user cannot list clusters by release image",
+			userCtx: &UserContext{
+				Email:        "user@example.com",
+				IsController: false,
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanListClustersByReleaseImage(tt.userCtx)
+			if result != tt.expected {
+				t.Errorf("CanListClustersByReleaseImage() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCanViewAuditLog(t *testing.T) {
+	tests := []struct {
+		name     string
+		userCtx  *UserContext
+		expected bool
+	}{
+		{
+			name: "controller can view audit log",
+			userCtx: &UserContext{
+				Email:        "controller@system.local",
+				IsController: true,
+			},
+			expected: true,
+		},
+		{
+			name: "regular user cannot view audit log",
+			userCtx: &UserContext{
+				Email:        "user@example.com",
+				IsController: false,
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanViewAuditLog(tt.userCtx)
+			if result != tt.expected {
+				t.Errorf("CanViewAuditLog() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCanDeleteControllerStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		userCtx  *UserContext
+		expected bool
+	}{
+		{
+			name: "controller can delete controller status",
+			userCtx: &UserContext{
+				Email:        "controller@system.local",
+				IsController: true,
+			},
+			expected: true,
+		},
+		{
+			name: "regular user cannot delete controller status",
+			userCtx: &UserContext{
+				Email:        "user@example.com",
+				IsController: false,
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanDeleteControllerStatus(tt.userCtx)
+			if result != tt.expected {
+				t.Errorf("CanDeleteControllerStatus() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCanClearControllerErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		userCtx  *UserContext
+		expected bool
+	}{
+		{
+			name: "controller can clear controller errors",
+			userCtx: &UserContext{
+				Email:        "controller@system.local",
+				IsController: true,
+			},
+			expected: true,
+		},
+		{
+			name: "regular user cannot clear controller errors",
+			userCtx: &UserContext{
+				Email:        "user@example.com",
+				IsController: false,
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanClearControllerErrors(tt.userCtx)
+			if result != tt.expected {
+				t.Errorf("CanClearControllerErrors() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCanListDeletedClusters(t *testing.T) {
+	tests := []struct {
+		name     string
+		userCtx  *UserContext
+		expected bool
+	}{
+		{
+			name: "controller can list deleted clusters",
+			userCtx: &UserContext{
+				Email:        "controller@system.local",
+				IsController: true,
+			},
+			expected: true,
+		},
+		{
+			name: "regular user cannot list deleted clusters",
+			userCtx: &UserContext{
+				Email:        "user@example.com",
+				IsController: false,
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanListDeletedClusters(tt.userCtx)
+			if result != tt.expected {
+				t.Errorf("CanListDeletedClusters() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCanViewOrphanedNodePools(t *testing.T) {
+	tests := []struct {
+		name     string
+		userCtx  *UserContext
+		expected bool
+	}{
+		{
+			name: "controller can view orphaned nodepools",
+			userCtx: &UserContext{
+				Email:        "controller@system.local",
+				IsController: true,
+			},
+			expected: true,
+		},
+		{
+			name: "regular user cannot view orphaned nodepools",
+			userCtx: &UserContext{
+				Email:        "user@example.com",
+				IsController: false,
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanViewOrphanedNodePools(tt.userCtx)
+			if result != tt.expected {
+				t.Errorf("CanViewOrphanedNodePools() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCanManageDatabasePool(t *testing.T) {
+	tests := []struct {
+		name     string
+		userCtx  *UserContext
+		expected bool
+	}{
+		{
+			name: "controller can manage the database pool",
+			userCtx: &UserContext{
+				Email:        "controller@system.local",
+				IsController: true,
+			},
+			expected: true,
+		},
+		{
+			name: "regular user cannot manage the database pool",
+			userCtx: &UserContext{
+				Email:        "user@example.com",
+				IsController: false,
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanManageDatabasePool(tt.userCtx)
+			if result != tt.expected {
+				t.Errorf("CanManageDatabasePool() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCanManageClusterGrants(t *testing.T) {
+	cluster := &models.Cluster{
+		ID:        uuid.New(),
+		CreatedBy: "owner@example.com",
+	}
+
+	tests := []struct {
+		name     string
+		userCtx  *UserContext
+		expected bool
+	}{
+		{
+			name:     "owner can manage grants",
+			userCtx:  &UserContext{Email: "owner@example.com"},
+			expected: true,
+		},
+		{
+			name:     "other user cannot manage grants",
+			userCtx:  &UserContext{Email: "grantee@example.com"},
+			expected: false,
+		},
+		{
+			name: "controller cannot manage grants",
+			userCtx: &UserContext{
+				Email:        "controller@system.local",
+				IsController: true,
+			},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanManageClusterGrants(tt.userCtx, cluster)
+			if result != tt.expected {
+				t.Errorf("CanManageClusterGrants() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
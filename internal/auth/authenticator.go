@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Authenticator derives the caller's user context from an incoming request
+type Authenticator interface {
+	Authenticate(r *http.Request) (*UserContext, error)
+}
+
+// AuthError is returned by an Authenticator when a request cannot be authenticated
+type AuthError struct {
+	Code    string // Machine-readable code returned to the client, e.g. AUTH_REQUIRED
+	Message string
+}
+
+func (e *AuthError) Error() string {
+	return e.Message
+}
+
+// Authentication failures shared by the authenticators
+var (
+	ErrAuthRequired = &AuthError{Code: "AUTH_REQUIRED", Message: "Authentication required"}
+	ErrInvalidEmail = &AuthError{Code: "INVALID_EMAIL", Message: "Invalid user email format"}
+	ErrInvalidToken = &AuthError{Code: "INVALID_TOKEN", Message: "Invalid bearer token"}
+	ErrTokenExpired = &AuthError{Code: "TOKEN_EXPIRED", Message: "Bearer token has expired"}
+)
+
+// UserEmailHeader is the header the API gateway sets to the authenticated user's email
+const UserEmailHeader = "X-User-Email"
+
+// HeaderAuthenticator trusts the user email header set by the API gateway. Controllers are
+// recognized by their system email.
+type HeaderAuthenticator struct{}
+
+// NewHeaderAuthenticator creates an authenticator for gateway-provided email headers
+func NewHeaderAuthenticator() *HeaderAuthenticator {
+	return &HeaderAuthenticator{}
+}
+
+// Authenticate builds the user context from the email header
+func (a *HeaderAuthenticator) Authenticate(r *http.Request) (*UserContext, error) {
+	email := r.Header.Get(UserEmailHeader)
+	if email == "" {
+		return nil, ErrAuthRequired
+	}
+	if !isValidEmail(email) {
+		return nil, ErrInvalidEmail
+	}
+	return NewUserContext(email), nil
+}
+
+// isValidEmail performs basic email validation
+func isValidEmail(email string) bool {
+	// Basic email validation - contains @ and has parts before and after
+	parts := strings.Split(email, "@")
+	if len(parts) != 2 {
+		return false
+	}
+	if len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return false
+	}
+	// Must contain a dot in the domain part
+	return strings.Contains(parts[1], ".")
+}
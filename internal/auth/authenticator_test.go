@@ -0,0 +1,291 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHeaderAuthenticator(t *testing.T) {
+	tests := []struct {
+		name           string
+		email          string
+		wantErr        error
+		wantController bool
+	}{
+		{name: "regular user", email: "alice@example.com"},
+		{name: "controller", email: "controller@system.local", wantController: true},
+		{name: "other system user is not a controller", email: "operator@system.local"},
+		{name: "missing header", email: "", wantErr: ErrAuthRequired},
+		{name: "malformed email", email: "alice", wantErr: ErrInvalidEmail},
+	}
+
+	authenticator := NewHeaderAuthenticator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/api/v1/clusters", nil)
+			if tt.email != "" {
+				req.Header.Set(UserEmailHeader, tt.email)
+			}
+
+			userCtx, err := authenticator.Authenticate(req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Authenticate() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if userCtx.Email != tt.email {
+				t.Errorf("Email = %q, want %q", userCtx.Email, tt.email)
+			}
+			if userCtx.IsController != tt.wantController {
+				t.Errorf("IsController = %v, want %v", userCtx.IsController, tt.wantController)
+			}
+		})
+	}
+}
+
+// signHS256 builds an HS256 token with the given claims
+func signHS256(t *testing.T, secret []byte, claims map[string]interface{}) string {
+	t.Helper()
+	signingInput := encodeTestSegment(t, map[string]string{"alg": "HS256", "typ": "JWT"}) + "." + encodeTestSegment(t, claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signingInput))
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// signRS256 builds an RS256 token with the given claims
+func signRS256(t *testing.T, key *rsa.PrivateKey, claims map[string]interface{}) string {
+	t.Helper()
+	signingInput := encodeTestSegment(t, map[string]string{"alg": "RS256", "typ": "JWT"}) + "." + encodeTestSegment(t, claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func encodeTestSegment(t *testing.T, v interface{}) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Failed to encode token segment: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func authenticateBearer(authenticator Authenticator, token string) (*UserContext, error) {
+	req := httptest.NewRequest("GET", "/api/v1/clusters", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	return authenticator.Authenticate(req)
+}
+
+func TestJWTAuthenticator_HS256(t *testing.T) {
+	secret := []byte("test-secret")
+	now := time.Date(2025, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	authenticator, err := NewJWTAuthenticator(JWTConfig{Secret: secret, Issuer: "https://issuer.example.com", Audience: "cls-backend"})
+	if err != nil {
+		t.Fatalf("NewJWTAuthenticator() error = %v", err)
+	}
+	authenticator.now = func() time.Time { return now }
+
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"email":         "controller@example.com",
+			"is_controller": true,
+			"iss":           "https://issuer.example.com",
+			"aud":           []string{"other-service", "cls-backend"},
+			"exp":           now.Add(time.Hour).Unix(),
+		}
+	}
+
+	userCtx, err := authenticateBearer(authenticator, signHS256(t, secret, validClaims()))
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if userCtx.Email != "controller@example.com" || !userCtx.IsController {
+		t.Errorf("UserContext = %+v, want controller@example.com as a controller", userCtx)
+	}
+
+	// Privilege comes from the claim, not the email
+	claims := validClaims()
+	claims["email"] = "controller@system.local"
+	claims["is_controller"] = false
+	userCtx, err = authenticateBearer(authenticator, signHS256(t, secret, claims))
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if userCtx.IsController {
+		t.Error("A system email without the is_controller claim should not be a controller")
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(claims map[string]interface{})
+		token   func(claims map[string]interface{}) string
+		wantErr error
+	}{
+		{
+			name:    "expired token",
+			mutate:  func(claims map[string]interface{}) { claims["exp"] = now.Add(-time.Minute).Unix() },
+			wantErr: ErrTokenExpired,
+		},
+		{
+			name:   "expiry within the clock skew leeway",
+			mutate: func(claims map[string]interface{}) { claims["exp"] = now.Add(-10 * time.Second).Unix() },
+		},
+		{
+			name:    "missing expiry",
+			mutate:  func(claims map[string]interface{}) { delete(claims, "exp") },
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "not yet valid",
+			mutate:  func(claims map[string]interface{}) { claims["nbf"] = now.Add(time.Hour).Unix() },
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "wrong issuer",
+			mutate:  func(claims map[string]interface{}) { claims["iss"] = "https://attacker.example.com" },
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "wrong audience",
+			mutate:  func(claims map[string]interface{}) { claims["aud"] = "other-service" },
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "invalid email claim",
+			mutate:  func(claims map[string]interface{}) { claims["email"] = "not-an-email" },
+			wantErr: ErrInvalidEmail,
+		},
+		{
+			name:    "signed with another secret",
+			token:   func(claims map[string]interface{}) string { return signHS256(t, []byte("other-secret"), claims) },
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name: "unsigned token",
+			token: func(claims map[string]interface{}) string {
+				return encodeTestSegment(t, map[string]string{"alg": "none"}) + "." + encodeTestSegment(t, claims) + "."
+			},
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "malformed token",
+			token:   func(claims map[string]interface{}) string { return "not.a-token" },
+			wantErr: ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := validClaims()
+			if tt.mutate != nil {
+				tt.mutate(claims)
+			}
+			token := signHS256(t, secret, claims)
+			if tt.token != nil {
+				token = tt.token(claims)
+			}
+
+			_, err := authenticateBearer(authenticator, token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Authenticate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJWTAuthenticator_RS256(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("Failed to marshal public key: %v", err)
+	}
+	publicKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER})
+
+	authenticator, err := NewJWTAuthenticator(JWTConfig{PublicKeyPEM: publicKeyPEM})
+	if err != nil {
+		t.Fatalf("NewJWTAuthenticator() error = %v", err)
+	}
+
+	claims := map[string]interface{}{
+		"email": "alice@example.com",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}
+	userCtx, err := authenticateBearer(authenticator, signRS256(t, key, claims))
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if userCtx.Email != "alice@example.com" || userCtx.IsController {
+		t.Errorf("UserContext = %+v, want alice@example.com as a regular user", userCtx)
+	}
+
+	claims["exp"] = time.Now().Add(-time.Hour).Unix()
+	if _, err := authenticateBearer(authenticator, signRS256(t, key, claims)); !errors.Is(err, ErrTokenExpired) {
+		t.Errorf("Expired token error = %v, want %v", err, ErrTokenExpired)
+	}
+
+	// An HS256 token keyed with the public key must not pass as RS256
+	claims["exp"] = time.Now().Add(time.Hour).Unix()
+	if _, err := authenticateBearer(authenticator, signHS256(t, publicKeyPEM, claims)); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Algorithm confusion error = %v, want %v", err, ErrInvalidToken)
+	}
+}
+
+func TestJWTAuthenticator_AuthorizationHeader(t *testing.T) {
+	authenticator, err := NewJWTAuthenticator(JWTConfig{Secret: []byte("test-secret")})
+	if err != nil {
+		t.Fatalf("NewJWTAuthenticator() error = %v", err)
+	}
+
+	req := httptest.NewRequest("GET", "/api/v1/clusters", nil)
+	if _, err := authenticator.Authenticate(req); !errors.Is(err, ErrAuthRequired) {
+		t.Errorf("Missing header error = %v, want %v", err, ErrAuthRequired)
+	}
+
+	// The gateway email header is not trusted by the JWT provider
+	req.Header.Set(UserEmailHeader, "controller@system.local")
+	req.Header.Set("Authorization", "Basic dXNlcjpwYXNz")
+	if _, err := authenticator.Authenticate(req); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Non-bearer header error = %v, want %v", err, ErrInvalidToken)
+	}
+}
+
+func TestNewJWTAuthenticator(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     JWTConfig
+		wantErr bool
+	}{
+		{name: "secret", cfg: JWTConfig{Secret: []byte("s")}},
+		{name: "no key", cfg: JWTConfig{}, wantErr: true},
+		{name: "secret and public key", cfg: JWTConfig{Secret: []byte("s"), PublicKeyPEM: []byte("k")}, wantErr: true},
+		{name: "public key not PEM", cfg: JWTConfig{PublicKeyPEM: []byte("not pem")}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewJWTAuthenticator(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewJWTAuthenticator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
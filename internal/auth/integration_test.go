@@ -67,7 +67,7 @@ func TestAccessControlFlow(t *testing.T) {
 			}
 
 			// Test cluster access
-			canAccess := CanAccessCluster(userCtx, cluster)
+			canAccess := CanAccessCluster(userCtx, cluster, nil)
 			if canAccess != scenario.canAccess {
 				t.Errorf("Expected CanAccessCluster %v, got %v", scenario.canAccess, canAccess)
 			}
@@ -108,7 +108,7 @@ func TestControllerPrivileges(t *testing.T) {
 	}
 
 	for _, cluster := range clusters {
-		if !CanAccessCluster(controllerCtx, cluster) {
+		if !CanAccessCluster(controllerCtx, cluster, nil) {
 			t.Errorf("Controller should be able to access cluster %s created by %s", cluster.Name, cluster.CreatedBy)
 		}
 
@@ -150,22 +150,22 @@ func TestUserIsolation(t *testing.T) {
 	}
 
 	// User1 should access own cluster
-	if !CanAccessCluster(user1Ctx, user1Cluster) {
+	if !CanAccessCluster(user1Ctx, user1Cluster, nil) {
 		t.Error("User1 should be able to access own cluster")
 	}
 
 	// User1 should NOT access user2's cluster
-	if CanAccessCluster(user1Ctx, user2Cluster) {
+	if CanAccessCluster(user1Ctx, user2Cluster, nil) {
 		t.Error("User1 should NOT be able to access user2's cluster")
 	}
 
 	// User2 should access own cluster
-	if !CanAccessCluster(user2Ctx, user2Cluster) {
+	if !CanAccessCluster(user2Ctx, user2Cluster, nil) {
 		t.Error("User2 should be able to access own cluster")
 	}
 
 	// User2 should NOT access user1's cluster
-	if CanAccessCluster(user2Ctx, user1Cluster) {
+	if CanAccessCluster(user2Ctx, user1Cluster, nil) {
 		t.Error("User2 should NOT be able to access user1's cluster")
 	}
 
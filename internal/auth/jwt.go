@@ -0,0 +1,236 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// jwtLeeway tolerates clock skew between the token issuer and this service
+const jwtLeeway = 30 * time.Second
+
+// JWTConfig configures bearer token validation. Exactly one of Secret (HS256) and
+// PublicKeyPEM (RS256) must be set.
+type JWTConfig struct {
+	Secret       []byte
+	PublicKeyPEM []byte
+	Issuer       string // Required "iss" claim, if set
+	Audience     string // Required entry of the "aud" claim, if set
+}
+
+// JWTAuthenticator validates signed bearer tokens and takes the caller's email and
+// controller privilege from the token's claims
+type JWTAuthenticator struct {
+	algorithm string
+	secret    []byte
+	publicKey *rsa.PublicKey
+	issuer    string
+	audience  string
+	now       func() time.Time
+}
+
+// NewJWTAuthenticator creates a bearer token authenticator
+func NewJWTAuthenticator(cfg JWTConfig) (*JWTAuthenticator, error) {
+	a := &JWTAuthenticator{
+		issuer:   cfg.Issuer,
+		audience: cfg.Audience,
+		now:      time.Now,
+	}
+
+	switch {
+	case len(cfg.Secret) > 0 && len(cfg.PublicKeyPEM) > 0:
+		return nil, errors.New("JWT secret and public key are mutually exclusive")
+	case len(cfg.Secret) > 0:
+		a.algorithm = "HS256"
+		a.secret = cfg.Secret
+	case len(cfg.PublicKeyPEM) > 0:
+		publicKey, err := parseRSAPublicKey(cfg.PublicKeyPEM)
+		if err != nil {
+			return nil, err
+		}
+		a.algorithm = "RS256"
+		a.publicKey = publicKey
+	default:
+		return nil, errors.New("JWT secret or public key is required")
+	}
+
+	return a, nil
+}
+
+// parseRSAPublicKey decodes a PEM-encoded PKIX or PKCS#1 RSA public key
+func parseRSAPublicKey(data []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("JWT public key is not PEM encoded")
+	}
+
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JWT public key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("JWT public key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+// jwtHeader is the JOSE header of a token
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+}
+
+// jwtClaims holds the claims the authenticator reads
+type jwtClaims struct {
+	Email        string      `json:"email"`
+	IsController bool        `json:"is_controller"`
+	ExpiresAt    *float64    `json:"exp"`
+	NotBefore    *float64    `json:"nbf"`
+	Issuer       string      `json:"iss"`
+	Audience     jwtAudience `json:"aud"`
+}
+
+// jwtAudience accepts the "aud" claim as either a single string or a list
+type jwtAudience []string
+
+func (a *jwtAudience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = jwtAudience{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*a = list
+	return nil
+}
+
+func (a jwtAudience) contains(audience string) bool {
+	for _, entry := range a {
+		if entry == audience {
+			return true
+		}
+	}
+	return false
+}
+
+// Authenticate validates the bearer token in the Authorization header
+func (a *JWTAuthenticator) Authenticate(r *http.Request) (*UserContext, error) {
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return nil, ErrAuthRequired
+	}
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return nil, ErrInvalidToken
+	}
+
+	claims, err := a.verify(strings.TrimSpace(token))
+	if err != nil {
+		return nil, err
+	}
+
+	if !isValidEmail(claims.Email) {
+		return nil, ErrInvalidEmail
+	}
+
+	return &UserContext{
+		Email:        claims.Email,
+		IsController: claims.IsController,
+	}, nil
+}
+
+// verify checks the token's signature and time and issuer claims and returns its claims
+func (a *JWTAuthenticator) verify(token string) (*jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	var header jwtHeader
+	if err := decodeJWTSegment(parts[0], &header); err != nil {
+		return nil, ErrInvalidToken
+	}
+	// Only the configured algorithm is accepted, which rules out "none" and HS256 tokens
+	// signed with an RSA public key
+	if header.Algorithm != a.algorithm {
+		return nil, ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	if !a.validSignature(parts[0]+"."+parts[1], signature) {
+		return nil, ErrInvalidToken
+	}
+
+	var claims jwtClaims
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	now := a.now()
+	if claims.ExpiresAt == nil {
+		return nil, ErrInvalidToken
+	}
+	if now.After(numericDate(*claims.ExpiresAt).Add(jwtLeeway)) {
+		return nil, ErrTokenExpired
+	}
+	if claims.NotBefore != nil && now.Add(jwtLeeway).Before(numericDate(*claims.NotBefore)) {
+		return nil, ErrInvalidToken
+	}
+	if a.issuer != "" && claims.Issuer != a.issuer {
+		return nil, ErrInvalidToken
+	}
+	if a.audience != "" && !claims.Audience.contains(a.audience) {
+		return nil, ErrInvalidToken
+	}
+
+	return &claims, nil
+}
+
+// validSignature checks the signature of the token's signing input
+func (a *JWTAuthenticator) validSignature(signingInput string, signature []byte) bool {
+	switch a.algorithm {
+	case "HS256":
+		mac := hmac.New(sha256.New, a.secret)
+		mac.Write([]byte(signingInput))
+		return hmac.Equal(signature, mac.Sum(nil))
+	case "RS256":
+		digest := sha256.Sum256([]byte(signingInput))
+		return rsa.VerifyPKCS1v15(a.publicKey, crypto.SHA256, digest[:], signature) == nil
+	default:
+		return false
+	}
+}
+
+// decodeJWTSegment decodes a base64url-encoded JSON token segment
+func decodeJWTSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// numericDate converts a JWT NumericDate (seconds since the epoch) to a time
+func numericDate(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
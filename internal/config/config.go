@@ -1,8 +1,12 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
 	"os"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -19,6 +23,8 @@ type Config struct {
 	Reconciliation ReconciliationConfig
 	Aggregation    AggregationConfig
 	Metrics        MetricsConfig
+	RateLimit      RateLimitConfig
+	Webhooks       WebhooksConfig
 }
 
 // ReconciliationConfig holds reconciliation scheduler configuration
@@ -27,6 +33,11 @@ type ReconciliationConfig struct {
 	CheckInterval time.Duration `mapstructure:"check_interval"`
 	MaxConcurrent int           `mapstructure:"max_concurrent"`
 
+	// PlatformMaxConcurrent caps the reconcile events per check for clusters (and their
+	// nodepools) of a platform type, e.g. {"GCP": 20}, matched case-insensitively. MaxConcurrent
+	// still bounds the total across all platforms.
+	PlatformMaxConcurrent map[string]int `mapstructure:"platform_max_concurrent"`
+
 	// Health-aware interval configuration
 	AdaptiveEnabled   bool          `mapstructure:"adaptive_enabled"`
 	HealthyInterval   time.Duration `mapstructure:"healthy_interval"`
@@ -37,6 +48,43 @@ type ReconciliationConfig struct {
 	ReactiveEnabled            bool          `mapstructure:"reactive_enabled"`
 	ReactiveDebounce           time.Duration `mapstructure:"reactive_debounce"`
 	ReactiveMaxEventsPerMinute int           `mapstructure:"reactive_max_events_per_minute"`
+
+	// Delivery selects how reconcile events are sent to controllers: "pubsub" (default) or "webhook"
+	Delivery string        `mapstructure:"delivery"`
+	Webhook  WebhookConfig `mapstructure:"webhook"`
+
+	// DryRun makes the scheduler log and count the events it would publish without publishing them
+	DryRun bool `mapstructure:"dry_run"`
+
+	// RequireReconciler fails startup, instead of only warning, when neither the scheduler nor
+	// the reactive reconciler is running after startup and clusters would never be reconciled.
+	// Checked after the reconcilers start, since reactive reconciliation can be enabled in the database.
+	RequireReconciler bool `mapstructure:"require_reconciler"`
+}
+
+// Reconcile event delivery modes
+const (
+	DeliveryPubSub  = "pubsub"
+	DeliveryWebhook = "webhook"
+)
+
+// WebhookConfig holds HTTP webhook configuration for reconcile event delivery
+type WebhookConfig struct {
+	URL          string        `mapstructure:"url"`
+	Secret       string        `mapstructure:"secret"` // HMAC-SHA256 key used to sign payloads
+	Timeout      time.Duration `mapstructure:"timeout"`
+	MaxRetries   int           `mapstructure:"max_retries"`
+	RetryBackoff time.Duration `mapstructure:"retry_backoff"`
+}
+
+// WebhooksConfig holds the HTTP webhooks notified when a cluster's aggregated phase changes.
+// No URLs disables the notifications.
+type WebhooksConfig struct {
+	URLs         []string      `mapstructure:"urls"`
+	Secret       string        `mapstructure:"secret"` // HMAC-SHA256 key used to sign payloads
+	Timeout      time.Duration `mapstructure:"timeout"`
+	MaxRetries   int           `mapstructure:"max_retries"`
+	RetryBackoff time.Duration `mapstructure:"retry_backoff"`
 }
 
 // AggregationConfig holds status aggregation configuration
@@ -48,6 +96,36 @@ type AggregationConfig struct {
 	RetryAttempts       int           `mapstructure:"retry_attempts"`
 	RetryBackoff        time.Duration `mapstructure:"retry_backoff"`
 	HealthCheckInterval time.Duration `mapstructure:"health_check_interval"`
+	CollapseErrors      bool          `mapstructure:"collapse_errors"`
+
+	// ControllerWeights weights controllers in the readiness progress of a cluster or
+	// nodepool, e.g. {"cls-hypershift-client": 3}. Controllers not listed weigh 1.
+	ControllerWeights map[string]int `mapstructure:"controller_weights"`
+
+	// ReadyThreshold is the weighted readiness percentage at which a cluster or nodepool
+	// becomes Ready. 100 (the default) requires every controller to be ready.
+	ReadyThreshold int `mapstructure:"ready_threshold"`
+
+	// StalenessThreshold is how long a controller may go without reporting before it no
+	// longer counts as ready and a Stale condition is added. 0 disables the check.
+	StalenessThreshold time.Duration `mapstructure:"staleness_threshold"`
+
+	// StatusHistoryLimit is how many condition changes are kept per cluster controller in
+	// the status history; older ones are trimmed as new ones are recorded
+	StatusHistoryLimit int `mapstructure:"status_history_limit"`
+
+	// PlatformStrategies names the cluster status aggregation strategy of a platform type,
+	// e.g. {"AWS": "default"}. Platforms not listed use the default strategy.
+	PlatformStrategies map[string]string `mapstructure:"platform_strategies"`
+}
+
+// RateLimitConfig holds per-user API rate limiting configuration
+type RateLimitConfig struct {
+	Enabled           bool          `mapstructure:"enabled"`
+	RequestsPerSecond float64       `mapstructure:"requests_per_second"`
+	Burst             int           `mapstructure:"burst"`
+	Allowlist         []string      `mapstructure:"allowlist"`    // User emails exempt from rate limiting
+	IdleTimeout       time.Duration `mapstructure:"idle_timeout"` // Buckets unused for this long are evicted
 }
 
 // MetricsConfig holds metrics server configuration
@@ -65,8 +143,37 @@ type ServerConfig struct {
 	IdleTimeoutSeconds  int      `mapstructure:"idle_timeout_seconds"`
 	MaxHeaderBytes      int      `mapstructure:"max_header_bytes"`
 	CorsAllowedOrigins  []string `mapstructure:"cors_allowed_origins"`
+
+	// ShutdownTimeoutSeconds is how long shutdown waits for in-flight requests to complete
+	ShutdownTimeoutSeconds int `mapstructure:"shutdown_timeout_seconds"`
+
+	// MaxRequestBodyBytes caps the body of create, update and status report requests
+	MaxRequestBodyBytes int `mapstructure:"max_request_body_bytes"`
+
+	// CompressionMinBytes is the smallest response body gzip-compressed for clients that
+	// accept it; 0 compresses every response
+	CompressionMinBytes int `mapstructure:"compression_min_bytes"`
+
+	// CorsAllowedMethods, CorsAllowedHeaders and CorsAllowCredentials are granted to browsers
+	// on CorsAllowedOrigins. CORS is off, refusing every other origin, while no origin is allowed.
+	CorsAllowedMethods   []string `mapstructure:"cors_allowed_methods"`
+	CorsAllowedHeaders   []string `mapstructure:"cors_allowed_headers"`
+	CorsAllowCredentials bool     `mapstructure:"cors_allow_credentials"`
 }
 
+// DefaultMaxRequestBodyBytes is the default cap on create, update and status report bodies
+const DefaultMaxRequestBodyBytes = 256 << 10 // 256KB
+
+// DefaultCompressionMinBytes is the default smallest response body worth compressing
+const DefaultCompressionMinBytes = 1 << 10 // 1KB
+
+// DefaultCorsAllowedMethods and DefaultCorsAllowedHeaders cover the methods and request
+// headers the API uses
+var (
+	DefaultCorsAllowedMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
+	DefaultCorsAllowedHeaders = []string{"Authorization", "Content-Type", "Idempotency-Key", "If-None-Match", "X-Request-ID", "X-User-Email"}
+)
+
 // DatabaseConfig holds database connection configuration
 type DatabaseConfig struct {
 	URL             string
@@ -74,6 +181,15 @@ type DatabaseConfig struct {
 	MaxIdleConns    int
 	ConnMaxLifetime time.Duration
 	ConnMaxIdleTime time.Duration
+
+	// TxMaxRetries is how many times a transaction aborted by a serialization failure or
+	// deadlock is re-run; TxRetryBaseDelay is the backoff before the first retry
+	TxMaxRetries     int
+	TxRetryBaseDelay time.Duration
+
+	// StatementTimeout is the server-side limit on any single statement, set on every pooled
+	// connection so a slow query errors instead of holding its connection. 0 disables it.
+	StatementTimeout time.Duration
 }
 
 // PubSubConfig holds Cloud Pub/Sub configuration (simplified for fan-out architecture)
@@ -85,6 +201,11 @@ type PubSubConfig struct {
 	CredentialsFile        string `mapstructure:"credentials_file"`
 	MaxConcurrentHandlers  int    `mapstructure:"max_concurrent_handlers"`
 	MaxOutstandingMessages int    `mapstructure:"max_outstanding_messages"`
+
+	// Publish retry policy for transient failures
+	PublishMaxAttempts    int           `mapstructure:"publish_max_attempts"`
+	PublishInitialBackoff time.Duration `mapstructure:"publish_initial_backoff"`
+	PublishMaxBackoff     time.Duration `mapstructure:"publish_max_backoff"`
 }
 
 // LoggingConfig holds logging configuration
@@ -96,12 +217,35 @@ type LoggingConfig struct {
 // AuthConfig holds authentication configuration
 type AuthConfig struct {
 	Enabled bool `mapstructure:"enabled"`
+
+	// Provider selects how callers are authenticated: "header" (default) trusts the
+	// X-User-Email header set by the API gateway, "jwt" validates bearer tokens
+	Provider string `mapstructure:"provider"`
+
+	// Bearer token validation; set JWTSecret for HS256 or JWTPublicKeyFile for RS256
+	JWTSecret        string `mapstructure:"jwt_secret"`
+	JWTPublicKeyFile string `mapstructure:"jwt_public_key_file"`
+	JWTIssuer        string `mapstructure:"jwt_issuer"`
+	JWTAudience      string `mapstructure:"jwt_audience"`
 }
 
+// Authentication providers
+const (
+	AuthProviderHeader = "header"
+	AuthProviderJWT    = "jwt"
+)
+
 // ClusterConfig holds cluster-related configuration
 type ClusterConfig struct {
 	DefaultVersion      string `mapstructure:"default_version"`
 	DefaultChannelGroup string `mapstructure:"default_channel_group"`
+
+	// RestoreRetention is how long after deletion a soft-deleted cluster can still be restored
+	RestoreRetention time.Duration `mapstructure:"restore_retention"`
+
+	// AllowedImageRegistries lists the registries release images may be pulled from, e.g.
+	// quay.io. Empty allows any registry.
+	AllowedImageRegistries []string `mapstructure:"allowed_image_registries"`
 }
 
 // Load loads configuration from environment variables with defaults
@@ -114,14 +258,25 @@ func Load() (*Config, error) {
 			WriteTimeoutSeconds: getIntEnv("SERVER_WRITE_TIMEOUT_SECONDS", 30),
 			IdleTimeoutSeconds:  getIntEnv("SERVER_IDLE_TIMEOUT_SECONDS", 120),
 			MaxHeaderBytes:      getIntEnv("SERVER_MAX_HEADER_BYTES", 1<<20), // 1MB default
-			CorsAllowedOrigins:  getStringSliceEnv("CORS_ALLOWED_ORIGINS", []string{"*"}),
+			CorsAllowedOrigins:  getStringSliceEnv("CORS_ALLOWED_ORIGINS", nil),
+
+			ShutdownTimeoutSeconds: getIntEnv("SERVER_SHUTDOWN_TIMEOUT_SECONDS", 30),
+			MaxRequestBodyBytes:    getIntEnv("SERVER_MAX_REQUEST_BODY_BYTES", DefaultMaxRequestBodyBytes),
+			CompressionMinBytes:    getIntEnv("SERVER_COMPRESSION_MIN_BYTES", DefaultCompressionMinBytes),
+
+			CorsAllowedMethods:   getStringSliceEnv("CORS_ALLOWED_METHODS", DefaultCorsAllowedMethods),
+			CorsAllowedHeaders:   getStringSliceEnv("CORS_ALLOWED_HEADERS", DefaultCorsAllowedHeaders),
+			CorsAllowCredentials: getBoolEnv("CORS_ALLOW_CREDENTIALS", false),
 		},
 		Database: DatabaseConfig{
-			URL:             getEnv("DATABASE_URL", ""),
-			MaxOpenConns:    getIntEnv("DATABASE_MAX_OPEN_CONNS", 25),
-			MaxIdleConns:    getIntEnv("DATABASE_MAX_IDLE_CONNS", 5),
-			ConnMaxLifetime: getDurationEnv("DATABASE_CONN_MAX_LIFETIME", 5*time.Minute),
-			ConnMaxIdleTime: getDurationEnv("DATABASE_CONN_MAX_IDLE_TIME", 1*time.Minute),
+			URL:              getEnv("DATABASE_URL", ""),
+			MaxOpenConns:     getIntEnv("DATABASE_MAX_OPEN_CONNS", 25),
+			MaxIdleConns:     getIntEnv("DATABASE_MAX_IDLE_CONNS", 5),
+			ConnMaxLifetime:  getDurationEnv("DATABASE_CONN_MAX_LIFETIME", 5*time.Minute),
+			ConnMaxIdleTime:  getDurationEnv("DATABASE_CONN_MAX_IDLE_TIME", 1*time.Minute),
+			TxMaxRetries:     getIntEnv("DATABASE_TX_MAX_RETRIES", 3),
+			TxRetryBaseDelay: getDurationEnv("DATABASE_TX_RETRY_BASE_DELAY", 50*time.Millisecond),
+			StatementTimeout: getDurationEnv("DATABASE_STATEMENT_TIMEOUT", 15*time.Second),
 		},
 		PubSub: PubSubConfig{
 			ProjectID:              getEnv("GOOGLE_CLOUD_PROJECT", ""),
@@ -131,23 +286,35 @@ func Load() (*Config, error) {
 			CredentialsFile:        getEnv("GOOGLE_APPLICATION_CREDENTIALS", ""),
 			MaxConcurrentHandlers:  getIntEnv("PUBSUB_MAX_CONCURRENT_HANDLERS", 10),
 			MaxOutstandingMessages: getIntEnv("PUBSUB_MAX_OUTSTANDING_MESSAGES", 100),
+			PublishMaxAttempts:     getIntEnv("PUBSUB_PUBLISH_MAX_ATTEMPTS", 3),
+			PublishInitialBackoff:  getDurationEnv("PUBSUB_PUBLISH_INITIAL_BACKOFF", 100*time.Millisecond),
+			PublishMaxBackoff:      getDurationEnv("PUBSUB_PUBLISH_MAX_BACKOFF", 800*time.Millisecond),
 		},
 		Logging: LoggingConfig{
 			Level:  getEnv("LOG_LEVEL", "info"),
 			Format: getEnv("LOG_FORMAT", "json"),
 		},
 		Auth: AuthConfig{
-			Enabled: !getBoolEnv("DISABLE_AUTH", false),
+			Enabled:          !getBoolEnv("DISABLE_AUTH", false),
+			Provider:         getEnv("AUTH_PROVIDER", AuthProviderHeader),
+			JWTSecret:        getEnv("AUTH_JWT_SECRET", ""),
+			JWTPublicKeyFile: getEnv("AUTH_JWT_PUBLIC_KEY_FILE", ""),
+			JWTIssuer:        getEnv("AUTH_JWT_ISSUER", ""),
+			JWTAudience:      getEnv("AUTH_JWT_AUDIENCE", ""),
 		},
 		Cluster: ClusterConfig{
-			DefaultVersion:      getEnv("DEFAULT_CLUSTER_VERSION", ""),
-			DefaultChannelGroup: getEnv("DEFAULT_CHANNEL_GROUP", ""),
+			DefaultVersion:         getEnv("DEFAULT_CLUSTER_VERSION", ""),
+			DefaultChannelGroup:    getEnv("DEFAULT_CHANNEL_GROUP", ""),
+			RestoreRetention:       getDurationEnv("CLUSTER_RESTORE_RETENTION", 7*24*time.Hour),
+			AllowedImageRegistries: getStringSliceEnv("CLUSTER_ALLOWED_IMAGE_REGISTRIES", nil),
 		},
 		Reconciliation: ReconciliationConfig{
 			Enabled:       getBoolEnv("RECONCILIATION_ENABLED", true),
 			CheckInterval: getDurationEnv("RECONCILIATION_CHECK_INTERVAL", 1*time.Minute),
 			MaxConcurrent: getIntEnv("RECONCILIATION_MAX_CONCURRENT", 50),
 
+			PlatformMaxConcurrent: getIntMapEnv("RECONCILIATION_PLATFORM_MAX_CONCURRENT"),
+
 			// Health-aware configuration
 			AdaptiveEnabled:   getBoolEnv("RECONCILIATION_ADAPTIVE_ENABLED", true),
 			HealthyInterval:   getDurationEnv("RECONCILIATION_HEALTHY_INTERVAL", 5*time.Minute),
@@ -158,6 +325,20 @@ func Load() (*Config, error) {
 			ReactiveEnabled:            getBoolEnv("REACTIVE_RECONCILIATION_ENABLED", false),
 			ReactiveDebounce:           getDurationEnv("REACTIVE_RECONCILIATION_DEBOUNCE", 2*time.Second),
 			ReactiveMaxEventsPerMinute: getIntEnv("REACTIVE_RECONCILIATION_MAX_EVENTS_PER_MINUTE", 60),
+
+			// Reconcile event delivery
+			Delivery: getEnv("RECONCILIATION_DELIVERY", DeliveryPubSub),
+			Webhook: WebhookConfig{
+				URL:          getEnv("RECONCILIATION_WEBHOOK_URL", ""),
+				Secret:       getEnv("RECONCILIATION_WEBHOOK_SECRET", ""),
+				Timeout:      getDurationEnv("RECONCILIATION_WEBHOOK_TIMEOUT", 10*time.Second),
+				MaxRetries:   getIntEnv("RECONCILIATION_WEBHOOK_MAX_RETRIES", 3),
+				RetryBackoff: getDurationEnv("RECONCILIATION_WEBHOOK_RETRY_BACKOFF", 500*time.Millisecond),
+			},
+
+			DryRun: getBoolEnv("RECONCILIATION_DRY_RUN", false),
+
+			RequireReconciler: getBoolEnv("RECONCILIATION_REQUIRE_RECONCILER", false),
 		},
 		Aggregation: AggregationConfig{
 			Enabled:             getBoolEnv("AGGREGATION_ENABLED", true),
@@ -167,11 +348,32 @@ func Load() (*Config, error) {
 			RetryAttempts:       getIntEnv("AGGREGATION_RETRY_ATTEMPTS", 3),
 			RetryBackoff:        getDurationEnv("AGGREGATION_RETRY_BACKOFF", 5*time.Second),
 			HealthCheckInterval: getDurationEnv("AGGREGATION_HEALTH_CHECK_INTERVAL", 60*time.Second),
+			CollapseErrors:      getBoolEnv("AGGREGATION_COLLAPSE_ERRORS", true),
+
+			ControllerWeights:  getIntMapEnv("AGGREGATION_CONTROLLER_WEIGHTS"),
+			ReadyThreshold:     getIntEnv("AGGREGATION_READY_THRESHOLD", 100),
+			StalenessThreshold: getDurationEnv("AGGREGATION_STALENESS_THRESHOLD", 10*time.Minute),
+			StatusHistoryLimit: getIntEnv("AGGREGATION_STATUS_HISTORY_LIMIT", 50),
+			PlatformStrategies: getStringMapEnv("AGGREGATION_PLATFORM_STRATEGIES"),
 		},
 		Metrics: MetricsConfig{
 			Enabled: getBoolEnv("METRICS_ENABLED", true),
 			Port:    getIntEnv("METRICS_PORT", 8081),
 		},
+		RateLimit: RateLimitConfig{
+			Enabled:           getBoolEnv("RATE_LIMIT_ENABLED", true),
+			RequestsPerSecond: getFloatEnv("RATE_LIMIT_REQUESTS_PER_SECOND", 10),
+			Burst:             getIntEnv("RATE_LIMIT_BURST", 20),
+			Allowlist:         getStringSliceEnv("RATE_LIMIT_ALLOWLIST", nil),
+			IdleTimeout:       getDurationEnv("RATE_LIMIT_IDLE_TIMEOUT", 10*time.Minute),
+		},
+		Webhooks: WebhooksConfig{
+			URLs:         getStringSliceEnv("PHASE_WEBHOOK_URLS", nil),
+			Secret:       getEnv("PHASE_WEBHOOK_SECRET", ""),
+			Timeout:      getDurationEnv("PHASE_WEBHOOK_TIMEOUT", 10*time.Second),
+			MaxRetries:   getIntEnv("PHASE_WEBHOOK_MAX_RETRIES", 3),
+			RetryBackoff: getDurationEnv("PHASE_WEBHOOK_RETRY_BACKOFF", 500*time.Millisecond),
+		},
 	}
 
 	if err := config.Validate(); err != nil {
@@ -181,16 +383,41 @@ func Load() (*Config, error) {
 	return config, nil
 }
 
-// Validate validates the configuration
+// Validate validates the configuration. Every problem found is reported, so a
+// misconfigured deployment can be fixed in one pass instead of one error per restart.
 func (c *Config) Validate() error {
+	var errs []error
+
 	if c.Database.URL == "" {
-		return fmt.Errorf("DATABASE_URL is required")
+		errs = append(errs, fmt.Errorf("DATABASE_URL is required"))
 	}
 
 	if c.PubSub.ProjectID == "" {
-		return fmt.Errorf("GOOGLE_CLOUD_PROJECT is required")
+		errs = append(errs, fmt.Errorf("GOOGLE_CLOUD_PROJECT is required"))
+	}
+
+	switch c.Reconciliation.Delivery {
+	case DeliveryPubSub:
+	case DeliveryWebhook:
+		if c.Reconciliation.Webhook.URL == "" {
+			errs = append(errs, fmt.Errorf("RECONCILIATION_WEBHOOK_URL is required when RECONCILIATION_DELIVERY is %q", DeliveryWebhook))
+		}
+		errs = append(errs, requirePositiveDuration("RECONCILIATION_WEBHOOK_TIMEOUT", c.Reconciliation.Webhook.Timeout))
+	default:
+		errs = append(errs, fmt.Errorf("invalid RECONCILIATION_DELIVERY %q: must be %q or %q", c.Reconciliation.Delivery, DeliveryPubSub, DeliveryWebhook))
 	}
 
+	errs = append(errs, c.Auth.validate()...)
+	errs = append(errs, c.Server.validate()...)
+	errs = append(errs, c.Metrics.validate(c.Server.Port)...)
+	errs = append(errs, c.Database.validate()...)
+	errs = append(errs, c.PubSub.validate()...)
+	errs = append(errs, c.Reconciliation.validate()...)
+	errs = append(errs, c.Aggregation.validate()...)
+	errs = append(errs, c.RateLimit.validate()...)
+	errs = append(errs, c.Webhooks.validate()...)
+	errs = append(errs, requirePositiveDuration("CLUSTER_RESTORE_RETENTION", c.Cluster.RestoreRetention))
+
 	if c.Cluster.DefaultVersion == "" {
 		fmt.Println("WARNING: DEFAULT_CLUSTER_VERSION is not set; clusters without an explicit version will be rejected")
 	}
@@ -199,6 +426,270 @@ func (c *Config) Validate() error {
 		fmt.Println("WARNING: DEFAULT_CHANNEL_GROUP is not set; clusters without an explicit channelGroup will be rejected")
 	}
 
+	return errors.Join(errs...)
+}
+
+func (s ServerConfig) validate() []error {
+	var errs []error
+	if s.Port < 1 || s.Port > 65535 {
+		errs = append(errs, fmt.Errorf("PORT must be between 1 and 65535 (got %d)", s.Port))
+	}
+	errs = append(errs,
+		requirePositiveInt("SERVER_READ_TIMEOUT_SECONDS", s.ReadTimeoutSeconds),
+		requirePositiveInt("SERVER_WRITE_TIMEOUT_SECONDS", s.WriteTimeoutSeconds),
+		requirePositiveInt("SERVER_IDLE_TIMEOUT_SECONDS", s.IdleTimeoutSeconds),
+		requirePositiveInt("SERVER_MAX_HEADER_BYTES", s.MaxHeaderBytes),
+		requirePositiveInt("SERVER_SHUTDOWN_TIMEOUT_SECONDS", s.ShutdownTimeoutSeconds),
+		requirePositiveInt("SERVER_MAX_REQUEST_BODY_BYTES", s.MaxRequestBodyBytes),
+	)
+	if s.CompressionMinBytes < 0 {
+		errs = append(errs, fmt.Errorf("SERVER_COMPRESSION_MIN_BYTES must not be negative (got %d)", s.CompressionMinBytes))
+	}
+	// Browsers refuse credentialed responses that allow any origin
+	if s.CorsAllowCredentials && slices.Contains(s.CorsAllowedOrigins, "*") {
+		errs = append(errs, errors.New("CORS_ALLOW_CREDENTIALS cannot be combined with CORS_ALLOWED_ORIGINS=*"))
+	}
+	return errs
+}
+
+func (m MetricsConfig) validate(serverPort int) []error {
+	if !m.Enabled {
+		return nil
+	}
+	var errs []error
+	if m.Port < 1 || m.Port > 65535 {
+		errs = append(errs, fmt.Errorf("METRICS_PORT must be between 1 and 65535 (got %d)", m.Port))
+	} else if m.Port == serverPort {
+		errs = append(errs, fmt.Errorf("METRICS_PORT must differ from PORT (both %d)", m.Port))
+	}
+	return errs
+}
+
+func (d DatabaseConfig) validate() []error {
+	var errs []error
+	if d.MaxOpenConns < 0 {
+		errs = append(errs, fmt.Errorf("DATABASE_MAX_OPEN_CONNS must not be negative (got %d)", d.MaxOpenConns))
+	}
+	if d.MaxIdleConns < 0 {
+		errs = append(errs, fmt.Errorf("DATABASE_MAX_IDLE_CONNS must not be negative (got %d)", d.MaxIdleConns))
+	}
+	// A MaxOpenConns of 0 means unlimited
+	if d.MaxOpenConns > 0 && d.MaxIdleConns > d.MaxOpenConns {
+		errs = append(errs, fmt.Errorf(
+			"DATABASE_MAX_IDLE_CONNS (%d) must not exceed DATABASE_MAX_OPEN_CONNS (%d)",
+			d.MaxIdleConns, d.MaxOpenConns,
+		))
+	}
+	if d.TxMaxRetries < 0 {
+		errs = append(errs, fmt.Errorf("DATABASE_TX_MAX_RETRIES must not be negative (got %d)", d.TxMaxRetries))
+	}
+	if d.StatementTimeout < 0 {
+		errs = append(errs, fmt.Errorf("DATABASE_STATEMENT_TIMEOUT must not be negative (got %s)", d.StatementTimeout))
+	}
+	errs = append(errs,
+		requirePositiveDuration("DATABASE_CONN_MAX_LIFETIME", d.ConnMaxLifetime),
+		requirePositiveDuration("DATABASE_CONN_MAX_IDLE_TIME", d.ConnMaxIdleTime),
+		requirePositiveDuration("DATABASE_TX_RETRY_BASE_DELAY", d.TxRetryBaseDelay),
+	)
+	return errs
+}
+
+func (p PubSubConfig) validate() []error {
+	errs := []error{
+		requirePositiveInt("PUBSUB_PUBLISH_MAX_ATTEMPTS", p.PublishMaxAttempts),
+		requirePositiveDuration("PUBSUB_PUBLISH_INITIAL_BACKOFF", p.PublishInitialBackoff),
+		requirePositiveDuration("PUBSUB_PUBLISH_MAX_BACKOFF", p.PublishMaxBackoff),
+	}
+	if p.PublishInitialBackoff > p.PublishMaxBackoff {
+		errs = append(errs, fmt.Errorf(
+			"PUBSUB_PUBLISH_INITIAL_BACKOFF (%s) must not exceed PUBSUB_PUBLISH_MAX_BACKOFF (%s)",
+			p.PublishInitialBackoff, p.PublishMaxBackoff,
+		))
+	}
+	return errs
+}
+
+func (r ReconciliationConfig) validate() []error {
+	var errs []error
+
+	// Reactive reconciliation runs without the periodic scheduler, so its settings are
+	// checked either way
+	if r.ReactiveEnabled {
+		errs = append(errs,
+			requirePositiveDuration("REACTIVE_RECONCILIATION_DEBOUNCE", r.ReactiveDebounce),
+			requirePositiveInt("REACTIVE_RECONCILIATION_MAX_EVENTS_PER_MINUTE", r.ReactiveMaxEventsPerMinute),
+		)
+	}
+
+	if !r.Enabled {
+		return errs
+	}
+
+	errs = append(errs,
+		requirePositiveDuration("RECONCILIATION_CHECK_INTERVAL", r.CheckInterval),
+		requirePositiveInt("RECONCILIATION_MAX_CONCURRENT", r.MaxConcurrent),
+		requirePositiveDuration("RECONCILIATION_DEFAULT_INTERVAL", r.DefaultInterval),
+	)
+
+	platforms := make([]string, 0, len(r.PlatformMaxConcurrent))
+	for platform := range r.PlatformMaxConcurrent {
+		platforms = append(platforms, platform)
+	}
+	sort.Strings(platforms)
+	for _, platform := range platforms {
+		if limit := r.PlatformMaxConcurrent[platform]; limit <= 0 {
+			errs = append(errs, fmt.Errorf(
+				"RECONCILIATION_PLATFORM_MAX_CONCURRENT limit for %s must be a positive integer (got %d)",
+				platform, limit,
+			))
+		}
+	}
+
+	// Checking less often than the default interval would silently delay reconciliation
+	if r.CheckInterval > r.DefaultInterval {
+		errs = append(errs, fmt.Errorf(
+			"RECONCILIATION_CHECK_INTERVAL (%s) must not exceed RECONCILIATION_DEFAULT_INTERVAL (%s)",
+			r.CheckInterval, r.DefaultInterval,
+		))
+	}
+
+	if r.AdaptiveEnabled {
+		errs = append(errs,
+			requirePositiveDuration("RECONCILIATION_HEALTHY_INTERVAL", r.HealthyInterval),
+			requirePositiveDuration("RECONCILIATION_UNHEALTHY_INTERVAL", r.UnhealthyInterval),
+		)
+		if r.UnhealthyInterval > r.HealthyInterval {
+			errs = append(errs, fmt.Errorf(
+				"RECONCILIATION_UNHEALTHY_INTERVAL (%s) must not exceed RECONCILIATION_HEALTHY_INTERVAL (%s)",
+				r.UnhealthyInterval, r.HealthyInterval,
+			))
+		}
+	}
+
+	return errs
+}
+
+func (a AuthConfig) validate() []error {
+	if !a.Enabled {
+		return nil
+	}
+
+	switch a.Provider {
+	case AuthProviderHeader:
+	case AuthProviderJWT:
+		if (a.JWTSecret == "") == (a.JWTPublicKeyFile == "") {
+			return []error{errors.New("exactly one of AUTH_JWT_SECRET and AUTH_JWT_PUBLIC_KEY_FILE is required when AUTH_PROVIDER is \"jwt\"")}
+		}
+	default:
+		return []error{fmt.Errorf("invalid AUTH_PROVIDER %q: must be %q or %q", a.Provider, AuthProviderHeader, AuthProviderJWT)}
+	}
+	return nil
+}
+
+func (a AggregationConfig) validate() []error {
+	// Readiness weighting applies to on-read aggregation, so it is checked even when the
+	// background aggregation loop is disabled
+	var errs []error
+
+	controllers := make([]string, 0, len(a.ControllerWeights))
+	for controller := range a.ControllerWeights {
+		controllers = append(controllers, controller)
+	}
+	sort.Strings(controllers)
+	for _, controller := range controllers {
+		if weight := a.ControllerWeights[controller]; weight <= 0 {
+			errs = append(errs, fmt.Errorf(
+				"AGGREGATION_CONTROLLER_WEIGHTS weight for %s must be a positive integer (got %d)",
+				controller, weight,
+			))
+		}
+	}
+
+	if a.ReadyThreshold <= 0 || a.ReadyThreshold > 100 {
+		errs = append(errs, fmt.Errorf("AGGREGATION_READY_THRESHOLD must be between 1 and 100 (got %d)", a.ReadyThreshold))
+	}
+
+	if a.StalenessThreshold < 0 {
+		errs = append(errs, fmt.Errorf("AGGREGATION_STALENESS_THRESHOLD must not be negative (got %s)", a.StalenessThreshold))
+	}
+
+	// Status history is recorded on every status report, not by the background loop
+	errs = append(errs, requirePositiveInt("AGGREGATION_STATUS_HISTORY_LIMIT", a.StatusHistoryLimit))
+
+	// Strategy names are resolved when the repository is configured; only blanks are caught here
+	platforms := make([]string, 0, len(a.PlatformStrategies))
+	for platform := range a.PlatformStrategies {
+		platforms = append(platforms, platform)
+	}
+	sort.Strings(platforms)
+	for _, platform := range platforms {
+		if a.PlatformStrategies[platform] == "" {
+			errs = append(errs, fmt.Errorf("AGGREGATION_PLATFORM_STRATEGIES strategy for %s must not be empty", platform))
+		}
+	}
+
+	if !a.Enabled {
+		return errs
+	}
+
+	return append(errs,
+		requirePositiveDuration("AGGREGATION_INTERVAL", a.Interval),
+		requirePositiveInt("AGGREGATION_BATCH_SIZE", a.BatchSize),
+		requirePositiveInt("AGGREGATION_MAX_CONCURRENCY", a.MaxConcurrency),
+	)
+}
+
+func (r RateLimitConfig) validate() []error {
+	if !r.Enabled {
+		return nil
+	}
+
+	var errs []error
+	if r.RequestsPerSecond <= 0 {
+		errs = append(errs, fmt.Errorf("RATE_LIMIT_REQUESTS_PER_SECOND must be positive (got %g)", r.RequestsPerSecond))
+	}
+	errs = append(errs,
+		requirePositiveInt("RATE_LIMIT_BURST", r.Burst),
+		requirePositiveDuration("RATE_LIMIT_IDLE_TIMEOUT", r.IdleTimeout),
+	)
+	return errs
+}
+
+func (w WebhooksConfig) validate() []error {
+	if len(w.URLs) == 0 {
+		return nil
+	}
+
+	var errs []error
+	for _, rawURL := range w.URLs {
+		parsed, err := url.Parse(rawURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			errs = append(errs, fmt.Errorf("PHASE_WEBHOOK_URLS entry %q must be an http or https URL", rawURL))
+		}
+	}
+	if w.MaxRetries < 0 {
+		errs = append(errs, fmt.Errorf("PHASE_WEBHOOK_MAX_RETRIES must not be negative (got %d)", w.MaxRetries))
+	}
+	errs = append(errs,
+		requirePositiveDuration("PHASE_WEBHOOK_TIMEOUT", w.Timeout),
+		requirePositiveDuration("PHASE_WEBHOOK_RETRY_BACKOFF", w.RetryBackoff),
+	)
+	return errs
+}
+
+// requirePositiveDuration returns an error naming the setting when the duration is not positive
+func requirePositiveDuration(name string, value time.Duration) error {
+	if value <= 0 {
+		return fmt.Errorf("%s must be positive (got %s)", name, value)
+	}
+	return nil
+}
+
+// requirePositiveInt returns an error naming the setting when the value is not positive
+func requirePositiveInt(name string, value int) error {
+	if value <= 0 {
+		return fmt.Errorf("%s must be positive (got %d)", name, value)
+	}
 	return nil
 }
 
@@ -220,6 +711,15 @@ func getIntEnv(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getFloatEnv(key string, defaultValue float64) float64 {
+	if value, exists := os.LookupEnv(key); exists {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
+
 func getBoolEnv(key string, defaultValue bool) bool {
 	if value, exists := os.LookupEnv(key); exists {
 		if boolValue, err := strconv.ParseBool(value); err == nil {
@@ -238,6 +738,47 @@ func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 	return defaultValue
 }
 
+// getIntMapEnv parses a comma-separated list of key=value pairs, e.g. "GCP=20,AWS=10".
+// Values that are not integers are kept as 0 so validation reports them.
+func getIntMapEnv(key string) map[string]int {
+	value, exists := os.LookupEnv(key)
+	if !exists || value == "" {
+		return nil
+	}
+
+	result := make(map[string]int)
+	for _, item := range strings.Split(value, ",") {
+		name, rawValue, _ := strings.Cut(item, "=")
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		intValue, _ := strconv.Atoi(strings.TrimSpace(rawValue))
+		result[name] = intValue
+	}
+	return result
+}
+
+// getStringMapEnv parses a comma-separated list of key=value pairs, e.g. "AWS=default".
+// Values are trimmed; a missing value is kept as "" so validation reports it.
+func getStringMapEnv(key string) map[string]string {
+	value, exists := os.LookupEnv(key)
+	if !exists || value == "" {
+		return nil
+	}
+
+	result := make(map[string]string)
+	for _, item := range strings.Split(value, ",") {
+		name, rawValue, _ := strings.Cut(item, "=")
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		result[name] = strings.TrimSpace(rawValue)
+	}
+	return result
+}
+
 func getStringSliceEnv(key string, defaultValue []string) []string {
 	if value, exists := os.LookupEnv(key); exists && value != "" {
 		// Simple comma-separated parsing
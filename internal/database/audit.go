@@ -0,0 +1,133 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// AuditRepository handles audit log database operations
+type AuditRepository struct {
+	client *Client
+	logger *utils.Logger
+}
+
+// NewAuditRepository creates a new audit repository
+func NewAuditRepository(client *Client) *AuditRepository {
+	return &AuditRepository{
+		client: client,
+		logger: utils.NewLogger("audit_repository"),
+	}
+}
+
+// Record writes an audit entry. Call it on a transaction repository so the entry is
+// committed or rolled back together with the mutation it describes.
+func (r *AuditRepository) Record(ctx context.Context, entry *models.AuditEntry) error {
+	if entry.ID == uuid.Nil {
+		entry.ID = uuid.New()
+	}
+	entry.CreatedAt = time.Now()
+
+	diff := entry.Diff
+	if diff == nil {
+		diff = map[string]models.AuditChange{}
+	}
+	diffJSON, err := json.Marshal(diff)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit diff: %w", err)
+	}
+
+	query := `
+		INSERT INTO audit_log (id, actor_email, action, resource_type, resource_id, diff, reason, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err = r.client.ExecContext(ctx, query,
+		entry.ID,
+		entry.ActorEmail,
+		entry.Action,
+		entry.ResourceType,
+		entry.ResourceID,
+		diffJSON,
+		entry.Reason,
+		entry.CreatedAt,
+	)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to record audit entry",
+			zap.String("action", entry.Action),
+			zap.String("resource_type", entry.ResourceType),
+			zap.String("resource_id", entry.ResourceID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to record audit entry: %w", err)
+	}
+
+	return nil
+}
+
+// RecordChange records a write operation with the diff between the before and after specs.
+// before is nil for creates and after is nil for deletes.
+func (r *AuditRepository) RecordChange(ctx context.Context, actorEmail, action, resourceType string, resourceID uuid.UUID, before, after interface{}) error {
+	entry, err := models.NewAuditEntry(actorEmail, action, resourceType, resourceID, before, after)
+	if err != nil {
+		return fmt.Errorf("failed to build audit entry: %w", err)
+	}
+	return r.Record(ctx, entry)
+}
+
+// ListByResource lists the audit entries for a resource, oldest first
+func (r *AuditRepository) ListByResource(ctx context.Context, resourceID uuid.UUID, limit int) ([]*models.AuditEntry, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+
+	query := `
+		SELECT id, actor_email, action, resource_type, resource_id, diff, reason, created_at
+		FROM audit_log
+		WHERE resource_id = $1
+		ORDER BY created_at ASC, id ASC
+		LIMIT $2`
+
+	rows, err := r.client.QueryContext(ctx, query, resourceID, limit)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to list audit entries",
+			zap.String("resource_id", resourceID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to list audit entries: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []*models.AuditEntry{}
+	for rows.Next() {
+		entry := &models.AuditEntry{}
+		var diffJSON []byte
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.ActorEmail,
+			&entry.Action,
+			&entry.ResourceType,
+			&entry.ResourceID,
+			&diffJSON,
+			&entry.Reason,
+			&entry.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan audit entry: %w", err)
+		}
+		if err := json.Unmarshal(diffJSON, &entry.Diff); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal audit diff: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating audit entries: %w", err)
+	}
+
+	return entries, nil
+}
@@ -3,13 +3,16 @@ package database
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"fmt"
+	"math/rand"
 	"time"
 
 	"github.com/apahim/cls-backend/internal/config"
 	"github.com/apahim/cls-backend/internal/utils"
 
-	_ "github.com/lib/pq" // PostgreSQL driver
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 )
 
@@ -19,16 +22,25 @@ type Client struct {
 	logger *utils.Logger
 	config config.DatabaseConfig
 	tx     *sql.Tx // Optional transaction for transaction-aware operations
+
+	readiness        ReadinessConfig           // Controller weighting used by status aggregation
+	phaseNotifier    PhaseTransitionNotifier   // Optional receiver of cluster phase changes
+	statusStrategies map[string]StatusStrategy // Cluster status strategies by upper-case platform type
 }
 
 // NewClient creates a new database client
 func NewClient(cfg config.DatabaseConfig) (*Client, error) {
 	logger := utils.NewLogger("database")
 
-	db, err := sql.Open("postgres", cfg.URL)
+	connector, err := pq.NewConnector(cfg.URL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database connection: %w", err)
 	}
+	var dbConnector driver.Connector = connector
+	if cfg.StatementTimeout > 0 {
+		dbConnector = &statementTimeoutConnector{Connector: connector, timeout: cfg.StatementTimeout}
+	}
+	db := sql.OpenDB(dbConnector)
 
 	// Configure connection pool
 	db.SetMaxOpenConns(cfg.MaxOpenConns)
@@ -51,11 +63,40 @@ func NewClient(cfg config.DatabaseConfig) (*Client, error) {
 		zap.Int("max_open_conns", cfg.MaxOpenConns),
 		zap.Int("max_idle_conns", cfg.MaxIdleConns),
 		zap.Duration("conn_max_lifetime", cfg.ConnMaxLifetime),
+		zap.Duration("statement_timeout", cfg.StatementTimeout),
 	)
 
 	return client, nil
 }
 
+// statementTimeoutConnector sets statement_timeout on every new connection, so the server
+// cancels any statement that runs too long, inside or outside a transaction, rather than
+// letting it hold a pooled connection indefinitely.
+type statementTimeoutConnector struct {
+	driver.Connector
+	timeout time.Duration
+}
+
+// Connect opens a connection and applies the statement timeout to its session
+func (c *statementTimeoutConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	execer, ok := conn.(driver.ExecerContext)
+	if !ok {
+		conn.Close()
+		return nil, fmt.Errorf("database driver does not support setting a statement timeout")
+	}
+	query := fmt.Sprintf("SET statement_timeout = %d", c.timeout.Milliseconds())
+	if _, err := execer.ExecContext(ctx, query, nil); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to set statement timeout: %w", err)
+	}
+	return conn, nil
+}
+
 // Close closes the database connection
 func (c *Client) Close() error {
 	c.logger.Info("Closing database connection")
@@ -75,8 +116,83 @@ func (c *Client) DB() *sql.DB {
 	return c.db
 }
 
-// Transaction executes a function within a database transaction
+// retryableTxCodes are the Postgres SQLSTATEs after which the whole transaction can be
+// re-run: serialization_failure and deadlock_detected
+var retryableTxCodes = map[pq.ErrorCode]bool{
+	"40001": true,
+	"40P01": true,
+}
+
+// isRetryableTxError reports whether err was caused by a serialization failure or deadlock
+func isRetryableTxError(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && retryableTxCodes[pqErr.Code]
+}
+
+// Transaction executes a function within a database transaction. Transactions aborted by a
+// serialization failure or deadlock are re-run up to DatabaseConfig.TxMaxRetries times with
+// jittered exponential backoff, so fn must be safe to run more than once: side effects such as
+// publishing events belong after Transaction returns.
 func (c *Client) Transaction(ctx context.Context, fn func(*sql.Tx) error) error {
+	// Already inside a transaction - join it instead of starting a new one. The outermost
+	// transaction owns retries, since a failed statement aborts the whole transaction.
+	if c.tx != nil {
+		return fn(c.tx)
+	}
+
+	return retryTransaction(ctx, c.config.TxMaxRetries, c.config.TxRetryBaseDelay, c.logger, func() error {
+		return c.runTransaction(ctx, fn)
+	})
+}
+
+// withTx returns a client that runs its queries in tx
+func (c *Client) withTx(tx *sql.Tx) *Client {
+	return &Client{
+		db:     nil, // tx will be used instead
+		logger: c.logger,
+		config: c.config,
+		tx:     tx,
+
+		readiness:        c.readiness,
+		phaseNotifier:    c.phaseNotifier,
+		statusStrategies: c.statusStrategies,
+	}
+}
+
+// retryTransaction calls run until it succeeds, fails with a non-retryable error, or
+// maxRetries retries have been made
+func retryTransaction(ctx context.Context, maxRetries int, baseDelay time.Duration, logger *utils.Logger, run func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := run()
+		if err == nil || attempt >= maxRetries || !isRetryableTxError(err) {
+			return err
+		}
+
+		delay := txRetryDelay(baseDelay, attempt)
+		logger.Warn("Retrying transaction after serialization failure",
+			zap.Int("attempt", attempt+1),
+			zap.Int("max_retries", maxRetries),
+			zap.Duration("delay", delay),
+			zap.Error(err),
+		)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+	}
+}
+
+// txRetryDelay returns a random delay between half and all of baseDelay doubled per attempt,
+// so concurrent transactions that conflicted don't retry in lockstep
+func txRetryDelay(baseDelay time.Duration, attempt int) time.Duration {
+	backoff := baseDelay << attempt
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// runTransaction executes fn in a single database transaction attempt
+func (c *Client) runTransaction(ctx context.Context, fn func(*sql.Tx) error) error {
 	tx, err := c.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
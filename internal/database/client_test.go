@@ -3,11 +3,15 @@ package database
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/apahim/cls-backend/internal/config"
+	"github.com/apahim/cls-backend/internal/models"
 	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/lib/pq"
 )
 
 func TestNewClient(t *testing.T) {
@@ -107,6 +111,142 @@ func TestClient_Transaction(t *testing.T) {
 	utils.AssertEqual(t, 2, count, "Should still have 2 rows after failed transaction")
 }
 
+func TestClient_TransactionRetriesSerializationFailure(t *testing.T) {
+	utils.SkipIfNoTestDB(t)
+
+	testDBURL := utils.SetupTestDB(t)
+	cfg := config.DatabaseConfig{
+		URL:              testDBURL,
+		MaxOpenConns:     10,
+		MaxIdleConns:     5,
+		ConnMaxLifetime:  5 * time.Minute,
+		ConnMaxIdleTime:  1 * time.Minute,
+		TxMaxRetries:     3,
+		TxRetryBaseDelay: time.Millisecond,
+	}
+
+	client, err := NewClient(cfg)
+	utils.AssertError(t, err, false, "Should create client")
+	defer client.Close()
+
+	ctx := context.Background()
+	_, err = client.ExecContext(ctx, "CREATE TABLE retry_table (id SERIAL PRIMARY KEY, name TEXT)")
+	utils.AssertError(t, err, false, "Should create test table")
+
+	// The first attempt writes a row and then hits a serialization failure
+	attempts := 0
+	err = client.Transaction(ctx, func(tx *sql.Tx) error {
+		attempts++
+		if _, err := tx.Exec("INSERT INTO retry_table (name) VALUES ($1)", "row"); err != nil {
+			return err
+		}
+		if attempts == 1 {
+			return &pq.Error{Code: "40001", Message: "could not serialize access due to concurrent update"}
+		}
+		return nil
+	})
+	utils.AssertError(t, err, false, "Transaction should commit after a retry")
+	utils.AssertEqual(t, 2, attempts, "Transaction should be run twice")
+
+	// Only the committed attempt's row remains
+	var count int
+	err = client.QueryRowContext(ctx, "SELECT COUNT(*) FROM retry_table").Scan(&count)
+	utils.AssertError(t, err, false, "Should count rows")
+	utils.AssertEqual(t, 1, count, "Failed attempt should have been rolled back")
+}
+
+func TestClient_StatementTimeout(t *testing.T) {
+	utils.SkipIfNoTestDB(t)
+
+	cfg := config.DatabaseConfig{
+		URL:              utils.GetTestDBURL(),
+		MaxOpenConns:     10,
+		MaxIdleConns:     5,
+		ConnMaxLifetime:  5 * time.Minute,
+		ConnMaxIdleTime:  1 * time.Minute,
+		TxRetryBaseDelay: time.Millisecond,
+		StatementTimeout: 100 * time.Millisecond,
+	}
+
+	client, err := NewClient(cfg)
+	utils.AssertError(t, err, false, "Should create client")
+	defer client.Close()
+
+	ctx := context.Background()
+
+	// A deliberately slow query is cancelled by the server instead of hanging
+	start := time.Now()
+	_, err = client.ExecContext(ctx, "SELECT pg_sleep(5)")
+	utils.AssertError(t, err, true, "Slow query should time out")
+	var pqErr *pq.Error
+	utils.AssertTrue(t, errors.As(err, &pqErr) && pqErr.Code == "57014", "Error should be a query cancellation", err)
+	utils.AssertTrue(t, time.Since(start) < 2*time.Second, "Slow query should not run to completion")
+
+	// Statements inside a transaction are bound by the same timeout
+	start = time.Now()
+	err = client.Transaction(ctx, func(tx *sql.Tx) error {
+		_, err := tx.Exec("SELECT pg_sleep(5)")
+		return err
+	})
+	utils.AssertError(t, err, true, "Slow query in a transaction should time out")
+	utils.AssertTrue(t, time.Since(start) < 2*time.Second, "Slow transaction should not run to completion")
+
+	// The connection stays usable for fast queries
+	var one int
+	err = client.QueryRowContext(ctx, "SELECT 1").Scan(&one)
+	utils.AssertError(t, err, false, "Fast query should succeed")
+	utils.AssertEqual(t, 1, one, "Fast query should return its result")
+}
+
+func TestRetryTransaction(t *testing.T) {
+	logger := utils.NewLogger("test")
+	ctx := context.Background()
+	serializationErr := fmt.Errorf("failed to commit transaction: %w", &pq.Error{Code: "40001"})
+	deadlockErr := &pq.Error{Code: "40P01"}
+
+	tests := []struct {
+		name         string
+		errs         []error // returned by successive attempts, nil once exhausted
+		maxRetries   int
+		wantErr      bool
+		wantAttempts int
+	}{
+		{name: "success on first attempt", maxRetries: 3, wantAttempts: 1},
+		{name: "serialization failure retried", errs: []error{serializationErr}, maxRetries: 3, wantAttempts: 2},
+		{name: "deadlock retried", errs: []error{deadlockErr, deadlockErr}, maxRetries: 3, wantAttempts: 3},
+		{name: "retries exhausted", errs: []error{deadlockErr, deadlockErr, deadlockErr}, maxRetries: 2, wantErr: true, wantAttempts: 3},
+		{name: "retries disabled", errs: []error{serializationErr}, maxRetries: 0, wantErr: true, wantAttempts: 1},
+		{name: "other errors not retried", errs: []error{&pq.Error{Code: "23505"}}, maxRetries: 3, wantErr: true, wantAttempts: 1},
+		{name: "plain errors not retried", errs: []error{models.ErrClusterNotFound}, maxRetries: 3, wantErr: true, wantAttempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			err := retryTransaction(ctx, tt.maxRetries, time.Millisecond, logger, func() error {
+				attempts++
+				if attempts <= len(tt.errs) {
+					return tt.errs[attempts-1]
+				}
+				return nil
+			})
+			utils.AssertError(t, err, tt.wantErr, "Unexpected retry result")
+			utils.AssertEqual(t, tt.wantAttempts, attempts, "Unexpected number of attempts")
+		})
+	}
+}
+
+func TestTxRetryDelay(t *testing.T) {
+	base := 10 * time.Millisecond
+	for attempt := 0; attempt < 4; attempt++ {
+		backoff := base << attempt
+		for i := 0; i < 20; i++ {
+			delay := txRetryDelay(base, attempt)
+			utils.AssertTrue(t, delay >= backoff/2 && delay <= backoff, "Delay should be jittered within the backoff", attempt, delay)
+		}
+	}
+}
+
 func TestClient_QueryOperations(t *testing.T) {
 	utils.SkipIfNoTestDB(t)
 
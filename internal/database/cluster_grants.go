@@ -0,0 +1,119 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ClusterGrantsRepository handles the read access cluster owners share with other users
+type ClusterGrantsRepository struct {
+	client *Client
+	logger *utils.Logger
+}
+
+// NewClusterGrantsRepository creates a new cluster grants repository
+func NewClusterGrantsRepository(client *Client) *ClusterGrantsRepository {
+	return &ClusterGrantsRepository{
+		client: client,
+		logger: utils.NewLogger("cluster_grants_repo"),
+	}
+}
+
+// Upsert grants a user access to a cluster. Granting again replaces the role and keeps the
+// original grant time.
+func (r *ClusterGrantsRepository) Upsert(ctx context.Context, grant *models.ClusterGrant) error {
+	query := `
+		INSERT INTO cluster_grants (cluster_id, grantee_email, role, created_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (cluster_id, grantee_email)
+		DO UPDATE SET role = EXCLUDED.role, created_by = EXCLUDED.created_by
+		RETURNING created_at`
+
+	err := r.client.QueryRowContext(ctx, query,
+		grant.ClusterID, grant.GranteeEmail, grant.Role, grant.CreatedBy,
+	).Scan(&grant.CreatedAt)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to save cluster grant",
+			zap.String("cluster_id", grant.ClusterID.String()),
+			zap.String("grantee_email", grant.GranteeEmail),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to save cluster grant: %w", err)
+	}
+
+	return nil
+}
+
+// Delete revokes a user's grant on a cluster
+func (r *ClusterGrantsRepository) Delete(ctx context.Context, clusterID uuid.UUID, granteeEmail string) error {
+	result, err := r.client.ExecContext(ctx,
+		`DELETE FROM cluster_grants WHERE cluster_id = $1 AND grantee_email = $2`,
+		clusterID, granteeEmail,
+	)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to delete cluster grant",
+			zap.String("cluster_id", clusterID.String()),
+			zap.String("grantee_email", granteeEmail),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to delete cluster grant: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrClusterGrantNotFound
+	}
+
+	return nil
+}
+
+// ListByCluster returns the grants on a cluster, ordered by grantee
+func (r *ClusterGrantsRepository) ListByCluster(ctx context.Context, clusterID uuid.UUID) ([]*models.ClusterGrant, error) {
+	query := `
+		SELECT cluster_id, grantee_email, role, created_by, created_at
+		FROM cluster_grants
+		WHERE cluster_id = $1
+		ORDER BY grantee_email`
+
+	rows, err := r.client.QueryContext(ctx, query, clusterID)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to list cluster grants",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to list cluster grants: %w", err)
+	}
+	defer rows.Close()
+
+	grants := []*models.ClusterGrant{}
+	for rows.Next() {
+		var grant models.ClusterGrant
+		if err := rows.Scan(
+			&grant.ClusterID,
+			&grant.GranteeEmail,
+			&grant.Role,
+			&grant.CreatedBy,
+			&grant.CreatedAt,
+		); err != nil {
+			r.logger.WithContext(ctx).Error("Failed to scan cluster grant row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan cluster grant: %w", err)
+		}
+		grants = append(grants, &grant)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.WithContext(ctx).Error("Error iterating cluster grant rows", zap.Error(err))
+		return nil, fmt.Errorf("error iterating cluster grants: %w", err)
+	}
+
+	return grants, nil
+}
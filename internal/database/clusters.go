@@ -4,12 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/apahim/cls-backend/internal/models"
 	"github.com/apahim/cls-backend/internal/utils"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 )
 
@@ -25,32 +25,131 @@ func NewClustersRepository(client *Client) *ClustersRepository {
 	return &ClustersRepository{
 		client:           client,
 		logger:           utils.NewLogger("clusters_repo"),
-		statusAggregator: NewStatusAggregator(client),
+		statusAggregator: NewStatusAggregator(client, nil),
 	}
 }
 
-// isPrivilegedSystemUser checks if the user is a privileged system user that can access all clusters
-func (r *ClustersRepository) isPrivilegedSystemUser(userEmail string) bool {
-	// System users (controllers, operators, etc.) can access all clusters
-	if strings.HasSuffix(userEmail, "@system.local") {
-		return true
+// appendClusterPagination adds ordering and pagination to a cluster list query.
+// Rows are ordered by (created_at, id) descending so pages are stable. When a cursor is
+// set, keyset pagination is used and any offset is ignored; otherwise offset pagination applies.
+func appendClusterPagination(query string, args []interface{}, opts *models.ListOptions) (string, []interface{}) {
+	argIndex := len(args) + 1
+
+	if opts != nil && opts.Cursor != nil {
+		query += fmt.Sprintf(" AND (created_at, id) < ($%d, $%d)", argIndex, argIndex+1)
+		args = append(args, opts.Cursor.CreatedAt, opts.Cursor.ID)
+		argIndex += 2
 	}
 
-	// Known privileged system users
-	privilegedUsers := []string{
-		"controller@system.local",
-		"operator@system.local",
-		"scheduler@system.local",
-		"system@system.local",
+	query += " ORDER BY created_at DESC, id DESC"
+
+	if opts != nil {
+		if opts.Limit > 0 {
+			query += fmt.Sprintf(" LIMIT $%d", argIndex)
+			args = append(args, opts.Limit)
+			argIndex++
+		}
+		if opts.Offset > 0 && opts.Cursor == nil {
+			query += fmt.Sprintf(" OFFSET $%d", argIndex)
+			args = append(args, opts.Offset)
+		}
 	}
 
-	for _, privilegedUser := range privilegedUsers {
-		if userEmail == privilegedUser {
-			return true
+	return query, args
+}
+
+// clusterReadableBy is the condition matching clusters the user bound to the given
+// parameter created or holds a grant for
+func clusterReadableBy(param int) string {
+	return fmt.Sprintf(`(created_by = $%[1]d OR EXISTS (
+			SELECT 1 FROM cluster_grants g WHERE g.cluster_id = clusters.id AND g.grantee_email = $%[1]d))`, param)
+}
+
+// appendClusterFilters constrains a cluster query to the status phases, creation window and
+// target project in opts. Clusters whose status has never been calculated have no cached phase and count as Pending.
+// The phases are bound as a single array parameter, so any number of them stays parameterized.
+func appendClusterFilters(query string, args []interface{}, opts *models.ListOptions) (string, []interface{}) {
+	if opts == nil {
+		return query, args
+	}
+
+	if len(opts.Status) > 0 {
+		args = append(args, pq.Array(opts.Status))
+		query += fmt.Sprintf(" AND COALESCE(status->>'phase', 'Pending') = ANY($%d)", len(args))
+	}
+	if opts.CreatedAfter != nil {
+		args = append(args, *opts.CreatedAfter)
+		query += fmt.Sprintf(" AND created_at >= $%d", len(args))
+	}
+	if opts.CreatedBefore != nil {
+		args = append(args, *opts.CreatedBefore)
+		query += fmt.Sprintf(" AND created_at < $%d", len(args))
+	}
+	if opts.TargetProjectID != "" {
+		args = append(args, opts.TargetProjectID)
+		query += fmt.Sprintf(" AND target_project_id = $%d", len(args))
+	}
+
+	return query, args
+}
+
+// refreshDirtyStatuses recalculates and caches the status of every cluster matching the given
+// condition whose cached status is dirty, expired or from another aggregator version, so that
+// filtering on the cached phase sees the values enrichment would return
+func (r *ClustersRepository) refreshDirtyStatuses(ctx context.Context, condition string, args ...interface{}) error {
+	staleCondition, args := r.statusAggregator.staleClusterStatusCondition(args)
+	query := `
+		SELECT id, name, target_project_id, created_by,
+			   generation, resource_version, spec, status,
+			   status_dirty, created_at, updated_at, deleted_at
+		FROM clusters
+		WHERE ` + staleCondition + ` AND deleted_at IS NULL` + condition
+
+	rows, err := r.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to get dirty clusters for refresh", zap.Error(err))
+		return fmt.Errorf("failed to get dirty clusters: %w", err)
+	}
+	defer rows.Close()
+
+	var clusters []*models.Cluster
+	for rows.Next() {
+		var cluster models.Cluster
+		err := rows.Scan(
+			&cluster.ID,
+			&cluster.Name,
+			&cluster.TargetProjectID,
+			&cluster.CreatedBy,
+			&cluster.Generation,
+			&cluster.ResourceVersion,
+			&cluster.Spec,
+			&cluster.Status,
+			&cluster.StatusDirty,
+			&cluster.CreatedAt,
+			&cluster.UpdatedAt,
+			&cluster.DeletedAt,
+		)
+		if err != nil {
+			r.logger.WithContext(ctx).Error("Failed to scan dirty cluster row", zap.Error(err))
+			return fmt.Errorf("failed to scan dirty cluster: %w", err)
 		}
+		clusters = append(clusters, &cluster)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.WithContext(ctx).Error("Error iterating dirty cluster rows", zap.Error(err))
+		return fmt.Errorf("error iterating dirty clusters: %w", err)
 	}
 
-	return false
+	if err := r.statusAggregator.EnrichClustersWithStatus(ctx, clusters); err != nil {
+		r.logger.WithContext(ctx).Warn("Failed to refresh some dirty cluster statuses",
+			zap.Int("cluster_count", len(clusters)),
+			zap.Error(err),
+		)
+		// Continue - clusters that failed keep their previously cached phase
+	}
+
+	return nil
 }
 
 // Create creates a new cluster
@@ -59,8 +158,11 @@ func (r *ClustersRepository) Create(ctx context.Context, cluster *models.Cluster
 		cluster.ID = uuid.New()
 	}
 
-	cluster.CreatedAt = time.Now()
-	cluster.UpdatedAt = time.Now()
+	// Postgres keeps microseconds; truncating here makes the returned timestamps equal to the
+	// stored ones, so a pagination cursor built from this cluster excludes it
+	now := time.Now().Truncate(time.Microsecond)
+	cluster.CreatedAt = now
+	cluster.UpdatedAt = now
 
 	query := `
 		INSERT INTO clusters (
@@ -85,14 +187,14 @@ func (r *ClustersRepository) Create(ctx context.Context, cluster *models.Cluster
 	)
 
 	if err != nil {
-		r.logger.Error("Failed to create cluster",
+		r.logger.WithContext(ctx).Error("Failed to create cluster",
 			zap.String("cluster_name", cluster.Name),
 			zap.Error(err),
 		)
 		return fmt.Errorf("failed to create cluster: %w", err)
 	}
 
-	r.logger.Info("Cluster created successfully",
+	r.logger.WithContext(ctx).Info("Cluster created successfully",
 		zap.String("cluster_id", cluster.ID.String()),
 		zap.String("cluster_name", cluster.Name),
 	)
@@ -100,33 +202,20 @@ func (r *ClustersRepository) Create(ctx context.Context, cluster *models.Cluster
 	return nil
 }
 
-// GetByID retrieves a cluster by ID with client isolation (skipped for privileged system users)
-func (r *ClustersRepository) GetByID(ctx context.Context, id uuid.UUID, createdBy string) (*models.Cluster, error) {
-	var query string
-	var args []interface{}
-
-	if r.isPrivilegedSystemUser(createdBy) {
-		// Privileged system users can access any cluster
-		query = `
-			SELECT id, name, target_project_id, created_by,
-				   generation, resource_version, spec, status,
-				   status_dirty, created_at, updated_at, deleted_at
-			FROM clusters
-			WHERE id = $1 AND deleted_at IS NULL`
-		args = []interface{}{id}
-	} else {
-		// Regular users can only access their own clusters
-		query = `
-			SELECT id, name, target_project_id, created_by,
-				   generation, resource_version, spec, status,
-				   status_dirty, created_at, updated_at, deleted_at
-			FROM clusters
-			WHERE id = $1 AND created_by = $2 AND deleted_at IS NULL`
-		args = []interface{}{id, createdBy}
-	}
+// GetByID retrieves a cluster by ID with client isolation: the cluster must have been created
+// by, or shared with, createdBy. includeAllTenants, which callers derive from the caller's
+// auth.UserContext.IsController, lifts the filter. Callers that modify the cluster must
+// still check ownership, since grantees can read it too.
+func (r *ClustersRepository) GetByID(ctx context.Context, id uuid.UUID, createdBy string, includeAllTenants bool) (*models.Cluster, error) {
+	query := `
+		SELECT id, name, target_project_id, created_by,
+			   generation, resource_version, spec, status,
+			   status_dirty, created_at, updated_at, deleted_at
+		FROM clusters
+		WHERE id = $1 AND ($3 OR ` + clusterReadableBy(2) + `) AND deleted_at IS NULL`
 
 	var cluster models.Cluster
-	err := r.client.QueryRowContext(ctx, query, args...).Scan(
+	err := r.client.QueryRowContext(ctx, query, id, createdBy, includeAllTenants).Scan(
 		&cluster.ID,
 		&cluster.Name,
 		&cluster.TargetProjectID,
@@ -145,7 +234,7 @@ func (r *ClustersRepository) GetByID(ctx context.Context, id uuid.UUID, createdB
 		return nil, models.ErrClusterNotFound
 	}
 	if err != nil {
-		r.logger.Error("Failed to get cluster by ID",
+		r.logger.WithContext(ctx).Error("Failed to get cluster by ID",
 			zap.String("cluster_id", id.String()),
 			zap.Error(err),
 		)
@@ -154,7 +243,7 @@ func (r *ClustersRepository) GetByID(ctx context.Context, id uuid.UUID, createdB
 
 	// Enrich with real-time status
 	if err := r.statusAggregator.EnrichClusterWithStatus(ctx, &cluster); err != nil {
-		r.logger.Warn("Failed to enrich cluster with real-time status",
+		r.logger.WithContext(ctx).Warn("Failed to enrich cluster with real-time status",
 			zap.String("cluster_id", id.String()),
 			zap.Error(err),
 		)
@@ -164,33 +253,38 @@ func (r *ClustersRepository) GetByID(ctx context.Context, id uuid.UUID, createdB
 	return &cluster, nil
 }
 
-// GetByName retrieves a cluster by name with client isolation (skipped for privileged system users)
-func (r *ClustersRepository) GetByName(ctx context.Context, name string, createdBy string) (*models.Cluster, error) {
-	var query string
-	var args []interface{}
-
-	if r.isPrivilegedSystemUser(createdBy) {
-		// Privileged system users can access any cluster
-		query = `
-			SELECT id, name, target_project_id, created_by,
-				   generation, resource_version, spec, status,
-				   status_dirty, created_at, updated_at, deleted_at
-			FROM clusters
-			WHERE name = $1 AND deleted_at IS NULL`
-		args = []interface{}{name}
-	} else {
-		// Regular users can only access their own clusters
-		query = `
-			SELECT id, name, target_project_id, created_by,
-				   generation, resource_version, spec, status,
-				   status_dirty, created_at, updated_at, deleted_at
-			FROM clusters
-			WHERE name = $1 AND created_by = $2 AND deleted_at IS NULL`
-		args = []interface{}{name, createdBy}
+// NameExists reports whether the user already has a cluster with the given name. Soft-deleted
+// clusters count, since they still hold the name in the unique constraint.
+func (r *ClustersRepository) NameExists(ctx context.Context, name string, createdBy string) (bool, error) {
+	var exists bool
+	err := r.client.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM clusters WHERE name = $1 AND created_by = $2)`,
+		name, createdBy,
+	).Scan(&exists)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to check cluster name",
+			zap.String("cluster_name", name),
+			zap.Error(err),
+		)
+		return false, fmt.Errorf("failed to check cluster name: %w", err)
 	}
+	return exists, nil
+}
+
+// GetByName retrieves a cluster by name with client isolation. Names are only unique per user,
+// so with includeAllTenants the most recently created matching cluster is returned.
+func (r *ClustersRepository) GetByName(ctx context.Context, name string, createdBy string, includeAllTenants bool) (*models.Cluster, error) {
+	query := `
+		SELECT id, name, target_project_id, created_by,
+			   generation, resource_version, spec, status,
+			   status_dirty, created_at, updated_at, deleted_at
+		FROM clusters
+		WHERE name = $1 AND ($3 OR created_by = $2) AND deleted_at IS NULL
+		ORDER BY created_by = $2 DESC, created_at DESC
+		LIMIT 1`
 
 	var cluster models.Cluster
-	err := r.client.QueryRowContext(ctx, query, args...).Scan(
+	err := r.client.QueryRowContext(ctx, query, name, createdBy, includeAllTenants).Scan(
 		&cluster.ID,
 		&cluster.Name,
 		&cluster.TargetProjectID,
@@ -209,7 +303,7 @@ func (r *ClustersRepository) GetByName(ctx context.Context, name string, created
 		return nil, models.ErrClusterNotFound
 	}
 	if err != nil {
-		r.logger.Error("Failed to get cluster by name",
+		r.logger.WithContext(ctx).Error("Failed to get cluster by name",
 			zap.String("cluster_name", name),
 			zap.Error(err),
 		)
@@ -218,7 +312,7 @@ func (r *ClustersRepository) GetByName(ctx context.Context, name string, created
 
 	// Enrich with real-time status
 	if err := r.statusAggregator.EnrichClusterWithStatus(ctx, &cluster); err != nil {
-		r.logger.Warn("Failed to enrich cluster with real-time status",
+		r.logger.WithContext(ctx).Warn("Failed to enrich cluster with real-time status",
 			zap.String("cluster_name", name),
 			zap.Error(err),
 		)
@@ -228,42 +322,31 @@ func (r *ClustersRepository) GetByName(ctx context.Context, name string, created
 	return &cluster, nil
 }
 
-// List retrieves clusters for a specific user with client isolation
+// List retrieves the clusters a user created or holds a grant for, with client isolation
 func (r *ClustersRepository) List(ctx context.Context, createdBy string, opts *models.ListOptions) ([]*models.Cluster, error) {
 	baseQuery := `
 		SELECT id, name, target_project_id, created_by,
 			   generation, resource_version, spec, status,
 			   status_dirty, created_at, updated_at, deleted_at
 		FROM clusters
-		WHERE created_by = $1 AND deleted_at IS NULL`
+		WHERE ` + clusterReadableBy(1) + ` AND deleted_at IS NULL`
 
 	var args []interface{}
 	args = append(args, createdBy)
-	argIndex := 2
-
-	// Build the complete query - base query already has the created_by filter
-	query := baseQuery
 
-	// Add ordering
-	query += " ORDER BY created_at DESC"
-
-	// Add pagination
-	if opts != nil {
-		if opts.Limit > 0 {
-			query += fmt.Sprintf(" LIMIT $%d", argIndex)
-			args = append(args, opts.Limit)
-			argIndex++
-		}
-		if opts.Offset > 0 {
-			query += fmt.Sprintf(" OFFSET $%d", argIndex)
-			args = append(args, opts.Offset)
-			argIndex++
+	if opts != nil && len(opts.Status) > 0 {
+		if err := r.refreshDirtyStatuses(ctx, " AND "+clusterReadableBy(1), createdBy); err != nil {
+			return nil, err
 		}
 	}
 
+	// Build the complete query - base query already has the user filter
+	query, args := appendClusterFilters(baseQuery, args, opts)
+	query, args = appendClusterPagination(query, args, opts)
+
 	rows, err := r.client.QueryContext(ctx, query, args...)
 	if err != nil {
-		r.logger.Error("Failed to list clusters", zap.Error(err))
+		r.logger.WithContext(ctx).Error("Failed to list clusters", zap.Error(err))
 		return nil, fmt.Errorf("failed to list clusters: %w", err)
 	}
 	defer rows.Close()
@@ -286,20 +369,20 @@ func (r *ClustersRepository) List(ctx context.Context, createdBy string, opts *m
 			&cluster.DeletedAt,
 		)
 		if err != nil {
-			r.logger.Error("Failed to scan cluster row", zap.Error(err))
+			r.logger.WithContext(ctx).Error("Failed to scan cluster row", zap.Error(err))
 			return nil, fmt.Errorf("failed to scan cluster: %w", err)
 		}
 		clusters = append(clusters, &cluster)
 	}
 
 	if err = rows.Err(); err != nil {
-		r.logger.Error("Error iterating cluster rows", zap.Error(err))
+		r.logger.WithContext(ctx).Error("Error iterating cluster rows", zap.Error(err))
 		return nil, fmt.Errorf("error iterating clusters: %w", err)
 	}
 
 	// Enrich all clusters with real-time status
 	if err := r.statusAggregator.EnrichClustersWithStatus(ctx, clusters); err != nil {
-		r.logger.Warn("Failed to enrich some clusters with real-time status",
+		r.logger.WithContext(ctx).Warn("Failed to enrich some clusters with real-time status",
 			zap.Int("cluster_count", len(clusters)),
 			zap.Error(err),
 		)
@@ -330,7 +413,7 @@ func (r *ClustersRepository) Update(ctx context.Context, cluster *models.Cluster
 	)
 
 	if err != nil {
-		r.logger.Error("Failed to update cluster",
+		r.logger.WithContext(ctx).Error("Failed to update cluster",
 			zap.String("cluster_id", cluster.ID.String()),
 			zap.Error(err),
 		)
@@ -346,7 +429,7 @@ func (r *ClustersRepository) Update(ctx context.Context, cluster *models.Cluster
 		return models.ErrClusterNotFound
 	}
 
-	r.logger.Info("Cluster updated successfully",
+	r.logger.WithContext(ctx).Info("Cluster updated successfully",
 		zap.String("cluster_id", cluster.ID.String()),
 		zap.String("cluster_name", cluster.Name),
 	)
@@ -363,7 +446,7 @@ func (r *ClustersRepository) Delete(ctx context.Context, id uuid.UUID, createdBy
 
 	result, err := r.client.ExecContext(ctx, query, id, createdBy)
 	if err != nil {
-		r.logger.Error("Failed to delete cluster",
+		r.logger.WithContext(ctx).Error("Failed to delete cluster",
 			zap.String("cluster_id", id.String()),
 			zap.Error(err),
 		)
@@ -379,21 +462,75 @@ func (r *ClustersRepository) Delete(ctx context.Context, id uuid.UUID, createdBy
 		return models.ErrClusterNotFound
 	}
 
-	r.logger.Info("Cluster deleted successfully",
+	r.logger.WithContext(ctx).Info("Cluster deleted successfully",
+		zap.String("cluster_id", id.String()),
+	)
+
+	return nil
+}
+
+// Restore clears deleted_at on a cluster the user soft-deleted less than retention ago and
+// marks its status dirty so it is recalculated. Nodepools soft-deleted along with the cluster
+// (same deleted_at) are restored too. Live clusters, clusters deleted longer ago and clusters
+// owned by other users return ErrClusterNotFound.
+func (r *ClustersRepository) Restore(ctx context.Context, id uuid.UUID, createdBy string, retention time.Duration) error {
+	query := `
+		WITH target AS (
+			SELECT id, deleted_at FROM clusters
+			WHERE id = $1 AND created_by = $2 AND deleted_at IS NOT NULL AND deleted_at > $3
+			FOR UPDATE
+		), restored_nodepools AS (
+			UPDATE nodepools np
+			SET deleted_at = NULL, updated_at = NOW()
+			FROM target
+			WHERE np.cluster_id = target.id AND np.deleted_at = target.deleted_at
+		)
+		UPDATE clusters c
+		SET deleted_at = NULL, status_dirty = TRUE, updated_at = NOW()
+		FROM target
+		WHERE c.id = target.id`
+
+	result, err := r.client.ExecContext(ctx, query, id, createdBy, time.Now().Add(-retention))
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to restore cluster",
+			zap.String("cluster_id", id.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to restore cluster: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrClusterNotFound
+	}
+
+	r.logger.WithContext(ctx).Info("Cluster restored successfully",
 		zap.String("cluster_id", id.String()),
 	)
 
 	return nil
 }
 
-// Count returns the total number of clusters for a specific user
+// Count returns the total number of clusters a user created or holds a grant for
 func (r *ClustersRepository) Count(ctx context.Context, createdBy string) (int64, error) {
-	query := "SELECT COUNT(*) FROM clusters WHERE created_by = $1 AND deleted_at IS NULL"
+	return r.CountWithOptions(ctx, createdBy, nil)
+}
+
+// CountWithOptions returns the number of clusters a user created or holds a grant for
+// matching the filters in opts
+func (r *ClustersRepository) CountWithOptions(ctx context.Context, createdBy string, opts *models.ListOptions) (int64, error) {
+	query, args := appendClusterFilters(
+		"SELECT COUNT(*) FROM clusters WHERE "+clusterReadableBy(1)+" AND deleted_at IS NULL",
+		[]interface{}{createdBy}, opts)
 
 	var count int64
-	err := r.client.QueryRowContext(ctx, query, createdBy).Scan(&count)
+	err := r.client.QueryRowContext(ctx, query, args...).Scan(&count)
 	if err != nil {
-		r.logger.Error("Failed to count clusters", zap.Error(err))
+		r.logger.WithContext(ctx).Error("Failed to count clusters", zap.Error(err))
 		return 0, fmt.Errorf("failed to count clusters: %w", err)
 	}
 
@@ -409,7 +546,7 @@ func (r *ClustersRepository) MarkDirtyStatus(ctx context.Context, id uuid.UUID)
 
 	result, err := r.client.ExecContext(ctx, query, id)
 	if err != nil {
-		r.logger.Error("Failed to mark cluster status as dirty",
+		r.logger.WithContext(ctx).Error("Failed to mark cluster status as dirty",
 			zap.String("cluster_id", id.String()),
 			zap.Error(err),
 		)
@@ -425,7 +562,7 @@ func (r *ClustersRepository) MarkDirtyStatus(ctx context.Context, id uuid.UUID)
 		return models.ErrClusterNotFound
 	}
 
-	r.logger.Debug("Marked cluster status as dirty",
+	r.logger.WithContext(ctx).Debug("Marked cluster status as dirty",
 		zap.String("cluster_id", id.String()),
 	)
 
@@ -445,7 +582,7 @@ func (r *ClustersRepository) GetDirtyClusters(ctx context.Context, limit int) ([
 
 	rows, err := r.client.QueryContext(ctx, query, limit)
 	if err != nil {
-		r.logger.Error("Failed to get dirty clusters", zap.Error(err))
+		r.logger.WithContext(ctx).Error("Failed to get dirty clusters", zap.Error(err))
 		return nil, fmt.Errorf("failed to get dirty clusters: %w", err)
 	}
 	defer rows.Close()
@@ -468,18 +605,18 @@ func (r *ClustersRepository) GetDirtyClusters(ctx context.Context, limit int) ([
 			&cluster.DeletedAt,
 		)
 		if err != nil {
-			r.logger.Error("Failed to scan dirty cluster row", zap.Error(err))
+			r.logger.WithContext(ctx).Error("Failed to scan dirty cluster row", zap.Error(err))
 			return nil, fmt.Errorf("failed to scan dirty cluster: %w", err)
 		}
 		clusters = append(clusters, &cluster)
 	}
 
 	if err = rows.Err(); err != nil {
-		r.logger.Error("Error iterating dirty cluster rows", zap.Error(err))
+		r.logger.WithContext(ctx).Error("Error iterating dirty cluster rows", zap.Error(err))
 		return nil, fmt.Errorf("error iterating dirty clusters: %w", err)
 	}
 
-	r.logger.Debug("Retrieved dirty clusters",
+	r.logger.WithContext(ctx).Debug("Retrieved dirty clusters",
 		zap.Int("count", len(clusters)),
 	)
 
@@ -497,28 +634,12 @@ func (r *ClustersRepository) ListByCreatedBy(ctx context.Context, createdBy stri
 
 	var args []interface{}
 	args = append(args, createdBy)
-	argIndex := 2
-
-	// Add ordering
-	query := baseQuery + " ORDER BY created_at DESC"
 
-	// Add pagination
-	if opts != nil {
-		if opts.Limit > 0 {
-			query += fmt.Sprintf(" LIMIT $%d", argIndex)
-			args = append(args, opts.Limit)
-			argIndex++
-		}
-		if opts.Offset > 0 {
-			query += fmt.Sprintf(" OFFSET $%d", argIndex)
-			args = append(args, opts.Offset)
-			argIndex++
-		}
-	}
+	query, args := appendClusterPagination(baseQuery, args, opts)
 
 	rows, err := r.client.QueryContext(ctx, query, args...)
 	if err != nil {
-		r.logger.Error("Failed to list clusters by created_by",
+		r.logger.WithContext(ctx).Error("Failed to list clusters by created_by",
 			zap.String("created_by", createdBy),
 			zap.Error(err),
 		)
@@ -544,20 +665,20 @@ func (r *ClustersRepository) ListByCreatedBy(ctx context.Context, createdBy stri
 			&cluster.DeletedAt,
 		)
 		if err != nil {
-			r.logger.Error("Failed to scan cluster row", zap.Error(err))
+			r.logger.WithContext(ctx).Error("Failed to scan cluster row", zap.Error(err))
 			return nil, fmt.Errorf("failed to scan cluster: %w", err)
 		}
 		clusters = append(clusters, &cluster)
 	}
 
 	if err = rows.Err(); err != nil {
-		r.logger.Error("Error iterating cluster rows", zap.Error(err))
+		r.logger.WithContext(ctx).Error("Error iterating cluster rows", zap.Error(err))
 		return nil, fmt.Errorf("error iterating clusters: %w", err)
 	}
 
 	// Enrich all clusters with real-time status
 	if err := r.statusAggregator.EnrichClustersWithStatus(ctx, clusters); err != nil {
-		r.logger.Warn("Failed to enrich some clusters with real-time status",
+		r.logger.WithContext(ctx).Warn("Failed to enrich some clusters with real-time status",
 			zap.String("created_by", createdBy),
 			zap.Int("cluster_count", len(clusters)),
 			zap.Error(err),
@@ -575,7 +696,7 @@ func (r *ClustersRepository) CountByCreatedBy(ctx context.Context, createdBy str
 	var count int64
 	err := r.client.QueryRowContext(ctx, query, createdBy).Scan(&count)
 	if err != nil {
-		r.logger.Error("Failed to count clusters by created_by",
+		r.logger.WithContext(ctx).Error("Failed to count clusters by created_by",
 			zap.String("created_by", createdBy),
 			zap.Error(err),
 		)
@@ -596,29 +717,18 @@ func (r *ClustersRepository) ListAll(ctx context.Context, opts *models.ListOptio
 		FROM clusters
 		WHERE deleted_at IS NULL`
 
-	var args []interface{}
-	argIndex := 1
-
-	// Add ordering
-	query := baseQuery + " ORDER BY created_at DESC"
-
-	// Add pagination
-	if opts != nil {
-		if opts.Limit > 0 {
-			query += fmt.Sprintf(" LIMIT $%d", argIndex)
-			args = append(args, opts.Limit)
-			argIndex++
-		}
-		if opts.Offset > 0 {
-			query += fmt.Sprintf(" OFFSET $%d", argIndex)
-			args = append(args, opts.Offset)
-			argIndex++
+	if opts != nil && len(opts.Status) > 0 {
+		if err := r.refreshDirtyStatuses(ctx, ""); err != nil {
+			return nil, err
 		}
 	}
 
+	query, args := appendClusterFilters(baseQuery, nil, opts)
+	query, args = appendClusterPagination(query, args, opts)
+
 	rows, err := r.client.QueryContext(ctx, query, args...)
 	if err != nil {
-		r.logger.Error("Failed to list all clusters", zap.Error(err))
+		r.logger.WithContext(ctx).Error("Failed to list all clusters", zap.Error(err))
 		return nil, fmt.Errorf("failed to list all clusters: %w", err)
 	}
 	defer rows.Close()
@@ -641,20 +751,20 @@ func (r *ClustersRepository) ListAll(ctx context.Context, opts *models.ListOptio
 			&cluster.DeletedAt,
 		)
 		if err != nil {
-			r.logger.Error("Failed to scan cluster row", zap.Error(err))
+			r.logger.WithContext(ctx).Error("Failed to scan cluster row", zap.Error(err))
 			return nil, fmt.Errorf("failed to scan cluster: %w", err)
 		}
 		clusters = append(clusters, &cluster)
 	}
 
 	if err = rows.Err(); err != nil {
-		r.logger.Error("Error iterating cluster rows", zap.Error(err))
+		r.logger.WithContext(ctx).Error("Error iterating cluster rows", zap.Error(err))
 		return nil, fmt.Errorf("error iterating clusters: %w", err)
 	}
 
 	// Enrich all clusters with real-time status
 	if err := r.statusAggregator.EnrichClustersWithStatus(ctx, clusters); err != nil {
-		r.logger.Warn("Failed to enrich some clusters with real-time status",
+		r.logger.WithContext(ctx).Warn("Failed to enrich some clusters with real-time status",
 			zap.Int("cluster_count", len(clusters)),
 			zap.Error(err),
 		)
@@ -664,66 +774,203 @@ func (r *ClustersRepository) ListAll(ctx context.Context, opts *models.ListOptio
 	return clusters, nil
 }
 
-// CountAll returns the total number of clusters system-wide
-func (r *ClustersRepository) CountAll(ctx context.Context) (int64, error) {
-	query := "SELECT COUNT(*) FROM clusters WHERE deleted_at IS NULL"
+// ListAllIncludingDeleted retrieves clusters like ListAll but keeps soft-deleted clusters,
+// which carry their deleted_at, so operators can audit recent deletions (system-wide access
+// for controllers)
+func (r *ClustersRepository) ListAllIncludingDeleted(ctx context.Context, opts *models.ListOptions) ([]*models.Cluster, error) {
+	baseQuery := `
+		SELECT id, name, target_project_id, created_by,
+			   generation, resource_version, spec, status,
+			   status_dirty, created_at, updated_at, deleted_at
+		FROM clusters
+		WHERE TRUE`
 
-	var count int64
-	err := r.client.QueryRowContext(ctx, query).Scan(&count)
+	if opts != nil && len(opts.Status) > 0 {
+		if err := r.refreshDirtyStatuses(ctx, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	query, args := appendClusterFilters(baseQuery, nil, opts)
+	query, args = appendClusterPagination(query, args, opts)
+
+	rows, err := r.client.QueryContext(ctx, query, args...)
 	if err != nil {
-		r.logger.Error("Failed to count all clusters", zap.Error(err))
-		return 0, fmt.Errorf("failed to count all clusters: %w", err)
+		r.logger.WithContext(ctx).Error("Failed to list all clusters including deleted", zap.Error(err))
+		return nil, fmt.Errorf("failed to list all clusters including deleted: %w", err)
 	}
+	defer rows.Close()
 
-	return count, nil
+	var clusters []*models.Cluster
+	for rows.Next() {
+		var cluster models.Cluster
+		err := rows.Scan(
+			&cluster.ID,
+			&cluster.Name,
+			&cluster.TargetProjectID,
+			&cluster.CreatedBy,
+			&cluster.Generation,
+			&cluster.ResourceVersion,
+			&cluster.Spec,
+			&cluster.Status,
+			&cluster.StatusDirty,
+			&cluster.CreatedAt,
+			&cluster.UpdatedAt,
+			&cluster.DeletedAt,
+		)
+		if err != nil {
+			r.logger.WithContext(ctx).Error("Failed to scan cluster row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan cluster: %w", err)
+		}
+		clusters = append(clusters, &cluster)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.WithContext(ctx).Error("Error iterating cluster rows", zap.Error(err))
+		return nil, fmt.Errorf("error iterating clusters: %w", err)
+	}
+
+	// Enrich all clusters with real-time status
+	if err := r.statusAggregator.EnrichClustersWithStatus(ctx, clusters); err != nil {
+		r.logger.WithContext(ctx).Warn("Failed to enrich some clusters with real-time status",
+			zap.Int("cluster_count", len(clusters)),
+			zap.Error(err),
+		)
+		// Continue without failing - return clusters with existing status
+	}
+
+	return clusters, nil
 }
 
-// GetByIDWithoutFilter retrieves a cluster by ID without access control filtering (for controllers)
-func (r *ClustersRepository) GetByIDWithoutFilter(ctx context.Context, id uuid.UUID) (*models.Cluster, error) {
-	query := `
+// ListByReleaseImage retrieves all clusters whose spec references the given release image
+// (system-wide access for controllers)
+func (r *ClustersRepository) ListByReleaseImage(ctx context.Context, image string, opts *models.ListOptions) ([]*models.Cluster, error) {
+	// The expression matches idx_clusters_release_image
+	imageCondition := " AND spec->'release'->>'image' = $1"
+	baseQuery := `
 		SELECT id, name, target_project_id, created_by,
 			   generation, resource_version, spec, status,
 			   status_dirty, created_at, updated_at, deleted_at
 		FROM clusters
-		WHERE id = $1 AND deleted_at IS NULL`
+		WHERE deleted_at IS NULL` + imageCondition
 
-	var cluster models.Cluster
-	err := r.client.QueryRowContext(ctx, query, id).Scan(
-		&cluster.ID,
-		&cluster.Name,
-		&cluster.TargetProjectID,
-		&cluster.CreatedBy,
-		&cluster.Generation,
-		&cluster.ResourceVersion,
-		&cluster.Spec,
-		&cluster.Status,
-		&cluster.StatusDirty,
-		&cluster.CreatedAt,
-		&cluster.UpdatedAt,
-		&cluster.DeletedAt,
-	)
-
-	if err == sql.ErrNoRows {
-		return nil, models.ErrClusterNotFound
+	if opts != nil && len(opts.Status) > 0 {
+		if err := r.refreshDirtyStatuses(ctx, imageCondition, image); err != nil {
+			return nil, err
+		}
 	}
+
+	query, args := appendClusterFilters(baseQuery, []interface{}{image}, opts)
+	query, args = appendClusterPagination(query, args, opts)
+
+	rows, err := r.client.QueryContext(ctx, query, args...)
 	if err != nil {
-		r.logger.Error("Failed to get cluster by ID without filter",
-			zap.String("cluster_id", id.String()),
+		r.logger.WithContext(ctx).Error("Failed to list clusters by release image",
+			zap.String("image", image),
 			zap.Error(err),
 		)
-		return nil, fmt.Errorf("failed to get cluster: %w", err)
+		return nil, fmt.Errorf("failed to list clusters by release image: %w", err)
 	}
+	defer rows.Close()
 
-	// Enrich with real-time status
-	if err := r.statusAggregator.EnrichClusterWithStatus(ctx, &cluster); err != nil {
-		r.logger.Warn("Failed to enrich cluster with real-time status",
-			zap.String("cluster_id", id.String()),
+	var clusters []*models.Cluster
+	for rows.Next() {
+		var cluster models.Cluster
+		err := rows.Scan(
+			&cluster.ID,
+			&cluster.Name,
+			&cluster.TargetProjectID,
+			&cluster.CreatedBy,
+			&cluster.Generation,
+			&cluster.ResourceVersion,
+			&cluster.Spec,
+			&cluster.Status,
+			&cluster.StatusDirty,
+			&cluster.CreatedAt,
+			&cluster.UpdatedAt,
+			&cluster.DeletedAt,
+		)
+		if err != nil {
+			r.logger.WithContext(ctx).Error("Failed to scan cluster row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan cluster: %w", err)
+		}
+		clusters = append(clusters, &cluster)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.WithContext(ctx).Error("Error iterating cluster rows", zap.Error(err))
+		return nil, fmt.Errorf("error iterating clusters: %w", err)
+	}
+
+	// Enrich all clusters with real-time status
+	if err := r.statusAggregator.EnrichClustersWithStatus(ctx, clusters); err != nil {
+		r.logger.WithContext(ctx).Warn("Failed to enrich some clusters with real-time status",
+			zap.Int("cluster_count", len(clusters)),
 			zap.Error(err),
 		)
-		// Continue without failing - return cluster with existing status
+		// Continue without failing - return clusters with existing status
 	}
 
-	return &cluster, nil
+	return clusters, nil
+}
+
+// CountByReleaseImage returns the number of clusters whose spec references the given release
+// image and that match the filters in opts
+func (r *ClustersRepository) CountByReleaseImage(ctx context.Context, image string, opts *models.ListOptions) (int64, error) {
+	query, args := appendClusterFilters(
+		"SELECT COUNT(*) FROM clusters WHERE deleted_at IS NULL AND spec->'release'->>'image' = $1",
+		[]interface{}{image}, opts)
+
+	var count int64
+	err := r.client.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to count clusters by release image",
+			zap.String("image", image),
+			zap.Error(err),
+		)
+		return 0, fmt.Errorf("failed to count clusters by release image: %w", err)
+	}
+
+	return count, nil
+}
+
+// CountAll returns the total number of clusters system-wide
+func (r *ClustersRepository) CountAll(ctx context.Context) (int64, error) {
+	return r.CountAllWithOptions(ctx, nil)
+}
+
+// CountAllWithOptions returns the number of clusters system-wide matching the filters
+// in opts
+func (r *ClustersRepository) CountAllWithOptions(ctx context.Context, opts *models.ListOptions) (int64, error) {
+	query, args := appendClusterFilters("SELECT COUNT(*) FROM clusters WHERE deleted_at IS NULL", nil, opts)
+
+	var count int64
+	err := r.client.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to count all clusters", zap.Error(err))
+		return 0, fmt.Errorf("failed to count all clusters: %w", err)
+	}
+
+	return count, nil
+}
+
+// CountAllIncludingDeleted counts the clusters ListAllIncludingDeleted returns, ignoring pagination
+func (r *ClustersRepository) CountAllIncludingDeleted(ctx context.Context, opts *models.ListOptions) (int64, error) {
+	query, args := appendClusterFilters("SELECT COUNT(*) FROM clusters WHERE TRUE", nil, opts)
+
+	var count int64
+	err := r.client.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to count all clusters including deleted", zap.Error(err))
+		return 0, fmt.Errorf("failed to count all clusters including deleted: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetByIDWithoutFilter retrieves a cluster by ID without access control filtering (for controllers)
+func (r *ClustersRepository) GetByIDWithoutFilter(ctx context.Context, id uuid.UUID) (*models.Cluster, error) {
+	return r.GetByID(ctx, id, "", true)
 }
 
 // UpdateWithoutFilter updates a cluster without access control filtering (for controllers)
@@ -746,7 +993,7 @@ func (r *ClustersRepository) UpdateWithoutFilter(ctx context.Context, cluster *m
 	)
 
 	if err != nil {
-		r.logger.Error("Failed to update cluster without filter",
+		r.logger.WithContext(ctx).Error("Failed to update cluster without filter",
 			zap.String("cluster_id", cluster.ID.String()),
 			zap.Error(err),
 		)
@@ -762,7 +1009,7 @@ func (r *ClustersRepository) UpdateWithoutFilter(ctx context.Context, cluster *m
 		return models.ErrClusterNotFound
 	}
 
-	r.logger.Info("Cluster updated successfully without filter",
+	r.logger.WithContext(ctx).Info("Cluster updated successfully without filter",
 		zap.String("cluster_id", cluster.ID.String()),
 		zap.String("cluster_name", cluster.Name),
 	)
@@ -779,7 +1026,7 @@ func (r *ClustersRepository) DeleteWithoutFilter(ctx context.Context, id uuid.UU
 
 	result, err := r.client.ExecContext(ctx, query, id)
 	if err != nil {
-		r.logger.Error("Failed to delete cluster without filter",
+		r.logger.WithContext(ctx).Error("Failed to delete cluster without filter",
 			zap.String("cluster_id", id.String()),
 			zap.Error(err),
 		)
@@ -795,7 +1042,59 @@ func (r *ClustersRepository) DeleteWithoutFilter(ctx context.Context, id uuid.UU
 		return models.ErrClusterNotFound
 	}
 
-	r.logger.Info("Cluster deleted successfully without filter",
+	r.logger.WithContext(ctx).Info("Cluster deleted successfully without filter",
+		zap.String("cluster_id", id.String()),
+	)
+
+	return nil
+}
+
+// Purge permanently removes a soft-deleted cluster and all of its related rows.
+// The cluster must already be soft-deleted; live clusters return ErrClusterNotDeleted.
+func (r *ClustersRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	err := r.client.Transaction(ctx, func(tx *sql.Tx) error {
+		var deletedAt sql.NullTime
+		err := tx.QueryRowContext(ctx, `SELECT deleted_at FROM clusters WHERE id = $1 FOR UPDATE`, id).Scan(&deletedAt)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return models.ErrClusterNotFound
+			}
+			return fmt.Errorf("failed to lock cluster: %w", err)
+		}
+
+		if !deletedAt.Valid {
+			return models.ErrClusterNotDeleted
+		}
+
+		// Delete dependents first; cluster_events has no foreign key so it must be removed explicitly
+		cascade := []struct {
+			table string
+			query string
+		}{
+			{"nodepool_controller_status", `DELETE FROM nodepool_controller_status WHERE nodepool_id IN (SELECT id FROM nodepools WHERE cluster_id = $1)`},
+			{"nodepools", `DELETE FROM nodepools WHERE cluster_id = $1`},
+			{"controller_status", `DELETE FROM controller_status WHERE cluster_id = $1`},
+			{"cluster_events", `DELETE FROM cluster_events WHERE cluster_id = $1`},
+			{"clusters", `DELETE FROM clusters WHERE id = $1`},
+		}
+
+		for _, step := range cascade {
+			if _, err := tx.ExecContext(ctx, step.query, id); err != nil {
+				return fmt.Errorf("failed to purge %s: %w", step.table, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to purge cluster",
+			zap.String("cluster_id", id.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	r.logger.WithContext(ctx).Info("Cluster purged successfully",
 		zap.String("cluster_id", id.String()),
 	)
 
@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -34,9 +35,12 @@ func setupTestRepository(t *testing.T) *Repository {
 		CREATE TABLE IF NOT EXISTS clusters (
 			id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
 			name VARCHAR(255) NOT NULL UNIQUE,
+			target_project_id VARCHAR(255),
+			created_by VARCHAR(255) NOT NULL DEFAULT '',
 			generation BIGINT NOT NULL DEFAULT 1,
 			resource_version VARCHAR(255) NOT NULL DEFAULT uuid_generate_v4()::text,
 			spec JSONB NOT NULL,
+			status JSONB,
 			overall_status VARCHAR(50) NOT NULL DEFAULT 'Pending',
 			overall_health VARCHAR(50) NOT NULL DEFAULT 'Unknown',
 			created_at TIMESTAMP NOT NULL DEFAULT NOW(),
@@ -45,6 +49,15 @@ func setupTestRepository(t *testing.T) *Repository {
 			status_dirty BOOLEAN DEFAULT FALSE
 		);
 
+		CREATE TABLE IF NOT EXISTS cluster_grants (
+			cluster_id UUID NOT NULL REFERENCES clusters(id) ON DELETE CASCADE,
+			grantee_email VARCHAR(255) NOT NULL,
+			role VARCHAR(50) NOT NULL DEFAULT 'viewer',
+			created_by VARCHAR(255) NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (cluster_id, grantee_email)
+		);
+
 		CREATE OR REPLACE FUNCTION update_updated_at_column()
 		RETURNS TRIGGER AS $$
 		BEGIN
@@ -129,7 +142,7 @@ func TestClustersRepository_GetByID(t *testing.T) {
 	utils.AssertError(t, err, false, "Should create cluster")
 
 	// Get existing cluster
-	retrieved, err := repo.Clusters.GetByID(ctx, cluster.ID, "")
+	retrieved, err := repo.Clusters.GetByID(ctx, cluster.ID, "", false)
 	utils.AssertError(t, err, false, "Should get cluster by ID")
 	utils.AssertNotNil(t, retrieved, "Retrieved cluster should not be nil")
 	utils.AssertEqual(t, cluster.ID, retrieved.ID, "Cluster ID should match")
@@ -137,7 +150,7 @@ func TestClustersRepository_GetByID(t *testing.T) {
 
 	// Get non-existent cluster
 	nonExistentID := uuid.New()
-	retrieved, err = repo.Clusters.GetByID(ctx, nonExistentID, "")
+	retrieved, err = repo.Clusters.GetByID(ctx, nonExistentID, "", false)
 	utils.AssertError(t, err, true, "Should return error for non-existent cluster")
 	utils.AssertEqual(t, models.ErrClusterNotFound, err, "Should return ErrClusterNotFound")
 	utils.AssertNil(t, retrieved, "Retrieved cluster should be nil")
@@ -154,19 +167,122 @@ func TestClustersRepository_GetByName(t *testing.T) {
 	utils.AssertError(t, err, false, "Should create cluster")
 
 	// Get existing cluster
-	retrieved, err := repo.Clusters.GetByName(ctx, cluster.Name, "")
+	retrieved, err := repo.Clusters.GetByName(ctx, cluster.Name, "", false)
 	utils.AssertError(t, err, false, "Should get cluster by name")
 	utils.AssertNotNil(t, retrieved, "Retrieved cluster should not be nil")
 	utils.AssertEqual(t, cluster.ID, retrieved.ID, "Cluster ID should match")
 	utils.AssertEqual(t, cluster.Name, retrieved.Name, "Cluster name should match")
 
 	// Get non-existent cluster
-	retrieved, err = repo.Clusters.GetByName(ctx, "non-existent", "")
+	retrieved, err = repo.Clusters.GetByName(ctx, "non-existent", "", false)
 	utils.AssertError(t, err, true, "Should return error for non-existent cluster")
 	utils.AssertEqual(t, models.ErrClusterNotFound, err, "Should return ErrClusterNotFound")
 	utils.AssertNil(t, retrieved, "Retrieved cluster should be nil")
 }
 
+func TestClustersRepository_GetTenantScoping(t *testing.T) {
+	repo := setupTestRepository(t)
+	defer repo.Close()
+
+	cluster := createTestCluster()
+	cluster.CreatedBy = "owner@example.com"
+
+	ctx := context.Background()
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	// The owner sees their cluster with or without the flag
+	for _, includeAllTenants := range []bool{false, true} {
+		retrieved, err := repo.Clusters.GetByID(ctx, cluster.ID, cluster.CreatedBy, includeAllTenants)
+		utils.AssertError(t, err, false, "Owner should get cluster by ID", includeAllTenants)
+		utils.AssertEqual(t, cluster.ID, retrieved.ID, "Cluster ID should match")
+
+		retrieved, err = repo.Clusters.GetByName(ctx, cluster.Name, cluster.CreatedBy, includeAllTenants)
+		utils.AssertError(t, err, false, "Owner should get cluster by name", includeAllTenants)
+		utils.AssertEqual(t, cluster.ID, retrieved.ID, "Cluster ID should match")
+	}
+
+	// Another user is scoped to their own clusters
+	retrieved, err := repo.Clusters.GetByID(ctx, cluster.ID, "other@example.com", false)
+	utils.AssertEqual(t, models.ErrClusterNotFound, err, "Other user should not see the cluster by ID")
+	utils.AssertNil(t, retrieved, "Retrieved cluster should be nil")
+
+	retrieved, err = repo.Clusters.GetByName(ctx, cluster.Name, "other@example.com", false)
+	utils.AssertEqual(t, models.ErrClusterNotFound, err, "Other user should not see the cluster by name")
+	utils.AssertNil(t, retrieved, "Retrieved cluster should be nil")
+
+	// A controller reads across tenants whatever its own email is
+	retrieved, err = repo.Clusters.GetByID(ctx, cluster.ID, "controller@system.local", true)
+	utils.AssertError(t, err, false, "Controller should get any cluster by ID")
+	utils.AssertEqual(t, cluster.ID, retrieved.ID, "Cluster ID should match")
+
+	retrieved, err = repo.Clusters.GetByName(ctx, cluster.Name, "controller@system.local", true)
+	utils.AssertError(t, err, false, "Controller should get any cluster by name")
+	utils.AssertEqual(t, cluster.ID, retrieved.ID, "Cluster ID should match")
+
+	// The email alone grants nothing: a system address without the flag is scoped
+	_, err = repo.Clusters.GetByID(ctx, cluster.ID, "controller@system.local", false)
+	utils.AssertEqual(t, models.ErrClusterNotFound, err, "System email without the flag should be scoped")
+
+	// The caller's own cluster wins when names collide across tenants
+	own := createTestCluster()
+	own.CreatedBy = "controller@system.local"
+	err = repo.Clusters.Create(ctx, own)
+	utils.AssertError(t, err, false, "Should create same-named cluster for another user")
+
+	retrieved, err = repo.Clusters.GetByName(ctx, cluster.Name, own.CreatedBy, true)
+	utils.AssertError(t, err, false, "Controller should get cluster by name")
+	utils.AssertEqual(t, own.ID, retrieved.ID, "Caller's own cluster should be preferred")
+}
+
+func TestClustersRepository_GrantScoping(t *testing.T) {
+	repo := setupTestRepository(t)
+	defer repo.Close()
+
+	cluster := createTestCluster()
+	cluster.CreatedBy = "owner@example.com"
+
+	ctx := context.Background()
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	grantee := "grantee@example.com"
+	_, err = repo.Clusters.GetByID(ctx, cluster.ID, grantee, false)
+	utils.AssertEqual(t, models.ErrClusterNotFound, err, "User without a grant should not see the cluster")
+
+	// A grant makes the cluster readable and listable by the grantee
+	err = repo.ClusterGrants.Upsert(ctx, &models.ClusterGrant{
+		ClusterID:    cluster.ID,
+		GranteeEmail: grantee,
+		Role:         models.ClusterGrantRoleViewer,
+		CreatedBy:    cluster.CreatedBy,
+	})
+	utils.AssertError(t, err, false, "Should grant access")
+
+	retrieved, err := repo.Clusters.GetByID(ctx, cluster.ID, grantee, false)
+	utils.AssertError(t, err, false, "Grantee should get cluster by ID")
+	utils.AssertEqual(t, cluster.CreatedBy, retrieved.CreatedBy, "Cluster should still belong to its owner")
+
+	clusters, err := repo.Clusters.List(ctx, grantee, &models.ListOptions{Limit: 10})
+	utils.AssertError(t, err, false, "Should list grantee's clusters")
+	utils.AssertEqual(t, 1, len(clusters), "Shared cluster should be listed")
+	count, err := repo.Clusters.CountWithOptions(ctx, grantee, nil)
+	utils.AssertError(t, err, false, "Should count grantee's clusters")
+	utils.AssertEqual(t, int64(1), count, "Shared cluster should be counted")
+
+	// Revoking the grant removes access
+	err = repo.ClusterGrants.Delete(ctx, cluster.ID, grantee)
+	utils.AssertError(t, err, false, "Should revoke access")
+	err = repo.ClusterGrants.Delete(ctx, cluster.ID, grantee)
+	utils.AssertEqual(t, models.ErrClusterGrantNotFound, err, "Revoking twice should report the grant missing")
+
+	_, err = repo.Clusters.GetByID(ctx, cluster.ID, grantee, false)
+	utils.AssertEqual(t, models.ErrClusterNotFound, err, "Revoked grantee should not see the cluster")
+	clusters, err = repo.Clusters.List(ctx, grantee, &models.ListOptions{Limit: 10})
+	utils.AssertError(t, err, false, "Should list grantee's clusters")
+	utils.AssertEqual(t, 0, len(clusters), "Revoked cluster should not be listed")
+}
+
 func TestClustersRepository_List(t *testing.T) {
 	repo := setupTestRepository(t)
 	defer repo.Close()
@@ -230,7 +346,7 @@ func TestClustersRepository_Update(t *testing.T) {
 	utils.AssertTrue(t, cluster.UpdatedAt.After(originalUpdatedAt), "UpdatedAt should be updated")
 
 	// Verify in database
-	retrieved, err := repo.Clusters.GetByID(ctx, cluster.ID, "")
+	retrieved, err := repo.Clusters.GetByID(ctx, cluster.ID, "", false)
 	utils.AssertError(t, err, false, "Should get updated cluster")
 	utils.AssertEqual(t, int64(2), retrieved.Generation, "Generation should be persisted")
 
@@ -241,6 +357,135 @@ func TestClustersRepository_Update(t *testing.T) {
 	utils.AssertEqual(t, models.ErrClusterNotFound, err, "Should return ErrClusterNotFound")
 }
 
+func TestClustersRepository_ListWithCursor(t *testing.T) {
+	repo := setupTestRepository(t)
+	defer repo.Close()
+
+	ctx := context.Background()
+
+	// Create 5 clusters, some sharing a creation timestamp to exercise the id tie-breaker
+	created := make(map[uuid.UUID]bool)
+	sharedTime := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+	for i := 0; i < 5; i++ {
+		cluster := createTestCluster()
+		cluster.Name = fmt.Sprintf("cursor-cluster-%d", i)
+		err := repo.Clusters.Create(ctx, cluster)
+		utils.AssertError(t, err, false, "Should create cluster")
+		created[cluster.ID] = true
+
+		if i < 3 {
+			_, err = repo.GetClient().ExecContext(ctx, `UPDATE clusters SET created_at = $2 WHERE id = $1`, cluster.ID, sharedTime)
+			utils.AssertError(t, err, false, "Should set shared created_at")
+		}
+	}
+
+	// Iterate the full set with page size 2
+	seen := make(map[uuid.UUID]bool)
+	opts := &models.ListOptions{Limit: 2}
+	pages := 0
+	for {
+		page, err := repo.Clusters.List(ctx, "", opts)
+		utils.AssertError(t, err, false, "Should list page")
+		pages++
+
+		for _, cluster := range page {
+			utils.AssertFalse(t, seen[cluster.ID], "Cluster should not appear on more than one page")
+			seen[cluster.ID] = true
+		}
+
+		next := models.NextClusterCursor(page, opts.Limit)
+		if next == "" {
+			break
+		}
+		cursor, err := models.DecodeListCursor(next)
+		utils.AssertError(t, err, false, "Should decode next cursor")
+		opts = &models.ListOptions{Limit: 2, Cursor: cursor, Offset: 100} // Offset is ignored when a cursor is set
+
+		if pages > 5 {
+			t.Fatal("Pagination did not terminate")
+		}
+	}
+
+	utils.AssertEqual(t, len(created), len(seen), "Every cluster should be returned exactly once")
+	utils.AssertEqual(t, 3, pages, "Should take 3 pages of size 2 to list 5 clusters")
+}
+
+func TestClustersRepository_ListWithCursorConcurrentInserts(t *testing.T) {
+	repo := setupTestRepository(t)
+	defer repo.Close()
+
+	ctx := context.Background()
+	inserted := 0
+	insertCluster := func(createdAt *time.Time) *models.Cluster {
+		cluster := createTestCluster()
+		cluster.Name = fmt.Sprintf("concurrent-cluster-%d", inserted)
+		inserted++
+		err := repo.Clusters.Create(ctx, cluster)
+		utils.AssertError(t, err, false, "Should create cluster", cluster.Name)
+		if createdAt != nil {
+			_, err = repo.GetClient().ExecContext(ctx, `UPDATE clusters SET created_at = $2 WHERE id = $1`, cluster.ID, *createdAt)
+			utils.AssertError(t, err, false, "Should set created_at", cluster.Name)
+			cluster.CreatedAt = *createdAt
+		}
+		return cluster
+	}
+
+	// Six existing clusters, four of them tied on created_at so page boundaries fall inside the tie
+	existing := make(map[uuid.UUID]bool)
+	sharedTime := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+	for i := 0; i < 6; i++ {
+		var createdAt *time.Time
+		if i < 4 {
+			createdAt = &sharedTime
+		}
+		existing[insertCluster(createdAt).ID] = true
+	}
+
+	// Page through while other clusters are inserted between fetches: newer clusters, which
+	// belong before the cursor, and clusters tied with the cursor's timestamp
+	seen := make(map[uuid.UUID]int)
+	newer := make(map[uuid.UUID]bool)
+	opts := &models.ListOptions{Limit: 2}
+	for pages := 1; ; pages++ {
+		page, err := repo.Clusters.List(ctx, "", opts)
+		utils.AssertError(t, err, false, "Should list page", pages)
+		for _, cluster := range page {
+			seen[cluster.ID]++
+			utils.AssertFalse(t, newer[cluster.ID], "Cluster inserted before the cursor should not be returned", cluster.Name)
+		}
+
+		next := models.NextClusterCursor(page, opts.Limit)
+		if next == "" {
+			break
+		}
+		cursor, err := models.DecodeListCursor(next)
+		utils.AssertError(t, err, false, "Should decode next cursor")
+
+		newer[insertCluster(nil).ID] = true
+		insertCluster(&cursor.CreatedAt)
+
+		opts = &models.ListOptions{Limit: 2, Cursor: cursor}
+		if pages > 20 {
+			t.Fatal("Pagination did not terminate")
+		}
+	}
+
+	for id, count := range seen {
+		utils.AssertEqual(t, 1, count, "No cluster should be returned twice", id)
+	}
+	for id := range existing {
+		utils.AssertEqual(t, 1, seen[id], "Every existing cluster should be returned", id)
+	}
+
+	// A cursor built from a freshly created cluster starts strictly after it
+	created := insertCluster(nil)
+	cursor := models.ListCursor{CreatedAt: created.CreatedAt, ID: created.ID}
+	page, err := repo.Clusters.List(ctx, "", &models.ListOptions{Limit: 1, Cursor: &cursor})
+	utils.AssertError(t, err, false, "Should list after created cluster")
+	utils.AssertEqual(t, 1, len(page), "The next page should not be empty")
+	utils.AssertNotEqual(t, created.ID, page[0].ID, "The cursor's own cluster should not be returned")
+}
+
 // TestClustersRepository_UpdateStatus removed - UpdateStatus method no longer exists
 // Status updates now happen via controller status tracking and aggregation
 
@@ -259,7 +504,7 @@ func TestClustersRepository_Delete(t *testing.T) {
 	utils.AssertError(t, err, false, "Should delete cluster")
 
 	// Verify cluster is soft deleted
-	retrieved, err := repo.Clusters.GetByID(ctx, cluster.ID, "")
+	retrieved, err := repo.Clusters.GetByID(ctx, cluster.ID, "", false)
 	utils.AssertError(t, err, true, "Should not find deleted cluster")
 	utils.AssertEqual(t, models.ErrClusterNotFound, err, "Should return ErrClusterNotFound")
 	utils.AssertNil(t, retrieved, "Retrieved cluster should be nil")
@@ -307,3 +552,167 @@ func TestClustersRepository_Count(t *testing.T) {
 	utils.AssertError(t, err, false, "Should count after delete")
 	utils.AssertEqual(t, int64(1), count, "Should have 1 cluster after delete")
 }
+
+func TestClustersRepository_ListCreatedWindow(t *testing.T) {
+	repo := setupTestRepository(t)
+	defer repo.Close()
+
+	ctx := context.Background()
+	base := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	// Clusters created at day 0, 1, 2 and 3 relative to base
+	for day := 0; day < 4; day++ {
+		cluster := createTestCluster()
+		cluster.Name = fmt.Sprintf("window-cluster-%d", day)
+		err := repo.Clusters.Create(ctx, cluster)
+		utils.AssertError(t, err, false, "Should create cluster", cluster.Name)
+
+		_, err = repo.GetClient().ExecContext(ctx, "UPDATE clusters SET created_at = $2 WHERE id = $1",
+			cluster.ID, base.AddDate(0, 0, day))
+		utils.AssertError(t, err, false, "Should backdate cluster", cluster.Name)
+	}
+
+	after := base.AddDate(0, 0, 1)
+	before := base.AddDate(0, 0, 3)
+	opts := &models.ListOptions{CreatedAfter: &after, CreatedBefore: &before}
+
+	// created_after is inclusive and created_before exclusive: days 1 and 2
+	clusters, err := repo.Clusters.List(ctx, "", opts)
+	utils.AssertError(t, err, false, "Should list clusters in window")
+	utils.AssertEqual(t, 2, len(clusters), "Window should include its start and exclude its end")
+	utils.AssertEqual(t, "window-cluster-2", clusters[0].Name, "Newest cluster in window should be first")
+	utils.AssertEqual(t, "window-cluster-1", clusters[1].Name, "Cluster created exactly at created_after should be included")
+
+	count, err := repo.Clusters.CountWithOptions(ctx, "", opts)
+	utils.AssertError(t, err, false, "Should count clusters in window")
+	utils.AssertEqual(t, int64(2), count, "Count should match the window")
+
+	// Open-ended windows
+	clusters, err = repo.Clusters.List(ctx, "", &models.ListOptions{CreatedAfter: &before})
+	utils.AssertError(t, err, false, "Should list clusters after a time")
+	utils.AssertEqual(t, 1, len(clusters), "Only the cluster created at created_after or later should be listed")
+
+	count, err = repo.Clusters.CountAllWithOptions(ctx, &models.ListOptions{CreatedBefore: &after})
+	utils.AssertError(t, err, false, "Should count clusters before a time")
+	utils.AssertEqual(t, int64(1), count, "Only the cluster created before created_before should be counted")
+}
+
+func TestClustersRepository_ListTargetProject(t *testing.T) {
+	repo := setupTestRepository(t)
+	defer repo.Close()
+
+	ctx := context.Background()
+	for i, project := range []string{"project-a", "project-a", "project-b"} {
+		cluster := createTestCluster()
+		cluster.Name = fmt.Sprintf("project-cluster-%d", i)
+		cluster.TargetProjectID = project
+		err := repo.Clusters.Create(ctx, cluster)
+		utils.AssertError(t, err, false, "Should create cluster", cluster.Name)
+	}
+
+	opts := &models.ListOptions{TargetProjectID: "project-a"}
+	clusters, err := repo.Clusters.List(ctx, "", opts)
+	utils.AssertError(t, err, false, "Should list clusters in project")
+	utils.AssertEqual(t, 2, len(clusters), "Only clusters targeting the project should be listed")
+	for _, cluster := range clusters {
+		utils.AssertEqual(t, "project-a", cluster.TargetProjectID, "Listed cluster should target the project", cluster.Name)
+	}
+
+	count, err := repo.Clusters.CountWithOptions(ctx, "", opts)
+	utils.AssertError(t, err, false, "Should count clusters in project")
+	utils.AssertEqual(t, int64(2), count, "Count should match the project filter")
+
+	count, err = repo.Clusters.CountAllWithOptions(ctx, &models.ListOptions{TargetProjectID: "project-b"})
+	utils.AssertError(t, err, false, "Should count all clusters in project")
+	utils.AssertEqual(t, int64(1), count, "Count across users should match the project filter")
+
+	clusters, err = repo.Clusters.List(ctx, "", &models.ListOptions{TargetProjectID: "project-c"})
+	utils.AssertError(t, err, false, "Should list clusters in unknown project")
+	utils.AssertEqual(t, 0, len(clusters), "No clusters should target an unknown project")
+}
+
+func setupPurgeTestSchema(t *testing.T, repo *Repository) {
+	ctx := context.Background()
+	_, err := repo.GetClient().ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS nodepools (
+			id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+			cluster_id UUID NOT NULL REFERENCES clusters(id),
+			name VARCHAR(255) NOT NULL,
+			spec JSONB NOT NULL DEFAULT '{}',
+			created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+			deleted_at TIMESTAMP NULL
+		);
+
+		CREATE TABLE IF NOT EXISTS controller_status (
+			id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+			cluster_id UUID NOT NULL REFERENCES clusters(id),
+			controller_name VARCHAR(255) NOT NULL
+		);
+
+		CREATE TABLE IF NOT EXISTS nodepool_controller_status (
+			id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+			nodepool_id UUID NOT NULL REFERENCES nodepools(id),
+			controller_name VARCHAR(255) NOT NULL
+		);
+
+		CREATE TABLE IF NOT EXISTS cluster_events (
+			id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+			cluster_id UUID NOT NULL,
+			controller_name VARCHAR(255) NOT NULL,
+			event_type VARCHAR(100) NOT NULL,
+			published_at TIMESTAMP NOT NULL DEFAULT NOW()
+		);
+	`)
+	utils.AssertError(t, err, false, "Should create purge test schema")
+}
+
+func countRows(t *testing.T, repo *Repository, query string, id uuid.UUID) int {
+	var count int
+	err := repo.GetClient().QueryRowContext(context.Background(), query, id).Scan(&count)
+	utils.AssertError(t, err, false, "Should count rows")
+	return count
+}
+
+func TestClustersRepository_Purge(t *testing.T) {
+	repo := setupTestRepository(t)
+	defer repo.Close()
+	setupPurgeTestSchema(t, repo)
+
+	cluster := createTestCluster()
+
+	ctx := context.Background()
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	// Purging a live cluster is rejected
+	err = repo.Clusters.Purge(ctx, cluster.ID)
+	utils.AssertEqual(t, models.ErrClusterNotDeleted, err, "Should reject purge of a live cluster")
+
+	// Populate related rows
+	nodepoolID := uuid.New()
+	_, err = repo.GetClient().ExecContext(ctx, `INSERT INTO nodepools (id, cluster_id, name) VALUES ($1, $2, 'np-1')`, nodepoolID, cluster.ID)
+	utils.AssertError(t, err, false, "Should create nodepool")
+	_, err = repo.GetClient().ExecContext(ctx, `INSERT INTO controller_status (cluster_id, controller_name) VALUES ($1, 'test-controller')`, cluster.ID)
+	utils.AssertError(t, err, false, "Should create controller status")
+	_, err = repo.GetClient().ExecContext(ctx, `INSERT INTO nodepool_controller_status (nodepool_id, controller_name) VALUES ($1, 'test-controller')`, nodepoolID)
+	utils.AssertError(t, err, false, "Should create nodepool controller status")
+	_, err = repo.GetClient().ExecContext(ctx, `INSERT INTO cluster_events (cluster_id, controller_name, event_type) VALUES ($1, 'test-controller', 'cluster.created')`, cluster.ID)
+	utils.AssertError(t, err, false, "Should create cluster event")
+
+	err = repo.Clusters.Delete(ctx, cluster.ID, "")
+	utils.AssertError(t, err, false, "Should soft delete cluster")
+
+	err = repo.Clusters.Purge(ctx, cluster.ID)
+	utils.AssertError(t, err, false, "Should purge soft-deleted cluster")
+
+	// Verify the cluster and all related rows are gone
+	utils.AssertEqual(t, 0, countRows(t, repo, `SELECT COUNT(*) FROM clusters WHERE id = $1`, cluster.ID), "Cluster row should be removed")
+	utils.AssertEqual(t, 0, countRows(t, repo, `SELECT COUNT(*) FROM nodepools WHERE cluster_id = $1`, cluster.ID), "Nodepools should be removed")
+	utils.AssertEqual(t, 0, countRows(t, repo, `SELECT COUNT(*) FROM controller_status WHERE cluster_id = $1`, cluster.ID), "Controller status should be removed")
+	utils.AssertEqual(t, 0, countRows(t, repo, `SELECT COUNT(*) FROM nodepool_controller_status WHERE nodepool_id = $1`, nodepoolID), "Nodepool controller status should be removed")
+	utils.AssertEqual(t, 0, countRows(t, repo, `SELECT COUNT(*) FROM cluster_events WHERE cluster_id = $1`, cluster.ID), "Cluster events should be removed")
+
+	// Purging again reports not found
+	err = repo.Clusters.Purge(ctx, cluster.ID)
+	utils.AssertEqual(t, models.ErrClusterNotFound, err, "Should return ErrClusterNotFound after purge")
+}
@@ -0,0 +1,68 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// IdempotencyRepository handles Idempotency-Key bookkeeping for create requests
+type IdempotencyRepository struct {
+	client *Client
+	logger *utils.Logger
+}
+
+// NewIdempotencyRepository creates a new idempotency repository
+func NewIdempotencyRepository(client *Client) *IdempotencyRepository {
+	return &IdempotencyRepository{
+		client: client,
+		logger: utils.NewLogger("idempotency_repository"),
+	}
+}
+
+// GetClusterID returns the cluster created with an unexpired idempotency key by a user
+func (r *IdempotencyRepository) GetClusterID(ctx context.Context, key, createdBy string) (uuid.UUID, error) {
+	query := `
+		SELECT cluster_id
+		FROM idempotency_keys
+		WHERE idempotency_key = $1 AND created_by = $2 AND expires_at > NOW()`
+
+	var clusterID uuid.UUID
+	err := r.client.QueryRowContext(ctx, query, key, createdBy).Scan(&clusterID)
+	if err == sql.ErrNoRows {
+		return uuid.Nil, models.ErrIdempotencyKeyNotFound
+	}
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	return clusterID, nil
+}
+
+// SaveClusterKey records the cluster created with an idempotency key. Expired keys are
+// swept first, so an expired key can be reused for a new cluster.
+func (r *IdempotencyRepository) SaveClusterKey(ctx context.Context, key, createdBy string, clusterID uuid.UUID, ttl time.Duration) error {
+	if _, err := r.client.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= NOW()`); err != nil {
+		return fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+
+	query := `
+		INSERT INTO idempotency_keys (idempotency_key, created_by, cluster_id, expires_at)
+		VALUES ($1, $2, $3, $4)`
+
+	if _, err := r.client.ExecContext(ctx, query, key, createdBy, clusterID, time.Now().Add(ttl)); err != nil {
+		r.logger.WithContext(ctx).Error("Failed to save idempotency key",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to save idempotency key: %w", err)
+	}
+
+	return nil
+}
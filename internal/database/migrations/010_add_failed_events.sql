@@ -0,0 +1,51 @@
+-- =============================================================================
+-- FAILED EVENTS (DEAD-LETTER) TABLE
+-- =============================================================================
+-- This migration adds a dead-letter table for reconciliation events that could
+-- not be delivered even after publish retries. It enables:
+--   1. An audit trail of lost reconciliation events
+--   2. Manual replay of failed events through the admin API
+--
+-- Migration: 010
+-- Created: 2026-10-14
+-- =============================================================================
+
+-- -----------------------------------------------------------------------------
+-- 1. Create failed_events table
+-- -----------------------------------------------------------------------------
+-- payload holds the serialized event exactly as it would have been published.
+-- There is no foreign key to clusters so the audit trail survives purges.
+
+CREATE TABLE IF NOT EXISTS failed_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_type VARCHAR(100) NOT NULL,
+    cluster_id UUID NOT NULL,
+    nodepool_id UUID,
+    payload JSONB NOT NULL,
+    error_message TEXT NOT NULL,
+    failed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    replayed_at TIMESTAMP WITH TIME ZONE
+);
+
+COMMENT ON TABLE failed_events IS
+    'Reconciliation events that failed to publish after retries were exhausted.';
+
+COMMENT ON COLUMN failed_events.replayed_at IS
+    'Set when the event has been successfully replayed; NULL while pending.';
+
+-- -----------------------------------------------------------------------------
+-- 2. Create partial index for pending events
+-- -----------------------------------------------------------------------------
+
+CREATE INDEX IF NOT EXISTS idx_failed_events_pending ON failed_events(failed_at DESC)
+    WHERE replayed_at IS NULL;
+
+-- -----------------------------------------------------------------------------
+-- 3. Migration complete
+-- -----------------------------------------------------------------------------
+-- Summary of changes:
+--   ✓ Added failed_events table
+--   ✓ Added partial index for pending failed events
+--
+-- Result: Undeliverable reconciliation events are persisted for audit and replay.
+-- =============================================================================
@@ -0,0 +1,38 @@
+-- =============================================================================
+-- ADD RECONCILIATION_PAUSED TO CLUSTERS TABLE
+-- =============================================================================
+-- This migration adds a per-cluster switch to pause periodic reconciliation,
+-- e.g. during maintenance. Paused clusters are kept as-is but the scheduler
+-- publishes no reconciliation events for them until they are resumed.
+--
+-- Migration: 011
+-- Created: 2026-10-14
+-- =============================================================================
+
+-- -----------------------------------------------------------------------------
+-- 1. Add reconciliation_paused column to clusters table
+-- -----------------------------------------------------------------------------
+
+ALTER TABLE clusters ADD COLUMN IF NOT EXISTS reconciliation_paused BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN clusters.reconciliation_paused IS
+    'When TRUE the reconciliation scheduler skips this cluster. Set via reconciliation:pause/resume.';
+
+-- -----------------------------------------------------------------------------
+-- 2. Create partial index for paused clusters
+-- -----------------------------------------------------------------------------
+-- Few clusters are paused at any time, so a partial index keeps the per-tick
+-- paused count cheap.
+
+CREATE INDEX IF NOT EXISTS idx_clusters_reconciliation_paused ON clusters(id)
+    WHERE reconciliation_paused = TRUE AND deleted_at IS NULL;
+
+-- -----------------------------------------------------------------------------
+-- 3. Migration complete
+-- -----------------------------------------------------------------------------
+-- Summary of changes:
+--   ✓ Added reconciliation_paused column to clusters table
+--   ✓ Added partial index for paused clusters
+--
+-- Result: Reconciliation can be paused and resumed per cluster.
+-- =============================================================================
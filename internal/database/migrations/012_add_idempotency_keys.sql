@@ -0,0 +1,47 @@
+-- =============================================================================
+-- IDEMPOTENCY KEYS TABLE
+-- =============================================================================
+-- This migration adds a table mapping client-supplied Idempotency-Key headers
+-- to the cluster they created. A retried POST /clusters with the same key
+-- returns the original cluster instead of attempting a second insert.
+--
+-- Migration: 012
+-- Created: 2026-10-14
+-- =============================================================================
+
+-- -----------------------------------------------------------------------------
+-- 1. Create idempotency_keys table
+-- -----------------------------------------------------------------------------
+-- Keys are scoped to the user who sent them, so two clients picking the same
+-- key never see each other's clusters.
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    idempotency_key VARCHAR(255) NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    cluster_id UUID NOT NULL REFERENCES clusters(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (idempotency_key, created_by)
+);
+
+COMMENT ON TABLE idempotency_keys IS
+    'Idempotency-Key headers of cluster create requests and the cluster each one created.';
+
+COMMENT ON COLUMN idempotency_keys.expires_at IS
+    'After this time the key is ignored and may be reused for a new cluster.';
+
+-- -----------------------------------------------------------------------------
+-- 2. Create index for expiry cleanup
+-- -----------------------------------------------------------------------------
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys(expires_at);
+
+-- -----------------------------------------------------------------------------
+-- 3. Migration complete
+-- -----------------------------------------------------------------------------
+-- Summary of changes:
+--   ✓ Added idempotency_keys table
+--   ✓ Added index for expired key cleanup
+--
+-- Result: Retried cluster creates with the same Idempotency-Key are safe.
+-- =============================================================================
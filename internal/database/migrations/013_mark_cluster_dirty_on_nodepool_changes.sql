@@ -0,0 +1,115 @@
+-- =============================================================================
+-- MARK CLUSTER STATUS DIRTY ON NODEPOOL CHANGES
+-- =============================================================================
+-- Cluster status now folds in the readiness of the cluster's nodepools as a
+-- NodePoolsReady condition. Migration 008 stopped nodepool controller status
+-- changes from marking the parent cluster dirty, so the cached cluster status
+-- would never see a nodepool become ready or fail. This migration marks the
+-- parent cluster dirty as well as the nodepool when the rollup can change.
+--
+-- Migration: 013
+-- Created: 2026-10-14
+-- =============================================================================
+
+-- -----------------------------------------------------------------------------
+-- 1. Mark the nodepool and its cluster dirty on controller status changes
+-- -----------------------------------------------------------------------------
+-- The cluster is only marked dirty when a report changes what the nodepool
+-- rollup counts: the observed generation, the Available condition or whether
+-- an error is reported. Controllers report periodically, so marking it on
+-- every report would rewrite the cluster row, and bump its updated_at, for
+-- reports that leave the rollup unchanged. A silent controller that reports
+-- again is picked up once the cached cluster status expires.
+
+CREATE OR REPLACE FUNCTION nodepool_status_available(p_conditions JSONB)
+RETURNS BOOLEAN AS $$
+    SELECT EXISTS (
+        SELECT 1
+        FROM jsonb_array_elements(COALESCE(p_conditions, '[]'::jsonb)) AS condition
+        WHERE condition->>'type' = 'Available' AND condition->>'status' = 'True'
+    );
+$$ LANGUAGE sql IMMUTABLE;
+
+COMMENT ON FUNCTION nodepool_status_available(JSONB) IS
+    'Whether nodepool controller conditions include Available=True, as counted by the cluster nodepool rollup';
+
+CREATE OR REPLACE FUNCTION mark_nodepool_status_dirty()
+RETURNS TRIGGER AS $$
+DECLARE
+    v_cluster_id UUID;
+BEGIN
+    -- Mark the nodepool as dirty so status aggregation will be triggered
+    UPDATE nodepools
+    SET status_dirty = TRUE, updated_at = NOW()
+    WHERE id = NEW.nodepool_id AND deleted_at IS NULL
+    RETURNING cluster_id INTO v_cluster_id;
+
+    -- Reports that leave the nodepool rollup unchanged don't touch the cluster
+    IF TG_OP = 'UPDATE'
+       AND OLD.observed_generation = NEW.observed_generation
+       AND (OLD.last_error IS NULL) = (NEW.last_error IS NULL)
+       AND nodepool_status_available(OLD.conditions) = nodepool_status_available(NEW.conditions) THEN
+        RETURN NEW;
+    END IF;
+
+    -- The cluster status includes the nodepool rollup
+    IF v_cluster_id IS NOT NULL THEN
+        UPDATE clusters
+        SET status_dirty = TRUE, updated_at = NOW()
+        WHERE id = v_cluster_id AND deleted_at IS NULL;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+COMMENT ON FUNCTION mark_nodepool_status_dirty() IS
+    'Marks nodepool dirty when controller status changes, and its cluster when the change affects the nodepool rollup';
+
+-- -----------------------------------------------------------------------------
+-- 2. Mark the cluster dirty when nodepools are added, changed or deleted
+-- -----------------------------------------------------------------------------
+-- A new nodepool or a generation bump leaves the nodepool not ready until its
+-- controllers report, and a deleted nodepool no longer counts.
+
+CREATE OR REPLACE FUNCTION mark_nodepool_cluster_status_dirty()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE clusters
+    SET status_dirty = TRUE, updated_at = NOW()
+    WHERE id = NEW.cluster_id AND deleted_at IS NULL;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS nodepool_cluster_status_dirty_trigger ON nodepools;
+
+CREATE TRIGGER nodepool_cluster_status_dirty_trigger
+    AFTER INSERT OR UPDATE OF generation, deleted_at ON nodepools
+    FOR EACH ROW
+    EXECUTE FUNCTION mark_nodepool_cluster_status_dirty();
+
+COMMENT ON TRIGGER nodepool_cluster_status_dirty_trigger ON nodepools IS
+    'Marks the parent cluster dirty when a nodepool is created, changed or deleted';
+
+-- -----------------------------------------------------------------------------
+-- 3. Backfill clusters with nodepools
+-- -----------------------------------------------------------------------------
+
+UPDATE clusters
+SET status_dirty = TRUE
+WHERE deleted_at IS NULL
+  AND EXISTS (SELECT 1 FROM nodepools np WHERE np.cluster_id = clusters.id AND np.deleted_at IS NULL);
+
+-- -----------------------------------------------------------------------------
+-- 4. Migration complete
+-- -----------------------------------------------------------------------------
+-- Summary of changes:
+--   ✓ Nodepool controller status changes that affect the rollup mark the parent
+--     cluster dirty
+--   ✓ Nodepool create, generation bump and delete mark the parent cluster dirty
+--   ✓ Existing clusters with nodepools marked dirty
+--
+-- Result: Cached cluster status keeps its NodePoolsReady condition current.
+-- =============================================================================
@@ -0,0 +1,30 @@
+-- =============================================================================
+-- ADD RECONCILIATION_INTERVAL TO CLUSTERS TABLE
+-- =============================================================================
+-- This migration adds an optional per-cluster reconciliation interval. When
+-- set, the scheduler considers the cluster due once this interval has passed
+-- since its last reconciliation, instead of using the health-aware
+-- healthy/unhealthy intervals of its reconciliation schedule.
+--
+-- Migration: 014
+-- Created: 2026-10-14
+-- =============================================================================
+
+-- -----------------------------------------------------------------------------
+-- 1. Add reconciliation_interval column to clusters table
+-- -----------------------------------------------------------------------------
+-- NULL keeps the default health-aware intervals.
+
+ALTER TABLE clusters ADD COLUMN IF NOT EXISTS reconciliation_interval INTERVAL NULL;
+
+COMMENT ON COLUMN clusters.reconciliation_interval IS
+    'Optional override of the reconciliation interval. Set via PUT /clusters/{id}/reconciliation.';
+
+-- -----------------------------------------------------------------------------
+-- 2. Migration complete
+-- -----------------------------------------------------------------------------
+-- Summary of changes:
+--   ✓ Added nullable reconciliation_interval column to clusters table
+--
+-- Result: Clusters can be reconciled more or less often than the defaults.
+-- =============================================================================
@@ -0,0 +1,69 @@
+-- =============================================================================
+-- AUDIT LOG TABLE
+-- =============================================================================
+-- This migration adds an append-only audit trail of write operations on
+-- clusters and nodepools. Each row records who made the change, what was
+-- changed, and a JSON diff of the spec before and after the change. Rows are
+-- written in the same transaction as the mutation they describe.
+--
+-- Migration: 015
+-- Created: 2026-10-14
+-- =============================================================================
+
+-- -----------------------------------------------------------------------------
+-- 1. Create audit_log table
+-- -----------------------------------------------------------------------------
+-- There is no foreign key to clusters or nodepools so the audit trail survives
+-- purges. diff maps each changed spec field path to its before/after values.
+
+CREATE TABLE IF NOT EXISTS audit_log (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    actor_email VARCHAR(255) NOT NULL,
+    action VARCHAR(20) NOT NULL CHECK (action IN ('create', 'update', 'delete')),
+    resource_type VARCHAR(50) NOT NULL,
+    resource_id UUID NOT NULL,
+    diff JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE audit_log IS
+    'Append-only audit trail of cluster and nodepool write operations.';
+
+COMMENT ON COLUMN audit_log.diff IS
+    'Changed spec field paths mapped to {"before": ..., "after": ...}.';
+
+-- -----------------------------------------------------------------------------
+-- 2. Create index for per-resource lookups
+-- -----------------------------------------------------------------------------
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_resource ON audit_log(resource_id, created_at DESC);
+
+-- -----------------------------------------------------------------------------
+-- 3. Make audit_log immutable
+-- -----------------------------------------------------------------------------
+-- Updates and deletes are rejected so recorded entries cannot be rewritten.
+
+CREATE OR REPLACE FUNCTION prevent_audit_log_modification()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS audit_log_immutable_trigger ON audit_log;
+
+CREATE TRIGGER audit_log_immutable_trigger
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_audit_log_modification();
+
+-- -----------------------------------------------------------------------------
+-- 4. Migration complete
+-- -----------------------------------------------------------------------------
+-- Summary of changes:
+--   ✓ Added audit_log table
+--   ✓ Added per-resource index
+--   ✓ Added trigger rejecting updates and deletes of audit entries
+--
+-- Result: Cluster and nodepool writes leave an immutable audit trail.
+-- =============================================================================
@@ -0,0 +1,29 @@
+-- =============================================================================
+-- ADD RELEASE IMAGE INDEX TO CLUSTERS TABLE
+-- =============================================================================
+-- This migration adds an expression index on the release image in the cluster
+-- spec. When a vulnerable release image is identified, security response can
+-- look up every cluster that uses it through GET /clusters/by-release-image
+-- without scanning the whole table.
+--
+-- Migration: 016
+-- Created: 2026-10-14
+-- =============================================================================
+
+-- -----------------------------------------------------------------------------
+-- 1. Create release image expression index
+-- -----------------------------------------------------------------------------
+-- The expression must match ClustersRepository.ListByReleaseImage exactly for
+-- the planner to use it.
+
+CREATE INDEX IF NOT EXISTS idx_clusters_release_image ON clusters ((spec->'release'->>'image'))
+    WHERE deleted_at IS NULL;
+
+-- -----------------------------------------------------------------------------
+-- 2. Migration complete
+-- -----------------------------------------------------------------------------
+-- Summary of changes:
+--   ✓ Added partial expression index on spec->'release'->>'image'
+--
+-- Result: Clusters can be looked up by release image efficiently.
+-- =============================================================================
@@ -0,0 +1,42 @@
+-- =============================================================================
+-- ADD REASON AND CLEAR_ERRORS ACTION TO AUDIT LOG
+-- =============================================================================
+-- This migration lets the audit log record operator actions that are not spec
+-- writes. Clearing a cluster's controller errors through
+-- POST /clusters/{id}/errors:clear is recorded with the new clear_errors
+-- action and the reason the operator gave for it.
+--
+-- Migration: 017
+-- Created: 2026-10-14
+-- =============================================================================
+
+-- -----------------------------------------------------------------------------
+-- 1. Add reason column
+-- -----------------------------------------------------------------------------
+-- Existing entries describe spec writes, which carry no reason.
+
+ALTER TABLE audit_log ADD COLUMN IF NOT EXISTS reason TEXT NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN audit_log.reason IS
+    'Operator-supplied justification for the action, empty for spec writes.';
+
+-- -----------------------------------------------------------------------------
+-- 2. Allow the clear_errors action
+-- -----------------------------------------------------------------------------
+-- The inline CHECK from migration 015 is named audit_log_action_check by
+-- PostgreSQL.
+
+ALTER TABLE audit_log DROP CONSTRAINT IF EXISTS audit_log_action_check;
+
+ALTER TABLE audit_log ADD CONSTRAINT audit_log_action_check
+    CHECK (action IN ('create', 'update', 'delete', 'clear_errors'));
+
+-- -----------------------------------------------------------------------------
+-- 3. Migration complete
+-- -----------------------------------------------------------------------------
+-- Summary of changes:
+--   ✓ Added audit_log.reason column
+--   ✓ Extended the action check with clear_errors
+--
+-- Result: Clearing controller errors leaves an audit entry with its reason.
+-- =============================================================================
@@ -0,0 +1,50 @@
+-- =============================================================================
+-- NODEPOOL EVENTS TABLE
+-- =============================================================================
+-- This migration gives nodepools the lifecycle event trail clusters already
+-- have in cluster_events. A row is written in the same transaction as every
+-- nodepool create, update, scale and delete, and the trail is served by
+-- GET /nodepools/{id}/events.
+--
+-- Migration: 018
+-- Created: 2026-10-14
+-- =============================================================================
+
+-- -----------------------------------------------------------------------------
+-- 1. Create nodepool_events table
+-- -----------------------------------------------------------------------------
+-- cluster_id is denormalized so a cluster's nodepool events can be found
+-- without joining nodepools. Events are removed with their nodepool when it is
+-- purged.
+
+CREATE TABLE IF NOT EXISTS nodepool_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    nodepool_id UUID NOT NULL REFERENCES nodepools(id) ON DELETE CASCADE,
+    cluster_id UUID NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    generation BIGINT NOT NULL DEFAULT 0,
+    metadata JSONB NOT NULL DEFAULT '{}',
+    published_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE nodepool_events IS
+    'Lifecycle events of nodepools, mirroring cluster_events.';
+
+COMMENT ON COLUMN nodepool_events.generation IS
+    'Nodepool generation after the change the event records.';
+
+-- -----------------------------------------------------------------------------
+-- 2. Create index for per-nodepool listing
+-- -----------------------------------------------------------------------------
+
+CREATE INDEX IF NOT EXISTS idx_nodepool_events_nodepool ON nodepool_events(nodepool_id, published_at DESC);
+
+-- -----------------------------------------------------------------------------
+-- 3. Migration complete
+-- -----------------------------------------------------------------------------
+-- Summary of changes:
+--   ✓ Added nodepool_events table
+--   ✓ Added per-nodepool index
+--
+-- Result: Nodepool lifecycle operations leave an event trail.
+-- =============================================================================
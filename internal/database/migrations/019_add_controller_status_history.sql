@@ -0,0 +1,51 @@
+-- =============================================================================
+-- CONTROLLER STATUS HISTORY TABLE
+-- =============================================================================
+-- This migration records how cluster controller conditions change over time.
+-- controller_status only holds the latest report; a row is added here whenever
+-- a report changes the status of one of the controller's conditions, and the
+-- trail is served by GET /clusters/{id}/status/history. Rows beyond the
+-- configured per-controller limit are trimmed, oldest first, as new ones are
+-- recorded.
+--
+-- Migration: 019
+-- Created: 2026-10-14
+-- =============================================================================
+
+-- -----------------------------------------------------------------------------
+-- 1. Create controller_status_history table
+-- -----------------------------------------------------------------------------
+-- from_status is empty for the first report of a condition.
+
+CREATE TABLE IF NOT EXISTS controller_status_history (
+    id BIGSERIAL PRIMARY KEY,
+    cluster_id UUID NOT NULL REFERENCES clusters(id) ON DELETE CASCADE,
+    controller_name VARCHAR(255) NOT NULL,
+    condition_type TEXT NOT NULL,
+    from_status TEXT NOT NULL DEFAULT '',
+    to_status TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    message TEXT NOT NULL DEFAULT '',
+    observed_generation BIGINT NOT NULL DEFAULT 0,
+    transitioned_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE controller_status_history IS
+    'Condition status changes reported by cluster controllers, capped per controller.';
+
+-- -----------------------------------------------------------------------------
+-- 2. Create index for per-controller listing and trimming
+-- -----------------------------------------------------------------------------
+
+CREATE INDEX IF NOT EXISTS idx_controller_status_history_controller
+    ON controller_status_history(cluster_id, controller_name, id DESC);
+
+-- -----------------------------------------------------------------------------
+-- 3. Migration complete
+-- -----------------------------------------------------------------------------
+-- Summary of changes:
+--   ✓ Added controller_status_history table
+--   ✓ Added per-controller index
+--
+-- Result: Cluster controller condition changes leave a bounded history.
+-- =============================================================================
@@ -0,0 +1,46 @@
+-- =============================================================================
+-- CLUSTER GRANTS TABLE
+-- =============================================================================
+-- This migration lets cluster owners share read access with other users.
+-- Clusters are otherwise only visible to the user in created_by (and to
+-- controllers); a row here makes the cluster and its status readable by the
+-- grantee as well. Grants are managed by the owner through
+-- /clusters/{id}/grants and go away with the cluster.
+--
+-- Migration: 020
+-- Created: 2026-10-14
+-- =============================================================================
+
+-- -----------------------------------------------------------------------------
+-- 1. Create cluster_grants table
+-- -----------------------------------------------------------------------------
+-- A user holds at most one grant per cluster; granting again replaces the role.
+
+CREATE TABLE IF NOT EXISTS cluster_grants (
+    cluster_id UUID NOT NULL REFERENCES clusters(id) ON DELETE CASCADE,
+    grantee_email VARCHAR(255) NOT NULL,
+    role VARCHAR(50) NOT NULL DEFAULT 'viewer',
+    created_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (cluster_id, grantee_email)
+);
+
+COMMENT ON TABLE cluster_grants IS
+    'Read access to clusters shared by their owners with other users.';
+
+-- -----------------------------------------------------------------------------
+-- 2. Create index for listing the clusters shared with a user
+-- -----------------------------------------------------------------------------
+
+CREATE INDEX IF NOT EXISTS idx_cluster_grants_grantee
+    ON cluster_grants(grantee_email);
+
+-- -----------------------------------------------------------------------------
+-- 3. Migration complete
+-- -----------------------------------------------------------------------------
+-- Summary of changes:
+--   ✓ Added cluster_grants table
+--   ✓ Added grantee index
+--
+-- Result: Cluster owners can share read access with other users.
+-- =============================================================================
@@ -0,0 +1,38 @@
+-- =============================================================================
+-- ADD RECONCILIATION_PAUSED TO NODEPOOLS TABLE
+-- =============================================================================
+-- This migration adds a per-nodepool switch to pause periodic reconciliation,
+-- e.g. while draining or debugging a single pool. Unlike the cluster switch it
+-- leaves the parent cluster and its sibling nodepools untouched.
+--
+-- Migration: 021
+-- Created: 2026-10-14
+-- =============================================================================
+
+-- -----------------------------------------------------------------------------
+-- 1. Add reconciliation_paused column to nodepools table
+-- -----------------------------------------------------------------------------
+
+ALTER TABLE nodepools ADD COLUMN IF NOT EXISTS reconciliation_paused BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN nodepools.reconciliation_paused IS
+    'When TRUE the reconciliation scheduler skips this nodepool. Set via reconciliation:pause/resume.';
+
+-- -----------------------------------------------------------------------------
+-- 2. Create partial index for paused nodepools
+-- -----------------------------------------------------------------------------
+-- Few nodepools are paused at any time, so a partial index keeps the per-tick
+-- paused count cheap.
+
+CREATE INDEX IF NOT EXISTS idx_nodepools_reconciliation_paused ON nodepools(id)
+    WHERE reconciliation_paused = TRUE AND deleted_at IS NULL;
+
+-- -----------------------------------------------------------------------------
+-- 3. Migration complete
+-- -----------------------------------------------------------------------------
+-- Summary of changes:
+--   ✓ Added reconciliation_paused column to nodepools table
+--   ✓ Added partial index for paused nodepools
+--
+-- Result: Reconciliation can be paused and resumed per nodepool.
+-- =============================================================================
@@ -0,0 +1,60 @@
+-- =============================================================================
+-- LET BATCH STATUS REPORTS MARK THE CLUSTER DIRTY ONCE
+-- =============================================================================
+-- This migration lets a transaction turn off the per-row dirty marking done by
+-- mark_cluster_status_dirty(). A batch status report for several controllers
+-- would otherwise update the cluster row once per controller; instead it sets
+-- cls.defer_status_dirty for its transaction and marks the cluster dirty itself
+-- after the last report. The setting is transaction-local, so every other
+-- writer keeps the per-row behavior.
+--
+-- Migration: 022
+-- Created: 2026-10-14
+-- =============================================================================
+
+-- -----------------------------------------------------------------------------
+-- 1. Skip dirty marking while the transaction defers it
+-- -----------------------------------------------------------------------------
+
+CREATE OR REPLACE FUNCTION mark_cluster_status_dirty()
+RETURNS TRIGGER AS $$
+DECLARE
+    v_cluster_id UUID;
+BEGIN
+    -- The transaction marks the cluster dirty itself once it is done
+    IF current_setting('cls.defer_status_dirty', true) = 'on' THEN
+        RETURN NEW;
+    END IF;
+
+    -- For controller_status table, cluster_id is directly available
+    IF TG_TABLE_NAME = 'controller_status' THEN
+        UPDATE clusters
+        SET status_dirty = TRUE, updated_at = NOW()
+        WHERE id = NEW.cluster_id;
+    -- For nodepool_controller_status table, get cluster_id via nodepool
+    ELSIF TG_TABLE_NAME = 'nodepool_controller_status' THEN
+        SELECT cluster_id INTO v_cluster_id
+        FROM nodepools
+        WHERE id = NEW.nodepool_id;
+
+        IF v_cluster_id IS NOT NULL THEN
+            UPDATE clusters
+            SET status_dirty = TRUE, updated_at = NOW()
+            WHERE id = v_cluster_id;
+        END IF;
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+COMMENT ON FUNCTION mark_cluster_status_dirty() IS 'Marks cluster as dirty when controller status changes, unless the transaction set cls.defer_status_dirty to mark it once itself. Handles both controller_status (direct cluster_id) and nodepool_controller_status (lookup via nodepools table)';
+
+-- -----------------------------------------------------------------------------
+-- 2. Migration complete
+-- -----------------------------------------------------------------------------
+-- Summary of changes:
+--   ✓ mark_cluster_status_dirty() honors cls.defer_status_dirty
+--
+-- Result: A batch status report marks its cluster dirty once.
+-- =============================================================================
@@ -9,6 +9,7 @@ import (
 	"github.com/apahim/cls-backend/internal/models"
 	"github.com/apahim/cls-backend/internal/utils"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 )
 
@@ -24,7 +25,7 @@ func NewNodePoolsRepository(client *Client) *NodePoolsRepository {
 	return &NodePoolsRepository{
 		client:           client,
 		logger:           utils.NewLogger("nodepools_repo"),
-		statusAggregator: NewStatusAggregator(client), // Initialize status aggregator
+		statusAggregator: NewStatusAggregator(client, nil), // Initialize status aggregator
 	}
 }
 
@@ -59,7 +60,7 @@ func (r *NodePoolsRepository) Create(ctx context.Context, nodepool *models.NodeP
 	)
 
 	if err != nil {
-		r.logger.Error("Failed to create nodepool",
+		r.logger.WithContext(ctx).Error("Failed to create nodepool",
 			zap.String("nodepool_name", nodepool.Name),
 			zap.String("cluster_id", nodepool.ClusterID.String()),
 			zap.Error(err),
@@ -67,7 +68,7 @@ func (r *NodePoolsRepository) Create(ctx context.Context, nodepool *models.NodeP
 		return fmt.Errorf("failed to create nodepool: %w", err)
 	}
 
-	r.logger.Info("NodePool created successfully",
+	r.logger.WithContext(ctx).Info("NodePool created successfully",
 		zap.String("nodepool_id", nodepool.ID.String()),
 		zap.String("nodepool_name", nodepool.Name),
 		zap.String("cluster_id", nodepool.ClusterID.String()),
@@ -106,7 +107,7 @@ func (r *NodePoolsRepository) GetByID(ctx context.Context, id uuid.UUID, created
 		return nil, models.ErrNodePoolNotFound
 	}
 	if err != nil {
-		r.logger.Error("Failed to get nodepool by ID",
+		r.logger.WithContext(ctx).Error("Failed to get nodepool by ID",
 			zap.String("nodepool_id", id.String()),
 			zap.Error(err),
 		)
@@ -115,7 +116,7 @@ func (r *NodePoolsRepository) GetByID(ctx context.Context, id uuid.UUID, created
 
 	// Enrich with real-time status if dirty
 	if err := r.statusAggregator.EnrichNodePoolWithStatus(ctx, &nodepool); err != nil {
-		r.logger.Warn("Failed to enrich nodepool with status",
+		r.logger.WithContext(ctx).Warn("Failed to enrich nodepool with status",
 			zap.String("nodepool_id", id.String()),
 			zap.Error(err))
 		// Continue without failing - return nodepool with existing status
@@ -153,7 +154,7 @@ func (r *NodePoolsRepository) GetByIDInternal(ctx context.Context, id uuid.UUID)
 		return nil, models.ErrNodePoolNotFound
 	}
 	if err != nil {
-		r.logger.Error("Failed to get nodepool by ID (internal)",
+		r.logger.WithContext(ctx).Error("Failed to get nodepool by ID (internal)",
 			zap.String("nodepool_id", id.String()),
 			zap.Error(err),
 		)
@@ -162,7 +163,7 @@ func (r *NodePoolsRepository) GetByIDInternal(ctx context.Context, id uuid.UUID)
 
 	// Enrich with real-time status if dirty
 	if err := r.statusAggregator.EnrichNodePoolWithStatus(ctx, &nodepool); err != nil {
-		r.logger.Warn("Failed to enrich nodepool with status",
+		r.logger.WithContext(ctx).Warn("Failed to enrich nodepool with status",
 			zap.String("nodepool_id", id.String()),
 			zap.Error(err))
 		// Continue without failing - return nodepool with existing status
@@ -201,7 +202,7 @@ func (r *NodePoolsRepository) GetByClusterAndName(ctx context.Context, clusterID
 		return nil, models.ErrNodePoolNotFound
 	}
 	if err != nil {
-		r.logger.Error("Failed to get nodepool by cluster and name",
+		r.logger.WithContext(ctx).Error("Failed to get nodepool by cluster and name",
 			zap.String("cluster_id", clusterID.String()),
 			zap.String("nodepool_name", name),
 			zap.Error(err),
@@ -211,7 +212,7 @@ func (r *NodePoolsRepository) GetByClusterAndName(ctx context.Context, clusterID
 
 	// Enrich with real-time status if dirty
 	if err := r.statusAggregator.EnrichNodePoolWithStatus(ctx, &nodepool); err != nil {
-		r.logger.Warn("Failed to enrich nodepool with status",
+		r.logger.WithContext(ctx).Warn("Failed to enrich nodepool with status",
 			zap.String("nodepool_id", nodepool.ID.String()),
 			zap.Error(err))
 		// Continue without failing - return nodepool with existing status
@@ -220,6 +221,122 @@ func (r *NodePoolsRepository) GetByClusterAndName(ctx context.Context, clusterID
 	return &nodepool, nil
 }
 
+// GetByClusterAndNameInternal retrieves a nodepool by cluster ID and name without client isolation
+// This should only be used for internal operations like controllers
+func (r *NodePoolsRepository) GetByClusterAndNameInternal(ctx context.Context, clusterID uuid.UUID, name string) (*models.NodePool, error) {
+	query := `
+		SELECT np.id, np.cluster_id, np.name, np.created_by, np.generation, np.resource_version, np.spec,
+			   np.status, np.status_dirty,
+			   np.created_at, np.updated_at, np.deleted_at
+		FROM nodepools np
+		INNER JOIN clusters c ON np.cluster_id = c.id
+		WHERE np.cluster_id = $1 AND np.name = $2 AND np.deleted_at IS NULL AND c.deleted_at IS NULL`
+
+	var nodepool models.NodePool
+	err := r.client.QueryRowContext(ctx, query, clusterID, name).Scan(
+		&nodepool.ID,
+		&nodepool.ClusterID,
+		&nodepool.Name,
+		&nodepool.CreatedBy,
+		&nodepool.Generation,
+		&nodepool.ResourceVersion,
+		&nodepool.Spec,
+		&nodepool.Status,
+		&nodepool.StatusDirty,
+		&nodepool.CreatedAt,
+		&nodepool.UpdatedAt,
+		&nodepool.DeletedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, models.ErrNodePoolNotFound
+	}
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to get nodepool by cluster and name (internal)",
+			zap.String("cluster_id", clusterID.String()),
+			zap.String("nodepool_name", name),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get nodepool: %w", err)
+	}
+
+	// Enrich with real-time status if dirty
+	if err := r.statusAggregator.EnrichNodePoolWithStatus(ctx, &nodepool); err != nil {
+		r.logger.WithContext(ctx).Warn("Failed to enrich nodepool with status",
+			zap.String("nodepool_id", nodepool.ID.String()),
+			zap.Error(err))
+		// Continue without failing - return nodepool with existing status
+	}
+
+	return &nodepool, nil
+}
+
+// appendNodePoolFilters adds the list filters in opts to a nodepool query over "np", with
+// args holding the query's existing arguments. Nodepools whose status has never been
+// calculated match the Pending phase.
+func appendNodePoolFilters(query string, args []interface{}, opts *models.ListOptions) (string, []interface{}) {
+	if opts != nil && len(opts.Status) > 0 {
+		args = append(args, pq.Array(opts.Status))
+		query += fmt.Sprintf(" AND COALESCE(np.status->>'phase', 'Pending') = ANY($%d)", len(args))
+	}
+	return query, args
+}
+
+// refreshDirtyStatuses recalculates and caches the status of every dirty nodepool in the
+// clusters of createdBy matching the given condition (with createdBy as $1), so that
+// filtering on the cached phase sees current values
+func (r *NodePoolsRepository) refreshDirtyStatuses(ctx context.Context, condition string, createdBy string, args ...interface{}) error {
+	query := `
+		SELECT np.id, np.cluster_id, np.name, np.created_by, np.generation, np.resource_version, np.spec,
+			   np.status, np.status_dirty,
+			   np.created_at, np.updated_at, np.deleted_at
+		FROM nodepools np
+		INNER JOIN clusters c ON np.cluster_id = c.id
+		WHERE np.status_dirty = TRUE AND np.deleted_at IS NULL AND c.deleted_at IS NULL
+		  AND c.created_by = $1` + condition
+
+	rows, err := r.client.QueryContext(ctx, query, append([]interface{}{createdBy}, args...)...)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to get dirty nodepools for refresh", zap.Error(err))
+		return fmt.Errorf("failed to get dirty nodepools: %w", err)
+	}
+	defer rows.Close()
+
+	var nodepools []*models.NodePool
+	for rows.Next() {
+		var nodepool models.NodePool
+		if err := rows.Scan(
+			&nodepool.ID,
+			&nodepool.ClusterID,
+			&nodepool.Name,
+			&nodepool.CreatedBy,
+			&nodepool.Generation,
+			&nodepool.ResourceVersion,
+			&nodepool.Spec,
+			&nodepool.Status,
+			&nodepool.StatusDirty,
+			&nodepool.CreatedAt,
+			&nodepool.UpdatedAt,
+			&nodepool.DeletedAt,
+		); err != nil {
+			r.logger.WithContext(ctx).Error("Failed to scan dirty nodepool row", zap.Error(err))
+			return fmt.Errorf("failed to scan nodepool: %w", err)
+		}
+		nodepools = append(nodepools, &nodepool)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating dirty nodepools: %w", err)
+	}
+
+	// Recalculating caches the status and clears the dirty flag
+	if err := r.statusAggregator.EnrichNodePoolsWithStatus(ctx, nodepools); err != nil {
+		r.logger.WithContext(ctx).Warn("Failed to refresh some dirty nodepool statuses", zap.Error(err))
+	}
+
+	return nil
+}
+
 // ListByCluster retrieves all nodepools for a cluster with client isolation
 func (r *NodePoolsRepository) ListByCluster(ctx context.Context, clusterID uuid.UUID, createdBy string, opts *models.ListOptions) ([]*models.NodePool, error) {
 	baseQuery := `
@@ -230,22 +347,15 @@ func (r *NodePoolsRepository) ListByCluster(ctx context.Context, clusterID uuid.
 		INNER JOIN clusters c ON np.cluster_id = c.id
 		WHERE np.cluster_id = $1 AND c.created_by = $2 AND np.deleted_at IS NULL AND c.deleted_at IS NULL`
 
-	var args []interface{}
-	args = append(args, clusterID, createdBy)
-	var conditions []string
-	argIndex := 3
-
-	// Note: Status and Health filters removed - use status.phase instead if needed
-
-	// Build the complete query
-	query := baseQuery
-	if len(conditions) > 0 {
-		query += " AND " + conditions[0]
-		for _, condition := range conditions[1:] {
-			query += " AND " + condition
+	if opts != nil && len(opts.Status) > 0 {
+		if err := r.refreshDirtyStatuses(ctx, " AND np.cluster_id = $2", createdBy, clusterID); err != nil {
+			return nil, err
 		}
 	}
 
+	query, args := appendNodePoolFilters(baseQuery, []interface{}{clusterID, createdBy}, opts)
+	argIndex := len(args) + 1
+
 	// Add ordering
 	query += " ORDER BY created_at DESC"
 
@@ -265,7 +375,7 @@ func (r *NodePoolsRepository) ListByCluster(ctx context.Context, clusterID uuid.
 
 	rows, err := r.client.QueryContext(ctx, query, args...)
 	if err != nil {
-		r.logger.Error("Failed to list nodepools by cluster",
+		r.logger.WithContext(ctx).Error("Failed to list nodepools by cluster",
 			zap.String("cluster_id", clusterID.String()),
 			zap.Error(err),
 		)
@@ -291,20 +401,80 @@ func (r *NodePoolsRepository) ListByCluster(ctx context.Context, clusterID uuid.
 			&nodepool.DeletedAt,
 		)
 		if err != nil {
-			r.logger.Error("Failed to scan nodepool row", zap.Error(err))
+			r.logger.WithContext(ctx).Error("Failed to scan nodepool row", zap.Error(err))
 			return nil, fmt.Errorf("failed to scan nodepool: %w", err)
 		}
 		nodepools = append(nodepools, &nodepool)
 	}
 
 	if err = rows.Err(); err != nil {
-		r.logger.Error("Error iterating nodepool rows", zap.Error(err))
+		r.logger.WithContext(ctx).Error("Error iterating nodepool rows", zap.Error(err))
 		return nil, fmt.Errorf("error iterating nodepools: %w", err)
 	}
 
 	// Enrich all nodepools with real-time status (batch operation)
 	if err := r.statusAggregator.EnrichNodePoolsWithStatus(ctx, nodepools); err != nil {
-		r.logger.Warn("Failed to enrich nodepools with status",
+		r.logger.WithContext(ctx).Warn("Failed to enrich nodepools with status",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err))
+		// Continue without failing - return nodepools with existing status
+	}
+
+	return nodepools, nil
+}
+
+// ListByClusterInternal retrieves all nodepools of a cluster without client isolation (for controllers)
+func (r *NodePoolsRepository) ListByClusterInternal(ctx context.Context, clusterID uuid.UUID) ([]*models.NodePool, error) {
+	query := `
+		SELECT id, cluster_id, name, created_by, generation, resource_version, spec,
+		       status, status_dirty,
+		       created_at, updated_at, deleted_at
+		FROM nodepools
+		WHERE cluster_id = $1 AND deleted_at IS NULL
+		ORDER BY name`
+
+	rows, err := r.client.QueryContext(ctx, query, clusterID)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to list nodepools by cluster (internal)",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to list nodepools: %w", err)
+	}
+	defer rows.Close()
+
+	nodepools := []*models.NodePool{}
+	for rows.Next() {
+		var nodepool models.NodePool
+		err := rows.Scan(
+			&nodepool.ID,
+			&nodepool.ClusterID,
+			&nodepool.Name,
+			&nodepool.CreatedBy,
+			&nodepool.Generation,
+			&nodepool.ResourceVersion,
+			&nodepool.Spec,
+			&nodepool.Status,
+			&nodepool.StatusDirty,
+			&nodepool.CreatedAt,
+			&nodepool.UpdatedAt,
+			&nodepool.DeletedAt,
+		)
+		if err != nil {
+			r.logger.WithContext(ctx).Error("Failed to scan nodepool row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan nodepool: %w", err)
+		}
+		nodepools = append(nodepools, &nodepool)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.WithContext(ctx).Error("Error iterating nodepool rows", zap.Error(err))
+		return nil, fmt.Errorf("error iterating nodepools: %w", err)
+	}
+
+	// Enrich all nodepools with real-time status (batch operation)
+	if err := r.statusAggregator.EnrichNodePoolsWithStatus(ctx, nodepools); err != nil {
+		r.logger.WithContext(ctx).Warn("Failed to enrich nodepools with status",
 			zap.String("cluster_id", clusterID.String()),
 			zap.Error(err))
 		// Continue without failing - return nodepools with existing status
@@ -323,22 +493,15 @@ func (r *NodePoolsRepository) List(ctx context.Context, createdBy string, opts *
 		INNER JOIN clusters c ON np.cluster_id = c.id
 		WHERE np.deleted_at IS NULL AND c.deleted_at IS NULL AND c.created_by = $1`
 
-	var args []interface{}
-	args = append(args, createdBy)
-	var conditions []string
-	argIndex := 2 // Start at 2 because $1 is createdBy
-
-	// Note: Status and Health filters removed - use status.phase instead if needed
-
-	// Build the complete query
-	query := baseQuery
-	if len(conditions) > 0 {
-		query += " AND " + conditions[0]
-		for _, condition := range conditions[1:] {
-			query += " AND " + condition
+	if opts != nil && len(opts.Status) > 0 {
+		if err := r.refreshDirtyStatuses(ctx, "", createdBy); err != nil {
+			return nil, err
 		}
 	}
 
+	query, args := appendNodePoolFilters(baseQuery, []interface{}{createdBy}, opts)
+	argIndex := len(args) + 1
+
 	// Add ordering
 	query += " ORDER BY created_at DESC"
 
@@ -358,7 +521,7 @@ func (r *NodePoolsRepository) List(ctx context.Context, createdBy string, opts *
 
 	rows, err := r.client.QueryContext(ctx, query, args...)
 	if err != nil {
-		r.logger.Error("Failed to list nodepools", zap.Error(err))
+		r.logger.WithContext(ctx).Error("Failed to list nodepools", zap.Error(err))
 		return nil, fmt.Errorf("failed to list nodepools: %w", err)
 	}
 	defer rows.Close()
@@ -381,20 +544,20 @@ func (r *NodePoolsRepository) List(ctx context.Context, createdBy string, opts *
 			&nodepool.DeletedAt,
 		)
 		if err != nil {
-			r.logger.Error("Failed to scan nodepool row", zap.Error(err))
+			r.logger.WithContext(ctx).Error("Failed to scan nodepool row", zap.Error(err))
 			return nil, fmt.Errorf("failed to scan nodepool: %w", err)
 		}
 		nodepools = append(nodepools, &nodepool)
 	}
 
 	if err = rows.Err(); err != nil {
-		r.logger.Error("Error iterating nodepool rows", zap.Error(err))
+		r.logger.WithContext(ctx).Error("Error iterating nodepool rows", zap.Error(err))
 		return nil, fmt.Errorf("error iterating nodepools: %w", err)
 	}
 
 	// Enrich all nodepools with real-time status (batch operation)
 	if err := r.statusAggregator.EnrichNodePoolsWithStatus(ctx, nodepools); err != nil {
-		r.logger.Warn("Failed to enrich nodepools with status", zap.Error(err))
+		r.logger.WithContext(ctx).Warn("Failed to enrich nodepools with status", zap.Error(err))
 		// Continue without failing - return nodepools with existing status
 	}
 
@@ -424,7 +587,7 @@ func (r *NodePoolsRepository) Update(ctx context.Context, nodepool *models.NodeP
 	)
 
 	if err != nil {
-		r.logger.Error("Failed to update nodepool",
+		r.logger.WithContext(ctx).Error("Failed to update nodepool",
 			zap.String("nodepool_id", nodepool.ID.String()),
 			zap.Error(err),
 		)
@@ -440,7 +603,7 @@ func (r *NodePoolsRepository) Update(ctx context.Context, nodepool *models.NodeP
 		return models.ErrNodePoolNotFound
 	}
 
-	r.logger.Info("NodePool updated successfully",
+	r.logger.WithContext(ctx).Info("NodePool updated successfully",
 		zap.String("nodepool_id", nodepool.ID.String()),
 		zap.String("nodepool_name", nodepool.Name),
 	)
@@ -449,12 +612,13 @@ func (r *NodePoolsRepository) Update(ctx context.Context, nodepool *models.NodeP
 }
 
 // UpdateStatus is deprecated - status is now managed through controller_status table
-// This method is kept for backwards compatibility but does nothing
+// It always returns models.ErrStatusUpdateDeprecated so callers don't mistake it for a
+// successful update; use StatusRepository.UpsertNodePoolControllerStatus instead
 func (r *NodePoolsRepository) UpdateStatus(ctx context.Context, id uuid.UUID, status, health string) error {
-	r.logger.Warn("UpdateStatus called with deprecated overall_status/overall_health fields - ignoring",
+	r.logger.WithContext(ctx).Warn("UpdateStatus called with deprecated overall_status/overall_health fields - rejecting",
 		zap.String("nodepool_id", id.String()),
 	)
-	return nil
+	return models.ErrStatusUpdateDeprecated
 }
 
 // Delete performs a soft delete of a nodepool with client isolation
@@ -467,7 +631,7 @@ func (r *NodePoolsRepository) Delete(ctx context.Context, id uuid.UUID, createdB
 
 	result, err := r.client.ExecContext(ctx, query, id, createdBy)
 	if err != nil {
-		r.logger.Error("Failed to delete nodepool",
+		r.logger.WithContext(ctx).Error("Failed to delete nodepool",
 			zap.String("nodepool_id", id.String()),
 			zap.Error(err),
 		)
@@ -483,42 +647,177 @@ func (r *NodePoolsRepository) Delete(ctx context.Context, id uuid.UUID, createdB
 		return models.ErrNodePoolNotFound
 	}
 
-	r.logger.Info("NodePool deleted successfully",
+	r.logger.WithContext(ctx).Info("NodePool deleted successfully",
+		zap.String("nodepool_id", id.String()),
+	)
+
+	return nil
+}
+
+// MarkDirtyStatus marks a nodepool's status and its cluster's status as dirty, requiring
+// recalculation. Deleting controller status does not fire the dirty triggers.
+func (r *NodePoolsRepository) MarkDirtyStatus(ctx context.Context, id uuid.UUID) error {
+	query := `
+		WITH nodepool AS (
+			UPDATE nodepools
+			SET status_dirty = TRUE, updated_at = NOW()
+			WHERE id = $1 AND deleted_at IS NULL
+			RETURNING cluster_id
+		), cluster AS (
+			UPDATE clusters
+			SET status_dirty = TRUE, updated_at = NOW()
+			WHERE id IN (SELECT cluster_id FROM nodepool) AND deleted_at IS NULL
+		)
+		SELECT COUNT(*) FROM nodepool`
+
+	var rowsAffected int64
+	if err := r.client.QueryRowContext(ctx, query, id).Scan(&rowsAffected); err != nil {
+		r.logger.WithContext(ctx).Error("Failed to mark nodepool status as dirty",
+			zap.String("nodepool_id", id.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to mark nodepool status as dirty: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrNodePoolNotFound
+	}
+
+	r.logger.WithContext(ctx).Debug("Marked nodepool status as dirty",
 		zap.String("nodepool_id", id.String()),
 	)
 
 	return nil
 }
 
-// DeleteByCluster deletes all nodepools for a cluster (soft delete)
-func (r *NodePoolsRepository) DeleteByCluster(ctx context.Context, clusterID uuid.UUID) error {
+// DeleteByCluster deletes all nodepools for a cluster (soft delete) and returns the
+// nodepools it deleted, carrying their ID, cluster ID and generation
+func (r *NodePoolsRepository) DeleteByCluster(ctx context.Context, clusterID uuid.UUID) ([]*models.NodePool, error) {
 	query := `
 		UPDATE nodepools
 		SET deleted_at = NOW(), updated_at = NOW()
-		WHERE cluster_id = $1 AND deleted_at IS NULL`
+		WHERE cluster_id = $1 AND deleted_at IS NULL
+		RETURNING id, cluster_id, generation`
 
-	result, err := r.client.ExecContext(ctx, query, clusterID)
+	rows, err := r.client.QueryContext(ctx, query, clusterID)
 	if err != nil {
-		r.logger.Error("Failed to delete nodepools by cluster",
+		r.logger.WithContext(ctx).Error("Failed to delete nodepools by cluster",
 			zap.String("cluster_id", clusterID.String()),
 			zap.Error(err),
 		)
-		return fmt.Errorf("failed to delete nodepools by cluster: %w", err)
+		return nil, fmt.Errorf("failed to delete nodepools by cluster: %w", err)
 	}
+	defer rows.Close()
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+	var deleted []*models.NodePool
+	for rows.Next() {
+		var nodepool models.NodePool
+		if err := rows.Scan(&nodepool.ID, &nodepool.ClusterID, &nodepool.Generation); err != nil {
+			return nil, fmt.Errorf("failed to scan deleted nodepool: %w", err)
+		}
+		deleted = append(deleted, &nodepool)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating deleted nodepools: %w", err)
 	}
 
-	r.logger.Info("NodePools deleted successfully",
+	r.logger.WithContext(ctx).Info("NodePools deleted successfully",
 		zap.String("cluster_id", clusterID.String()),
-		zap.Int64("rows_affected", rowsAffected),
+		zap.Int("rows_affected", len(deleted)),
 	)
 
+	return deleted, nil
+}
+
+// ExistsIncludingDeleted reports ErrNodePoolNotFound unless the nodepool exists, live or
+// soft-deleted, in a cluster owned by createdBy. It lets a nodepool's history stay readable
+// after the nodepool is deleted.
+func (r *NodePoolsRepository) ExistsIncludingDeleted(ctx context.Context, id uuid.UUID, createdBy string) error {
+	query := `
+		SELECT 1
+		FROM nodepools np
+		INNER JOIN clusters c ON np.cluster_id = c.id
+		WHERE np.id = $1 AND c.created_by = $2`
+
+	return r.exists(ctx, query, id, createdBy)
+}
+
+// ExistsIncludingDeletedInternal reports ErrNodePoolNotFound unless the nodepool exists,
+// live or soft-deleted (internal use, no client isolation)
+func (r *NodePoolsRepository) ExistsIncludingDeletedInternal(ctx context.Context, id uuid.UUID) error {
+	return r.exists(ctx, `SELECT 1 FROM nodepools WHERE id = $1`, id)
+}
+
+// exists runs a single-row existence query for a nodepool
+func (r *NodePoolsRepository) exists(ctx context.Context, query string, id uuid.UUID, args ...interface{}) error {
+	var found int
+	err := r.client.QueryRowContext(ctx, query, append([]interface{}{id}, args...)...).Scan(&found)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.ErrNodePoolNotFound
+		}
+		r.logger.WithContext(ctx).Error("Failed to check nodepool existence",
+			zap.String("nodepool_id", id.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to check nodepool: %w", err)
+	}
 	return nil
 }
 
+// FindOrphaned retrieves live nodepools whose cluster no longer has a live row: the cluster
+// row is missing or the cluster was soft-deleted without its nodepools. Neither happens
+// through the API, so any result points at a data-integrity problem. Orphans are returned
+// as stored, without status enrichment, oldest first.
+func (r *NodePoolsRepository) FindOrphaned(ctx context.Context) ([]*models.NodePool, error) {
+	query := `
+		SELECT np.id, np.cluster_id, np.name, np.created_by, np.generation, np.resource_version, np.spec,
+			   np.status, np.status_dirty,
+			   np.created_at, np.updated_at, np.deleted_at
+		FROM nodepools np
+		LEFT JOIN clusters c ON np.cluster_id = c.id
+		WHERE np.deleted_at IS NULL AND (c.id IS NULL OR c.deleted_at IS NOT NULL)
+		ORDER BY np.created_at, np.id`
+
+	rows, err := r.client.QueryContext(ctx, query)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to find orphaned nodepools", zap.Error(err))
+		return nil, fmt.Errorf("failed to find orphaned nodepools: %w", err)
+	}
+	defer rows.Close()
+
+	nodepools := []*models.NodePool{}
+	for rows.Next() {
+		var nodepool models.NodePool
+		err := rows.Scan(
+			&nodepool.ID,
+			&nodepool.ClusterID,
+			&nodepool.Name,
+			&nodepool.CreatedBy,
+			&nodepool.Generation,
+			&nodepool.ResourceVersion,
+			&nodepool.Spec,
+			&nodepool.Status,
+			&nodepool.StatusDirty,
+			&nodepool.CreatedAt,
+			&nodepool.UpdatedAt,
+			&nodepool.DeletedAt,
+		)
+		if err != nil {
+			r.logger.WithContext(ctx).Error("Failed to scan nodepool row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan nodepool: %w", err)
+		}
+		nodepools = append(nodepools, &nodepool)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.WithContext(ctx).Error("Error iterating nodepool rows", zap.Error(err))
+		return nil, fmt.Errorf("error iterating nodepools: %w", err)
+	}
+
+	return nodepools, nil
+}
+
 // Count returns the total number of nodepools matching the filter criteria
 func (r *NodePoolsRepository) Count(ctx context.Context, createdBy string, opts *models.ListOptions) (int64, error) {
 	baseQuery := `SELECT COUNT(*)
@@ -526,25 +825,12 @@ func (r *NodePoolsRepository) Count(ctx context.Context, createdBy string, opts
 		INNER JOIN clusters c ON np.cluster_id = c.id
 		WHERE np.deleted_at IS NULL AND c.deleted_at IS NULL AND c.created_by = $1`
 
-	var args []interface{}
-	args = append(args, createdBy)
-	var conditions []string
-
-	// Note: Status and Health filters removed - use status.phase instead if needed
-
-	// Build the complete query
-	query := baseQuery
-	if len(conditions) > 0 {
-		query += " AND " + conditions[0]
-		for _, condition := range conditions[1:] {
-			query += " AND " + condition
-		}
-	}
+	query, args := appendNodePoolFilters(baseQuery, []interface{}{createdBy}, opts)
 
 	var count int64
 	err := r.client.QueryRowContext(ctx, query, args...).Scan(&count)
 	if err != nil {
-		r.logger.Error("Failed to count nodepools",
+		r.logger.WithContext(ctx).Error("Failed to count nodepools",
 			zap.String("created_by", createdBy),
 			zap.Error(err))
 		return 0, fmt.Errorf("failed to count nodepools: %w", err)
@@ -553,6 +839,27 @@ func (r *NodePoolsRepository) Count(ctx context.Context, createdBy string, opts
 	return count, nil
 }
 
+// CountByClusterWithOptions counts a cluster's nodepools matching the list filters, with
+// client isolation
+func (r *NodePoolsRepository) CountByClusterWithOptions(ctx context.Context, clusterID uuid.UUID, createdBy string, opts *models.ListOptions) (int64, error) {
+	baseQuery := `SELECT COUNT(*)
+		FROM nodepools np
+		INNER JOIN clusters c ON np.cluster_id = c.id
+		WHERE np.cluster_id = $1 AND c.created_by = $2 AND np.deleted_at IS NULL AND c.deleted_at IS NULL`
+
+	query, args := appendNodePoolFilters(baseQuery, []interface{}{clusterID, createdBy}, opts)
+
+	var count int64
+	if err := r.client.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		r.logger.WithContext(ctx).Error("Failed to count nodepools by cluster",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err))
+		return 0, fmt.Errorf("failed to count nodepools: %w", err)
+	}
+
+	return count, nil
+}
+
 // CountByCluster returns the total number of nodepools for a specific cluster
 func (r *NodePoolsRepository) CountByCluster(ctx context.Context, clusterID uuid.UUID) (int64, error) {
 	query := "SELECT COUNT(*) FROM nodepools WHERE cluster_id = $1 AND deleted_at IS NULL"
@@ -560,7 +867,7 @@ func (r *NodePoolsRepository) CountByCluster(ctx context.Context, clusterID uuid
 	var count int64
 	err := r.client.QueryRowContext(ctx, query, clusterID).Scan(&count)
 	if err != nil {
-		r.logger.Error("Failed to count nodepools by cluster",
+		r.logger.WithContext(ctx).Error("Failed to count nodepools by cluster",
 			zap.String("cluster_id", clusterID.String()),
 			zap.Error(err),
 		)
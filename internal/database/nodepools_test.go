@@ -0,0 +1,18 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/google/uuid"
+)
+
+func TestNodePoolsRepository_UpdateStatusDeprecated(t *testing.T) {
+	// No database client: the deprecated setter must not touch the database
+	repo := NewNodePoolsRepository(nil)
+
+	err := repo.UpdateStatus(context.Background(), uuid.New(), "Ready", "Healthy")
+	utils.AssertEqual(t, models.ErrStatusUpdateDeprecated, err, "Deprecated status update should be rejected")
+}
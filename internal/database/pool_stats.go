@@ -0,0 +1,50 @@
+package database
+
+import (
+	"go.uber.org/zap"
+)
+
+// PoolStats is a snapshot of the database connection pool, used to spot saturation such as
+// reconciliation bursts waiting on connections
+type PoolStats struct {
+	MaxOpenConnections  int     `json:"max_open_connections"` // 0 means unlimited
+	OpenConnections     int     `json:"open_connections"`
+	InUse               int     `json:"in_use"`
+	Idle                int     `json:"idle"`
+	WaitCount           int64   `json:"wait_count"`            // Total connections waited for
+	WaitDurationSeconds float64 `json:"wait_duration_seconds"` // Total time blocked waiting for a connection
+	MaxIdleClosed       int64   `json:"max_idle_closed"`
+	MaxIdleTimeClosed   int64   `json:"max_idle_time_closed"`
+	MaxLifetimeClosed   int64   `json:"max_lifetime_closed"`
+}
+
+// PoolStats returns a snapshot of the connection pool
+func (c *Client) PoolStats() PoolStats {
+	stats := c.Stats()
+	return PoolStats{
+		MaxOpenConnections:  stats.MaxOpenConnections,
+		OpenConnections:     stats.OpenConnections,
+		InUse:               stats.InUse,
+		Idle:                stats.Idle,
+		WaitCount:           stats.WaitCount,
+		WaitDurationSeconds: stats.WaitDuration.Seconds(),
+		MaxIdleClosed:       stats.MaxIdleClosed,
+		MaxIdleTimeClosed:   stats.MaxIdleTimeClosed,
+		MaxLifetimeClosed:   stats.MaxLifetimeClosed,
+	}
+}
+
+// SetPoolLimits resizes the connection pool at runtime. A nil limit is left unchanged. The
+// change is not persisted; a restart returns to DATABASE_MAX_OPEN_CONNS and DATABASE_MAX_IDLE_CONNS.
+func (c *Client) SetPoolLimits(maxOpenConns, maxIdleConns *int) {
+	fields := []zap.Field{}
+	if maxOpenConns != nil {
+		c.db.SetMaxOpenConns(*maxOpenConns)
+		fields = append(fields, zap.Int("max_open_conns", *maxOpenConns))
+	}
+	if maxIdleConns != nil {
+		c.db.SetMaxIdleConns(*maxIdleConns)
+		fields = append(fields, zap.Int("max_idle_conns", *maxIdleConns))
+	}
+	c.logger.Info("Database connection pool resized", fields...)
+}
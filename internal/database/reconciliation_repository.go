@@ -28,7 +28,48 @@ func NewReconciliationRepository(client *Client) *ReconciliationRepository {
 
 // FindClustersNeedingReconciliation finds clusters that need reconciliation (fan-out to all controllers)
 func (r *ReconciliationRepository) FindClustersNeedingReconciliation(ctx context.Context) ([]*models.ReconciliationTarget, error) {
-	query := `SELECT * FROM find_clusters_needing_reconciliation()`
+	// Paused clusters are filtered out here; WITH ORDINALITY keeps the function's priority order.
+	// Clusters with a reconciliation_interval override are due once that interval has passed
+	// since their last reconciliation, instead of at the schedule's next_reconcile_at.
+	query := `
+		SELECT cluster_id, reason, last_reconciled_at, cluster_generation, platform
+		FROM (
+			SELECT f.cluster_id, f.reason, f.last_reconciled_at, f.cluster_generation,
+				   COALESCE(c.spec->'platform'->>'type', '') AS platform,
+				   0 AS source, f.ord, NULL::TIMESTAMP AS due_at
+			FROM find_clusters_needing_reconciliation()
+				WITH ORDINALITY AS f(cluster_id, reason, last_reconciled_at, cluster_generation, ord)
+			JOIN clusters c ON c.id = f.cluster_id
+			WHERE c.reconciliation_paused = FALSE
+			  AND c.reconciliation_interval IS NULL
+
+			UNION ALL
+
+			SELECT rs.cluster_id,
+				   CASE
+					   WHEN rs.last_reconciled_at IS NULL THEN 'never_reconciled'
+					   WHEN rs.last_reconciled_at + c.reconciliation_interval <= NOW() THEN 'interval_reconciliation'
+					   ELSE 'generation_mismatch'
+				   END::CHARACTER VARYING AS reason,
+				   rs.last_reconciled_at, c.generation AS cluster_generation,
+				   COALESCE(c.spec->'platform'->>'type', '') AS platform,
+				   1 AS source, 0 AS ord, rs.last_reconciled_at + c.reconciliation_interval AS due_at
+			FROM reconciliation_schedule rs
+			JOIN clusters c ON c.id = rs.cluster_id
+			WHERE rs.enabled = TRUE
+			  AND c.deleted_at IS NULL
+			  AND c.reconciliation_paused = FALSE
+			  AND c.reconciliation_interval IS NOT NULL
+			  AND (
+				  rs.last_reconciled_at IS NULL
+				  OR rs.last_reconciled_at + c.reconciliation_interval <= NOW()
+				  OR c.generation > COALESCE(
+					  (SELECT MAX(observed_generation) FROM controller_status cs WHERE cs.cluster_id = rs.cluster_id),
+					  0
+				  )
+			  )
+		) targets
+		ORDER BY source, ord, due_at ASC NULLS FIRST`
 
 	rows, err := r.client.QueryContext(ctx, query)
 	if err != nil {
@@ -44,6 +85,7 @@ func (r *ReconciliationRepository) FindClustersNeedingReconciliation(ctx context
 			&target.Reason,
 			&target.LastReconciledAt,
 			&target.ClusterGeneration,
+			&target.Platform,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan reconciliation target: %w", err)
 		}
@@ -54,12 +96,86 @@ func (r *ReconciliationRepository) FindClustersNeedingReconciliation(ctx context
 		return nil, fmt.Errorf("error iterating reconciliation targets: %w", err)
 	}
 
-	r.logger.Debug("Found clusters needing reconciliation (fan-out)",
+	r.logger.WithContext(ctx).Debug("Found clusters needing reconciliation (fan-out)",
 		zap.Int("count", len(targets)))
 
 	return targets, nil
 }
 
+// SetReconciliationPaused pauses or resumes reconciliation for a cluster
+func (r *ReconciliationRepository) SetReconciliationPaused(ctx context.Context, clusterID uuid.UUID, paused bool) error {
+	query := `
+		UPDATE clusters
+		SET reconciliation_paused = $2
+		WHERE id = $1 AND deleted_at IS NULL`
+
+	result, err := r.client.ExecContext(ctx, query, clusterID, paused)
+	if err != nil {
+		return fmt.Errorf("failed to set cluster reconciliation paused: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrClusterNotFound
+	}
+
+	r.logger.WithContext(ctx).Info("Updated cluster reconciliation pause state",
+		zap.String("cluster_id", clusterID.String()),
+		zap.Bool("paused", paused))
+
+	return nil
+}
+
+// SetReconciliationInterval sets or clears (nil) the reconciliation interval override for a cluster
+func (r *ReconciliationRepository) SetReconciliationInterval(ctx context.Context, clusterID uuid.UUID, interval *time.Duration) error {
+	query := `
+		UPDATE clusters
+		SET reconciliation_interval = $2::FLOAT8 * INTERVAL '1 second'
+		WHERE id = $1 AND deleted_at IS NULL`
+
+	var seconds *float64
+	if interval != nil {
+		s := interval.Seconds()
+		seconds = &s
+	}
+
+	result, err := r.client.ExecContext(ctx, query, clusterID, seconds)
+	if err != nil {
+		return fmt.Errorf("failed to set cluster reconciliation interval: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrClusterNotFound
+	}
+
+	r.logger.WithContext(ctx).Info("Updated cluster reconciliation interval",
+		zap.String("cluster_id", clusterID.String()),
+		zap.Any("interval", interval))
+
+	return nil
+}
+
+// CountPausedClusters counts active clusters with reconciliation paused
+func (r *ReconciliationRepository) CountPausedClusters(ctx context.Context) (int, error) {
+	query := `SELECT COUNT(*) FROM clusters WHERE reconciliation_paused = TRUE AND deleted_at IS NULL`
+
+	var count int
+	if err := r.client.QueryRowContext(ctx, query).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count paused clusters: %w", err)
+	}
+
+	return count, nil
+}
+
 // UpdateReconciliationSchedule updates the cluster reconciliation schedule (fan-out approach)
 func (r *ReconciliationRepository) UpdateReconciliationSchedule(ctx context.Context, clusterID uuid.UUID) error {
 	query := `SELECT update_cluster_reconciliation_schedule($1)`
@@ -69,7 +185,7 @@ func (r *ReconciliationRepository) UpdateReconciliationSchedule(ctx context.Cont
 		return fmt.Errorf("failed to update cluster reconciliation schedule: %w", err)
 	}
 
-	r.logger.Debug("Updated cluster reconciliation schedule (fan-out)",
+	r.logger.WithContext(ctx).Debug("Updated cluster reconciliation schedule (fan-out)",
 		zap.String("cluster_id", clusterID.String()))
 
 	return nil
@@ -112,6 +228,34 @@ func (r *ReconciliationRepository) GetReconciliationSchedule(ctx context.Context
 	return schedule, nil
 }
 
+// GetReconciliationTiming returns when a cluster was last reconciled and when it is next due.
+// A cluster with a reconciliation_interval override is due once that interval has passed since
+// its last reconciliation, as in FindClustersNeedingReconciliation. A cluster without a schedule
+// or never reconciled gets an empty timing.
+func (r *ReconciliationRepository) GetReconciliationTiming(ctx context.Context, clusterID uuid.UUID) (*models.ReconciliationTiming, error) {
+	query := `
+		SELECT rs.last_reconciled_at,
+			   CASE
+				   WHEN rs.last_reconciled_at IS NULL OR NOT rs.enabled OR c.reconciliation_paused THEN NULL
+				   WHEN c.reconciliation_interval IS NOT NULL THEN rs.last_reconciled_at + c.reconciliation_interval
+				   ELSE rs.next_reconcile_at
+			   END
+		FROM reconciliation_schedule rs
+		JOIN clusters c ON c.id = rs.cluster_id
+		WHERE rs.cluster_id = $1`
+
+	timing := &models.ReconciliationTiming{}
+	err := r.client.QueryRowContext(ctx, query, clusterID).Scan(&timing.LastReconciledAt, &timing.NextReconcileAt)
+	if err == sql.ErrNoRows {
+		return timing, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster reconciliation timing: %w", err)
+	}
+
+	return timing, nil
+}
+
 // CreateReconciliationSchedule creates a new cluster reconciliation schedule (fan-out approach)
 func (r *ReconciliationRepository) CreateReconciliationSchedule(ctx context.Context, schedule *models.ReconciliationSchedule) error {
 	query := `
@@ -145,7 +289,7 @@ func (r *ReconciliationRepository) CreateReconciliationSchedule(ctx context.Cont
 		return fmt.Errorf("failed to create cluster reconciliation schedule: %w", err)
 	}
 
-	r.logger.Info("Created cluster reconciliation schedule (fan-out)",
+	r.logger.WithContext(ctx).Info("Created cluster reconciliation schedule (fan-out)",
 		zap.String("cluster_id", schedule.ClusterID.String()),
 		zap.Int64("schedule_id", schedule.ID))
 
@@ -173,7 +317,7 @@ func (r *ReconciliationRepository) UpdateReconciliationScheduleConfig(ctx contex
 		return models.ErrReconciliationScheduleNotFound
 	}
 
-	r.logger.Info("Updated cluster reconciliation schedule config (fan-out)",
+	r.logger.WithContext(ctx).Info("Updated cluster reconciliation schedule config (fan-out)",
 		zap.String("cluster_id", clusterID.String()),
 		zap.Duration("interval", interval),
 		zap.Bool("enabled", enabled))
@@ -195,7 +339,7 @@ func (r *ReconciliationRepository) DeleteReconciliationSchedules(ctx context.Con
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 
-	r.logger.Info("Deleted reconciliation schedules",
+	r.logger.WithContext(ctx).Info("Deleted reconciliation schedules",
 		zap.String("cluster_id", clusterID.String()),
 		zap.Int64("schedules_deleted", rowsAffected))
 
@@ -280,7 +424,7 @@ func (r *ReconciliationRepository) MarkReconciliationNeeded(ctx context.Context,
 		return r.CreateReconciliationSchedule(ctx, schedule)
 	}
 
-	r.logger.Info("Marked cluster reconciliation as needed (fan-out)",
+	r.logger.WithContext(ctx).Info("Marked cluster reconciliation as needed (fan-out)",
 		zap.String("cluster_id", clusterID.String()))
 
 	return nil
@@ -288,7 +432,17 @@ func (r *ReconciliationRepository) MarkReconciliationNeeded(ctx context.Context,
 
 // FindNodePoolsNeedingReconciliation finds nodepools that need reconciliation
 func (r *ReconciliationRepository) FindNodePoolsNeedingReconciliation(ctx context.Context) ([]*models.NodePoolReconciliationTarget, error) {
-	query := `SELECT * FROM find_nodepools_needing_reconciliation()`
+	// The parent cluster's platform is joined in and paused nodepools are filtered out;
+	// WITH ORDINALITY keeps the function's priority order
+	query := `
+		SELECT f.nodepool_id, f.reason, f.last_reconciled_at, f.nodepool_generation,
+			   COALESCE(c.spec->'platform'->>'type', '') AS platform
+		FROM find_nodepools_needing_reconciliation()
+			WITH ORDINALITY AS f(nodepool_id, reason, last_reconciled_at, nodepool_generation, ord)
+		LEFT JOIN nodepools n ON n.id = f.nodepool_id
+		LEFT JOIN clusters c ON c.id = n.cluster_id
+		WHERE n.reconciliation_paused IS NOT TRUE
+		ORDER BY f.ord`
 
 	rows, err := r.client.QueryContext(ctx, query)
 	if err != nil {
@@ -304,6 +458,7 @@ func (r *ReconciliationRepository) FindNodePoolsNeedingReconciliation(ctx contex
 			&target.Reason,
 			&target.LastReconciledAt,
 			&target.NodePoolGeneration,
+			&target.Platform,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan nodepool reconciliation target: %w", err)
 		}
@@ -314,12 +469,52 @@ func (r *ReconciliationRepository) FindNodePoolsNeedingReconciliation(ctx contex
 		return nil, fmt.Errorf("error iterating nodepool reconciliation targets: %w", err)
 	}
 
-	r.logger.Debug("Found nodepools needing reconciliation",
+	r.logger.WithContext(ctx).Debug("Found nodepools needing reconciliation",
 		zap.Int("count", len(targets)))
 
 	return targets, nil
 }
 
+// SetNodePoolReconciliationPaused pauses or resumes reconciliation for a single nodepool
+func (r *ReconciliationRepository) SetNodePoolReconciliationPaused(ctx context.Context, nodepoolID uuid.UUID, paused bool) error {
+	query := `
+		UPDATE nodepools
+		SET reconciliation_paused = $2
+		WHERE id = $1 AND deleted_at IS NULL`
+
+	result, err := r.client.ExecContext(ctx, query, nodepoolID, paused)
+	if err != nil {
+		return fmt.Errorf("failed to set nodepool reconciliation paused: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrNodePoolNotFound
+	}
+
+	r.logger.WithContext(ctx).Info("Updated nodepool reconciliation pause state",
+		zap.String("nodepool_id", nodepoolID.String()),
+		zap.Bool("paused", paused))
+
+	return nil
+}
+
+// CountPausedNodePools counts active nodepools with reconciliation paused
+func (r *ReconciliationRepository) CountPausedNodePools(ctx context.Context) (int, error) {
+	query := `SELECT COUNT(*) FROM nodepools WHERE reconciliation_paused = TRUE AND deleted_at IS NULL`
+
+	var count int
+	if err := r.client.QueryRowContext(ctx, query).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count paused nodepools: %w", err)
+	}
+
+	return count, nil
+}
+
 // UpdateNodePoolReconciliationSchedule updates the nodepool reconciliation schedule
 func (r *ReconciliationRepository) UpdateNodePoolReconciliationSchedule(ctx context.Context, nodepoolID uuid.UUID) error {
 	query := `SELECT update_nodepool_reconciliation_schedule($1)`
@@ -329,7 +524,7 @@ func (r *ReconciliationRepository) UpdateNodePoolReconciliationSchedule(ctx cont
 		return fmt.Errorf("failed to update nodepool reconciliation schedule: %w", err)
 	}
 
-	r.logger.Debug("Updated nodepool reconciliation schedule",
+	r.logger.WithContext(ctx).Debug("Updated nodepool reconciliation schedule",
 		zap.String("nodepool_id", nodepoolID.String()))
 
 	return nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/apahim/cls-backend/internal/config"
 	"github.com/apahim/cls-backend/internal/utils"
@@ -20,6 +21,9 @@ type Repository struct {
 	Status           *StatusRepository
 	Reconciliation   *ReconciliationRepository
 	StatusAggregator *StatusAggregator
+	Idempotency      *IdempotencyRepository
+	Audit            *AuditRepository
+	ClusterGrants    *ClusterGrantsRepository
 }
 
 // NewRepository creates a new repository manager
@@ -47,13 +51,39 @@ func NewRepository(cfg config.DatabaseConfig) (*Repository, error) {
 		NodePools:        NewNodePoolsRepository(client),
 		Status:           statusRepo,
 		Reconciliation:   reconciliationRepo,
-		StatusAggregator: NewStatusAggregator(client),
+		StatusAggregator: NewStatusAggregator(client, nil),
+		Idempotency:      NewIdempotencyRepository(client),
+		Audit:            NewAuditRepository(client),
+		ClusterGrants:    NewClusterGrantsRepository(client),
 	}
 
 	logger.Info("Repository initialized successfully")
 	return repo, nil
 }
 
+// SetReadinessConfig sets how controllers are weighted when aggregating cluster and nodepool
+// status. Call it before serving requests.
+func (r *Repository) SetReadinessConfig(cfg ReadinessConfig) {
+	r.client.readiness = cfg
+}
+
+// SetStatusStrategies sets the cluster status aggregation strategy of each platform type.
+// Platform types are matched case-insensitively; other platforms use DefaultStrategy. Call
+// it before serving requests.
+func (r *Repository) SetStatusStrategies(strategies map[string]StatusStrategy) {
+	byPlatform := make(map[string]StatusStrategy, len(strategies))
+	for platform, strategy := range strategies {
+		byPlatform[strings.ToUpper(platform)] = strategy
+	}
+	r.client.statusStrategies = byPlatform
+}
+
+// SetPhaseTransitionNotifier sets the receiver notified when status aggregation changes a
+// cluster's phase. Call it before serving requests.
+func (r *Repository) SetPhaseTransitionNotifier(notifier PhaseTransitionNotifier) {
+	r.client.phaseNotifier = notifier
+}
+
 // Close closes all database connections
 func (r *Repository) Close() error {
 	r.logger.Info("Closing repository connections")
@@ -77,16 +107,12 @@ func (r *Repository) Stats() interface{} {
 func (r *Repository) Transaction(ctx context.Context, fn func(*Repository) error) error {
 	return r.client.Transaction(ctx, func(tx *sql.Tx) error {
 		// Create a transaction-aware client
-		txClient := &Client{
-			db:     nil, // tx will be used instead
-			logger: r.client.logger,
-			config: r.client.config,
-			tx:     tx, // Store the transaction
-		}
+		txClient := r.client.withTx(tx)
 
-		// Create transaction-aware repositories
+		// Create transaction-aware repositories. The status repository keeps its history
+		// limit and error collapsing settings.
 		txReconciliationRepo := NewReconciliationRepository(txClient)
-		txStatusRepo := NewStatusRepository(txClient)
+		txStatusRepo := r.Status.withClient(txClient)
 
 		// Wire up the reconciliation updater for transaction repositories
 		txStatusRepo.SetReconciliationUpdater(txReconciliationRepo)
@@ -99,7 +125,10 @@ func (r *Repository) Transaction(ctx context.Context, fn func(*Repository) error
 			NodePools:        NewNodePoolsRepository(txClient),
 			Status:           txStatusRepo,
 			Reconciliation:   txReconciliationRepo,
-			StatusAggregator: NewStatusAggregator(txClient),
+			StatusAggregator: NewStatusAggregator(txClient, nil),
+			Idempotency:      NewIdempotencyRepository(txClient),
+			Audit:            NewAuditRepository(txClient),
+			ClusterGrants:    NewClusterGrantsRepository(txClient),
 		}
 
 		return fn(txRepo)
@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/apahim/cls-backend/internal/models"
 	"github.com/apahim/cls-backend/internal/utils"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 )
 
@@ -22,13 +24,20 @@ type StatusRepository struct {
 	client                *Client
 	logger                *utils.Logger
 	reconciliationUpdater ReconciliationUpdater
+	collapseErrors        bool
+	historyLimit          int
 }
 
+// defaultStatusHistoryLimit is how many condition changes are kept per cluster controller
+// unless SetStatusHistoryLimit is called
+const defaultStatusHistoryLimit = 50
+
 // NewStatusRepository creates a new status repository
 func NewStatusRepository(client *Client) *StatusRepository {
 	return &StatusRepository{
-		client: client,
-		logger: utils.NewLogger("status_repo"),
+		client:       client,
+		logger:       utils.NewLogger("status_repo"),
+		historyLimit: defaultStatusHistoryLimit,
 	}
 }
 
@@ -37,10 +46,97 @@ func (r *StatusRepository) SetReconciliationUpdater(updater ReconciliationUpdate
 	r.reconciliationUpdater = updater
 }
 
-// UpsertClusterControllerStatus inserts or updates cluster controller status
+// SetCollapseErrors controls whether identical errors from different controllers are collapsed
+// into a single entry
+func (r *StatusRepository) SetCollapseErrors(enabled bool) {
+	r.collapseErrors = enabled
+}
+
+// SetStatusHistoryLimit sets how many condition changes are kept per cluster controller
+func (r *StatusRepository) SetStatusHistoryLimit(limit int) {
+	r.historyLimit = limit
+}
+
+// withClient returns a copy of the repository that runs its queries through client
+func (r *StatusRepository) withClient(client *Client) *StatusRepository {
+	txRepo := *r
+	txRepo.client = client
+	return &txRepo
+}
+
+// DeferStatusDirty stops the controller status triggers from marking the cluster dirty for
+// the rest of the current transaction, so a batch of reports can mark it dirty once when it
+// is done. It must be called inside Repository.Transaction.
+func (r *StatusRepository) DeferStatusDirty(ctx context.Context) error {
+	if r.client.tx == nil {
+		return fmt.Errorf("deferring status dirty marking requires a transaction")
+	}
+	if _, err := r.client.ExecContext(ctx, `SELECT set_config('cls.defer_status_dirty', 'on', true)`); err != nil {
+		return fmt.Errorf("failed to defer status dirty marking: %w", err)
+	}
+	return nil
+}
+
+// getStoredConditions loads the currently stored conditions for a controller, if any. query
+// must lock the row with FOR UPDATE, so concurrent reports for the controller merge one after
+// another instead of overwriting each other's transition times.
+func (r *StatusRepository) getStoredConditions(ctx context.Context, query string, id uuid.UUID, controllerName string) (models.ConditionList, error) {
+	var conditions models.ConditionList
+	err := r.client.QueryRowContext(ctx, query, id, controllerName).Scan(&conditions)
+	if err == sql.ErrNoRows {
+		return models.ConditionList{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stored conditions: %w", err)
+	}
+	return conditions, nil
+}
+
+// UpsertClusterControllerStatus inserts or updates cluster controller status. An update whose
+// observed generation is older than the stored one is rejected with models.ErrStaleGeneration,
+// so a late report cannot overwrite status for a newer spec.
 func (r *StatusRepository) UpsertClusterControllerStatus(ctx context.Context, status *models.ClusterControllerStatus) error {
+	return r.upsertClusterControllerStatus(ctx, status, false)
+}
+
+// ForceUpsertClusterControllerStatus inserts or updates cluster controller status regardless of
+// the stored observed generation, for recovering from a bad report
+func (r *StatusRepository) ForceUpsertClusterControllerStatus(ctx context.Context, status *models.ClusterControllerStatus) error {
+	return r.upsertClusterControllerStatus(ctx, status, true)
+}
+
+// upsertClusterControllerStatus stores the status and its condition history in one transaction,
+// so a failed history insert never leaves a stored status without its history. It joins the
+// caller's transaction when there is one.
+func (r *StatusRepository) upsertClusterControllerStatus(ctx context.Context, status *models.ClusterControllerStatus, force bool) error {
+	if r.client.tx != nil {
+		return r.upsertClusterControllerStatusTx(ctx, status, force)
+	}
+
+	// Upserting merges conditions into the report, so restore it if the transaction is retried
+	reported := *status
+	return r.client.Transaction(ctx, func(tx *sql.Tx) error {
+		*status = reported
+		return r.withClient(r.client.withTx(tx)).upsertClusterControllerStatusTx(ctx, status, force)
+	})
+}
+
+func (r *StatusRepository) upsertClusterControllerStatusTx(ctx context.Context, status *models.ClusterControllerStatus, force bool) error {
 	status.LastUpdated = time.Now()
 
+	// Merge reported conditions with stored ones so transition times are server-managed
+	stored, err := r.getStoredConditions(ctx,
+		`SELECT conditions FROM controller_status WHERE cluster_id = $1 AND controller_name = $2 FOR UPDATE`,
+		status.ClusterID, status.ControllerName)
+	if err != nil {
+		return err
+	}
+	merged, err := stored.MergeReported(status.Conditions, status.LastUpdated)
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrInvalidInput, err)
+	}
+	status.Conditions = merged
+
 	query := `
 		INSERT INTO controller_status (
 			cluster_id, controller_name, observed_generation, conditions,
@@ -54,9 +150,10 @@ func (r *StatusRepository) UpsertClusterControllerStatus(ctx context.Context, st
 			conditions = EXCLUDED.conditions,
 			metadata = EXCLUDED.metadata,
 			last_error = EXCLUDED.last_error,
-			updated_at = EXCLUDED.updated_at`
+			updated_at = EXCLUDED.updated_at
+		WHERE $8 OR controller_status.observed_generation <= EXCLUDED.observed_generation`
 
-	_, err := r.client.ExecContext(ctx, query,
+	result, err := r.client.ExecContext(ctx, query,
 		status.ClusterID,
 		status.ControllerName,
 		status.ObservedGeneration,
@@ -64,10 +161,11 @@ func (r *StatusRepository) UpsertClusterControllerStatus(ctx context.Context, st
 		status.Metadata,
 		status.LastError,
 		status.LastUpdated,
+		force,
 	)
 
 	if err != nil {
-		r.logger.Error("Failed to upsert cluster controller status",
+		r.logger.WithContext(ctx).Error("Failed to upsert cluster controller status",
 			zap.String("cluster_id", status.ClusterID.String()),
 			zap.String("controller_name", status.ControllerName),
 			zap.Error(err),
@@ -75,7 +173,24 @@ func (r *StatusRepository) UpsertClusterControllerStatus(ctx context.Context, st
 		return fmt.Errorf("failed to upsert cluster controller status: %w", err)
 	}
 
-	r.logger.Debug("Cluster controller status upserted",
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		r.logger.WithContext(ctx).Warn("Rejected cluster controller status for an older generation",
+			zap.String("cluster_id", status.ClusterID.String()),
+			zap.String("controller_name", status.ControllerName),
+			zap.Int64("observed_generation", status.ObservedGeneration),
+		)
+		return models.ErrStaleGeneration
+	}
+
+	if err := r.recordStatusHistory(ctx, status, status.Conditions.StatusChanges(stored)); err != nil {
+		return err
+	}
+
+	r.logger.WithContext(ctx).Debug("Cluster controller status upserted",
 		zap.String("cluster_id", status.ClusterID.String()),
 		zap.String("controller_name", status.ControllerName),
 		zap.Int64("observed_generation", status.ObservedGeneration),
@@ -89,6 +204,120 @@ func (r *StatusRepository) UpsertClusterControllerStatus(ctx context.Context, st
 	return nil
 }
 
+// recordStatusHistory appends a controller's condition status changes to its history and
+// trims the history to the configured limit
+func (r *StatusRepository) recordStatusHistory(ctx context.Context, status *models.ClusterControllerStatus, changes []models.ControllerStatusHistoryEntry) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	insertQuery := `
+		INSERT INTO controller_status_history (
+			cluster_id, controller_name, condition_type, from_status, to_status,
+			reason, message, observed_generation, transitioned_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	for _, change := range changes {
+		_, err := r.client.ExecContext(ctx, insertQuery,
+			status.ClusterID,
+			status.ControllerName,
+			change.ConditionType,
+			change.FromStatus,
+			change.ToStatus,
+			change.Reason,
+			change.Message,
+			status.ObservedGeneration,
+			change.TransitionedAt,
+		)
+		if err != nil {
+			r.logger.WithContext(ctx).Error("Failed to record controller status history",
+				zap.String("cluster_id", status.ClusterID.String()),
+				zap.String("controller_name", status.ControllerName),
+				zap.String("condition_type", change.ConditionType),
+				zap.Error(err),
+			)
+			return fmt.Errorf("failed to record controller status history: %w", err)
+		}
+	}
+
+	// Keep only the newest entries for the controller
+	trimQuery := `
+		DELETE FROM controller_status_history
+		WHERE cluster_id = $1 AND controller_name = $2
+		  AND id NOT IN (
+			SELECT id FROM controller_status_history
+			WHERE cluster_id = $1 AND controller_name = $2
+			ORDER BY id DESC
+			LIMIT $3
+		  )`
+
+	if _, err := r.client.ExecContext(ctx, trimQuery, status.ClusterID, status.ControllerName, r.historyLimit); err != nil {
+		r.logger.WithContext(ctx).Error("Failed to trim controller status history",
+			zap.String("cluster_id", status.ClusterID.String()),
+			zap.String("controller_name", status.ControllerName),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to trim controller status history: %w", err)
+	}
+
+	return nil
+}
+
+// ListClusterStatusHistory retrieves a cluster's controller condition changes, newest first,
+// optionally for a single controller
+func (r *StatusRepository) ListClusterStatusHistory(ctx context.Context, clusterID uuid.UUID, controllerName string, limit int) ([]*models.ControllerStatusHistoryEntry, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	query := `
+		SELECT id, cluster_id, controller_name, condition_type, from_status, to_status,
+			   reason, message, observed_generation, transitioned_at
+		FROM controller_status_history
+		WHERE cluster_id = $1 AND ($2 = '' OR controller_name = $2)
+		ORDER BY id DESC
+		LIMIT $3`
+
+	rows, err := r.client.QueryContext(ctx, query, clusterID, controllerName, limit)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to list controller status history",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to list controller status history: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []*models.ControllerStatusHistoryEntry{}
+	for rows.Next() {
+		var entry models.ControllerStatusHistoryEntry
+		err := rows.Scan(
+			&entry.ID,
+			&entry.ClusterID,
+			&entry.ControllerName,
+			&entry.ConditionType,
+			&entry.FromStatus,
+			&entry.ToStatus,
+			&entry.Reason,
+			&entry.Message,
+			&entry.ObservedGeneration,
+			&entry.TransitionedAt,
+		)
+		if err != nil {
+			r.logger.WithContext(ctx).Error("Failed to scan controller status history row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan controller status history: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.WithContext(ctx).Error("Error iterating controller status history rows", zap.Error(err))
+		return nil, fmt.Errorf("error iterating controller status history: %w", err)
+	}
+
+	return entries, nil
+}
+
 // GetClusterControllerStatus retrieves status for a specific cluster controller
 func (r *StatusRepository) GetClusterControllerStatus(ctx context.Context, clusterID uuid.UUID, controllerName string) (*models.ClusterControllerStatus, error) {
 	query := `
@@ -112,7 +341,7 @@ func (r *StatusRepository) GetClusterControllerStatus(ctx context.Context, clust
 		return nil, fmt.Errorf("controller status not found for cluster %s, controller %s", clusterID, controllerName)
 	}
 	if err != nil {
-		r.logger.Error("Failed to get cluster controller status",
+		r.logger.WithContext(ctx).Error("Failed to get cluster controller status",
 			zap.String("cluster_id", clusterID.String()),
 			zap.String("controller_name", controllerName),
 			zap.Error(err),
@@ -120,6 +349,7 @@ func (r *StatusRepository) GetClusterControllerStatus(ctx context.Context, clust
 		return nil, fmt.Errorf("failed to get cluster controller status: %w", err)
 	}
 
+	attributeError(status.LastError, status.ControllerName)
 	return &status, nil
 }
 
@@ -134,7 +364,7 @@ func (r *StatusRepository) ListClusterControllerStatus(ctx context.Context, clus
 
 	rows, err := r.client.QueryContext(ctx, query, clusterID)
 	if err != nil {
-		r.logger.Error("Failed to list cluster controller status",
+		r.logger.WithContext(ctx).Error("Failed to list cluster controller status",
 			zap.String("cluster_id", clusterID.String()),
 			zap.Error(err),
 		)
@@ -155,27 +385,167 @@ func (r *StatusRepository) ListClusterControllerStatus(ctx context.Context, clus
 			&status.LastUpdated,
 		)
 		if err != nil {
-			r.logger.Error("Failed to scan cluster controller status row", zap.Error(err))
+			r.logger.WithContext(ctx).Error("Failed to scan cluster controller status row", zap.Error(err))
 			return nil, fmt.Errorf("failed to scan cluster controller status: %w", err)
 		}
+		attributeError(status.LastError, status.ControllerName)
 		statuses = append(statuses, &status)
 	}
 
 	if err = rows.Err(); err != nil {
-		r.logger.Error("Error iterating cluster controller status rows", zap.Error(err))
+		r.logger.WithContext(ctx).Error("Error iterating cluster controller status rows", zap.Error(err))
 		return nil, fmt.Errorf("error iterating cluster controller status: %w", err)
 	}
 
 	return statuses, nil
 }
 
-// DeleteClusterControllerStatus deletes status for a specific cluster controller
+// ListClusterControllers lists the controllers reporting for a cluster with their
+// readiness, without loading full conditions or metadata
+func (r *StatusRepository) ListClusterControllers(ctx context.Context, clusterID uuid.UUID) ([]*models.ControllerSummary, error) {
+	query := `
+		SELECT controller_name,
+			   conditions @> '[{"type": "Ready", "status": "True"}]' AS ready,
+			   conditions @> '[{"type": "Available", "status": "True"}]' AS available,
+			   updated_at
+		FROM controller_status
+		WHERE cluster_id = $1
+		ORDER BY controller_name`
+
+	rows, err := r.client.QueryContext(ctx, query, clusterID)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to list cluster controllers",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to list cluster controllers: %w", err)
+	}
+	defer rows.Close()
+
+	controllers := []*models.ControllerSummary{}
+	for rows.Next() {
+		var controller models.ControllerSummary
+		if err := rows.Scan(
+			&controller.ControllerName,
+			&controller.Ready,
+			&controller.Available,
+			&controller.LastUpdated,
+		); err != nil {
+			r.logger.WithContext(ctx).Error("Failed to scan cluster controller row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan cluster controller: %w", err)
+		}
+		controllers = append(controllers, &controller)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.WithContext(ctx).Error("Error iterating cluster controller rows", zap.Error(err))
+		return nil, fmt.Errorf("error iterating cluster controllers: %w", err)
+	}
+
+	return controllers, nil
+}
+
+// ListStaleClusterControllers lists the controllers whose observed generation is behind
+// the cluster's current generation, i.e. that have not yet reported on the latest spec
+func (r *StatusRepository) ListStaleClusterControllers(ctx context.Context, clusterID uuid.UUID) ([]string, error) {
+	query := `
+		SELECT cs.controller_name
+		FROM controller_status cs
+		JOIN clusters c ON cs.cluster_id = c.id
+		WHERE cs.cluster_id = $1 AND cs.observed_generation < c.generation
+		ORDER BY cs.controller_name`
+
+	rows, err := r.client.QueryContext(ctx, query, clusterID)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to list stale cluster controllers",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to list stale cluster controllers: %w", err)
+	}
+	defer rows.Close()
+
+	controllers := []string{}
+	for rows.Next() {
+		var controllerName string
+		if err := rows.Scan(&controllerName); err != nil {
+			r.logger.WithContext(ctx).Error("Failed to scan stale cluster controller row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan stale cluster controller: %w", err)
+		}
+		controllers = append(controllers, controllerName)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.WithContext(ctx).Error("Error iterating stale cluster controller rows", zap.Error(err))
+		return nil, fmt.Errorf("error iterating stale cluster controllers: %w", err)
+	}
+
+	return controllers, nil
+}
+
+// CountClusterControllers returns the controller counts for each of the given clusters in a
+// single query. Clusters without any controller reports are included with zero counts.
+func (r *StatusRepository) CountClusterControllers(ctx context.Context, clusterIDs []uuid.UUID) (map[uuid.UUID]models.ControllerCounts, error) {
+	counts := make(map[uuid.UUID]models.ControllerCounts, len(clusterIDs))
+	if len(clusterIDs) == 0 {
+		return counts, nil
+	}
+
+	ids := make([]string, len(clusterIDs))
+	for i, id := range clusterIDs {
+		ids[i] = id.String()
+	}
+
+	query := `
+		SELECT c.id,
+			   COUNT(cs.controller_name) AS total,
+			   COUNT(cs.controller_name) FILTER (
+				   WHERE cs.conditions @> '[{"type": "Available", "status": "True"}]'
+			   ) AS ready,
+			   COUNT(cs.controller_name) FILTER (
+				   WHERE cs.observed_generation < c.generation
+			   ) AS stale
+		FROM clusters c
+		LEFT JOIN controller_status cs ON cs.cluster_id = c.id
+		WHERE c.id = ANY($1::uuid[])
+		GROUP BY c.id`
+
+	rows, err := r.client.QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to count cluster controllers",
+			zap.Int("cluster_count", len(clusterIDs)),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to count cluster controllers: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var clusterID uuid.UUID
+		var clusterCounts models.ControllerCounts
+		if err := rows.Scan(&clusterID, &clusterCounts.Total, &clusterCounts.Ready, &clusterCounts.Stale); err != nil {
+			r.logger.WithContext(ctx).Error("Failed to scan cluster controller counts row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan cluster controller counts: %w", err)
+		}
+		counts[clusterID] = clusterCounts
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.WithContext(ctx).Error("Error iterating cluster controller counts rows", zap.Error(err))
+		return nil, fmt.Errorf("error iterating cluster controller counts: %w", err)
+	}
+
+	return counts, nil
+}
+
+// DeleteClusterControllerStatus deletes status for a specific cluster controller. It returns
+// models.ErrControllerStatusNotFound when the controller has no status for the cluster.
 func (r *StatusRepository) DeleteClusterControllerStatus(ctx context.Context, clusterID uuid.UUID, controllerName string) error {
 	query := `DELETE FROM controller_status WHERE cluster_id = $1 AND controller_name = $2`
 
 	result, err := r.client.ExecContext(ctx, query, clusterID, controllerName)
 	if err != nil {
-		r.logger.Error("Failed to delete cluster controller status",
+		r.logger.WithContext(ctx).Error("Failed to delete cluster controller status",
 			zap.String("cluster_id", clusterID.String()),
 			zap.String("controller_name", controllerName),
 			zap.Error(err),
@@ -188,7 +558,11 @@ func (r *StatusRepository) DeleteClusterControllerStatus(ctx context.Context, cl
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 
-	r.logger.Debug("Cluster controller status deleted",
+	if rowsAffected == 0 {
+		return models.ErrControllerStatusNotFound
+	}
+
+	r.logger.WithContext(ctx).Debug("Cluster controller status deleted",
 		zap.String("cluster_id", clusterID.String()),
 		zap.String("controller_name", controllerName),
 		zap.Int64("rows_affected", rowsAffected),
@@ -203,7 +577,7 @@ func (r *StatusRepository) DeleteAllClusterControllerStatus(ctx context.Context,
 
 	result, err := r.client.ExecContext(ctx, query, clusterID)
 	if err != nil {
-		r.logger.Error("Failed to delete all cluster controller status",
+		r.logger.WithContext(ctx).Error("Failed to delete all cluster controller status",
 			zap.String("cluster_id", clusterID.String()),
 			zap.Error(err),
 		)
@@ -215,7 +589,7 @@ func (r *StatusRepository) DeleteAllClusterControllerStatus(ctx context.Context,
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 
-	r.logger.Info("All cluster controller status deleted",
+	r.logger.WithContext(ctx).Info("All cluster controller status deleted",
 		zap.String("cluster_id", clusterID.String()),
 		zap.Int64("rows_affected", rowsAffected),
 	)
@@ -223,10 +597,97 @@ func (r *StatusRepository) DeleteAllClusterControllerStatus(ctx context.Context,
 	return nil
 }
 
-// UpsertNodePoolControllerStatus inserts or updates nodepool controller status
+// ClearClusterErrors clears the last error on every controller status report for a cluster and
+// returns the number of reports cleared. Controllers that are still failing report the error
+// again on their next status update.
+func (r *StatusRepository) ClearClusterErrors(ctx context.Context, clusterID uuid.UUID) (int64, error) {
+	query := `UPDATE controller_status SET last_error = NULL WHERE cluster_id = $1 AND last_error IS NOT NULL`
+
+	result, err := r.client.ExecContext(ctx, query, clusterID)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to clear cluster controller errors",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		return 0, fmt.Errorf("failed to clear cluster controller errors: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	r.logger.WithContext(ctx).Info("Cluster controller errors cleared",
+		zap.String("cluster_id", clusterID.String()),
+		zap.Int64("rows_affected", rowsAffected),
+	)
+
+	return rowsAffected, nil
+}
+
+// ClearNodePoolErrorsByCluster clears the last error on the controller status reports of every
+// nodepool in a cluster and returns the number of reports cleared
+func (r *StatusRepository) ClearNodePoolErrorsByCluster(ctx context.Context, clusterID uuid.UUID) (int64, error) {
+	query := `
+		UPDATE nodepool_controller_status npcs
+		SET last_error = NULL
+		FROM nodepools np
+		WHERE npcs.nodepool_id = np.id AND np.cluster_id = $1 AND np.deleted_at IS NULL
+			AND npcs.last_error IS NOT NULL`
+
+	result, err := r.client.ExecContext(ctx, query, clusterID)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to clear nodepool controller errors",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		return 0, fmt.Errorf("failed to clear nodepool controller errors: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	r.logger.WithContext(ctx).Info("Nodepool controller errors cleared",
+		zap.String("cluster_id", clusterID.String()),
+		zap.Int64("rows_affected", rowsAffected),
+	)
+
+	return rowsAffected, nil
+}
+
+// UpsertNodePoolControllerStatus inserts or updates nodepool controller status. The stored
+// conditions are read and merged in one transaction, joining the caller's when there is one.
 func (r *StatusRepository) UpsertNodePoolControllerStatus(ctx context.Context, status *models.NodePoolControllerStatus) error {
+	if r.client.tx != nil {
+		return r.upsertNodePoolControllerStatusTx(ctx, status)
+	}
+
+	// Upserting merges conditions into the report, so restore it if the transaction is retried
+	reported := *status
+	return r.client.Transaction(ctx, func(tx *sql.Tx) error {
+		*status = reported
+		return r.withClient(r.client.withTx(tx)).upsertNodePoolControllerStatusTx(ctx, status)
+	})
+}
+
+func (r *StatusRepository) upsertNodePoolControllerStatusTx(ctx context.Context, status *models.NodePoolControllerStatus) error {
 	status.LastUpdated = time.Now()
 
+	// Merge reported conditions with stored ones so transition times are server-managed
+	stored, err := r.getStoredConditions(ctx,
+		`SELECT conditions FROM nodepool_controller_status WHERE nodepool_id = $1 AND controller_name = $2 FOR UPDATE`,
+		status.NodePoolID, status.ControllerName)
+	if err != nil {
+		return err
+	}
+	merged, err := stored.MergeReported(status.Conditions, status.LastUpdated)
+	if err != nil {
+		return fmt.Errorf("%w: %v", models.ErrInvalidInput, err)
+	}
+	status.Conditions = merged
+
 	query := `
 		INSERT INTO nodepool_controller_status (
 			nodepool_id, controller_name, observed_generation, conditions,
@@ -242,7 +703,7 @@ func (r *StatusRepository) UpsertNodePoolControllerStatus(ctx context.Context, s
 			last_error = EXCLUDED.last_error,
 			updated_at = EXCLUDED.updated_at`
 
-	_, err := r.client.ExecContext(ctx, query,
+	_, err = r.client.ExecContext(ctx, query,
 		status.NodePoolID,
 		status.ControllerName,
 		status.ObservedGeneration,
@@ -253,7 +714,7 @@ func (r *StatusRepository) UpsertNodePoolControllerStatus(ctx context.Context, s
 	)
 
 	if err != nil {
-		r.logger.Error("Failed to upsert nodepool controller status",
+		r.logger.WithContext(ctx).Error("Failed to upsert nodepool controller status",
 			zap.String("nodepool_id", status.NodePoolID.String()),
 			zap.String("controller_name", status.ControllerName),
 			zap.Error(err),
@@ -261,7 +722,7 @@ func (r *StatusRepository) UpsertNodePoolControllerStatus(ctx context.Context, s
 		return fmt.Errorf("failed to upsert nodepool controller status: %w", err)
 	}
 
-	r.logger.Debug("NodePool controller status upserted",
+	r.logger.WithContext(ctx).Debug("NodePool controller status upserted",
 		zap.String("nodepool_id", status.NodePoolID.String()),
 		zap.String("controller_name", status.ControllerName),
 		zap.Int64("observed_generation", status.ObservedGeneration),
@@ -293,7 +754,7 @@ func (r *StatusRepository) GetNodePoolControllerStatus(ctx context.Context, node
 		return nil, fmt.Errorf("controller status not found for nodepool %s, controller %s", nodepoolID, controllerName)
 	}
 	if err != nil {
-		r.logger.Error("Failed to get nodepool controller status",
+		r.logger.WithContext(ctx).Error("Failed to get nodepool controller status",
 			zap.String("nodepool_id", nodepoolID.String()),
 			zap.String("controller_name", controllerName),
 			zap.Error(err),
@@ -301,6 +762,7 @@ func (r *StatusRepository) GetNodePoolControllerStatus(ctx context.Context, node
 		return nil, fmt.Errorf("failed to get nodepool controller status: %w", err)
 	}
 
+	attributeError(status.LastError, status.ControllerName)
 	return &status, nil
 }
 
@@ -315,7 +777,7 @@ func (r *StatusRepository) ListNodePoolControllerStatus(ctx context.Context, nod
 
 	rows, err := r.client.QueryContext(ctx, query, nodepoolID)
 	if err != nil {
-		r.logger.Error("Failed to list nodepool controller status",
+		r.logger.WithContext(ctx).Error("Failed to list nodepool controller status",
 			zap.String("nodepool_id", nodepoolID.String()),
 			zap.Error(err),
 		)
@@ -336,14 +798,15 @@ func (r *StatusRepository) ListNodePoolControllerStatus(ctx context.Context, nod
 			&status.LastUpdated,
 		)
 		if err != nil {
-			r.logger.Error("Failed to scan nodepool controller status row", zap.Error(err))
+			r.logger.WithContext(ctx).Error("Failed to scan nodepool controller status row", zap.Error(err))
 			return nil, fmt.Errorf("failed to scan nodepool controller status: %w", err)
 		}
+		attributeError(status.LastError, status.ControllerName)
 		statuses = append(statuses, &status)
 	}
 
 	if err = rows.Err(); err != nil {
-		r.logger.Error("Error iterating nodepool controller status rows", zap.Error(err))
+		r.logger.WithContext(ctx).Error("Error iterating nodepool controller status rows", zap.Error(err))
 		return nil, fmt.Errorf("error iterating nodepool controller status: %w", err)
 	}
 
@@ -362,7 +825,7 @@ func (r *StatusRepository) ListNodePoolControllerStatusByCluster(ctx context.Con
 
 	rows, err := r.client.QueryContext(ctx, query, clusterID)
 	if err != nil {
-		r.logger.Error("Failed to list nodepool controller status by cluster",
+		r.logger.WithContext(ctx).Error("Failed to list nodepool controller status by cluster",
 			zap.String("cluster_id", clusterID.String()),
 			zap.Error(err),
 		)
@@ -383,27 +846,29 @@ func (r *StatusRepository) ListNodePoolControllerStatusByCluster(ctx context.Con
 			&status.LastUpdated,
 		)
 		if err != nil {
-			r.logger.Error("Failed to scan nodepool controller status row", zap.Error(err))
+			r.logger.WithContext(ctx).Error("Failed to scan nodepool controller status row", zap.Error(err))
 			return nil, fmt.Errorf("failed to scan nodepool controller status: %w", err)
 		}
+		attributeError(status.LastError, status.ControllerName)
 		statuses = append(statuses, &status)
 	}
 
 	if err = rows.Err(); err != nil {
-		r.logger.Error("Error iterating nodepool controller status rows", zap.Error(err))
+		r.logger.WithContext(ctx).Error("Error iterating nodepool controller status rows", zap.Error(err))
 		return nil, fmt.Errorf("error iterating nodepool controller status: %w", err)
 	}
 
 	return statuses, nil
 }
 
-// DeleteNodePoolControllerStatus deletes status for a specific nodepool controller
+// DeleteNodePoolControllerStatus deletes status for a specific nodepool controller. It returns
+// models.ErrControllerStatusNotFound when the controller has no status for the nodepool.
 func (r *StatusRepository) DeleteNodePoolControllerStatus(ctx context.Context, nodepoolID uuid.UUID, controllerName string) error {
 	query := `DELETE FROM nodepool_controller_status WHERE nodepool_id = $1 AND controller_name = $2`
 
 	result, err := r.client.ExecContext(ctx, query, nodepoolID, controllerName)
 	if err != nil {
-		r.logger.Error("Failed to delete nodepool controller status",
+		r.logger.WithContext(ctx).Error("Failed to delete nodepool controller status",
 			zap.String("nodepool_id", nodepoolID.String()),
 			zap.String("controller_name", controllerName),
 			zap.Error(err),
@@ -416,7 +881,11 @@ func (r *StatusRepository) DeleteNodePoolControllerStatus(ctx context.Context, n
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 
-	r.logger.Debug("NodePool controller status deleted",
+	if rowsAffected == 0 {
+		return models.ErrControllerStatusNotFound
+	}
+
+	r.logger.WithContext(ctx).Debug("NodePool controller status deleted",
 		zap.String("nodepool_id", nodepoolID.String()),
 		zap.String("controller_name", controllerName),
 		zap.Int64("rows_affected", rowsAffected),
@@ -431,7 +900,7 @@ func (r *StatusRepository) DeleteAllNodePoolControllerStatus(ctx context.Context
 
 	result, err := r.client.ExecContext(ctx, query, nodepoolID)
 	if err != nil {
-		r.logger.Error("Failed to delete all nodepool controller status",
+		r.logger.WithContext(ctx).Error("Failed to delete all nodepool controller status",
 			zap.String("nodepool_id", nodepoolID.String()),
 			zap.Error(err),
 		)
@@ -443,7 +912,7 @@ func (r *StatusRepository) DeleteAllNodePoolControllerStatus(ctx context.Context
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 
-	r.logger.Info("All nodepool controller status deleted",
+	r.logger.WithContext(ctx).Info("All nodepool controller status deleted",
 		zap.String("nodepool_id", nodepoolID.String()),
 		zap.Int64("rows_affected", rowsAffected),
 	)
@@ -451,6 +920,35 @@ func (r *StatusRepository) DeleteAllNodePoolControllerStatus(ctx context.Context
 	return nil
 }
 
+// DeleteNodePoolControllerStatusByCluster deletes the controller status of every nodepool of
+// a cluster, including soft-deleted nodepools
+func (r *StatusRepository) DeleteNodePoolControllerStatusByCluster(ctx context.Context, clusterID uuid.UUID) error {
+	query := `
+		DELETE FROM nodepool_controller_status
+		WHERE nodepool_id IN (SELECT id FROM nodepools WHERE cluster_id = $1)`
+
+	result, err := r.client.ExecContext(ctx, query, clusterID)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to delete nodepool controller status by cluster",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to delete nodepool controller status by cluster: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	r.logger.WithContext(ctx).Info("Nodepool controller status deleted by cluster",
+		zap.String("cluster_id", clusterID.String()),
+		zap.Int64("rows_affected", rowsAffected),
+	)
+
+	return nil
+}
+
 // CreateClusterEvent creates a new cluster event
 func (r *StatusRepository) CreateClusterEvent(ctx context.Context, event *models.ClusterEvent) error {
 	event.BeforeCreate()
@@ -469,7 +967,7 @@ func (r *StatusRepository) CreateClusterEvent(ctx context.Context, event *models
 	)
 
 	if err != nil {
-		r.logger.Error("Failed to create cluster event",
+		r.logger.WithContext(ctx).Error("Failed to create cluster event",
 			zap.String("cluster_id", event.ClusterID.String()),
 			zap.String("event_type", event.EventType),
 			zap.Error(err),
@@ -477,7 +975,7 @@ func (r *StatusRepository) CreateClusterEvent(ctx context.Context, event *models
 		return fmt.Errorf("failed to create cluster event: %w", err)
 	}
 
-	r.logger.Debug("Cluster event created",
+	r.logger.WithContext(ctx).Debug("Cluster event created",
 		zap.String("event_id", event.ID.String()),
 		zap.String("cluster_id", event.ClusterID.String()),
 		zap.String("event_type", event.EventType),
@@ -486,30 +984,49 @@ func (r *StatusRepository) CreateClusterEvent(ctx context.Context, event *models
 	return nil
 }
 
-// ListClusterEvents retrieves events for a cluster
-func (r *StatusRepository) ListClusterEvents(ctx context.Context, clusterID uuid.UUID, limit int) ([]*models.ClusterEvent, error) {
+// ListClusterEvents retrieves a page of a cluster's events, newest first, along with the
+// total number of events matching the options
+func (r *StatusRepository) ListClusterEvents(ctx context.Context, clusterID uuid.UUID, opts *models.ClusterEventListOptions) ([]*models.ClusterEvent, int64, error) {
+	limit := opts.Limit
 	if limit <= 0 {
 		limit = 50
 	}
 
-	query := `
+	where := "WHERE cluster_id = $1"
+	args := []interface{}{clusterID}
+	if opts.EventType != "" {
+		args = append(args, opts.EventType)
+		where += fmt.Sprintf(" AND event_type = $%d", len(args))
+	}
+
+	var total int64
+	countQuery := "SELECT COUNT(*) FROM cluster_events " + where
+	if err := r.client.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		r.logger.WithContext(ctx).Error("Failed to count cluster events",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		return nil, 0, fmt.Errorf("failed to count cluster events: %w", err)
+	}
+
+	query := fmt.Sprintf(`
 		SELECT id, cluster_id, event_type, metadata, published_at
 		FROM cluster_events
-		WHERE cluster_id = $1
-		ORDER BY published_at DESC
-		LIMIT $2`
+		%s
+		ORDER BY published_at DESC, id DESC
+		LIMIT $%d OFFSET $%d`, where, len(args)+1, len(args)+2)
 
-	rows, err := r.client.QueryContext(ctx, query, clusterID, limit)
+	rows, err := r.client.QueryContext(ctx, query, append(args, limit, opts.Offset)...)
 	if err != nil {
-		r.logger.Error("Failed to list cluster events",
+		r.logger.WithContext(ctx).Error("Failed to list cluster events",
 			zap.String("cluster_id", clusterID.String()),
 			zap.Error(err),
 		)
-		return nil, fmt.Errorf("failed to list cluster events: %w", err)
+		return nil, 0, fmt.Errorf("failed to list cluster events: %w", err)
 	}
 	defer rows.Close()
 
-	var events []*models.ClusterEvent
+	events := []*models.ClusterEvent{}
 	for rows.Next() {
 		var event models.ClusterEvent
 		err := rows.Scan(
@@ -520,8 +1037,8 @@ func (r *StatusRepository) ListClusterEvents(ctx context.Context, clusterID uuid
 			&event.PublishedAt,
 		)
 		if err != nil {
-			r.logger.Error("Failed to scan cluster event row", zap.Error(err))
-			return nil, fmt.Errorf("failed to scan cluster event: %w", err)
+			r.logger.WithContext(ctx).Error("Failed to scan cluster event row", zap.Error(err))
+			return nil, 0, fmt.Errorf("failed to scan cluster event: %w", err)
 		}
 		// Set generation to 0 as default since it's not stored in this schema
 		event.Generation = 0
@@ -529,24 +1046,334 @@ func (r *StatusRepository) ListClusterEvents(ctx context.Context, clusterID uuid
 	}
 
 	if err = rows.Err(); err != nil {
-		r.logger.Error("Error iterating cluster event rows", zap.Error(err))
-		return nil, fmt.Errorf("error iterating cluster events: %w", err)
+		r.logger.WithContext(ctx).Error("Error iterating cluster event rows", zap.Error(err))
+		return nil, 0, fmt.Errorf("error iterating cluster events: %w", err)
+	}
+
+	return events, total, nil
+}
+
+// CreateNodePoolEvent creates a new nodepool event
+func (r *StatusRepository) CreateNodePoolEvent(ctx context.Context, event *models.NodePoolEvent) error {
+	event.BeforeCreate()
+
+	query := `
+		INSERT INTO nodepool_events (id, nodepool_id, cluster_id, event_type, generation, metadata, published_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := r.client.ExecContext(ctx, query,
+		event.ID,
+		event.NodePoolID,
+		event.ClusterID,
+		event.EventType,
+		event.Generation,
+		event.Changes,
+		event.PublishedAt,
+	)
+
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to create nodepool event",
+			zap.String("nodepool_id", event.NodePoolID.String()),
+			zap.String("event_type", event.EventType),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create nodepool event: %w", err)
+	}
+
+	r.logger.WithContext(ctx).Debug("Nodepool event created",
+		zap.String("event_id", event.ID.String()),
+		zap.String("nodepool_id", event.NodePoolID.String()),
+		zap.String("event_type", event.EventType),
+	)
+
+	return nil
+}
+
+// ListNodePoolEvents retrieves a page of a nodepool's events, newest first, along with the
+// total number of events matching the options
+func (r *StatusRepository) ListNodePoolEvents(ctx context.Context, nodepoolID uuid.UUID, opts *models.NodePoolEventListOptions) ([]*models.NodePoolEvent, int64, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+
+	where := "WHERE nodepool_id = $1"
+	args := []interface{}{nodepoolID}
+	if opts.EventType != "" {
+		args = append(args, opts.EventType)
+		where += fmt.Sprintf(" AND event_type = $%d", len(args))
+	}
+
+	var total int64
+	countQuery := "SELECT COUNT(*) FROM nodepool_events " + where
+	if err := r.client.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		r.logger.WithContext(ctx).Error("Failed to count nodepool events",
+			zap.String("nodepool_id", nodepoolID.String()),
+			zap.Error(err),
+		)
+		return nil, 0, fmt.Errorf("failed to count nodepool events: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, nodepool_id, cluster_id, event_type, generation, metadata, published_at
+		FROM nodepool_events
+		%s
+		ORDER BY published_at DESC, id DESC
+		LIMIT $%d OFFSET $%d`, where, len(args)+1, len(args)+2)
+
+	rows, err := r.client.QueryContext(ctx, query, append(args, limit, opts.Offset)...)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to list nodepool events",
+			zap.String("nodepool_id", nodepoolID.String()),
+			zap.Error(err),
+		)
+		return nil, 0, fmt.Errorf("failed to list nodepool events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []*models.NodePoolEvent{}
+	for rows.Next() {
+		var event models.NodePoolEvent
+		err := rows.Scan(
+			&event.ID,
+			&event.NodePoolID,
+			&event.ClusterID,
+			&event.EventType,
+			&event.Generation,
+			&event.Changes,
+			&event.PublishedAt,
+		)
+		if err != nil {
+			r.logger.WithContext(ctx).Error("Failed to scan nodepool event row", zap.Error(err))
+			return nil, 0, fmt.Errorf("failed to scan nodepool event: %w", err)
+		}
+		events = append(events, &event)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.WithContext(ctx).Error("Error iterating nodepool event rows", zap.Error(err))
+		return nil, 0, fmt.Errorf("error iterating nodepool events: %w", err)
+	}
+
+	return events, total, nil
+}
+
+// ListPhaseTransitions retrieves the aggregated phase changes of a cluster, oldest first
+func (r *StatusRepository) ListPhaseTransitions(ctx context.Context, clusterID uuid.UUID, limit int) ([]*models.PhaseTransition, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	query := `
+		SELECT metadata->>'from_phase', metadata->>'to_phase', published_at
+		FROM cluster_events
+		WHERE cluster_id = $1 AND event_type = $2
+		ORDER BY published_at ASC, id ASC
+		LIMIT $3`
+
+	rows, err := r.client.QueryContext(ctx, query, clusterID, models.EventTypePhaseTransition, limit)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to list phase transitions",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to list phase transitions: %w", err)
+	}
+	defer rows.Close()
+
+	transitions := []*models.PhaseTransition{}
+	for rows.Next() {
+		var transition models.PhaseTransition
+		if err := rows.Scan(&transition.FromPhase, &transition.ToPhase, &transition.TransitionedAt); err != nil {
+			r.logger.WithContext(ctx).Error("Failed to scan phase transition row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan phase transition: %w", err)
+		}
+		transitions = append(transitions, &transition)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.WithContext(ctx).Error("Error iterating phase transition rows", zap.Error(err))
+		return nil, fmt.Errorf("error iterating phase transitions: %w", err)
+	}
+
+	return transitions, nil
+}
+
+// RecordFailedEvent persists a reconciliation event that could not be published
+func (r *StatusRepository) RecordFailedEvent(ctx context.Context, event *models.FailedEvent) error {
+	if event.ID == uuid.Nil {
+		event.ID = uuid.New()
+	}
+	event.FailedAt = time.Now()
+
+	query := `
+		INSERT INTO failed_events (id, event_type, cluster_id, nodepool_id, payload, error_message, failed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := r.client.ExecContext(ctx, query,
+		event.ID,
+		event.EventType,
+		event.ClusterID,
+		event.NodePoolID,
+		[]byte(event.Payload),
+		event.ErrorMessage,
+		event.FailedAt,
+	)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to record failed event",
+			zap.String("event_type", event.EventType),
+			zap.String("cluster_id", event.ClusterID.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to record failed event: %w", err)
+	}
+
+	return nil
+}
+
+// ListFailedEvents lists failed events, most recent first. Replayed events are
+// only included when includeReplayed is true.
+func (r *StatusRepository) ListFailedEvents(ctx context.Context, includeReplayed bool, limit int) ([]*models.FailedEvent, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+
+	query := `
+		SELECT id, event_type, cluster_id, nodepool_id, payload, error_message, failed_at, replayed_at
+		FROM failed_events
+		WHERE $1 OR replayed_at IS NULL
+		ORDER BY failed_at DESC
+		LIMIT $2`
+
+	rows, err := r.client.QueryContext(ctx, query, includeReplayed, limit)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("Failed to list failed events", zap.Error(err))
+		return nil, fmt.Errorf("failed to list failed events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []*models.FailedEvent{}
+	for rows.Next() {
+		event, err := scanFailedEvent(rows)
+		if err != nil {
+			r.logger.WithContext(ctx).Error("Failed to scan failed event row", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan failed event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.logger.WithContext(ctx).Error("Error iterating failed event rows", zap.Error(err))
+		return nil, fmt.Errorf("error iterating failed events: %w", err)
 	}
 
 	return events, nil
 }
 
+// GetFailedEvent retrieves a failed event by ID
+func (r *StatusRepository) GetFailedEvent(ctx context.Context, id uuid.UUID) (*models.FailedEvent, error) {
+	query := `
+		SELECT id, event_type, cluster_id, nodepool_id, payload, error_message, failed_at, replayed_at
+		FROM failed_events
+		WHERE id = $1`
+
+	event, err := scanFailedEvent(r.client.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrFailedEventNotFound
+		}
+		r.logger.WithContext(ctx).Error("Failed to get failed event",
+			zap.String("id", id.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to get failed event: %w", err)
+	}
+
+	return event, nil
+}
+
+// MarkFailedEventReplayed records that a failed event was successfully replayed
+func (r *StatusRepository) MarkFailedEventReplayed(ctx context.Context, id uuid.UUID) error {
+	query := `UPDATE failed_events SET replayed_at = NOW() WHERE id = $1`
+
+	result, err := r.client.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark failed event replayed: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrFailedEventNotFound
+	}
+
+	return nil
+}
+
+// rowScanner is implemented by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanFailedEvent scans a failed_events row
+func scanFailedEvent(row rowScanner) (*models.FailedEvent, error) {
+	var event models.FailedEvent
+	var payload []byte
+	err := row.Scan(
+		&event.ID,
+		&event.EventType,
+		&event.ClusterID,
+		&event.NodePoolID,
+		&payload,
+		&event.ErrorMessage,
+		&event.FailedAt,
+		&event.ReplayedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	event.Payload = payload
+	return &event, nil
+}
+
+// GetClusterErrors returns the errors reported by the cluster and nodepool controllers of a cluster,
+// newest first. Repeats of an error from the same controller are always dropped; identical errors
+// from different controllers are collapsed into one entry when error collapsing is enabled
+func (r *StatusRepository) GetClusterErrors(ctx context.Context, clusterID uuid.UUID) ([]models.ErrorInfo, error) {
+	errors, err := r.getClusterErrors(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	errors = models.DedupeErrors(errors)
+	if r.collapseErrors {
+		errors = models.CollapseErrors(errors)
+	}
+	if errors == nil {
+		errors = []models.ErrorInfo{}
+	}
+
+	sort.SliceStable(errors, func(i, j int) bool {
+		return errors[i].Timestamp.After(errors[j].Timestamp)
+	})
+	return errors, nil
+}
+
 // getClusterErrors retrieves detailed error information for a cluster
 func (r *StatusRepository) getClusterErrors(ctx context.Context, clusterID uuid.UUID) ([]models.ErrorInfo, error) {
 	query := `
-		SELECT last_error
+		SELECT controller_name, last_error
 		FROM controller_status
 		WHERE cluster_id = $1 AND last_error IS NOT NULL
 		UNION ALL
-		SELECT npcs.last_error
+		SELECT npcs.controller_name, npcs.last_error
 		FROM nodepool_controller_status npcs
 		JOIN nodepools np ON npcs.nodepool_id = np.id
-		WHERE np.cluster_id = $1 AND npcs.last_error IS NOT NULL AND np.deleted_at IS NULL`
+		WHERE np.cluster_id = $1 AND npcs.last_error IS NOT NULL AND np.deleted_at IS NULL
+		ORDER BY controller_name`
 
 	rows, err := r.client.QueryContext(ctx, query, clusterID)
 	if err != nil {
@@ -556,13 +1383,27 @@ func (r *StatusRepository) getClusterErrors(ctx context.Context, clusterID uuid.
 
 	var errors []models.ErrorInfo
 	for rows.Next() {
+		var controllerName string
 		var errorInfo models.ErrorInfo
-		err := rows.Scan(&errorInfo)
+		err := rows.Scan(&controllerName, &errorInfo)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan error info: %w", err)
 		}
+		attributeError(&errorInfo, controllerName)
 		errors = append(errors, errorInfo)
 	}
 
 	return errors, rows.Err()
 }
+
+// GetNodePoolRollup summarizes the readiness of a cluster's nodepools
+func (r *StatusRepository) GetNodePoolRollup(ctx context.Context, clusterID uuid.UUID) (*models.NodePoolRollup, error) {
+	return NewStatusAggregator(r.client, nil).GetNodePoolRollup(ctx, clusterID)
+}
+
+// attributeError fills in the reporting controller on an error that does not name one
+func attributeError(errorInfo *models.ErrorInfo, controllerName string) {
+	if errorInfo != nil && errorInfo.ControllerName == "" {
+		errorInfo.ControllerName = controllerName
+	}
+}
@@ -2,6 +2,8 @@ package database
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -11,18 +13,127 @@ import (
 	"go.uber.org/zap"
 )
 
+// StatusAggregatorVersion identifies the cluster status aggregation logic. It is stamped
+// into every cached status; bump it whenever the aggregation rules change so statuses
+// cached by older logic are recomputed on their next read.
+const StatusAggregatorVersion = 5
+
 // StatusAggregator handles real-time status aggregation logic
 type StatusAggregator struct {
-	client *Client
-	logger *utils.Logger
+	client   *Client
+	logger   *utils.Logger
+	version  int            // Aggregator version stamped into computed statuses
+	strategy StatusStrategy // Overrides the client's per-platform strategies when set
+}
+
+// PhaseTransitionNotifier is told when aggregation changes a cluster's cached phase. oldPhase is
+// empty for a cluster whose status had never been calculated.
+type PhaseTransitionNotifier interface {
+	NotifyPhaseTransition(ctx context.Context, clusterID uuid.UUID, oldPhase, newPhase string)
 }
 
-// NewStatusAggregator creates a new status aggregator
-func NewStatusAggregator(client *Client) *StatusAggregator {
+// NewStatusAggregator creates a new status aggregator. Cluster status is computed with
+// strategy, or when it is nil with the strategy configured on the client for the cluster's
+// platform, falling back to DefaultStrategy.
+func NewStatusAggregator(client *Client, strategy StatusStrategy) *StatusAggregator {
 	return &StatusAggregator{
-		client: client,
-		logger: utils.NewLogger("status_aggregator"),
+		client:   client,
+		logger:   utils.NewLogger("status_aggregator"),
+		version:  StatusAggregatorVersion,
+		strategy: strategy,
+	}
+}
+
+// ReadinessConfig weights controllers when computing readiness progress
+type ReadinessConfig struct {
+	// ControllerWeights maps controller names to their weight; controllers not listed weigh 1
+	ControllerWeights map[string]int
+
+	// ReadyThreshold is the weighted readiness percentage at which a cluster or nodepool is
+	// Ready. 0 or 100 keeps the flat rule that every controller must be ready.
+	ReadyThreshold int
+
+	// StalenessThreshold is how long a controller may go without reporting before it no
+	// longer counts as ready, whatever it last reported. 0 disables the check.
+	StalenessThreshold time.Duration
+}
+
+// readiness returns the readiness configuration of the aggregator's client
+func (a *StatusAggregator) readiness() ReadinessConfig {
+	if a.client == nil {
+		return ReadinessConfig{}
+	}
+	return a.client.readiness
+}
+
+// statusExpired reports whether a cached status computed at lastUpdate is older than the
+// staleness threshold. A controller that goes silent writes nothing that would mark the
+// status dirty, so without expiry a cached Ready would never see the staleness rule.
+func (a *StatusAggregator) statusExpired(lastUpdate time.Time) bool {
+	threshold := a.readiness().StalenessThreshold
+	return threshold > 0 && time.Since(lastUpdate) > threshold
+}
+
+// staleClusterStatusCondition returns a SQL condition matching the clusters whose cached status
+// EnrichClusterWithStatus would recalculate: dirty, never calculated, computed by a different
+// aggregator version or expired. Its placeholders continue after args.
+func (a *StatusAggregator) staleClusterStatusCondition(args []interface{}) (string, []interface{}) {
+	args = append(args, a.version)
+	condition := fmt.Sprintf(`(status_dirty = TRUE OR status IS NULL
+			OR COALESCE((status->>'aggregatorVersion')::int, 0) <> $%d`, len(args))
+
+	if threshold := a.readiness().StalenessThreshold; threshold > 0 {
+		args = append(args, time.Now().Add(-threshold))
+		condition += fmt.Sprintf(`
+			OR (status->>'lastUpdateTime')::timestamptz < $%d`, len(args))
+	}
+	return condition + ")", args
+}
+
+// controllerWeightsJSON encodes the controller weights for the stats queries
+func (a *StatusAggregator) controllerWeightsJSON() (string, error) {
+	weights := a.readiness().ControllerWeights
+	if weights == nil {
+		weights = map[string]int{}
+	}
+	data, err := json.Marshal(weights)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal controller weights: %w", err)
+	}
+	return string(data), nil
+}
+
+// stalenessSeconds returns the staleness threshold in seconds for the stats queries, where 0
+// disables the check
+func (a *StatusAggregator) stalenessSeconds() float64 {
+	return a.readiness().StalenessThreshold.Seconds()
+}
+
+// readinessProgress returns the weighted percentage of ready controllers, rounded down so
+// that 100 means every controller is ready
+func readinessProgress(stats *ControllerStats) int {
+	if stats.TotalWeight <= 0 {
+		return 0
+	}
+	return stats.ReadyWeight * 100 / stats.TotalWeight
+}
+
+// passesReadyGate reports whether enough controllers are ready for the Ready phase: all of
+// them, or with a ready threshold configured, enough of the weighted readiness
+func passesReadyGate(readiness ReadinessConfig, stats *ControllerStats) bool {
+	threshold := readiness.ReadyThreshold
+	if threshold <= 0 || threshold >= 100 {
+		return stats.ReadyCount == stats.TotalCount
 	}
+	return readinessProgress(stats) >= threshold
+}
+
+// readyControllersMessage describes the ready controllers of a status that passed the Ready gate
+func readyControllersMessage(stats *ControllerStats, state string) string {
+	if stats.ReadyCount < stats.TotalCount {
+		return fmt.Sprintf("%d of %d controllers are %s (%d%% weighted readiness)", stats.ReadyCount, stats.TotalCount, state, readinessProgress(stats))
+	}
+	return fmt.Sprintf("All %d controllers are %s", stats.TotalCount, state)
 }
 
 // StatusAggregationResult contains the computed status information
@@ -33,6 +144,7 @@ type StatusAggregationResult struct {
 	FailedControllers int                       `json:"failed_controllers"`
 	HasErrors         bool                      `json:"has_errors"`
 	Generation        int64                     `json:"generation"`
+	NodePools         *models.NodePoolRollup    `json:"nodepools,omitempty"`
 }
 
 // CalculateClusterStatus performs real-time status aggregation for a cluster
@@ -42,7 +154,7 @@ func (a *StatusAggregator) CalculateClusterStatus(ctx context.Context, cluster *
 		return nil, fmt.Errorf("cluster cannot be nil")
 	}
 
-	a.logger.Debug("Calculating cluster status",
+	a.logger.WithContext(ctx).Debug("Calculating cluster status",
 		zap.String("cluster_id", cluster.ID.String()),
 		zap.Int64("generation", cluster.Generation),
 	)
@@ -53,10 +165,18 @@ func (a *StatusAggregator) CalculateClusterStatus(ctx context.Context, cluster *
 		return nil, fmt.Errorf("failed to get controller stats: %w", err)
 	}
 
-	// Apply aggregation logic (same logic as the PostgreSQL function)
-	result := a.applyAggregationRules(stats, cluster.Generation)
+	// Apply the aggregation rules of the cluster's platform
+	result := a.aggregate(cluster.Spec.Platform.Type, stats, cluster.Generation, cluster.Spec.ExpectNoControllers)
+
+	// Fold the readiness of the cluster's nodepools into the cluster status
+	rollup, err := a.GetNodePoolRollup(ctx, cluster.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nodepool rollup: %w", err)
+	}
+	a.applyNodePoolRollup(result, rollup)
+	result.Status.AggregatorVersion = a.version
 
-	a.logger.Debug("Calculated cluster status",
+	a.logger.WithContext(ctx).Debug("Calculated cluster status",
 		zap.String("cluster_id", cluster.ID.String()),
 		zap.String("phase", result.Status.Phase),
 		zap.String("reason", result.Status.Reason),
@@ -72,9 +192,14 @@ type ControllerStats struct {
 	TotalCount                   int
 	ReadyCount                   int
 	ErrorCount                   int
+	TotalWeight                  int // Sum of the controllers' readiness weights
+	ReadyWeight                  int // Sum of the ready controllers' readiness weights
 	Generation                   int64
 	EarliestControllerReportTime *time.Time // When first controller reported status
 	HasRecentActivity            bool       // Any controller updated in last 5 minutes
+	SilentCount                  int        // Controllers that haven't reported within the staleness threshold
+	MinObservedGeneration        int64      // Lowest generation any controller has observed, at any generation
+	MaxObservedGeneration        int64      // Highest generation any controller has observed, at any generation
 }
 
 // getControllerStats queries controller status and counts them for the current generation
@@ -88,32 +213,55 @@ func (a *StatusAggregator) getControllerStats(ctx context.Context, clusterID uui
 					FROM jsonb_array_elements(conditions) AS condition
 					WHERE condition->>'type' = 'Available' AND condition->>'status' = 'True'
 				) > 0
+				AND ($4::float8 <= 0 OR updated_at > NOW() - make_interval(secs => $4::float8))
 			THEN 1 END) AS ready,
 			COUNT(CASE WHEN last_error IS NOT NULL THEN 1 END) AS errors,
+			COALESCE(SUM(COALESCE(($3::jsonb ->> controller_name)::int, 1)), 0) AS total_weight,
+			COALESCE(SUM(CASE WHEN
+				(
+					SELECT COUNT(*)
+					FROM jsonb_array_elements(conditions) AS condition
+					WHERE condition->>'type' = 'Available' AND condition->>'status' = 'True'
+				) > 0
+				AND ($4::float8 <= 0 OR updated_at > NOW() - make_interval(secs => $4::float8))
+			THEN COALESCE(($3::jsonb ->> controller_name)::int, 1) END), 0) AS ready_weight,
 			MIN(updated_at) AS earliest_report_time,
-			COUNT(CASE WHEN updated_at > NOW() - INTERVAL '5 minutes' THEN 1 END) > 0 AS has_recent_activity
+			COUNT(CASE WHEN updated_at > NOW() - INTERVAL '5 minutes' THEN 1 END) > 0 AS has_recent_activity,
+			COUNT(CASE WHEN $4::float8 > 0 AND updated_at <= NOW() - make_interval(secs => $4::float8) THEN 1 END) AS silent,
+			(SELECT COALESCE(MIN(observed_generation), 0) FROM controller_status WHERE cluster_id = $1) AS min_generation,
+			(SELECT COALESCE(MAX(observed_generation), 0) FROM controller_status WHERE cluster_id = $1) AS max_generation
 		FROM controller_status
 		WHERE cluster_id = $1 AND observed_generation = $2`
 
 	var stats ControllerStats
 	var earliestReportTime *time.Time
 
-	a.logger.Debug("Executing controller stats query",
+	a.logger.WithContext(ctx).Debug("Executing controller stats query",
 		zap.String("cluster_id", clusterID.String()),
 		zap.Int64("generation", generation),
 		zap.String("query", query),
 	)
 
-	err := a.client.QueryRowContext(ctx, query, clusterID, generation).Scan(
+	weights, err := a.controllerWeightsJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.client.QueryRowContext(ctx, query, clusterID, generation, weights, a.stalenessSeconds()).Scan(
 		&stats.TotalCount,
 		&stats.ReadyCount,
 		&stats.ErrorCount,
+		&stats.TotalWeight,
+		&stats.ReadyWeight,
 		&earliestReportTime,
 		&stats.HasRecentActivity,
+		&stats.SilentCount,
+		&stats.MinObservedGeneration,
+		&stats.MaxObservedGeneration,
 	)
 
 	if err != nil {
-		a.logger.Error("Failed to get controller stats",
+		a.logger.WithContext(ctx).Error("Failed to get controller stats",
 			zap.String("cluster_id", clusterID.String()),
 			zap.Int64("generation", generation),
 			zap.Error(err),
@@ -124,13 +272,18 @@ func (a *StatusAggregator) getControllerStats(ctx context.Context, clusterID uui
 	stats.Generation = generation
 	stats.EarliestControllerReportTime = earliestReportTime
 
-	a.logger.Debug("Controller stats retrieved",
+	a.logger.WithContext(ctx).Debug("Controller stats retrieved",
 		zap.String("cluster_id", clusterID.String()),
 		zap.Int64("generation", generation),
 		zap.Int("total", stats.TotalCount),
 		zap.Int("ready", stats.ReadyCount),
 		zap.Int("errors", stats.ErrorCount),
+		zap.Int("total_weight", stats.TotalWeight),
+		zap.Int("ready_weight", stats.ReadyWeight),
 		zap.Bool("has_recent_activity", stats.HasRecentActivity),
+		zap.Int("silent", stats.SilentCount),
+		zap.Int64("min_observed_generation", stats.MinObservedGeneration),
+		zap.Int64("max_observed_generation", stats.MaxObservedGeneration),
 		zap.Any("earliest_report_time", earliestReportTime),
 	)
 
@@ -144,7 +297,7 @@ const (
 )
 
 // isWithinGracePeriod checks if controllers are within their allowed timeout period
-func (a *StatusAggregator) isWithinGracePeriod(stats *ControllerStats) bool {
+func isWithinGracePeriod(logger *utils.Logger, stats *ControllerStats) bool {
 	if stats.EarliestControllerReportTime == nil {
 		return true // No controllers reported yet - still pending
 	}
@@ -160,7 +313,7 @@ func (a *StatusAggregator) isWithinGracePeriod(stats *ControllerStats) bool {
 
 	withinGracePeriod := timeSinceFirstReport < gracePeriod
 
-	a.logger.Debug("Grace period check",
+	logger.Debug("Grace period check",
 		zap.Duration("time_since_first_report", timeSinceFirstReport),
 		zap.Duration("grace_period", gracePeriod),
 		zap.Bool("within_grace_period", withinGracePeriod),
@@ -170,191 +323,161 @@ func (a *StatusAggregator) isWithinGracePeriod(stats *ControllerStats) bool {
 	return withinGracePeriod
 }
 
-// applyAggregationRules applies the Kubernetes-like status aggregation logic with timeout awareness
-func (a *StatusAggregator) applyAggregationRules(stats *ControllerStats, generation int64) *StatusAggregationResult {
-	now := time.Now()
-
-	var (
-		phase              string
-		reason             string
-		message            string
-		readyCondition     models.Condition
-		availableCondition models.Condition
-	)
-
-	failedCount := stats.TotalCount - stats.ReadyCount
-	hasErrors := stats.ErrorCount > 0
-
-	// Apply Kubernetes-like aggregation logic
-	if stats.TotalCount == 0 {
-		// No controllers have reported status yet
-		phase = "Pending"
-		reason = "NoControllers"
-		message = "Waiting for controllers to report status"
-
-		readyCondition = models.Condition{
-			Type:               "Ready",
-			Status:             "False",
-			LastTransitionTime: now,
-			Reason:             "ControllersNotReady",
-			Message:            "No controllers have reported status yet",
-		}
+// staleCondition reports the controllers that stopped reporting within the staleness threshold.
+// They no longer count as ready even if they last reported Available=True.
+func staleCondition(readiness ReadinessConfig, stats *ControllerStats, now time.Time) models.Condition {
+	return models.Condition{
+		Type:               "Stale",
+		Status:             "True",
+		LastTransitionTime: now,
+		Reason:             "ControllersNotReporting",
+		Message: fmt.Sprintf("%d of %d controllers have not reported in %s and are not counted as ready",
+			stats.SilentCount, stats.TotalCount, readiness.StalenessThreshold),
+	}
+}
 
-		availableCondition = models.Condition{
-			Type:               "Available",
-			Status:             "False",
-			LastTransitionTime: now,
-			Reason:             "ControllersNotAvailable",
-			Message:            "No controllers are available yet",
-		}
+// hasGenerationSkew reports whether controllers have observed generations more than one apart,
+// which points at a rollout that only part of the controllers have picked up
+func hasGenerationSkew(stats *ControllerStats) bool {
+	return stats.MaxObservedGeneration-stats.MinObservedGeneration > 1
+}
 
-	} else if stats.ReadyCount == stats.TotalCount && !hasErrors {
-		// All controllers ready and no errors
-		phase = "Ready"
-		reason = "AllControllersReady"
-		message = fmt.Sprintf("Cluster is ready with %d controllers operational", stats.TotalCount)
+// generationSkewCondition reports the spread of generations observed by the controllers
+func generationSkewCondition(stats *ControllerStats, now time.Time) models.Condition {
+	return models.Condition{
+		Type:               "GenerationSkew",
+		Status:             "True",
+		LastTransitionTime: now,
+		Reason:             "ControllersAtDifferentGenerations",
+		Message: fmt.Sprintf("Controllers report observed generations from %d to %d (skew of %d)",
+			stats.MinObservedGeneration, stats.MaxObservedGeneration, stats.MaxObservedGeneration-stats.MinObservedGeneration),
+	}
+}
 
-		readyCondition = models.Condition{
-			Type:               "Ready",
-			Status:             "True",
-			LastTransitionTime: now,
-			Reason:             "AllControllersReady",
-			Message:            fmt.Sprintf("All %d controllers are ready", stats.TotalCount),
-		}
+// nodePoolPhaseSeverity ranks nodepool phases from healthiest to worst
+var nodePoolPhaseSeverity = map[string]int{
+	"Ready":                       0,
+	"Pending":                     1,
+	"Progressing":                 2,
+	string(models.HealthDegraded): 3,
+	"Failed":                      4,
+}
 
-		availableCondition = models.Condition{
-			Type:               "Available",
-			Status:             "True",
-			LastTransitionTime: now,
-			Reason:             "AllControllersAvailable",
-			Message:            fmt.Sprintf("All %d controllers are available", stats.TotalCount),
+// GetNodePoolRollup aggregates the controller status of each of a cluster's nodepools,
+// using the nodepool aggregation rules, and counts how many are ready
+func (a *StatusAggregator) GetNodePoolRollup(ctx context.Context, clusterID uuid.UUID) (*models.NodePoolRollup, error) {
+	query := `
+		SELECT
+			np.generation,
+			COUNT(npcs.nodepool_id) AS total,
+			COUNT(CASE WHEN
+				(
+					SELECT COUNT(*)
+					FROM jsonb_array_elements(npcs.conditions) AS condition
+					WHERE condition->>'type' = 'Available' AND condition->>'status' = 'True'
+				) > 0
+				AND ($2::float8 <= 0 OR npcs.updated_at > NOW() - make_interval(secs => $2::float8))
+			THEN 1 END) AS ready,
+			COUNT(CASE WHEN npcs.last_error IS NOT NULL THEN 1 END) AS errors,
+			MIN(npcs.updated_at) AS earliest_report_time,
+			COUNT(CASE WHEN npcs.updated_at > NOW() - INTERVAL '5 minutes' THEN 1 END) > 0 AS has_recent_activity,
+			COUNT(CASE WHEN $2::float8 > 0 AND npcs.updated_at <= NOW() - make_interval(secs => $2::float8) THEN 1 END) AS silent
+		FROM nodepools np
+		LEFT JOIN nodepool_controller_status npcs
+			ON npcs.nodepool_id = np.id AND npcs.observed_generation = np.generation
+		WHERE np.cluster_id = $1 AND np.deleted_at IS NULL
+		GROUP BY np.id, np.generation`
+
+	rows, err := a.client.QueryContext(ctx, query, clusterID, a.stalenessSeconds())
+	if err != nil {
+		a.logger.WithContext(ctx).Error("Failed to get nodepool rollup",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to query nodepool rollup: %w", err)
+	}
+	defer rows.Close()
+
+	rollup := &models.NodePoolRollup{}
+	for rows.Next() {
+		var stats ControllerStats
+		err := rows.Scan(
+			&stats.Generation,
+			&stats.TotalCount,
+			&stats.ReadyCount,
+			&stats.ErrorCount,
+			&stats.EarliestControllerReportTime,
+			&stats.HasRecentActivity,
+			&stats.SilentCount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan nodepool rollup: %w", err)
 		}
 
-	} else if stats.ReadyCount > 0 {
-		// Some controllers ready
-		phase = "Progressing"
-
-		readyCondition = models.Condition{
-			Type:               "Ready",
-			Status:             "False",
-			LastTransitionTime: now,
-			Reason:             "PartiallyReady",
-			Message:            fmt.Sprintf("%d of %d controllers are ready", stats.ReadyCount, stats.TotalCount),
+		phase := a.applyNodePoolAggregationRules(&stats, stats.Generation).Status.Phase
+		rollup.Total++
+		if phase == "Ready" {
+			rollup.Ready++
 		}
-
-		if hasErrors {
-			reason = "ControllersWithErrors"
-			message = fmt.Sprintf("Cluster is progressing but some controllers have errors (%d/%d ready)", stats.ReadyCount, stats.TotalCount)
-
-			availableCondition = models.Condition{
-				Type:               "Available",
-				Status:             "False",
-				LastTransitionTime: now,
-				Reason:             "PartiallyAvailableWithErrors",
-				Message:            fmt.Sprintf("Some controllers have errors (%d available of %d)", stats.ReadyCount, stats.TotalCount),
-			}
-		} else {
-			reason = "PartialProgress"
-			message = fmt.Sprintf("Cluster is progressing (%d/%d controllers ready)", stats.ReadyCount, stats.TotalCount)
-
-			availableCondition = models.Condition{
-				Type:               "Available",
-				Status:             "False",
-				LastTransitionTime: now,
-				Reason:             "PartiallyAvailable",
-				Message:            fmt.Sprintf("Controllers are still becoming available (%d available of %d)", stats.ReadyCount, stats.TotalCount),
-			}
+		if rollup.WorstPhase == "" || nodePoolPhaseSeverity[phase] > nodePoolPhaseSeverity[rollup.WorstPhase] {
+			rollup.WorstPhase = phase
 		}
+	}
 
-	} else {
-		// No controllers ready - use timeout-aware logic
-		withinGracePeriod := a.isWithinGracePeriod(stats)
-		hasProgress := stats.HasRecentActivity || hasErrors // Recent activity or errors indicate progress
-
-		if withinGracePeriod || hasProgress {
-			// Controllers are working but not ready yet - give them time
-			phase = "Progressing"
-
-			if withinGracePeriod {
-				reason = "ControllersProvisioning"
-				var timeRemaining string
-				if stats.EarliestControllerReportTime != nil {
-					elapsed := time.Since(*stats.EarliestControllerReportTime)
-					remaining := time.Duration(DefaultGracePeriodMinutes)*time.Minute - elapsed
-					if remaining > 0 {
-						timeRemaining = fmt.Sprintf(" (%d minutes remaining)", int(remaining.Minutes()))
-					}
-				}
-				message = fmt.Sprintf("Controllers are provisioning resources%s (%d controllers working)", timeRemaining, stats.TotalCount)
-			} else {
-				reason = "ControllersShowingProgress"
-				message = fmt.Sprintf("Controllers are actively working but not yet ready (%d controllers showing progress)", stats.TotalCount)
-			}
-
-			readyCondition = models.Condition{
-				Type:               "Ready",
-				Status:             "False",
-				LastTransitionTime: now,
-				Reason:             "ControllersNotYetReady",
-				Message:            fmt.Sprintf("Controllers are still working (%d of %d controllers)", stats.TotalCount, stats.TotalCount),
-			}
-
-			availableCondition = models.Condition{
-				Type:               "Available",
-				Status:             "False",
-				LastTransitionTime: now,
-				Reason:             "ControllersBecomingAvailable",
-				Message:            fmt.Sprintf("Controllers are becoming available (%d working)", stats.TotalCount),
-			}
-		} else {
-			// Timeout exceeded with no progress - now it's truly failed
-			phase = "Failed"
-			reason = "ControllerTimeout"
-
-			timeoutDuration := "20+ minutes"
-			if stats.EarliestControllerReportTime != nil {
-				elapsed := time.Since(*stats.EarliestControllerReportTime)
-				timeoutDuration = fmt.Sprintf("%.0f minutes", elapsed.Minutes())
-			}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating nodepool rollup: %w", err)
+	}
 
-			message = fmt.Sprintf("Controllers failed to become ready after %s with no progress (%d controllers timed out)", timeoutDuration, stats.TotalCount)
+	return rollup, nil
+}
 
-			readyCondition = models.Condition{
-				Type:               "Ready",
-				Status:             "False",
-				LastTransitionTime: now,
-				Reason:             "ControllersTimedOut",
-				Message:            fmt.Sprintf("Controllers timed out after %s", timeoutDuration),
-			}
+// applyNodePoolRollup adds the NodePoolsReady condition to a cluster status. A cluster whose
+// controllers are all ready is not reported Ready while any of its nodepools is not ready:
+// it is Degraded when a nodepool has failed and Progressing otherwise.
+func (a *StatusAggregator) applyNodePoolRollup(result *StatusAggregationResult, rollup *models.NodePoolRollup) {
+	result.NodePools = rollup
+	status := result.Status
+
+	condition := models.Condition{
+		Type:               "NodePoolsReady",
+		Status:             "True",
+		ObservedGeneration: status.ObservedGeneration,
+		LastTransitionTime: status.LastUpdateTime,
+	}
+	switch {
+	case rollup.Total == 0:
+		condition.Reason = "NoNodePools"
+		condition.Message = "Cluster has no nodepools"
+	case rollup.Ready == rollup.Total:
+		condition.Reason = "AllNodePoolsReady"
+		condition.Message = fmt.Sprintf("All %d nodepools are ready", rollup.Total)
+	default:
+		condition.Status = "False"
+		condition.Reason = "NodePoolsNotReady"
+		condition.Message = fmt.Sprintf("%d of %d nodepools are ready (worst phase: %s)", rollup.Ready, rollup.Total, rollup.WorstPhase)
+	}
+	status.Conditions = append(status.Conditions, condition)
 
-			availableCondition = models.Condition{
-				Type:               "Available",
-				Status:             "False",
-				LastTransitionTime: now,
-				Reason:             "ControllersTimedOut",
-				Message:            fmt.Sprintf("No controllers became available after %s", timeoutDuration),
-			}
-		}
+	if condition.Status == "True" || (status.Phase != "Ready" && status.Phase != string(models.HealthDegraded)) {
+		return
 	}
 
-	// Build the Kubernetes-like status block
-	status := &models.ClusterStatusInfo{
-		ObservedGeneration: generation,
-		Conditions:         []models.Condition{readyCondition, availableCondition},
-		Phase:              phase,
-		Message:            message,
-		Reason:             reason,
-		LastUpdateTime:     now,
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == "Ready" {
+			status.Conditions[i].Status = "False"
+			status.Conditions[i].Reason = condition.Reason
+			status.Conditions[i].Message = condition.Message
+		}
 	}
 
-	return &StatusAggregationResult{
-		Status:            status,
-		TotalControllers:  stats.TotalCount,
-		ReadyControllers:  stats.ReadyCount,
-		FailedControllers: failedCount,
-		HasErrors:         hasErrors,
-		Generation:        generation,
+	if rollup.WorstPhase == "Failed" {
+		status.Phase = string(models.HealthDegraded)
+		status.Reason = "NodePoolsFailed"
+	} else if status.Phase == "Ready" {
+		status.Phase = "Progressing"
+		status.Reason = condition.Reason
 	}
+	status.Message = fmt.Sprintf("Cluster controllers are ready but %s", condition.Message)
 }
 
 // EnrichClusterWithStatus calculates and applies status to a cluster (only if dirty)
@@ -364,26 +487,48 @@ func (a *StatusAggregator) EnrichClusterWithStatus(ctx context.Context, cluster
 		return fmt.Errorf("cluster cannot be nil")
 	}
 
+	// Clusters being torn down keep their cached status; recalculating it during
+	// mass deletions is wasted work
+	if cluster.DeletedAt != nil {
+		a.logger.WithContext(ctx).Debug("Cluster is being deleted, skipping status recalculation",
+			zap.String("cluster_id", cluster.ID.String()),
+		)
+		if cluster.Status == nil {
+			cluster.Status = &models.ClusterStatusInfo{}
+		}
+		cluster.Status.Phase = string(models.StatusDeleting)
+		return nil
+	}
+
+	// A cached status computed by a different aggregator version, or before the staleness
+	// threshold, is stale even when clean
+	stale := cluster.Status == nil || cluster.Status.AggregatorVersion != a.version ||
+		a.statusExpired(cluster.Status.LastUpdateTime)
+
 	// If status is not dirty, use the cached status from database
-	if !cluster.StatusDirty {
-		a.logger.Debug("Status is clean, using cached status",
+	if !cluster.StatusDirty && !stale {
+		a.logger.WithContext(ctx).Debug("Status is clean, using cached status",
 			zap.String("cluster_id", cluster.ID.String()),
 		)
 		return nil // Status is already current, no need to recalculate
 	}
 
-	a.logger.Debug("Status is dirty, recalculating",
+	a.logger.WithContext(ctx).Debug("Status is dirty or stale, recalculating",
 		zap.String("cluster_id", cluster.ID.String()),
 		zap.Int64("generation", cluster.Generation),
+		zap.Bool("dirty", cluster.StatusDirty),
+		zap.Bool("stale", stale),
 	)
 
 	// Status is dirty, need to recalculate and cache
 	result, err := a.CalculateClusterStatus(ctx, cluster)
 	if err != nil {
-		a.logger.Error("Failed to calculate cluster status",
+		a.logger.WithContext(ctx).Error("Failed to calculate cluster status",
 			zap.String("cluster_id", cluster.ID.String()),
 			zap.Error(err),
 		)
+		// Callers carry on with the cached status, so flag it for API consumers
+		cluster.MarkStatusStale()
 		return fmt.Errorf("failed to calculate status for cluster %s: %w", cluster.ID, err)
 	}
 
@@ -393,19 +538,19 @@ func (a *StatusAggregator) EnrichClusterWithStatus(ctx context.Context, cluster
 	// Update the database with cached results and mark as clean
 	err = a.updateClusterStatusInDB(ctx, cluster.ID, result)
 	if err != nil {
-		a.logger.Warn("Failed to cache status in database",
+		a.logger.WithContext(ctx).Warn("Failed to cache status in database",
 			zap.String("cluster_id", cluster.ID.String()),
 			zap.Error(err))
 		// Don't fail the request - we have the calculated status in memory
 	} else {
 		// Mark as clean now that we've cached the results
 		cluster.StatusDirty = false
-		a.logger.Debug("Successfully cached status and marked cluster as clean",
+		a.logger.WithContext(ctx).Debug("Successfully cached status and marked cluster as clean",
 			zap.String("cluster_id", cluster.ID.String()),
 		)
 	}
 
-	a.logger.Debug("Enriched cluster with calculated status",
+	a.logger.WithContext(ctx).Debug("Enriched cluster with calculated status",
 		zap.String("cluster_id", cluster.ID.String()),
 		zap.String("phase", result.Status.Phase),
 		zap.String("reason", result.Status.Reason),
@@ -423,37 +568,67 @@ func (a *StatusAggregator) updateClusterStatusInDB(ctx context.Context, clusterI
 		return fmt.Errorf("failed to marshal status to JSON: %w", err)
 	}
 
+	// Cache the status and, in the same statement, record a phase_transition event when the
+	// aggregated phase differs from the cached one
 	query := `
-		UPDATE clusters
-		SET
-			status = $2,
-			status_dirty = FALSE,
-			updated_at = NOW()
-		WHERE id = $1 AND deleted_at IS NULL`
+		WITH previous AS (
+			SELECT id, status->>'phase' AS phase
+			FROM clusters
+			WHERE id = $1 AND deleted_at IS NULL
+			FOR UPDATE
+		), updated AS (
+			UPDATE clusters c
+			SET
+				status = $2,
+				status_dirty = FALSE,
+				updated_at = NOW()
+			FROM previous
+			WHERE c.id = previous.id
+			RETURNING c.id, previous.phase AS from_phase
+		), transition AS (
+			INSERT INTO cluster_events (cluster_id, controller_name, event_type, metadata)
+			SELECT id, $4, $5, jsonb_build_object('from_phase', COALESCE(from_phase, ''), 'to_phase', $3::TEXT)
+			FROM updated
+			WHERE from_phase IS DISTINCT FROM $3::TEXT
+			RETURNING id
+		)
+		SELECT (SELECT COUNT(*) FROM updated), (SELECT COUNT(*) FROM transition),
+			   (SELECT from_phase FROM updated)`
 
-	result_db, err := a.client.ExecContext(ctx, query,
+	var rowsAffected, transitions int64
+	var fromPhase sql.NullString
+	err = a.client.QueryRowContext(ctx, query,
 		clusterID,
 		statusJSON,
-	)
+		result.Status.Phase,
+		models.PhaseTransitionRecorder,
+		models.EventTypePhaseTransition,
+	).Scan(&rowsAffected, &transitions, &fromPhase)
 
 	if err != nil {
-		a.logger.Error("Failed to update cluster status in database",
+		a.logger.WithContext(ctx).Error("Failed to update cluster status in database",
 			zap.String("cluster_id", clusterID.String()),
 			zap.Error(err),
 		)
 		return fmt.Errorf("failed to update cluster status: %w", err)
 	}
 
-	rowsAffected, err := result_db.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-
 	if rowsAffected == 0 {
 		return fmt.Errorf("cluster not found or already deleted")
 	}
 
-	a.logger.Debug("Successfully cached cluster status in database",
+	if transitions > 0 {
+		a.logger.WithContext(ctx).Info("Cluster phase changed",
+			zap.String("cluster_id", clusterID.String()),
+			zap.String("from_phase", fromPhase.String),
+			zap.String("phase", result.Status.Phase),
+		)
+		if a.client.phaseNotifier != nil {
+			a.client.phaseNotifier.NotifyPhaseTransition(ctx, clusterID, fromPhase.String, result.Status.Phase)
+		}
+	}
+
+	a.logger.WithContext(ctx).Debug("Successfully cached cluster status in database",
 		zap.String("cluster_id", clusterID.String()),
 		zap.String("phase", result.Status.Phase),
 		zap.String("reason", result.Status.Reason),
@@ -468,7 +643,7 @@ func (a *StatusAggregator) EnrichClustersWithStatus(ctx context.Context, cluster
 		return nil
 	}
 
-	a.logger.Debug("Enriching multiple clusters with real-time status",
+	a.logger.WithContext(ctx).Debug("Enriching multiple clusters with real-time status",
 		zap.Int("cluster_count", len(clusters)),
 	)
 
@@ -476,7 +651,7 @@ func (a *StatusAggregator) EnrichClustersWithStatus(ctx context.Context, cluster
 
 	for _, cluster := range clusters {
 		if err := a.EnrichClusterWithStatus(ctx, cluster); err != nil {
-			a.logger.Error("Failed to enrich cluster with status",
+			a.logger.WithContext(ctx).Error("Failed to enrich cluster with status",
 				zap.String("cluster_id", cluster.ID.String()),
 				zap.Error(err),
 			)
@@ -486,7 +661,7 @@ func (a *StatusAggregator) EnrichClustersWithStatus(ctx context.Context, cluster
 	}
 
 	if len(enrichmentErrors) > 0 {
-		a.logger.Warn("Some clusters failed status enrichment",
+		a.logger.WithContext(ctx).Warn("Some clusters failed status enrichment",
 			zap.Int("failed_count", len(enrichmentErrors)),
 			zap.Int("total_count", len(clusters)),
 		)
@@ -494,7 +669,7 @@ func (a *StatusAggregator) EnrichClustersWithStatus(ctx context.Context, cluster
 		return fmt.Errorf("failed to enrich %d out of %d clusters with status: %w", len(enrichmentErrors), len(clusters), enrichmentErrors[0])
 	}
 
-	a.logger.Debug("Successfully enriched all clusters with real-time status",
+	a.logger.WithContext(ctx).Debug("Successfully enriched all clusters with real-time status",
 		zap.Int("cluster_count", len(clusters)),
 	)
 
@@ -525,7 +700,7 @@ func (a *StatusAggregator) CalculateNodePoolStatus(ctx context.Context, nodepool
 		return nil, fmt.Errorf("nodepool cannot be nil")
 	}
 
-	a.logger.Debug("Calculating nodepool status",
+	a.logger.WithContext(ctx).Debug("Calculating nodepool status",
 		zap.String("nodepool_id", nodepool.ID.String()),
 		zap.Int64("generation", nodepool.Generation),
 	)
@@ -539,7 +714,7 @@ func (a *StatusAggregator) CalculateNodePoolStatus(ctx context.Context, nodepool
 	// Apply aggregation logic (same logic as cluster aggregation)
 	result := a.applyNodePoolAggregationRules(stats, nodepool.Generation)
 
-	a.logger.Debug("Calculated nodepool status",
+	a.logger.WithContext(ctx).Debug("Calculated nodepool status",
 		zap.String("nodepool_id", nodepool.ID.String()),
 		zap.String("phase", result.Status.Phase),
 		zap.String("reason", result.Status.Reason),
@@ -561,31 +736,50 @@ func (a *StatusAggregator) getNodePoolControllerStats(ctx context.Context, nodep
 					FROM jsonb_array_elements(conditions) AS condition
 					WHERE condition->>'type' = 'Available' AND condition->>'status' = 'True'
 				) > 0
+				AND ($4::float8 <= 0 OR updated_at > NOW() - make_interval(secs => $4::float8))
 			THEN 1 END) AS ready,
 			COUNT(CASE WHEN last_error IS NOT NULL THEN 1 END) AS errors,
+			COALESCE(SUM(COALESCE(($3::jsonb ->> controller_name)::int, 1)), 0) AS total_weight,
+			COALESCE(SUM(CASE WHEN
+				(
+					SELECT COUNT(*)
+					FROM jsonb_array_elements(conditions) AS condition
+					WHERE condition->>'type' = 'Available' AND condition->>'status' = 'True'
+				) > 0
+				AND ($4::float8 <= 0 OR updated_at > NOW() - make_interval(secs => $4::float8))
+			THEN COALESCE(($3::jsonb ->> controller_name)::int, 1) END), 0) AS ready_weight,
 			MIN(updated_at) AS earliest_report_time,
-			COUNT(CASE WHEN updated_at > NOW() - INTERVAL '5 minutes' THEN 1 END) > 0 AS has_recent_activity
+			COUNT(CASE WHEN updated_at > NOW() - INTERVAL '5 minutes' THEN 1 END) > 0 AS has_recent_activity,
+			COUNT(CASE WHEN $4::float8 > 0 AND updated_at <= NOW() - make_interval(secs => $4::float8) THEN 1 END) AS silent
 		FROM nodepool_controller_status
 		WHERE nodepool_id = $1 AND observed_generation = $2`
 
 	var stats ControllerStats
 	var earliestReportTime *time.Time
 
-	a.logger.Debug("Executing nodepool controller stats query",
+	a.logger.WithContext(ctx).Debug("Executing nodepool controller stats query",
 		zap.String("nodepool_id", nodepoolID.String()),
 		zap.Int64("generation", generation),
 	)
 
-	err := a.client.QueryRowContext(ctx, query, nodepoolID, generation).Scan(
+	weights, err := a.controllerWeightsJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.client.QueryRowContext(ctx, query, nodepoolID, generation, weights, a.stalenessSeconds()).Scan(
 		&stats.TotalCount,
 		&stats.ReadyCount,
 		&stats.ErrorCount,
+		&stats.TotalWeight,
+		&stats.ReadyWeight,
 		&earliestReportTime,
 		&stats.HasRecentActivity,
+		&stats.SilentCount,
 	)
 
 	if err != nil {
-		a.logger.Error("Failed to get nodepool controller stats",
+		a.logger.WithContext(ctx).Error("Failed to get nodepool controller stats",
 			zap.String("nodepool_id", nodepoolID.String()),
 			zap.Int64("generation", generation),
 			zap.Error(err),
@@ -596,20 +790,23 @@ func (a *StatusAggregator) getNodePoolControllerStats(ctx context.Context, nodep
 	stats.Generation = generation
 	stats.EarliestControllerReportTime = earliestReportTime
 
-	a.logger.Debug("NodePool controller stats retrieved",
+	a.logger.WithContext(ctx).Debug("NodePool controller stats retrieved",
 		zap.String("nodepool_id", nodepoolID.String()),
 		zap.Int64("generation", generation),
 		zap.Int("total", stats.TotalCount),
 		zap.Int("ready", stats.ReadyCount),
 		zap.Int("errors", stats.ErrorCount),
+		zap.Int("total_weight", stats.TotalWeight),
+		zap.Int("ready_weight", stats.ReadyWeight),
 		zap.Bool("has_recent_activity", stats.HasRecentActivity),
+		zap.Int("silent", stats.SilentCount),
 	)
 
 	return &stats, nil
 }
 
 // applyNodePoolAggregationRules applies Kubernetes-like status aggregation logic for nodepools
-// This mirrors applyAggregationRules but with nodepool-specific messages
+// This mirrors DefaultStrategy.Aggregate but with nodepool-specific messages
 func (a *StatusAggregator) applyNodePoolAggregationRules(stats *ControllerStats, generation int64) *NodePoolStatusAggregationResult {
 	now := time.Now()
 
@@ -623,6 +820,7 @@ func (a *StatusAggregator) applyNodePoolAggregationRules(stats *ControllerStats,
 
 	failedCount := stats.TotalCount - stats.ReadyCount
 	hasErrors := stats.ErrorCount > 0
+	ready := passesReadyGate(a.readiness(), stats)
 
 	// Apply Kubernetes-like aggregation logic (same as clusters)
 	if stats.TotalCount == 0 {
@@ -647,7 +845,7 @@ func (a *StatusAggregator) applyNodePoolAggregationRules(stats *ControllerStats,
 			Message:            "No controllers are available yet",
 		}
 
-	} else if stats.ReadyCount == stats.TotalCount && !hasErrors {
+	} else if ready && !hasErrors {
 		// All controllers ready and no errors
 		phase = "Ready"
 		reason = "AllControllersReady"
@@ -658,7 +856,7 @@ func (a *StatusAggregator) applyNodePoolAggregationRules(stats *ControllerStats,
 			Status:             "True",
 			LastTransitionTime: now,
 			Reason:             "AllControllersReady",
-			Message:            fmt.Sprintf("All %d controllers are ready", stats.TotalCount),
+			Message:            readyControllersMessage(stats, "ready"),
 		}
 
 		availableCondition = models.Condition{
@@ -666,7 +864,7 @@ func (a *StatusAggregator) applyNodePoolAggregationRules(stats *ControllerStats,
 			Status:             "True",
 			LastTransitionTime: now,
 			Reason:             "AllControllersAvailable",
-			Message:            fmt.Sprintf("All %d controllers are available", stats.TotalCount),
+			Message:            readyControllersMessage(stats, "available"),
 		}
 
 	} else if stats.ReadyCount > 0 {
@@ -707,7 +905,7 @@ func (a *StatusAggregator) applyNodePoolAggregationRules(stats *ControllerStats,
 
 	} else {
 		// No controllers ready - use timeout-aware logic
-		withinGracePeriod := a.isWithinGracePeriod(stats)
+		withinGracePeriod := isWithinGracePeriod(a.logger, stats)
 		hasProgress := stats.HasRecentActivity || hasErrors
 
 		if withinGracePeriod || hasProgress {
@@ -776,13 +974,23 @@ func (a *StatusAggregator) applyNodePoolAggregationRules(stats *ControllerStats,
 		}
 	}
 
-	// Build the Kubernetes-like status block
+	conditions := []models.Condition{readyCondition, availableCondition}
+	if stats.SilentCount > 0 {
+		conditions = append(conditions, staleCondition(a.readiness(), stats, now))
+	}
+
+	// Build the Kubernetes-like status block; each condition reflects the current generation
+	for i := range conditions {
+		conditions[i].ObservedGeneration = generation
+	}
+
 	status := &models.NodePoolStatusInfo{
 		ObservedGeneration: generation,
-		Conditions:         []models.Condition{readyCondition, availableCondition},
+		Conditions:         conditions,
 		Phase:              phase,
 		Message:            message,
 		Reason:             reason,
+		ProgressPercent:    readinessProgress(stats),
 		LastUpdateTime:     now,
 	}
 
@@ -802,15 +1010,15 @@ func (a *StatusAggregator) EnrichNodePoolWithStatus(ctx context.Context, nodepoo
 		return fmt.Errorf("nodepool cannot be nil")
 	}
 
-	// If status is not dirty, use the cached status from database
-	if !nodepool.StatusDirty {
-		a.logger.Debug("NodePool status is clean, using cached status",
+	// If status is not dirty, use the cached status from database unless it has expired
+	if !nodepool.StatusDirty && (nodepool.Status == nil || !a.statusExpired(nodepool.Status.LastUpdateTime)) {
+		a.logger.WithContext(ctx).Debug("NodePool status is clean, using cached status",
 			zap.String("nodepool_id", nodepool.ID.String()),
 		)
 		return nil // Status is already current, no need to recalculate
 	}
 
-	a.logger.Debug("NodePool status is dirty, recalculating",
+	a.logger.WithContext(ctx).Debug("NodePool status is dirty, recalculating",
 		zap.String("nodepool_id", nodepool.ID.String()),
 		zap.Int64("generation", nodepool.Generation),
 	)
@@ -818,7 +1026,7 @@ func (a *StatusAggregator) EnrichNodePoolWithStatus(ctx context.Context, nodepoo
 	// Status is dirty, need to recalculate and cache
 	result, err := a.CalculateNodePoolStatus(ctx, nodepool)
 	if err != nil {
-		a.logger.Error("Failed to calculate nodepool status",
+		a.logger.WithContext(ctx).Error("Failed to calculate nodepool status",
 			zap.String("nodepool_id", nodepool.ID.String()),
 			zap.Error(err),
 		)
@@ -831,19 +1039,19 @@ func (a *StatusAggregator) EnrichNodePoolWithStatus(ctx context.Context, nodepoo
 	// Update the database with cached results and mark as clean
 	err = a.updateNodePoolStatusInDB(ctx, nodepool.ID, result)
 	if err != nil {
-		a.logger.Warn("Failed to cache nodepool status in database",
+		a.logger.WithContext(ctx).Warn("Failed to cache nodepool status in database",
 			zap.String("nodepool_id", nodepool.ID.String()),
 			zap.Error(err))
 		// Don't fail the request - we have the calculated status in memory
 	} else {
 		// Mark as clean now that we've cached the results
 		nodepool.StatusDirty = false
-		a.logger.Debug("Successfully cached nodepool status and marked as clean",
+		a.logger.WithContext(ctx).Debug("Successfully cached nodepool status and marked as clean",
 			zap.String("nodepool_id", nodepool.ID.String()),
 		)
 	}
 
-	a.logger.Debug("Enriched nodepool with calculated status",
+	a.logger.WithContext(ctx).Debug("Enriched nodepool with calculated status",
 		zap.String("nodepool_id", nodepool.ID.String()),
 		zap.String("phase", result.Status.Phase),
 		zap.String("reason", result.Status.Reason),
@@ -875,7 +1083,7 @@ func (a *StatusAggregator) updateNodePoolStatusInDB(ctx context.Context, nodepoo
 	)
 
 	if err != nil {
-		a.logger.Error("Failed to update nodepool status in database",
+		a.logger.WithContext(ctx).Error("Failed to update nodepool status in database",
 			zap.String("nodepool_id", nodepoolID.String()),
 			zap.Error(err),
 		)
@@ -891,7 +1099,7 @@ func (a *StatusAggregator) updateNodePoolStatusInDB(ctx context.Context, nodepoo
 		return fmt.Errorf("nodepool not found or already deleted")
 	}
 
-	a.logger.Debug("Successfully cached nodepool status in database",
+	a.logger.WithContext(ctx).Debug("Successfully cached nodepool status in database",
 		zap.String("nodepool_id", nodepoolID.String()),
 		zap.String("phase", result.Status.Phase),
 		zap.String("reason", result.Status.Reason),
@@ -906,7 +1114,7 @@ func (a *StatusAggregator) EnrichNodePoolsWithStatus(ctx context.Context, nodepo
 		return nil
 	}
 
-	a.logger.Debug("Enriching multiple nodepools with real-time status",
+	a.logger.WithContext(ctx).Debug("Enriching multiple nodepools with real-time status",
 		zap.Int("nodepool_count", len(nodepools)),
 	)
 
@@ -914,7 +1122,7 @@ func (a *StatusAggregator) EnrichNodePoolsWithStatus(ctx context.Context, nodepo
 
 	for _, nodepool := range nodepools {
 		if err := a.EnrichNodePoolWithStatus(ctx, nodepool); err != nil {
-			a.logger.Error("Failed to enrich nodepool with status",
+			a.logger.WithContext(ctx).Error("Failed to enrich nodepool with status",
 				zap.String("nodepool_id", nodepool.ID.String()),
 				zap.Error(err),
 			)
@@ -924,7 +1132,7 @@ func (a *StatusAggregator) EnrichNodePoolsWithStatus(ctx context.Context, nodepo
 	}
 
 	if len(enrichmentErrors) > 0 {
-		a.logger.Warn("Some nodepools failed status enrichment",
+		a.logger.WithContext(ctx).Warn("Some nodepools failed status enrichment",
 			zap.Int("failed_count", len(enrichmentErrors)),
 			zap.Int("total_count", len(nodepools)),
 		)
@@ -932,7 +1140,7 @@ func (a *StatusAggregator) EnrichNodePoolsWithStatus(ctx context.Context, nodepo
 		return fmt.Errorf("failed to enrich %d out of %d nodepools with status: %w", len(enrichmentErrors), len(nodepools), enrichmentErrors[0])
 	}
 
-	a.logger.Debug("Successfully enriched all nodepools with real-time status",
+	a.logger.WithContext(ctx).Debug("Successfully enriched all nodepools with real-time status",
 		zap.Int("nodepool_count", len(nodepools)),
 	)
 
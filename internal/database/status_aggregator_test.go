@@ -2,7 +2,9 @@ package database
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -62,6 +64,55 @@ func setupStatusAggregatorTest(t *testing.T) (*Repository, uuid.UUID) {
 
 			CONSTRAINT controller_status_cluster_controller_unique UNIQUE(cluster_id, controller_name)
 		);
+
+		CREATE TABLE IF NOT EXISTS nodepools (
+			id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+			cluster_id UUID NOT NULL REFERENCES clusters(id) ON DELETE CASCADE,
+			name VARCHAR(255) NOT NULL,
+			generation BIGINT NOT NULL DEFAULT 1,
+			resource_version VARCHAR(255) NOT NULL,
+			spec JSONB NOT NULL,
+			status JSONB,
+			status_dirty BOOLEAN DEFAULT TRUE,
+			created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+			deleted_at TIMESTAMP NULL,
+			UNIQUE(cluster_id, name)
+		);
+
+		CREATE TABLE IF NOT EXISTS nodepool_controller_status (
+			id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+			nodepool_id UUID NOT NULL REFERENCES nodepools(id) ON DELETE CASCADE,
+			controller_name VARCHAR(255) NOT NULL,
+			observed_generation BIGINT NOT NULL DEFAULT 0,
+			conditions JSONB,
+			last_error JSONB,
+			metadata JSONB NOT NULL DEFAULT '{}',
+			updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+			CONSTRAINT nodepool_controller_status_unique UNIQUE(nodepool_id, controller_name)
+		);
+
+		CREATE TABLE IF NOT EXISTS cluster_events (
+			id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+			cluster_id UUID NOT NULL,
+			controller_name VARCHAR(255) NOT NULL,
+			event_type VARCHAR(100) NOT NULL,
+			metadata JSONB NOT NULL DEFAULT '{}',
+			published_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+		);
+
+		CREATE TABLE IF NOT EXISTS controller_status_history (
+			id BIGSERIAL PRIMARY KEY,
+			cluster_id UUID NOT NULL REFERENCES clusters(id) ON DELETE CASCADE,
+			controller_name VARCHAR(255) NOT NULL,
+			condition_type TEXT NOT NULL,
+			from_status TEXT NOT NULL DEFAULT '',
+			to_status TEXT NOT NULL,
+			reason TEXT NOT NULL DEFAULT '',
+			message TEXT NOT NULL DEFAULT '',
+			observed_generation BIGINT NOT NULL DEFAULT 0,
+			transitioned_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+		);
 	`)
 	if err != nil {
 		t.Fatalf("Failed to create test schema: %v", err)
@@ -88,6 +139,670 @@ func setupStatusAggregatorTest(t *testing.T) (*Repository, uuid.UUID) {
 	return repo, clusterID
 }
 
+func TestStatusAggregator_SkipsDeletedCluster(t *testing.T) {
+	// No database client: any enrichment query would fail the test with a nil dereference
+	aggregator := NewStatusAggregator(nil, nil)
+
+	deletedAt := time.Now()
+	cluster := &models.Cluster{
+		ID:          uuid.New(),
+		Name:        "deleted-cluster",
+		Generation:  2,
+		StatusDirty: true,
+		DeletedAt:   &deletedAt,
+		Status: &models.ClusterStatusInfo{
+			ObservedGeneration: 1,
+			Phase:              "Ready",
+			Message:            "Cluster is ready",
+		},
+	}
+
+	err := aggregator.EnrichClusterWithStatus(context.Background(), cluster)
+	utils.AssertError(t, err, false, "Deleted cluster should not be enriched")
+	utils.AssertEqual(t, string(models.StatusDeleting), cluster.Status.Phase, "Deleted cluster should report the Deleting phase")
+	utils.AssertEqual(t, int64(1), cluster.Status.ObservedGeneration, "Cached status should be kept")
+	utils.AssertEqual(t, "Cluster is ready", cluster.Status.Message, "Cached message should be kept")
+
+	// Deleted clusters without a cached status still report Deleting
+	cluster.Status = nil
+	err = aggregator.EnrichClusterWithStatus(context.Background(), cluster)
+	utils.AssertError(t, err, false, "Deleted cluster should not be enriched")
+	utils.AssertEqual(t, string(models.StatusDeleting), cluster.Status.Phase, "Deleted cluster should report the Deleting phase")
+}
+
+func TestStatusAggregator_CleanCurrentVersionUsesCache(t *testing.T) {
+	// No database client: a recalculation would fail the test with a nil dereference
+	aggregator := NewStatusAggregator(nil, nil)
+
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Generation: 1,
+		Status: &models.ClusterStatusInfo{
+			ObservedGeneration: 1,
+			Phase:              "Ready",
+			AggregatorVersion:  StatusAggregatorVersion,
+		},
+	}
+
+	err := aggregator.EnrichClusterWithStatus(context.Background(), cluster)
+	utils.AssertError(t, err, false, "Clean status from the current version should not be recalculated")
+	utils.AssertEqual(t, "Ready", cluster.Status.Phase, "Cached status should be kept")
+}
+
+func TestStatusAggregator_VersionBumpForcesRecompute(t *testing.T) {
+	repo, clusterID := setupStatusAggregatorTest(t)
+	defer repo.Close()
+
+	ctx := context.Background()
+	aggregator := NewStatusAggregator(repo.GetClient(), nil)
+
+	loadCluster := func() *models.Cluster {
+		var statusJSON []byte
+		cluster := &models.Cluster{ID: clusterID, Generation: 1}
+		err := repo.GetClient().QueryRowContext(ctx,
+			`SELECT status, status_dirty FROM clusters WHERE id = $1`, clusterID,
+		).Scan(&statusJSON, &cluster.StatusDirty)
+		utils.AssertError(t, err, false, "Should load cluster status")
+		if statusJSON != nil {
+			cluster.Status = &models.ClusterStatusInfo{}
+			err = json.Unmarshal(statusJSON, cluster.Status)
+			utils.AssertError(t, err, false, "Should decode cached status")
+		}
+		return cluster
+	}
+
+	// First read computes and caches the status, stamped with the current version
+	cluster := loadCluster()
+	err := aggregator.EnrichClusterWithStatus(ctx, cluster)
+	utils.AssertError(t, err, false, "Should enrich dirty cluster")
+	utils.AssertEqual(t, StatusAggregatorVersion, cluster.Status.AggregatorVersion, "Computed status should carry the aggregator version")
+
+	// Tamper with the clean cached status so a recompute is observable
+	_, err = repo.GetClient().ExecContext(ctx,
+		`UPDATE clusters SET status = jsonb_set(status, '{phase}', '"Stale"') WHERE id = $1`, clusterID)
+	utils.AssertError(t, err, false, "Should update cached status")
+
+	cluster = loadCluster()
+	utils.AssertFalse(t, cluster.StatusDirty, "Cached status should be clean")
+	err = aggregator.EnrichClusterWithStatus(ctx, cluster)
+	utils.AssertError(t, err, false, "Should enrich clean cluster")
+	utils.AssertEqual(t, "Stale", cluster.Status.Phase, "Same version should use the cached status")
+
+	// After a logic change, clean statuses from the old version are recomputed
+	aggregator.version = StatusAggregatorVersion + 1
+	err = aggregator.EnrichClusterWithStatus(ctx, cluster)
+	utils.AssertError(t, err, false, "Should enrich cluster with a stale version")
+	utils.AssertNotEqual(t, "Stale", cluster.Status.Phase, "Version bump should force a recompute")
+	utils.AssertEqual(t, StatusAggregatorVersion+1, cluster.Status.AggregatorVersion, "Recomputed status should carry the new version")
+
+	cached := loadCluster()
+	utils.AssertEqual(t, StatusAggregatorVersion+1, cached.Status.AggregatorVersion, "Cached status should be restamped")
+	utils.AssertEqual(t, cluster.Status.Phase, cached.Status.Phase, "Recomputed status should be cached")
+}
+
+func TestNodePoolPhasesMatchAggregation(t *testing.T) {
+	utils.AssertEqual(t, len(nodePoolPhaseSeverity), len(models.NodePoolPhases), "Every aggregated phase should be filterable")
+	for phase := range nodePoolPhaseSeverity {
+		utils.AssertTrue(t, models.IsValidNodePoolPhase(phase), "Aggregated nodepool phase should be a valid filter", phase)
+	}
+}
+
+func TestStatusAggregator_ExpiredCacheForcesRecompute(t *testing.T) {
+	repo, clusterID := setupStatusAggregatorTest(t)
+	defer repo.Close()
+
+	ctx := context.Background()
+	repo.SetReadinessConfig(ReadinessConfig{StalenessThreshold: 10 * time.Minute})
+
+	enrichCluster := func() *models.Cluster {
+		var statusJSON []byte
+		cluster := &models.Cluster{ID: clusterID, Generation: 1, Spec: models.ClusterSpec{Platform: models.PlatformSpec{Type: "gcp"}}}
+		err := repo.GetClient().QueryRowContext(ctx,
+			`SELECT status, status_dirty FROM clusters WHERE id = $1`, clusterID,
+		).Scan(&statusJSON, &cluster.StatusDirty)
+		utils.AssertError(t, err, false, "Should load cluster status")
+		if statusJSON != nil {
+			cluster.Status = &models.ClusterStatusInfo{}
+			err = json.Unmarshal(statusJSON, cluster.Status)
+			utils.AssertError(t, err, false, "Should decode cached status")
+		}
+		err = repo.StatusAggregator.EnrichClusterWithStatus(ctx, cluster)
+		utils.AssertError(t, err, false, "Should enrich cluster")
+		return cluster
+	}
+
+	_, err := repo.GetClient().ExecContext(ctx, `
+		INSERT INTO controller_status (cluster_id, controller_name, observed_generation, conditions)
+		VALUES ($1, 'silent-controller', 1, '[{"type": "Available", "status": "True", "lastTransitionTime": "2025-10-17T12:00:00Z", "reason": "Reported"}]')
+	`, clusterID)
+	utils.AssertError(t, err, false, "Should create controller status")
+
+	cluster := enrichCluster()
+	utils.AssertFalse(t, models.ConditionList(cluster.Status.Conditions).HasCondition("Stale", "True"), "Reporting controller should not be stale")
+
+	// Mark the cached status so a recompute is observable; it is clean and fresh, so it is kept
+	_, err = repo.GetClient().ExecContext(ctx,
+		`UPDATE clusters SET status = jsonb_set(status, '{phase}', '"Cached"'), status_dirty = FALSE WHERE id = $1`, clusterID)
+	utils.AssertError(t, err, false, "Should update cached status")
+	cluster = enrichCluster()
+	utils.AssertEqual(t, "Cached", cluster.Status.Phase, "Fresh clean status should come from the cache")
+
+	// The controller goes silent: nothing marks the cluster dirty, but the cache ages out
+	_, err = repo.GetClient().ExecContext(ctx,
+		`UPDATE controller_status SET updated_at = NOW() - INTERVAL '15 minutes' WHERE cluster_id = $1`, clusterID)
+	utils.AssertError(t, err, false, "Should age controller report")
+	_, err = repo.GetClient().ExecContext(ctx, `
+		UPDATE clusters
+		SET status = jsonb_set(status, '{lastUpdateTime}', to_jsonb(NOW() - INTERVAL '15 minutes')), status_dirty = FALSE
+		WHERE id = $1`, clusterID)
+	utils.AssertError(t, err, false, "Should age cached status")
+
+	cluster = enrichCluster()
+	utils.AssertNotEqual(t, "Cached", cluster.Status.Phase, "Expired status should be recomputed")
+	utils.AssertNotEqual(t, "Ready", cluster.Status.Phase, "Silent controller should keep the cluster from Ready")
+	utils.AssertTrue(t, models.ConditionList(cluster.Status.Conditions).HasCondition("Stale", "True"), "Recomputed status should carry the Stale condition")
+}
+
+// recordingPhaseNotifier records the phase transitions it is told about
+type recordingPhaseNotifier struct {
+	transitions [][2]string
+}
+
+func (n *recordingPhaseNotifier) NotifyPhaseTransition(ctx context.Context, clusterID uuid.UUID, oldPhase, newPhase string) {
+	n.transitions = append(n.transitions, [2]string{oldPhase, newPhase})
+}
+
+func TestStatusAggregator_NotifiesPhaseTransition(t *testing.T) {
+	repo, clusterID := setupStatusAggregatorTest(t)
+	defer repo.Close()
+
+	ctx := context.Background()
+	notifier := &recordingPhaseNotifier{}
+	repo.SetPhaseTransitionNotifier(notifier)
+	aggregator := NewStatusAggregator(repo.GetClient(), nil)
+
+	// The first calculation moves the cluster from no phase to its computed one
+	cluster := &models.Cluster{ID: clusterID, Generation: 1, StatusDirty: true}
+	err := aggregator.EnrichClusterWithStatus(ctx, cluster)
+	utils.AssertError(t, err, false, "Should enrich dirty cluster")
+	utils.AssertEqual(t, 1, len(notifier.transitions), "First calculation should notify")
+	utils.AssertEqual(t, [2]string{"", cluster.Status.Phase}, notifier.transitions[0], "Transition should start from no phase")
+
+	// Recalculating to the same phase does not notify
+	cluster.StatusDirty = true
+	err = aggregator.EnrichClusterWithStatus(ctx, cluster)
+	utils.AssertError(t, err, false, "Should enrich dirty cluster again")
+	utils.AssertEqual(t, 1, len(notifier.transitions), "Unchanged phase should not notify")
+
+	// A phase change reports the previously cached phase
+	_, err = repo.GetClient().ExecContext(ctx,
+		`UPDATE clusters SET status = jsonb_set(status, '{phase}', '"Failed"'), status_dirty = TRUE WHERE id = $1`, clusterID)
+	utils.AssertError(t, err, false, "Should update cached phase")
+	cluster.StatusDirty = true
+	err = aggregator.EnrichClusterWithStatus(ctx, cluster)
+	utils.AssertError(t, err, false, "Should enrich cluster after a phase change")
+	utils.AssertEqual(t, 2, len(notifier.transitions), "Phase change should notify")
+	utils.AssertEqual(t, [2]string{"Failed", cluster.Status.Phase}, notifier.transitions[1], "Transition should start from the cached phase")
+}
+
+func TestStatusAggregator_DegradedWhenAllReadyWithErrors(t *testing.T) {
+	aggregator := NewStatusAggregator(nil, nil)
+	recent := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name      string
+		stats     *ControllerStats
+		wantPhase string
+		degraded  bool
+	}{
+		{
+			name:      "all ready without errors",
+			stats:     &ControllerStats{TotalCount: 3, ReadyCount: 3, EarliestControllerReportTime: &recent},
+			wantPhase: "Ready",
+		},
+		{
+			name:      "all ready with errors",
+			stats:     &ControllerStats{TotalCount: 3, ReadyCount: 3, ErrorCount: 1, EarliestControllerReportTime: &recent},
+			wantPhase: string(models.HealthDegraded),
+			degraded:  true,
+		},
+		{
+			name:      "partially ready with errors",
+			stats:     &ControllerStats{TotalCount: 3, ReadyCount: 2, ErrorCount: 1, EarliestControllerReportTime: &recent},
+			wantPhase: "Progressing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := aggregator.aggregate("GCP", tt.stats, 1, false)
+			utils.AssertEqual(t, tt.wantPhase, result.Status.Phase, "Unexpected phase")
+
+			conditions := models.ConditionList(result.Status.Conditions)
+			degraded := conditions.GetCondition("Degraded")
+			utils.AssertEqual(t, tt.degraded, degraded != nil, "Degraded condition presence")
+			if tt.degraded {
+				utils.AssertEqual(t, "True", degraded.Status, "Degraded condition should be true")
+				utils.AssertEqual(t, "ControllersWithErrors", degraded.Reason, "Degraded condition reason")
+				utils.AssertTrue(t, conditions.HasCondition("Ready", "True"), "Degraded cluster should still be ready")
+				utils.AssertTrue(t, conditions.HasCondition("Available", "True"), "Degraded cluster should still be available")
+			}
+		})
+	}
+}
+
+func TestStatusAggregator_NoControllersExpected(t *testing.T) {
+	aggregator := NewStatusAggregator(nil, nil)
+
+	// Default behavior: a cluster without controller reports waits in Pending
+	result := aggregator.aggregate("GCP", &ControllerStats{}, 1, false)
+	utils.AssertEqual(t, "Pending", result.Status.Phase, "Cluster without controllers should be pending by default")
+	utils.AssertEqual(t, "NoControllers", result.Status.Reason, "Default reason")
+
+	// A cluster that expects no controllers is ready straight away
+	result = aggregator.aggregate("GCP", &ControllerStats{}, 1, true)
+	utils.AssertEqual(t, "Ready", result.Status.Phase, "Cluster expecting no controllers should be ready")
+	utils.AssertEqual(t, "NoControllersExpected", result.Status.Reason, "Ready reason")
+	utils.AssertEqual(t, 100, result.Status.ProgressPercent, "Nothing to wait for should be 100%")
+	conditions := models.ConditionList(result.Status.Conditions)
+	utils.AssertTrue(t, conditions.HasCondition("Ready", "True"), "Ready condition should be true")
+	utils.AssertTrue(t, conditions.HasCondition("Available", "True"), "Available condition should be true")
+
+	// Once a controller does report, its status is aggregated as usual
+	recent := time.Now().Add(-time.Minute)
+	stats := &ControllerStats{TotalCount: 1, TotalWeight: 1, EarliestControllerReportTime: &recent}
+	result = aggregator.aggregate("GCP", stats, 1, true)
+	utils.AssertEqual(t, "Progressing", result.Status.Phase, "Reporting controller should be aggregated normally")
+}
+
+func TestStatusAggregator_ConditionsCarryObservedGeneration(t *testing.T) {
+	aggregator := NewStatusAggregator(nil, nil)
+	recent := time.Now().Add(-time.Minute)
+	stats := &ControllerStats{TotalCount: 2, ReadyCount: 2, ErrorCount: 1, EarliestControllerReportTime: &recent}
+
+	result := aggregator.aggregate("GCP", stats, 7, false)
+	utils.AssertEqual(t, 3, len(result.Status.Conditions), "Degraded cluster should have three conditions")
+	for _, condition := range result.Status.Conditions {
+		utils.AssertEqual(t, int64(7), condition.ObservedGeneration, "Cluster condition should carry the generation", condition.Type)
+	}
+
+	nodepoolResult := aggregator.applyNodePoolAggregationRules(stats, 4)
+	for _, condition := range nodepoolResult.Status.Conditions {
+		utils.AssertEqual(t, int64(4), condition.ObservedGeneration, "NodePool condition should carry the generation", condition.Type)
+	}
+}
+
+func TestStatusAggregator_WeightedProgress(t *testing.T) {
+	recent := time.Now().Add(-time.Minute)
+
+	// Three controllers with the core controller not ready yet
+	unweighted := &ControllerStats{TotalCount: 3, ReadyCount: 2, TotalWeight: 3, ReadyWeight: 2, EarliestControllerReportTime: &recent}
+	weighted := &ControllerStats{TotalCount: 3, ReadyCount: 2, TotalWeight: 6, ReadyWeight: 2, EarliestControllerReportTime: &recent}
+
+	aggregator := NewStatusAggregator(nil, nil)
+	unweightedResult := aggregator.aggregate("GCP", unweighted, 1, false)
+	weightedResult := aggregator.aggregate("GCP", weighted, 1, false)
+	utils.AssertEqual(t, 66, unweightedResult.Status.ProgressPercent, "Unweighted progress should count controllers")
+	utils.AssertEqual(t, 33, weightedResult.Status.ProgressPercent, "A heavy controller that is not ready should weigh on progress")
+	utils.AssertEqual(t, "Progressing", weightedResult.Status.Phase, "Progress alone should not change the phase")
+
+	nodepoolResult := aggregator.applyNodePoolAggregationRules(weighted, 1)
+	utils.AssertEqual(t, 33, nodepoolResult.Status.ProgressPercent, "NodePool progress should be weighted too")
+
+	allReady := &ControllerStats{TotalCount: 3, ReadyCount: 3, TotalWeight: 6, ReadyWeight: 6, EarliestControllerReportTime: &recent}
+	utils.AssertEqual(t, 100, aggregator.aggregate("GCP", allReady, 1, false).Status.ProgressPercent, "Every controller ready should be 100%")
+	utils.AssertEqual(t, 0, aggregator.aggregate("GCP", &ControllerStats{}, 1, false).Status.ProgressPercent, "No controllers should be 0%")
+}
+
+func TestStatusAggregator_WeightedReadyGate(t *testing.T) {
+	recent := time.Now().Add(-time.Minute)
+
+	// The optional controller (weight 1 of 10) is the only one not ready
+	optionalNotReady := &ControllerStats{TotalCount: 2, ReadyCount: 1, TotalWeight: 10, ReadyWeight: 9, EarliestControllerReportTime: &recent}
+	// The core controller (weight 9 of 10) is the only one not ready
+	coreNotReady := &ControllerStats{TotalCount: 2, ReadyCount: 1, TotalWeight: 10, ReadyWeight: 1, EarliestControllerReportTime: &recent}
+
+	tests := []struct {
+		name      string
+		threshold int
+		stats     *ControllerStats
+		wantPhase string
+	}{
+		{name: "flat gate waits for every controller", threshold: 100, stats: optionalNotReady, wantPhase: "Progressing"},
+		{name: "unset threshold keeps the flat gate", threshold: 0, stats: optionalNotReady, wantPhase: "Progressing"},
+		{name: "weighted gate ignores a light controller", threshold: 90, stats: optionalNotReady, wantPhase: "Ready"},
+		{name: "weighted gate waits for a heavy controller", threshold: 90, stats: coreNotReady, wantPhase: "Progressing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aggregator := NewStatusAggregator(&Client{readiness: ReadinessConfig{ReadyThreshold: tt.threshold}}, nil)
+
+			result := aggregator.aggregate("GCP", tt.stats, 1, false)
+			utils.AssertEqual(t, tt.wantPhase, result.Status.Phase, "Unexpected cluster phase")
+
+			nodepoolResult := aggregator.applyNodePoolAggregationRules(tt.stats, 1)
+			utils.AssertEqual(t, tt.wantPhase, nodepoolResult.Status.Phase, "Unexpected nodepool phase")
+		})
+	}
+
+	aggregator := NewStatusAggregator(&Client{readiness: ReadinessConfig{ReadyThreshold: 90}}, nil)
+	result := aggregator.aggregate("GCP", optionalNotReady, 1, false)
+	ready := models.ConditionList(result.Status.Conditions).GetCondition("Ready")
+	utils.AssertNotNil(t, ready, "Ready condition should be set")
+	utils.AssertEqual(t, "1 of 2 controllers are ready (90% weighted readiness)", ready.Message, "Ready message should not claim every controller is ready")
+}
+
+func TestStatusAggregator_ControllerWeightsQuery(t *testing.T) {
+	repo, clusterID := setupStatusAggregatorTest(t)
+	defer repo.Close()
+
+	ctx := context.Background()
+	reported := map[string]string{
+		"core-controller":     "False",
+		"dns-controller":      "True",
+		"optional-controller": "True",
+	}
+	for name, available := range reported {
+		err := repo.Status.UpsertClusterControllerStatus(ctx, &models.ClusterControllerStatus{
+			ClusterID:          clusterID,
+			ControllerName:     name,
+			ObservedGeneration: 1,
+			Conditions: models.ConditionList{
+				{Type: "Available", Status: available, LastTransitionTime: time.Now(), Reason: "Reported"},
+			},
+		})
+		utils.AssertError(t, err, false, "Should upsert controller status", name)
+	}
+
+	stats, err := repo.StatusAggregator.getControllerStats(ctx, clusterID, 1)
+	utils.AssertError(t, err, false, "Should get unweighted stats")
+	utils.AssertEqual(t, 3, stats.TotalWeight, "Unweighted controllers should weigh 1")
+	utils.AssertEqual(t, 2, stats.ReadyWeight, "Unweighted ready weight should match the ready count")
+	utils.AssertEqual(t, 66, readinessProgress(stats), "Unweighted progress")
+
+	repo.SetReadinessConfig(ReadinessConfig{ControllerWeights: map[string]int{"core-controller": 4}})
+	stats, err = repo.StatusAggregator.getControllerStats(ctx, clusterID, 1)
+	utils.AssertError(t, err, false, "Should get weighted stats")
+	utils.AssertEqual(t, 6, stats.TotalWeight, "Listed controllers should use their weight")
+	utils.AssertEqual(t, 2, stats.ReadyWeight, "The not-ready core controller should not add to the ready weight")
+	utils.AssertEqual(t, 33, readinessProgress(stats), "Weighted progress")
+	utils.AssertEqual(t, 2, stats.ReadyCount, "Weights should not change the ready count")
+}
+
+func TestStatusAggregator_SilentControllerQuery(t *testing.T) {
+	repo, clusterID := setupStatusAggregatorTest(t)
+	defer repo.Close()
+
+	ctx := context.Background()
+	available := `[{"type": "Available", "status": "True", "lastTransitionTime": "2025-10-17T12:00:00Z", "reason": "Reported"}]`
+	reportedAt := map[string]time.Time{
+		"live-controller":   time.Now(),
+		"silent-controller": time.Now().Add(-15 * time.Minute),
+	}
+	for name, updatedAt := range reportedAt {
+		_, err := repo.GetClient().ExecContext(ctx, `
+			INSERT INTO controller_status (cluster_id, controller_name, observed_generation, conditions, updated_at)
+			VALUES ($1, $2, 1, $3, $4)
+		`, clusterID, name, available, updatedAt)
+		utils.AssertError(t, err, false, "Should create controller status", name)
+	}
+
+	// Without a staleness threshold the last report is taken at face value
+	stats, err := repo.StatusAggregator.getControllerStats(ctx, clusterID, 1)
+	utils.AssertError(t, err, false, "Should get stats without a staleness threshold")
+	utils.AssertEqual(t, 2, stats.ReadyCount, "Both controllers should be ready")
+	utils.AssertEqual(t, 0, stats.SilentCount, "No controller should be silent")
+
+	repo.SetReadinessConfig(ReadinessConfig{StalenessThreshold: 10 * time.Minute})
+	stats, err = repo.StatusAggregator.getControllerStats(ctx, clusterID, 1)
+	utils.AssertError(t, err, false, "Should get stats with a staleness threshold")
+	utils.AssertEqual(t, 2, stats.TotalCount, "The silent controller should still be counted")
+	utils.AssertEqual(t, 1, stats.ReadyCount, "The controller last updated 15m ago should not count as ready")
+	utils.AssertEqual(t, 1, stats.ReadyWeight, "The silent controller should not add to the ready weight")
+	utils.AssertEqual(t, 1, stats.SilentCount, "The controller last updated 15m ago should be silent")
+
+	result := repo.StatusAggregator.aggregate("GCP", stats, 1, false)
+	utils.AssertEqual(t, "Progressing", result.Status.Phase, "A silent controller should keep the cluster from Ready")
+	utils.AssertEqual(t, 1, result.ReadyControllers, "Ready controllers should exclude the silent one")
+	utils.AssertTrue(t, models.ConditionList(result.Status.Conditions).HasCondition("Stale", "True"), "Stale condition should be set")
+}
+
+func TestStatusAggregator_StaleCondition(t *testing.T) {
+	aggregator := NewStatusAggregator(&Client{readiness: ReadinessConfig{StalenessThreshold: 10 * time.Minute}}, nil)
+	earlier := time.Now().Add(-time.Hour)
+
+	// The only controller last reported Available=True 15m ago, so it no longer counts
+	stats := &ControllerStats{TotalCount: 1, TotalWeight: 1, SilentCount: 1, EarliestControllerReportTime: &earlier}
+	result := aggregator.aggregate("GCP", stats, 1, false)
+	utils.AssertEqual(t, "Failed", result.Status.Phase, "A cluster whose only controller went silent should fail")
+	utils.AssertEqual(t, 0, result.ReadyControllers, "Silent controller should not be ready")
+
+	stale := models.ConditionList(result.Status.Conditions).GetCondition("Stale")
+	utils.AssertTrue(t, stale != nil, "Stale condition should be set")
+	utils.AssertEqual(t, "True", stale.Status, "Stale condition should be true")
+	utils.AssertEqual(t, "ControllersNotReporting", stale.Reason, "Stale condition reason")
+	utils.AssertEqual(t, "1 of 1 controllers have not reported in 10m0s and are not counted as ready", stale.Message, "Stale condition message")
+	utils.AssertEqual(t, int64(1), stale.ObservedGeneration, "Stale condition should carry the generation")
+
+	nodepoolResult := aggregator.applyNodePoolAggregationRules(stats, 1)
+	utils.AssertTrue(t, models.ConditionList(nodepoolResult.Status.Conditions).HasCondition("Stale", "True"), "NodePool Stale condition should be set")
+
+	// Controllers that keep reporting get no Stale condition
+	recent := time.Now().Add(-time.Minute)
+	live := &ControllerStats{TotalCount: 1, ReadyCount: 1, TotalWeight: 1, ReadyWeight: 1, EarliestControllerReportTime: &recent}
+	result = aggregator.aggregate("GCP", live, 1, false)
+	utils.AssertEqual(t, "Ready", result.Status.Phase, "Reporting controller should be ready")
+	utils.AssertTrue(t, models.ConditionList(result.Status.Conditions).GetCondition("Stale") == nil, "No Stale condition expected")
+}
+
+func TestStatusAggregator_GenerationSkewCondition(t *testing.T) {
+	aggregator := NewStatusAggregator(&Client{}, nil)
+	recent := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name     string
+		min, max int64
+		wantSkew bool
+	}{
+		{name: "same generation", min: 4, max: 4},
+		{name: "one generation apart", min: 3, max: 4},
+		{name: "two generations apart", min: 2, max: 4, wantSkew: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := &ControllerStats{
+				TotalCount: 1, ReadyCount: 1, TotalWeight: 1, ReadyWeight: 1,
+				EarliestControllerReportTime: &recent,
+				MinObservedGeneration:        tt.min,
+				MaxObservedGeneration:        tt.max,
+			}
+			result := aggregator.aggregate("GCP", stats, 4, false)
+			skew := models.ConditionList(result.Status.Conditions).GetCondition("GenerationSkew")
+			if !tt.wantSkew {
+				utils.AssertTrue(t, skew == nil, "No GenerationSkew condition expected")
+				return
+			}
+
+			utils.AssertTrue(t, skew != nil, "GenerationSkew condition should be set")
+			utils.AssertEqual(t, "True", skew.Status, "GenerationSkew condition should be true")
+			utils.AssertEqual(t, "ControllersAtDifferentGenerations", skew.Reason, "GenerationSkew condition reason")
+			utils.AssertEqual(t, "Controllers report observed generations from 2 to 4 (skew of 2)", skew.Message, "GenerationSkew condition message")
+			utils.AssertEqual(t, "Ready", result.Status.Phase, "Skew is a warning and should not change the phase")
+		})
+	}
+}
+
+func TestStatusAggregator_GenerationSkewQuery(t *testing.T) {
+	repo, clusterID := setupStatusAggregatorTest(t)
+	defer repo.Close()
+
+	ctx := context.Background()
+	available := `[{"type": "Available", "status": "True", "lastTransitionTime": "2025-10-17T12:00:00Z", "reason": "Reported"}]`
+	for name, generation := range map[string]int64{"lagging-controller": 2, "current-controller": 4} {
+		_, err := repo.GetClient().ExecContext(ctx, `
+			INSERT INTO controller_status (cluster_id, controller_name, observed_generation, conditions)
+			VALUES ($1, $2, $3, $4)
+		`, clusterID, name, generation, available)
+		utils.AssertError(t, err, false, "Should create controller status", name)
+	}
+
+	// The range covers every controller, not just those at the current generation
+	stats, err := repo.StatusAggregator.getControllerStats(ctx, clusterID, 4)
+	utils.AssertError(t, err, false, "Should get controller stats")
+	utils.AssertEqual(t, 1, stats.TotalCount, "Only the current controller counts towards readiness")
+	utils.AssertEqual(t, int64(2), stats.MinObservedGeneration, "Min generation should include the lagging controller")
+	utils.AssertEqual(t, int64(4), stats.MaxObservedGeneration, "Max generation should be the current one")
+
+	result := repo.StatusAggregator.aggregate("GCP", stats, 4, false)
+	skew := models.ConditionList(result.Status.Conditions).GetCondition("GenerationSkew")
+	utils.AssertTrue(t, skew != nil, "GenerationSkew condition should be set")
+	utils.AssertEqual(t, "Controllers report observed generations from 2 to 4 (skew of 2)", skew.Message, "GenerationSkew condition should carry the skew")
+}
+
+func TestStatusAggregator_NodePoolRollup(t *testing.T) {
+	aggregator := NewStatusAggregator(nil, nil)
+	recent := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name            string
+		stats           *ControllerStats
+		rollup          *models.NodePoolRollup
+		wantPhase       string
+		wantReady       string
+		wantNodePools   string
+		wantNodePoolsBy string
+	}{
+		{
+			name:            "no nodepools",
+			stats:           &ControllerStats{TotalCount: 2, ReadyCount: 2, EarliestControllerReportTime: &recent},
+			rollup:          &models.NodePoolRollup{},
+			wantPhase:       "Ready",
+			wantReady:       "True",
+			wantNodePools:   "True",
+			wantNodePoolsBy: "NoNodePools",
+		},
+		{
+			name:            "all nodepools ready",
+			stats:           &ControllerStats{TotalCount: 2, ReadyCount: 2, EarliestControllerReportTime: &recent},
+			rollup:          &models.NodePoolRollup{Total: 2, Ready: 2, WorstPhase: "Ready"},
+			wantPhase:       "Ready",
+			wantReady:       "True",
+			wantNodePools:   "True",
+			wantNodePoolsBy: "AllNodePoolsReady",
+		},
+		{
+			name:            "ready cluster with one failing nodepool",
+			stats:           &ControllerStats{TotalCount: 2, ReadyCount: 2, EarliestControllerReportTime: &recent},
+			rollup:          &models.NodePoolRollup{Total: 2, Ready: 1, WorstPhase: "Failed"},
+			wantPhase:       string(models.HealthDegraded),
+			wantReady:       "False",
+			wantNodePools:   "False",
+			wantNodePoolsBy: "NodePoolsNotReady",
+		},
+		{
+			name:            "ready cluster with a progressing nodepool",
+			stats:           &ControllerStats{TotalCount: 2, ReadyCount: 2, EarliestControllerReportTime: &recent},
+			rollup:          &models.NodePoolRollup{Total: 1, Ready: 0, WorstPhase: "Progressing"},
+			wantPhase:       "Progressing",
+			wantReady:       "False",
+			wantNodePools:   "False",
+			wantNodePoolsBy: "NodePoolsNotReady",
+		},
+		{
+			name:            "progressing cluster keeps its phase",
+			stats:           &ControllerStats{TotalCount: 2, ReadyCount: 1, EarliestControllerReportTime: &recent},
+			rollup:          &models.NodePoolRollup{Total: 1, Ready: 0, WorstPhase: "Failed"},
+			wantPhase:       "Progressing",
+			wantReady:       "False",
+			wantNodePools:   "False",
+			wantNodePoolsBy: "NodePoolsNotReady",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := aggregator.aggregate("GCP", tt.stats, 3, false)
+			aggregator.applyNodePoolRollup(result, tt.rollup)
+
+			utils.AssertEqual(t, tt.wantPhase, result.Status.Phase, "Unexpected phase")
+			utils.AssertEqual(t, tt.rollup, result.NodePools, "Rollup should be kept on the result")
+
+			conditions := models.ConditionList(result.Status.Conditions)
+			utils.AssertTrue(t, conditions.HasCondition("Ready", tt.wantReady), "Unexpected Ready condition")
+
+			nodepoolsReady := conditions.GetCondition("NodePoolsReady")
+			utils.AssertNotNil(t, nodepoolsReady, "NodePoolsReady condition should be added")
+			utils.AssertEqual(t, tt.wantNodePools, nodepoolsReady.Status, "Unexpected NodePoolsReady status")
+			utils.AssertEqual(t, tt.wantNodePoolsBy, nodepoolsReady.Reason, "Unexpected NodePoolsReady reason")
+			utils.AssertEqual(t, int64(3), nodepoolsReady.ObservedGeneration, "NodePoolsReady should carry the generation")
+		})
+	}
+}
+
+func TestStatusAggregator_ReadyClusterWithFailingNodePool(t *testing.T) {
+	repo, clusterID := setupStatusAggregatorTest(t)
+	defer repo.Close()
+
+	ctx := context.Background()
+	available := models.ConditionList{
+		{Type: "Available", Status: "True", LastTransitionTime: time.Now(), Reason: "Ready"},
+	}
+
+	err := repo.Status.UpsertClusterControllerStatus(ctx, &models.ClusterControllerStatus{
+		ClusterID:          clusterID,
+		ControllerName:     "cluster-controller",
+		ObservedGeneration: 1,
+		Conditions:         available,
+		Metadata:           models.JSONB{},
+	})
+	utils.AssertError(t, err, false, "Should create cluster controller status")
+
+	// One ready nodepool, and one whose controller stopped reporting long past the grace period
+	nodepools := map[string]struct {
+		conditions models.ConditionList
+		reportedAt time.Time
+	}{
+		"ready-pool": {available, time.Now()},
+		"failing-pool": {
+			models.ConditionList{{Type: "Available", Status: "False", LastTransitionTime: time.Now(), Reason: "NodesUnavailable"}},
+			time.Now().Add(-2 * time.Hour),
+		},
+	}
+	for name, np := range nodepools {
+		nodepoolID := uuid.New()
+		_, err := repo.GetClient().ExecContext(ctx, `
+			INSERT INTO nodepools (id, cluster_id, name, generation, resource_version, spec)
+			VALUES ($1, $2, $3, 1, $4, '{}')
+		`, nodepoolID, clusterID, name, uuid.New().String())
+		utils.AssertError(t, err, false, "Should create nodepool", name)
+
+		_, err = repo.GetClient().ExecContext(ctx, `
+			INSERT INTO nodepool_controller_status (nodepool_id, controller_name, observed_generation, conditions, updated_at)
+			VALUES ($1, 'nodepool-controller', 1, $2, $3)
+		`, nodepoolID, np.conditions, np.reportedAt)
+		utils.AssertError(t, err, false, "Should create nodepool controller status", name)
+	}
+
+	aggregator := NewStatusAggregator(repo.GetClient(), nil)
+	result, err := aggregator.CalculateClusterStatus(ctx, &models.Cluster{ID: clusterID, Generation: 1})
+	utils.AssertError(t, err, false, "Should calculate cluster status")
+
+	utils.AssertEqual(t, 2, result.NodePools.Total, "Both nodepools should be counted")
+	utils.AssertEqual(t, 1, result.NodePools.Ready, "Only the ready nodepool should be ready")
+	utils.AssertEqual(t, "Failed", result.NodePools.WorstPhase, "Failing nodepool should be the worst phase")
+	utils.AssertEqual(t, string(models.HealthDegraded), result.Status.Phase, "Cluster with a failing nodepool should be degraded")
+
+	conditions := models.ConditionList(result.Status.Conditions)
+	utils.AssertTrue(t, conditions.HasCondition("Ready", "False"), "Cluster with a failing nodepool should not be ready")
+	utils.AssertTrue(t, conditions.HasCondition("NodePoolsReady", "False"), "NodePoolsReady should be false")
+
+	rollup, err := repo.Status.GetNodePoolRollup(ctx, clusterID)
+	utils.AssertError(t, err, false, "Should get nodepool rollup")
+	utils.AssertEqual(t, *result.NodePools, *rollup, "Repository rollup should match the aggregated one")
+}
+
 func TestStatusAggregator_SQLQueryVariants(t *testing.T) {
 	repo, clusterID := setupStatusAggregatorTest(t)
 	defer repo.Close()
@@ -332,13 +1047,13 @@ func TestStatusAggregator_SQLQueryVariants(t *testing.T) {
 		}
 
 		// Get cluster for testing
-		cluster, err := repo.Clusters.GetByID(ctx, clusterID, "test@example.com")
+		cluster, err := repo.Clusters.GetByID(ctx, clusterID, "test@example.com", false)
 		if err != nil {
 			t.Fatalf("Failed to get cluster: %v", err)
 		}
 
 		// Test status aggregator
-		aggregator := NewStatusAggregator(repo.GetClient())
+		aggregator := NewStatusAggregator(repo.GetClient(), nil)
 		result, err := aggregator.CalculateClusterStatus(ctx, cluster)
 		if err != nil {
 			t.Fatalf("StatusAggregator failed: %v", err)
@@ -500,7 +1215,7 @@ func TestNodePoolStatusAggregator_NoControllers(t *testing.T) {
 	}
 
 	// Test status aggregator with no controllers
-	aggregator := NewStatusAggregator(repo.GetClient())
+	aggregator := NewStatusAggregator(repo.GetClient(), nil)
 	result, err := aggregator.CalculateNodePoolStatus(ctx, &nodepool)
 	if err != nil {
 		t.Fatalf("StatusAggregator failed: %v", err)
@@ -592,7 +1307,7 @@ func TestNodePoolStatusAggregator_AllReady(t *testing.T) {
 	}
 
 	// Test status aggregator
-	aggregator := NewStatusAggregator(repo.GetClient())
+	aggregator := NewStatusAggregator(repo.GetClient(), nil)
 	result, err := aggregator.CalculateNodePoolStatus(ctx, &nodepool)
 	if err != nil {
 		t.Fatalf("StatusAggregator failed: %v", err)
@@ -672,7 +1387,7 @@ func TestNodePoolStatusAggregator_PartialProgress(t *testing.T) {
 	}
 
 	// Test status aggregator
-	aggregator := NewStatusAggregator(repo.GetClient())
+	aggregator := NewStatusAggregator(repo.GetClient(), nil)
 	result, err := aggregator.CalculateNodePoolStatus(ctx, &nodepool)
 	if err != nil {
 		t.Fatalf("StatusAggregator failed: %v", err)
@@ -744,7 +1459,7 @@ func TestNodePoolStatusAggregator_EnrichWithStatus(t *testing.T) {
 	}
 
 	// Test enrichment
-	aggregator := NewStatusAggregator(repo.GetClient())
+	aggregator := NewStatusAggregator(repo.GetClient(), nil)
 	err = aggregator.EnrichNodePoolWithStatus(ctx, &nodepool)
 	if err != nil {
 		t.Fatalf("EnrichNodePoolWithStatus failed: %v", err)
@@ -833,7 +1548,7 @@ func TestNodePoolStatusAggregator_BatchEnrichment(t *testing.T) {
 	}
 
 	// Test batch enrichment
-	aggregator := NewStatusAggregator(repo.GetClient())
+	aggregator := NewStatusAggregator(repo.GetClient(), nil)
 	err := aggregator.EnrichNodePoolsWithStatus(ctx, nodepools)
 	if err != nil {
 		t.Fatalf("EnrichNodePoolsWithStatus failed: %v", err)
@@ -854,3 +1569,20 @@ func TestNodePoolStatusAggregator_BatchEnrichment(t *testing.T) {
 
 	t.Logf("Successfully enriched %d nodepools in batch", len(nodepools))
 }
+
+func TestStatusAggregator_StaleClusterStatusCondition(t *testing.T) {
+	aggregator := NewStatusAggregator(nil, nil)
+
+	// Placeholders continue after the caller's arguments
+	condition, args := aggregator.staleClusterStatusCondition([]interface{}{"owner@example.com"})
+	utils.AssertEqual(t, 2, len(args), "Version should be appended to the arguments")
+	utils.AssertEqual(t, StatusAggregatorVersion, args[1], "Version argument")
+	utils.AssertContains(t, condition, "status_dirty = TRUE", "Dirty clusters should be refreshed")
+	utils.AssertContains(t, condition, "<> $2", "Clusters from other aggregator versions should be refreshed")
+	utils.AssertFalse(t, strings.Contains(condition, "lastUpdateTime"), "Without a staleness threshold cached statuses don't expire")
+
+	aggregator = NewStatusAggregator(&Client{readiness: ReadinessConfig{StalenessThreshold: 10 * time.Minute}}, nil)
+	condition, args = aggregator.staleClusterStatusCondition(nil)
+	utils.AssertEqual(t, 2, len(args), "Expiry cutoff should be appended to the arguments")
+	utils.AssertContains(t, condition, "(status->>'lastUpdateTime')::timestamptz < $2", "Expired statuses should be refreshed")
+}
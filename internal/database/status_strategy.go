@@ -0,0 +1,333 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/utils"
+)
+
+// StatusStrategy turns the controller statistics of a cluster's current generation into its
+// aggregated status. Strategies are chosen per platform type, so platforms with different
+// readiness rules can be aggregated differently.
+type StatusStrategy interface {
+	Aggregate(stats *ControllerStats, generation int64) *StatusAggregationResult
+}
+
+// StatusStrategyDefault names DefaultStrategy in configuration
+const StatusStrategyDefault = "default"
+
+// NewStatusStrategy returns the strategy configured under name
+func NewStatusStrategy(name string, readiness ReadinessConfig) (StatusStrategy, error) {
+	switch name {
+	case StatusStrategyDefault:
+		return NewDefaultStrategy(readiness), nil
+	default:
+		return nil, fmt.Errorf("unknown status aggregation strategy %q", name)
+	}
+}
+
+// DefaultStrategy is the HyperShift-oriented aggregation: a cluster is Ready once enough
+// controllers report Available=True, Degraded while ready controllers report errors, and
+// Failed only when no controller became ready within the provisioning grace period.
+type DefaultStrategy struct {
+	// Readiness weights the controllers and sets the ready threshold and staleness check
+	Readiness ReadinessConfig
+
+	logger *utils.Logger
+}
+
+// NewDefaultStrategy creates the default aggregation strategy
+func NewDefaultStrategy(readiness ReadinessConfig) *DefaultStrategy {
+	return &DefaultStrategy{
+		Readiness: readiness,
+		logger:    utils.NewLogger("status_strategy"),
+	}
+}
+
+// strategyFor returns the strategy that aggregates clusters of the platform type
+func (a *StatusAggregator) strategyFor(platform string) StatusStrategy {
+	if a.strategy != nil {
+		return a.strategy
+	}
+	if a.client != nil {
+		if strategy, ok := a.client.statusStrategies[strings.ToUpper(platform)]; ok {
+			return strategy
+		}
+	}
+	return NewDefaultStrategy(a.readiness())
+}
+
+// aggregate computes a cluster's status with the strategy for its platform. expectNoControllers
+// makes a cluster without controller reports Ready instead of handing it to the strategy.
+func (a *StatusAggregator) aggregate(platform string, stats *ControllerStats, generation int64, expectNoControllers bool) *StatusAggregationResult {
+	if stats.TotalCount == 0 && expectNoControllers {
+		return noControllersExpectedResult(stats, generation)
+	}
+	return a.strategyFor(platform).Aggregate(stats, generation)
+}
+
+// noControllersExpectedResult is the status of a cluster that declares no controllers
+// manage it, so there is nothing to wait for
+func noControllersExpectedResult(stats *ControllerStats, generation int64) *StatusAggregationResult {
+	now := time.Now()
+	conditions := []models.Condition{
+		{
+			Type:               "Ready",
+			Status:             "True",
+			LastTransitionTime: now,
+			Reason:             "NoControllersExpected",
+			Message:            "No controllers are expected to report status",
+		},
+		{
+			Type:               "Available",
+			Status:             "True",
+			LastTransitionTime: now,
+			Reason:             "NoControllersExpected",
+			Message:            "No controllers are expected to report status",
+		},
+	}
+	if hasGenerationSkew(stats) {
+		conditions = append(conditions, generationSkewCondition(stats, now))
+	}
+
+	return newStatusAggregationResult(stats, generation, now,
+		"Ready", "NoControllersExpected", "Cluster is ready and expects no controllers", 100, conditions)
+}
+
+// newStatusAggregationResult builds the Kubernetes-like status block; each condition reflects
+// the current generation
+func newStatusAggregationResult(stats *ControllerStats, generation int64, now time.Time, phase, reason, message string, progress int, conditions []models.Condition) *StatusAggregationResult {
+	for i := range conditions {
+		conditions[i].ObservedGeneration = generation
+	}
+
+	return &StatusAggregationResult{
+		Status: &models.ClusterStatusInfo{
+			ObservedGeneration: generation,
+			Conditions:         conditions,
+			Phase:              phase,
+			Message:            message,
+			Reason:             reason,
+			ProgressPercent:    progress,
+			LastUpdateTime:     now,
+		},
+		TotalControllers:  stats.TotalCount,
+		ReadyControllers:  stats.ReadyCount,
+		FailedControllers: stats.TotalCount - stats.ReadyCount,
+		HasErrors:         stats.ErrorCount > 0,
+		Generation:        generation,
+	}
+}
+
+// Aggregate applies the Kubernetes-like status aggregation logic with timeout awareness
+func (s *DefaultStrategy) Aggregate(stats *ControllerStats, generation int64) *StatusAggregationResult {
+	now := time.Now()
+
+	var (
+		phase              string
+		reason             string
+		message            string
+		readyCondition     models.Condition
+		availableCondition models.Condition
+		extraConditions    []models.Condition
+	)
+
+	hasErrors := stats.ErrorCount > 0
+	ready := passesReadyGate(s.Readiness, stats)
+	progress := readinessProgress(stats)
+
+	// Apply Kubernetes-like aggregation logic
+	if stats.TotalCount == 0 {
+		// No controllers have reported status yet
+		phase = "Pending"
+		reason = "NoControllers"
+		message = "Waiting for controllers to report status"
+
+		readyCondition = models.Condition{
+			Type:               "Ready",
+			Status:             "False",
+			LastTransitionTime: now,
+			Reason:             "ControllersNotReady",
+			Message:            "No controllers have reported status yet",
+		}
+
+		availableCondition = models.Condition{
+			Type:               "Available",
+			Status:             "False",
+			LastTransitionTime: now,
+			Reason:             "ControllersNotAvailable",
+			Message:            "No controllers are available yet",
+		}
+
+	} else if ready && !hasErrors {
+		// All controllers ready and no errors
+		phase = "Ready"
+		reason = "AllControllersReady"
+		message = fmt.Sprintf("Cluster is ready with %d controllers operational", stats.TotalCount)
+
+		readyCondition = models.Condition{
+			Type:               "Ready",
+			Status:             "True",
+			LastTransitionTime: now,
+			Reason:             "AllControllersReady",
+			Message:            readyControllersMessage(stats, "ready"),
+		}
+
+		availableCondition = models.Condition{
+			Type:               "Available",
+			Status:             "True",
+			LastTransitionTime: now,
+			Reason:             "AllControllersAvailable",
+			Message:            readyControllersMessage(stats, "available"),
+		}
+
+	} else if ready {
+		// All controllers ready but some still report errors (e.g. transient failures)
+		phase = string(models.HealthDegraded)
+		reason = "ControllersWithErrors"
+		message = fmt.Sprintf("Cluster is available but %d of %d controllers report errors", stats.ErrorCount, stats.TotalCount)
+
+		readyCondition = models.Condition{
+			Type:               "Ready",
+			Status:             "True",
+			LastTransitionTime: now,
+			Reason:             "AllControllersReady",
+			Message:            readyControllersMessage(stats, "ready"),
+		}
+
+		availableCondition = models.Condition{
+			Type:               "Available",
+			Status:             "True",
+			LastTransitionTime: now,
+			Reason:             "AllControllersAvailable",
+			Message:            readyControllersMessage(stats, "available"),
+		}
+
+		extraConditions = append(extraConditions, models.Condition{
+			Type:               "Degraded",
+			Status:             "True",
+			LastTransitionTime: now,
+			Reason:             "ControllersWithErrors",
+			Message:            fmt.Sprintf("%d of %d controllers report errors", stats.ErrorCount, stats.TotalCount),
+		})
+
+	} else if stats.ReadyCount > 0 {
+		// Some controllers ready
+		phase = "Progressing"
+
+		readyCondition = models.Condition{
+			Type:               "Ready",
+			Status:             "False",
+			LastTransitionTime: now,
+			Reason:             "PartiallyReady",
+			Message:            fmt.Sprintf("%d of %d controllers are ready", stats.ReadyCount, stats.TotalCount),
+		}
+
+		if hasErrors {
+			reason = "ControllersWithErrors"
+			message = fmt.Sprintf("Cluster is progressing but some controllers have errors (%d/%d ready)", stats.ReadyCount, stats.TotalCount)
+
+			availableCondition = models.Condition{
+				Type:               "Available",
+				Status:             "False",
+				LastTransitionTime: now,
+				Reason:             "PartiallyAvailableWithErrors",
+				Message:            fmt.Sprintf("Some controllers have errors (%d available of %d)", stats.ReadyCount, stats.TotalCount),
+			}
+		} else {
+			reason = "PartialProgress"
+			message = fmt.Sprintf("Cluster is progressing (%d/%d controllers ready)", stats.ReadyCount, stats.TotalCount)
+
+			availableCondition = models.Condition{
+				Type:               "Available",
+				Status:             "False",
+				LastTransitionTime: now,
+				Reason:             "PartiallyAvailable",
+				Message:            fmt.Sprintf("Controllers are still becoming available (%d available of %d)", stats.ReadyCount, stats.TotalCount),
+			}
+		}
+
+	} else {
+		// No controllers ready - use timeout-aware logic
+		withinGracePeriod := isWithinGracePeriod(s.logger, stats)
+		hasProgress := stats.HasRecentActivity || hasErrors // Recent activity or errors indicate progress
+
+		if withinGracePeriod || hasProgress {
+			// Controllers are working but not ready yet - give them time
+			phase = "Progressing"
+
+			if withinGracePeriod {
+				reason = "ControllersProvisioning"
+				var timeRemaining string
+				if stats.EarliestControllerReportTime != nil {
+					elapsed := time.Since(*stats.EarliestControllerReportTime)
+					remaining := time.Duration(DefaultGracePeriodMinutes)*time.Minute - elapsed
+					if remaining > 0 {
+						timeRemaining = fmt.Sprintf(" (%d minutes remaining)", int(remaining.Minutes()))
+					}
+				}
+				message = fmt.Sprintf("Controllers are provisioning resources%s (%d controllers working)", timeRemaining, stats.TotalCount)
+			} else {
+				reason = "ControllersShowingProgress"
+				message = fmt.Sprintf("Controllers are actively working but not yet ready (%d controllers showing progress)", stats.TotalCount)
+			}
+
+			readyCondition = models.Condition{
+				Type:               "Ready",
+				Status:             "False",
+				LastTransitionTime: now,
+				Reason:             "ControllersNotYetReady",
+				Message:            fmt.Sprintf("Controllers are still working (%d of %d controllers)", stats.TotalCount, stats.TotalCount),
+			}
+
+			availableCondition = models.Condition{
+				Type:               "Available",
+				Status:             "False",
+				LastTransitionTime: now,
+				Reason:             "ControllersBecomingAvailable",
+				Message:            fmt.Sprintf("Controllers are becoming available (%d working)", stats.TotalCount),
+			}
+		} else {
+			// Timeout exceeded with no progress - now it's truly failed
+			phase = "Failed"
+			reason = "ControllerTimeout"
+
+			timeoutDuration := "20+ minutes"
+			if stats.EarliestControllerReportTime != nil {
+				elapsed := time.Since(*stats.EarliestControllerReportTime)
+				timeoutDuration = fmt.Sprintf("%.0f minutes", elapsed.Minutes())
+			}
+
+			message = fmt.Sprintf("Controllers failed to become ready after %s with no progress (%d controllers timed out)", timeoutDuration, stats.TotalCount)
+
+			readyCondition = models.Condition{
+				Type:               "Ready",
+				Status:             "False",
+				LastTransitionTime: now,
+				Reason:             "ControllersTimedOut",
+				Message:            fmt.Sprintf("Controllers timed out after %s", timeoutDuration),
+			}
+
+			availableCondition = models.Condition{
+				Type:               "Available",
+				Status:             "False",
+				LastTransitionTime: now,
+				Reason:             "ControllersTimedOut",
+				Message:            fmt.Sprintf("No controllers became available after %s", timeoutDuration),
+			}
+		}
+	}
+
+	if stats.SilentCount > 0 {
+		extraConditions = append(extraConditions, staleCondition(s.Readiness, stats, now))
+	}
+	if hasGenerationSkew(stats) {
+		extraConditions = append(extraConditions, generationSkewCondition(stats, now))
+	}
+
+	conditions := append([]models.Condition{readyCondition, availableCondition}, extraConditions...)
+	return newStatusAggregationResult(stats, generation, now, phase, reason, message, progress, conditions)
+}
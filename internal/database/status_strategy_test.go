@@ -0,0 +1,74 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/utils"
+)
+
+// recordingStrategy returns a fixed phase and records what it was asked to aggregate
+type recordingStrategy struct {
+	phase      string
+	calls      int
+	stats      *ControllerStats
+	generation int64
+}
+
+func (s *recordingStrategy) Aggregate(stats *ControllerStats, generation int64) *StatusAggregationResult {
+	s.calls++
+	s.stats = stats
+	s.generation = generation
+	return &StatusAggregationResult{
+		Status:     &models.ClusterStatusInfo{Phase: s.phase, ObservedGeneration: generation},
+		Generation: generation,
+	}
+}
+
+func TestStatusAggregator_DelegatesToStrategy(t *testing.T) {
+	strategy := &recordingStrategy{phase: "Custom"}
+	aggregator := NewStatusAggregator(nil, strategy)
+	stats := &ControllerStats{TotalCount: 2, ReadyCount: 1}
+
+	result := aggregator.aggregate("GCP", stats, 3, false)
+	utils.AssertEqual(t, 1, strategy.calls, "Aggregation should be delegated to the strategy")
+	utils.AssertTrue(t, strategy.stats == stats, "Strategy should receive the controller stats")
+	utils.AssertEqual(t, int64(3), strategy.generation, "Strategy should receive the generation")
+	utils.AssertEqual(t, "Custom", result.Status.Phase, "Strategy result should be returned")
+
+	// A cluster expecting no controllers is Ready without consulting the strategy
+	result = aggregator.aggregate("GCP", &ControllerStats{}, 3, true)
+	utils.AssertEqual(t, 1, strategy.calls, "Strategy should not be consulted")
+	utils.AssertEqual(t, "Ready", result.Status.Phase, "Cluster expecting no controllers should be ready")
+	utils.AssertEqual(t, "NoControllersExpected", result.Status.Reason, "Ready reason")
+}
+
+func TestStatusAggregator_StrategyPerPlatform(t *testing.T) {
+	strategy := &recordingStrategy{phase: "Custom"}
+	repo := &Repository{client: &Client{}}
+	repo.SetStatusStrategies(map[string]StatusStrategy{"aws": strategy})
+	aggregator := NewStatusAggregator(repo.client, nil)
+	stats := &ControllerStats{TotalCount: 1, ReadyCount: 1, TotalWeight: 1, ReadyWeight: 1}
+
+	result := aggregator.aggregate("AWS", stats, 1, false)
+	utils.AssertEqual(t, 1, strategy.calls, "AWS clusters should use the configured strategy")
+	utils.AssertEqual(t, "Custom", result.Status.Phase, "Configured strategy result should be returned")
+
+	// Unconfigured platforms fall back to the default strategy
+	result = aggregator.aggregate("GCP", stats, 1, false)
+	utils.AssertEqual(t, 1, strategy.calls, "GCP clusters should not use the AWS strategy")
+	utils.AssertEqual(t, "Ready", result.Status.Phase, "Default strategy should aggregate GCP clusters")
+	utils.AssertEqual(t, "AllControllersReady", result.Status.Reason, "Default strategy reason")
+}
+
+func TestNewStatusStrategy(t *testing.T) {
+	strategy, err := NewStatusStrategy(StatusStrategyDefault, ReadinessConfig{ReadyThreshold: 80})
+	utils.AssertError(t, err, false, "Default strategy should be known")
+	defaultStrategy, ok := strategy.(*DefaultStrategy)
+	utils.AssertTrue(t, ok, "Default name should create a DefaultStrategy")
+	utils.AssertEqual(t, 80, defaultStrategy.Readiness.ReadyThreshold, "Strategy should carry the readiness config")
+
+	_, err = NewStatusStrategy("hypershift-v2", ReadinessConfig{})
+	utils.AssertError(t, err, true, "Unknown strategy should be rejected")
+	utils.AssertContains(t, err.Error(), `unknown status aggregation strategy "hypershift-v2"`, "Error should name the strategy")
+}
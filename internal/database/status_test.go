@@ -0,0 +1,160 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/google/uuid"
+)
+
+func setupFailedEventsTestSchema(t *testing.T, repo *Repository) {
+	migration, err := os.ReadFile("migrations/010_add_failed_events.sql")
+	utils.AssertError(t, err, false, "Should read failed events migration")
+
+	_, err = repo.GetClient().ExecContext(context.Background(), string(migration))
+	utils.AssertError(t, err, false, "Should create failed events schema")
+}
+
+func TestStatusRepository_FailedEvents(t *testing.T) {
+	repo := setupTestRepository(t)
+	defer repo.Close()
+	setupFailedEventsTestSchema(t, repo)
+
+	ctx := context.Background()
+	clusterID := uuid.New()
+	nodepoolID := uuid.New()
+
+	clusterEvent := &models.FailedEvent{
+		EventType:    models.EventTypeClusterReconcile,
+		ClusterID:    clusterID,
+		Payload:      json.RawMessage(`{"type":"cluster.reconcile","cluster_id":"` + clusterID.String() + `"}`),
+		ErrorMessage: "failed to publish message: unavailable",
+	}
+	err := repo.Status.RecordFailedEvent(ctx, clusterEvent)
+	utils.AssertError(t, err, false, "Should record cluster failed event")
+	utils.AssertNotEqual(t, uuid.Nil, clusterEvent.ID, "Failed event ID should be assigned")
+
+	nodepoolEvent := &models.FailedEvent{
+		EventType:    models.EventTypeNodePoolReconcile,
+		ClusterID:    clusterID,
+		NodePoolID:   &nodepoolID,
+		Payload:      json.RawMessage(`{"type":"nodepool.reconcile","nodepool_id":"` + nodepoolID.String() + `"}`),
+		ErrorMessage: "webhook returned status 503",
+	}
+	err = repo.Status.RecordFailedEvent(ctx, nodepoolEvent)
+	utils.AssertError(t, err, false, "Should record nodepool failed event")
+
+	events, err := repo.Status.ListFailedEvents(ctx, false, 10)
+	utils.AssertError(t, err, false, "Should list failed events")
+	utils.AssertEqual(t, 2, len(events), "Should list both pending events")
+	utils.AssertEqual(t, nodepoolEvent.ID, events[0].ID, "Most recent event should be first")
+	utils.AssertNotNil(t, events[0].NodePoolID, "Nodepool event should keep its nodepool ID")
+	utils.AssertEqual(t, nodepoolID, *events[0].NodePoolID, "Nodepool ID should round-trip")
+	utils.AssertEqual(t, "webhook returned status 503", events[0].ErrorMessage, "Error message should round-trip")
+	utils.AssertNil(t, events[1].NodePoolID, "Cluster event should not have a nodepool ID")
+
+	var payload map[string]interface{}
+	err = json.Unmarshal(events[1].Payload, &payload)
+	utils.AssertError(t, err, false, "Payload should be valid JSON")
+	utils.AssertEqual(t, clusterID.String(), payload["cluster_id"], "Payload should round-trip")
+
+	// Replayed events are hidden unless requested
+	err = repo.Status.MarkFailedEventReplayed(ctx, clusterEvent.ID)
+	utils.AssertError(t, err, false, "Should mark event replayed")
+
+	events, err = repo.Status.ListFailedEvents(ctx, false, 10)
+	utils.AssertError(t, err, false, "Should list pending failed events")
+	utils.AssertEqual(t, 1, len(events), "Replayed event should be excluded")
+
+	events, err = repo.Status.ListFailedEvents(ctx, true, 10)
+	utils.AssertError(t, err, false, "Should list all failed events")
+	utils.AssertEqual(t, 2, len(events), "Replayed event should be included on request")
+
+	got, err := repo.Status.GetFailedEvent(ctx, clusterEvent.ID)
+	utils.AssertError(t, err, false, "Should get failed event")
+	utils.AssertNotNil(t, got.ReplayedAt, "Replayed time should be set")
+
+	_, err = repo.Status.GetFailedEvent(ctx, uuid.New())
+	utils.AssertEqual(t, models.ErrFailedEventNotFound, err, "Unknown event should return not found")
+
+	err = repo.Status.MarkFailedEventReplayed(ctx, uuid.New())
+	utils.AssertEqual(t, models.ErrFailedEventNotFound, err, "Marking unknown event should return not found")
+}
+
+func TestStatusRepository_WithClientKeepsSettings(t *testing.T) {
+	repo := NewStatusRepository(&Client{})
+	repo.SetStatusHistoryLimit(2)
+	repo.SetCollapseErrors(true)
+
+	txClient := &Client{}
+	txRepo := repo.withClient(txClient)
+	utils.AssertTrue(t, txRepo.client == txClient, "Transaction repository should use the transaction client")
+	utils.AssertFalse(t, repo.client == txClient, "Original repository should keep its client")
+	utils.AssertEqual(t, 2, txRepo.historyLimit, "Transaction repository should keep the history limit")
+	utils.AssertTrue(t, txRepo.collapseErrors, "Transaction repository should keep error collapsing")
+}
+
+func TestStatusRepository_DeferStatusDirty(t *testing.T) {
+	repo, clusterID := setupStatusAggregatorTest(t)
+	defer repo.Close()
+
+	ctx := context.Background()
+	migration, err := os.ReadFile("migrations/022_defer_status_dirty_in_batches.sql")
+	utils.AssertError(t, err, false, "Should read defer status dirty migration")
+	_, err = repo.GetClient().ExecContext(ctx, string(migration)+`
+		DROP TRIGGER IF EXISTS controller_status_dirty_trigger ON controller_status;
+		CREATE TRIGGER controller_status_dirty_trigger
+			AFTER INSERT OR UPDATE ON controller_status
+			FOR EACH ROW
+			EXECUTE FUNCTION mark_cluster_status_dirty();`)
+	utils.AssertError(t, err, false, "Should create the status dirty trigger")
+
+	isDirty := func(client *Client) bool {
+		var dirty bool
+		err := client.QueryRowContext(ctx, `SELECT status_dirty FROM clusters WHERE id = $1`, clusterID).Scan(&dirty)
+		utils.AssertError(t, err, false, "Should read status_dirty")
+		return dirty
+	}
+	markClean := func() {
+		_, err := repo.GetClient().ExecContext(ctx, `UPDATE clusters SET status_dirty = FALSE WHERE id = $1`, clusterID)
+		utils.AssertError(t, err, false, "Should mark the cluster clean")
+	}
+	report := func(controllerName string) *models.ClusterControllerStatus {
+		return &models.ClusterControllerStatus{ClusterID: clusterID, ControllerName: controllerName, ObservedGeneration: 1}
+	}
+
+	// Outside a deferring transaction every report marks the cluster dirty
+	markClean()
+	err = repo.Status.UpsertClusterControllerStatus(ctx, report("dns-controller"))
+	utils.AssertError(t, err, false, "Should store the report")
+	utils.AssertTrue(t, isDirty(repo.GetClient()), "A single report should mark the cluster dirty")
+
+	markClean()
+	err = repo.Transaction(ctx, func(txRepo *Repository) error {
+		if err := txRepo.Status.DeferStatusDirty(ctx); err != nil {
+			return err
+		}
+		for _, name := range []string{"dns-controller", "network-controller"} {
+			if err := txRepo.Status.UpsertClusterControllerStatus(ctx, report(name)); err != nil {
+				return err
+			}
+		}
+		utils.AssertFalse(t, isDirty(txRepo.GetClient()), "Deferred reports should not mark the cluster dirty")
+		return txRepo.Clusters.MarkDirtyStatus(ctx, clusterID)
+	})
+	utils.AssertError(t, err, false, "Batch transaction should commit")
+	utils.AssertTrue(t, isDirty(repo.GetClient()), "The batch should mark the cluster dirty once")
+
+	// The setting ends with the transaction
+	markClean()
+	err = repo.Status.UpsertClusterControllerStatus(ctx, report("dns-controller"))
+	utils.AssertError(t, err, false, "Should store the report")
+	utils.AssertTrue(t, isDirty(repo.GetClient()), "Reports after the batch should mark the cluster dirty again")
+
+	err = repo.Status.DeferStatusDirty(ctx)
+	utils.AssertError(t, err, true, "Deferring outside a transaction should fail")
+}
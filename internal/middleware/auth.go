@@ -1,8 +1,10 @@
 package middleware
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
-	"strings"
+	"os"
 
 	"github.com/apahim/cls-backend/internal/auth"
 	"github.com/apahim/cls-backend/internal/config"
@@ -10,43 +12,51 @@ import (
 	"go.uber.org/zap"
 )
 
-// AuthRequired middleware enforces authentication
-func AuthRequired(cfg *config.Config) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		// Check if authentication is disabled (for development)
-		if !cfg.Auth.Enabled {
-			c.Next()
-			return
+// NewAuthenticator builds the authenticator selected by AUTH_PROVIDER
+func NewAuthenticator(cfg config.AuthConfig) (auth.Authenticator, error) {
+	switch cfg.Provider {
+	case "", config.AuthProviderHeader:
+		return auth.NewHeaderAuthenticator(), nil
+	case config.AuthProviderJWT:
+		jwtCfg := auth.JWTConfig{
+			Secret:   []byte(cfg.JWTSecret),
+			Issuer:   cfg.JWTIssuer,
+			Audience: cfg.JWTAudience,
 		}
-
-		// Extract user email from header (set by API Gateway)
-		userEmail := c.GetHeader("X-User-Email")
-		if userEmail == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"error": "Authentication required",
-				"code":  "AUTH_REQUIRED",
-			})
-			c.Abort()
-			return
+		if cfg.JWTPublicKeyFile != "" {
+			publicKey, err := os.ReadFile(cfg.JWTPublicKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read JWT public key: %w", err)
+			}
+			jwtCfg.PublicKeyPEM = publicKey
 		}
+		return auth.NewJWTAuthenticator(jwtCfg)
+	default:
+		return nil, fmt.Errorf("unknown auth provider %q", cfg.Provider)
+	}
+}
 
-		// Validate email format (basic validation)
-		if !isValidEmail(userEmail) {
+// AuthRequired middleware enforces authentication
+func AuthRequired(authenticator auth.Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userCtx, err := authenticator.Authenticate(c.Request)
+		if err != nil {
+			var authErr *auth.AuthError
+			if !errors.As(err, &authErr) {
+				authErr = auth.ErrAuthRequired
+			}
 			c.JSON(http.StatusUnauthorized, gin.H{
-				"error": "Invalid user email format",
-				"code":  "INVALID_EMAIL",
+				"error": authErr.Message,
+				"code":  authErr.Code,
 			})
 			c.Abort()
 			return
 		}
 
-		// Create user context with access control information
-		userCtx := auth.NewUserContext(userEmail)
-
 		// Set user context in Gin context for handlers to use
 		c.Set("user_context", userCtx)
 		// Keep user_email for backward compatibility (if needed)
-		c.Set("user_email", userEmail)
+		c.Set("user_email", userCtx.Email)
 
 		// Log the authenticated request with access level
 		accessLevel := "user"
@@ -55,7 +65,7 @@ func AuthRequired(cfg *config.Config) gin.HandlerFunc {
 		}
 
 		zap.L().Debug("Authenticated request",
-			zap.String("user_email", userEmail),
+			zap.String("user_email", userCtx.Email),
 			zap.String("access_level", accessLevel),
 			zap.Bool("is_controller", userCtx.IsController),
 			zap.String("path", c.Request.URL.Path),
@@ -89,17 +99,3 @@ func GetUserContext(c *gin.Context) (*auth.UserContext, bool) {
 	authUserCtx, ok := userCtx.(*auth.UserContext)
 	return authUserCtx, ok
 }
-
-// isValidEmail performs basic email validation
-func isValidEmail(email string) bool {
-	// Basic email validation - contains @ and has parts before and after
-	parts := strings.Split(email, "@")
-	if len(parts) != 2 {
-		return false
-	}
-	if len(parts[0]) == 0 || len(parts[1]) == 0 {
-		return false
-	}
-	// Must contain a dot in the domain part
-	return strings.Contains(parts[1], ".")
-}
@@ -3,45 +3,51 @@ package middleware
 import (
 	"time"
 
+	"github.com/apahim/cls-backend/internal/utils"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
 
-// CORS middleware adds Cross-Origin Resource Sharing headers
-func CORS() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
-		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With, X-User-Email")
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE")
-
-		if c.Request.Method == "OPTIONS" {
-			c.AbortWithStatus(204)
-			return
-		}
+// RequestIDHeader carries the request ID used to correlate logs across layers
+const RequestIDHeader = "X-Request-ID"
 
-		c.Next()
-	}
-}
+// maxRequestIDLength bounds client-provided request IDs, which end up in every log line
+const maxRequestIDLength = 128
 
 // RequestID middleware adds a unique request ID to each request
 func RequestID() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Check if request ID already exists in headers
-		requestID := c.GetHeader("X-Request-ID")
-		if requestID == "" {
+		requestID := c.GetHeader(RequestIDHeader)
+		if !validRequestID(requestID) {
 			// Generate a new UUID for the request
 			requestID = uuid.New().String()
 		}
 
-		// Set the request ID in context and response header
+		// Set the request ID in the gin and request contexts and the response header, so
+		// loggers derived with utils.WithContext down to the repositories include it
 		c.Set("request_id", requestID)
-		c.Writer.Header().Set("X-Request-ID", requestID)
+		c.Request = c.Request.WithContext(utils.ContextWithRequestID(c.Request.Context(), requestID))
+		c.Writer.Header().Set(RequestIDHeader, requestID)
 
 		c.Next()
 	}
 }
 
+// validRequestID reports whether a client-provided request ID is safe to propagate: non-empty,
+// bounded and made of printable ASCII
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(requestID); i++ {
+		if requestID[i] < 0x21 || requestID[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
 // ResponseTime middleware adds response time information
 func ResponseTime() gin.HandlerFunc {
 	return func(c *gin.Context) {
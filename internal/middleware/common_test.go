@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func setupRequestIDRouter(seen *string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(RequestID())
+	router.GET("/clusters", func(c *gin.Context) {
+		*seen = utils.RequestIDFromContext(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+	return router
+}
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name      string
+		provided  string
+		preserved bool
+	}{
+		{name: "generated when missing"},
+		{name: "provided ID is preserved", provided: "req-1234", preserved: true},
+		{name: "oversized ID is replaced", provided: strings.Repeat("a", maxRequestIDLength+1)},
+		{name: "ID with control characters is replaced", provided: "req\t1234"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var seen string
+			router := setupRequestIDRouter(&seen)
+
+			req := httptest.NewRequest(http.MethodGet, "/clusters", nil)
+			if tt.provided != "" {
+				req.Header.Set(RequestIDHeader, tt.provided)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			requestID := w.Header().Get(RequestIDHeader)
+			utils.AssertTrue(t, requestID != "", "Response should carry a request ID")
+			utils.AssertEqual(t, requestID, seen, "Request context should carry the echoed request ID")
+			if tt.preserved {
+				utils.AssertEqual(t, tt.provided, requestID, "Provided request ID should be preserved")
+			} else {
+				_, err := uuid.Parse(requestID)
+				utils.AssertError(t, err, false, "Generated request ID should be a UUID")
+			}
+		})
+	}
+}
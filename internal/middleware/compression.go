@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// gzipResponseWriter buffers the response body so the compression decision can be made
+// once the full size is known
+type gzipResponseWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *gzipResponseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *gzipResponseWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+func (w *gzipResponseWriter) Written() bool {
+	return w.body.Len() > 0 || w.ResponseWriter.Written()
+}
+
+// Gzip middleware compresses response bodies of at least minBytes for clients that send
+// Accept-Encoding: gzip. Smaller bodies, bodies already carrying a Content-Encoding and
+// responses to other clients are passed through unchanged.
+func Gzip(minBytes int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Writer.Header().Add("Vary", "Accept-Encoding")
+		if !acceptsGzip(c.GetHeader("Accept-Encoding")) {
+			c.Next()
+			return
+		}
+
+		writer := &gzipResponseWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		defer func() {
+			c.Writer = writer.ResponseWriter
+			writer.flush(minBytes)
+		}()
+
+		c.Next()
+	}
+}
+
+// flush writes the buffered body to the underlying writer, compressed when it is large enough
+func (w *gzipResponseWriter) flush(minBytes int) {
+	if w.body.Len() == 0 {
+		return
+	}
+
+	header := w.Header()
+	if w.body.Len() < minBytes || header.Get("Content-Encoding") != "" {
+		w.ResponseWriter.Write(w.body.Bytes())
+		return
+	}
+
+	header.Set("Content-Encoding", "gzip")
+	header.Del("Content-Length")
+	gz := gzip.NewWriter(w.ResponseWriter)
+	gz.Write(w.body.Bytes())
+	gz.Close()
+}
+
+// acceptsGzip reports whether an Accept-Encoding header allows a gzip-encoded response
+func acceptsGzip(acceptEncoding string) bool {
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if !strings.EqualFold(strings.TrimSpace(coding), "gzip") {
+			continue
+		}
+		// An explicit zero quality value refuses the encoding
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			weight, err := strconv.ParseFloat(q, 64)
+			return err != nil || weight > 0
+		}
+		return true
+	}
+	return false
+}
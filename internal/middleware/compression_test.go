@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/gin-gonic/gin"
+)
+
+const testCompressionMinBytes = 1024
+
+func setupCompressionRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Gzip(testCompressionMinBytes))
+	router.GET("/clusters", func(c *gin.Context) {
+		clusters := []gin.H{}
+		for i := 0; i < 100; i++ {
+			clusters = append(clusters, gin.H{"name": fmt.Sprintf("cluster-%d", i), "phase": "Ready"})
+		}
+		c.JSON(http.StatusOK, gin.H{"clusters": clusters, "total": len(clusters)})
+	})
+	router.GET("/health", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": "healthy"})
+	})
+	router.DELETE("/clusters/:id", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+	return router
+}
+
+func doCompressionRequest(router *gin.Engine, method, path, acceptEncoding string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	if acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestGzip_CompressesLargeResponses(t *testing.T) {
+	router := setupCompressionRouter()
+
+	w := doCompressionRequest(router, http.MethodGet, "/clusters", "deflate, gzip;q=0.8")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Status should be preserved")
+	utils.AssertEqual(t, "gzip", w.Header().Get("Content-Encoding"), "Large response should be gzip-encoded")
+	utils.AssertEqual(t, "Accept-Encoding", w.Header().Get("Vary"), "Response should vary on Accept-Encoding")
+
+	reader, err := gzip.NewReader(w.Body)
+	utils.AssertError(t, err, false, "Body should be gzip data")
+	body, err := io.ReadAll(reader)
+	utils.AssertError(t, err, false, "Body should decompress")
+
+	var response struct {
+		Clusters []map[string]string `json:"clusters"`
+		Total    int                 `json:"total"`
+	}
+	err = json.Unmarshal(body, &response)
+	utils.AssertError(t, err, false, "Decompressed body should be the JSON response")
+	utils.AssertEqual(t, 100, response.Total, "Decompressed body should be complete")
+	utils.AssertEqual(t, 100, len(response.Clusters), "Every cluster should be returned")
+}
+
+func TestGzip_PassesThrough(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		acceptEncoding string
+		status         int
+	}{
+		{name: "client without Accept-Encoding", method: http.MethodGet, path: "/clusters", status: http.StatusOK},
+		{name: "client accepting other encodings", method: http.MethodGet, path: "/clusters", acceptEncoding: "br, deflate", status: http.StatusOK},
+		{name: "client refusing gzip", method: http.MethodGet, path: "/clusters", acceptEncoding: "gzip;q=0", status: http.StatusOK},
+		{name: "response under the threshold", method: http.MethodGet, path: "/health", acceptEncoding: "gzip", status: http.StatusOK},
+		{name: "response without a body", method: http.MethodDelete, path: "/clusters/abc", acceptEncoding: "gzip", status: http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := setupCompressionRouter()
+
+			w := doCompressionRequest(router, tt.method, tt.path, tt.acceptEncoding)
+			utils.AssertEqual(t, tt.status, w.Code, "Status should be preserved")
+			utils.AssertEqual(t, "", w.Header().Get("Content-Encoding"), "Response should not be encoded")
+			if tt.status == http.StatusOK {
+				utils.AssertTrue(t, json.Valid(w.Body.Bytes()), "Body should be plain JSON")
+			} else {
+				utils.AssertEqual(t, 0, w.Body.Len(), "Body should stay empty")
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/apahim/cls-backend/internal/config"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/gin-gonic/gin"
+)
+
+func setupCORSRouter(origins []string, credentials bool) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(CORS(config.ServerConfig{
+		CorsAllowedOrigins:   origins,
+		CorsAllowedMethods:   config.DefaultCorsAllowedMethods,
+		CorsAllowedHeaders:   config.DefaultCorsAllowedHeaders,
+		CorsAllowCredentials: credentials,
+	}))
+	router.GET("/clusters", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"clusters": []gin.H{}})
+	})
+	return router
+}
+
+func doCORSRequest(router *gin.Engine, method, origin string, preflight bool) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/clusters", nil)
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	if preflight {
+		req.Header.Set("Access-Control-Request-Method", http.MethodGet)
+		req.Header.Set("Access-Control-Request-Headers", "authorization")
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestCORS_AllowedOrigin(t *testing.T) {
+	router := setupCORSRouter([]string{"https://dashboard.example.com"}, true)
+
+	w := doCORSRequest(router, http.MethodGet, "https://dashboard.example.com", false)
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Request should reach the handler")
+	utils.AssertEqual(t, "https://dashboard.example.com", w.Header().Get("Access-Control-Allow-Origin"), "Allowed origin should be echoed")
+	utils.AssertEqual(t, "true", w.Header().Get("Access-Control-Allow-Credentials"), "Credentials should be allowed")
+	utils.AssertEqual(t, "Origin", w.Header().Get("Vary"), "Response should vary on Origin")
+	utils.AssertContains(t, w.Header().Get("Access-Control-Expose-Headers"), RequestIDHeader, "Request ID should be exposed")
+	utils.AssertContains(t, w.Header().Get("Access-Control-Expose-Headers"), "Idempotent-Replayed", "Idempotent-Replayed should be exposed")
+
+	w = doCORSRequest(router, http.MethodOptions, "https://dashboard.example.com", true)
+	utils.AssertEqual(t, http.StatusNoContent, w.Code, "Preflight should be answered")
+	utils.AssertEqual(t, "GET, POST, PUT, DELETE, OPTIONS", w.Header().Get("Access-Control-Allow-Methods"), "Preflight should list the allowed methods")
+	utils.AssertContains(t, w.Header().Get("Access-Control-Allow-Headers"), "Authorization", "Preflight should list the allowed headers")
+	utils.AssertContains(t, w.Header().Get("Access-Control-Allow-Headers"), "Idempotency-Key", "Preflight should allow idempotent cluster creation")
+}
+
+func TestCORS_AnyOrigin(t *testing.T) {
+	router := setupCORSRouter([]string{"*"}, false)
+
+	w := doCORSRequest(router, http.MethodGet, "https://anywhere.example.com", false)
+	utils.AssertEqual(t, "*", w.Header().Get("Access-Control-Allow-Origin"), "Wildcard should allow any origin")
+	utils.AssertEqual(t, "", w.Header().Get("Access-Control-Allow-Credentials"), "Credentials should not be allowed by default")
+}
+
+func TestCORS_RefusedOrigins(t *testing.T) {
+	tests := []struct {
+		name      string
+		origins   []string
+		origin    string
+		method    string
+		preflight bool
+		status    int
+	}{
+		{name: "disallowed origin", origins: []string{"https://dashboard.example.com"}, origin: "https://evil.example.com", method: http.MethodGet, status: http.StatusOK},
+		{name: "disallowed origin preflight", origins: []string{"https://dashboard.example.com"}, origin: "https://evil.example.com", method: http.MethodOptions, preflight: true, status: http.StatusForbidden},
+		{name: "no origins configured", origin: "https://dashboard.example.com", method: http.MethodGet, status: http.StatusOK},
+		{name: "no origins configured preflight", origin: "https://dashboard.example.com", method: http.MethodOptions, preflight: true, status: http.StatusNotFound},
+		{name: "same-origin request", origins: []string{"https://dashboard.example.com"}, method: http.MethodGet, status: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := setupCORSRouter(tt.origins, false)
+
+			w := doCORSRequest(router, tt.method, tt.origin, tt.preflight)
+			utils.AssertEqual(t, tt.status, w.Code, "Unexpected status")
+			utils.AssertEqual(t, "", w.Header().Get("Access-Control-Allow-Origin"), "No origin should be allowed")
+			utils.AssertEqual(t, "", w.Header().Get("Access-Control-Allow-Methods"), "No methods should be advertised")
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/config"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+// userLimiter is a token bucket for a single user along with when it was last used
+type userLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// rateLimiter keeps one token bucket per authenticated user
+type rateLimiter struct {
+	mu          sync.Mutex
+	limiters    map[string]*userLimiter
+	limit       rate.Limit
+	burst       int
+	allowlist   map[string]bool
+	idleTimeout time.Duration
+	lastSweep   time.Time
+	now         func() time.Time
+}
+
+func newRateLimiter(cfg config.RateLimitConfig) *rateLimiter {
+	allowlist := make(map[string]bool, len(cfg.Allowlist))
+	for _, email := range cfg.Allowlist {
+		allowlist[email] = true
+	}
+
+	return &rateLimiter{
+		limiters:    make(map[string]*userLimiter),
+		limit:       rate.Limit(cfg.RequestsPerSecond),
+		burst:       cfg.Burst,
+		allowlist:   allowlist,
+		idleTimeout: cfg.IdleTimeout,
+		lastSweep:   time.Now(),
+		now:         time.Now,
+	}
+}
+
+// reserve takes a token for the user, returning how long to wait before retrying
+// when the bucket is empty
+func (rl *rateLimiter) reserve(email string) (bool, time.Duration) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := rl.now()
+	rl.evictIdle(now)
+
+	entry, exists := rl.limiters[email]
+	if !exists {
+		entry = &userLimiter{limiter: rate.NewLimiter(rl.limit, rl.burst)}
+		rl.limiters[email] = entry
+	}
+	entry.lastSeen = now
+
+	reservation := entry.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return false, 0
+	}
+
+	delay := reservation.DelayFrom(now)
+	if delay > 0 {
+		// Rejected requests must not consume future tokens
+		reservation.CancelAt(now)
+		return false, delay
+	}
+	return true, 0
+}
+
+// evictIdle drops buckets for users not seen within the idle timeout. It runs at
+// most once per idle timeout so the map stays bounded without a background goroutine.
+func (rl *rateLimiter) evictIdle(now time.Time) {
+	if now.Sub(rl.lastSweep) < rl.idleTimeout {
+		return
+	}
+	rl.lastSweep = now
+
+	for email, entry := range rl.limiters {
+		if now.Sub(entry.lastSeen) >= rl.idleTimeout {
+			delete(rl.limiters, email)
+		}
+	}
+}
+
+// RateLimit middleware limits each authenticated user to a token bucket of
+// cfg.RequestsPerSecond with cfg.Burst. It must run after authentication so the
+// user context is available; requests without one are passed through. No user is
+// exempt unless listed in cfg.Allowlist. Controllers share one system identity, so a
+// fleet that outgrows a single bucket must add that identity to the allowlist.
+func RateLimit(cfg config.RateLimitConfig) gin.HandlerFunc {
+	limiter := newRateLimiter(cfg)
+
+	return func(c *gin.Context) {
+		userCtx, exists := GetUserContext(c)
+		if !exists || limiter.allowlist[userCtx.Email] {
+			c.Next()
+			return
+		}
+
+		allowed, retryAfter := limiter.reserve(userCtx.Email)
+		if !allowed {
+			// Retry-After is in whole seconds, rounded up so clients don't retry too early
+			retryAfterSeconds := int(math.Ceil(retryAfter.Seconds()))
+			if retryAfterSeconds < 1 {
+				retryAfterSeconds = 1
+			}
+
+			zap.L().Warn("Rate limit exceeded",
+				zap.String("user_email", userCtx.Email),
+				zap.String("path", c.Request.URL.Path),
+				zap.String("method", c.Request.Method),
+				zap.Int("retry_after_seconds", retryAfterSeconds),
+			)
+
+			c.Header("Retry-After", strconv.Itoa(retryAfterSeconds))
+			c.JSON(http.StatusTooManyRequests, gin.H{
+				"error": "Rate limit exceeded",
+				"code":  "RATE_LIMITED",
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/auth"
+	"github.com/apahim/cls-backend/internal/config"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/gin-gonic/gin"
+)
+
+func setupRateLimitRouter(cfg config.RateLimitConfig) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Set("user_context", auth.NewUserContext(c.GetHeader("X-User-Email")))
+		c.Next()
+	})
+	router.Use(RateLimit(cfg))
+	router.PUT("/clusters/:id/status", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return router
+}
+
+func doRateLimitedRequest(router *gin.Engine, userEmail string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPut, "/clusters/abc/status", nil)
+	req.Header.Set("X-User-Email", userEmail)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestRateLimit_ExceedingBurst(t *testing.T) {
+	router := setupRateLimitRouter(config.RateLimitConfig{
+		Enabled:           true,
+		RequestsPerSecond: 0.5,
+		Burst:             3,
+		IdleTimeout:       time.Minute,
+	})
+
+	for i := 0; i < 3; i++ {
+		w := doRateLimitedRequest(router, "user@example.com")
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Requests within the burst should pass", i)
+	}
+
+	w := doRateLimitedRequest(router, "user@example.com")
+	utils.AssertEqual(t, http.StatusTooManyRequests, w.Code, "Request past the burst should be limited")
+	utils.AssertEqual(t, "2", w.Header().Get("Retry-After"), "Retry-After should be the time until the next token")
+	utils.AssertContains(t, w.Body.String(), "RATE_LIMITED", "Response should carry the rate limit code")
+
+	// Buckets are per user
+	w = doRateLimitedRequest(router, "other@example.com")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "Other users should have their own bucket")
+}
+
+func TestRateLimit_Allowlist(t *testing.T) {
+	router := setupRateLimitRouter(config.RateLimitConfig{
+		Enabled:           true,
+		RequestsPerSecond: 1,
+		Burst:             1,
+		Allowlist:         []string{"dashboard@example.com"},
+		IdleTimeout:       time.Minute,
+	})
+
+	for i := 0; i < 5; i++ {
+		w := doRateLimitedRequest(router, "dashboard@example.com")
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Allowlisted users should not be limited", i)
+	}
+
+	w := doRateLimitedRequest(router, "user@example.com")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "First request should pass")
+	w = doRateLimitedRequest(router, "user@example.com")
+	utils.AssertEqual(t, http.StatusTooManyRequests, w.Code, "Users not on the allowlist should be limited")
+}
+
+func TestRateLimit_Controllers(t *testing.T) {
+	cfg := config.RateLimitConfig{
+		Enabled:           true,
+		RequestsPerSecond: 1,
+		Burst:             1,
+		IdleTimeout:       time.Minute,
+	}
+
+	// Controllers are limited like any other user by default
+	router := setupRateLimitRouter(cfg)
+	w := doRateLimitedRequest(router, "controller@system.local")
+	utils.AssertEqual(t, http.StatusOK, w.Code, "First controller request should pass")
+	w = doRateLimitedRequest(router, "controller@system.local")
+	utils.AssertEqual(t, http.StatusTooManyRequests, w.Code, "Controllers should be limited by default")
+
+	// Allowlisting the shared system identity exempts the fleet
+	cfg.Allowlist = []string{"controller@system.local"}
+	router = setupRateLimitRouter(cfg)
+	for i := 0; i < 50; i++ {
+		w := doRateLimitedRequest(router, "controller@system.local")
+		utils.AssertEqual(t, http.StatusOK, w.Code, "Allowlisted controllers should not be limited", i)
+	}
+}
+
+func TestRateLimiter_RefillAndEviction(t *testing.T) {
+	limiter := newRateLimiter(config.RateLimitConfig{
+		RequestsPerSecond: 1,
+		Burst:             1,
+		IdleTimeout:       time.Minute,
+	})
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+	limiter.lastSweep = now
+
+	allowed, _ := limiter.reserve("user@example.com")
+	utils.AssertTrue(t, allowed, "First request should pass")
+
+	allowed, retryAfter := limiter.reserve("user@example.com")
+	utils.AssertFalse(t, allowed, "Second request should be limited")
+	utils.AssertEqual(t, time.Second, retryAfter, "Retry should be allowed once a token refills")
+
+	// Rejected requests do not push back the next token
+	now = now.Add(time.Second)
+	allowed, _ = limiter.reserve("user@example.com")
+	utils.AssertTrue(t, allowed, "Request should pass after the bucket refills")
+
+	allowed, _ = limiter.reserve("other@example.com")
+	utils.AssertTrue(t, allowed, "Other user should pass")
+	utils.AssertEqual(t, 2, len(limiter.limiters), "Both users should have a bucket")
+
+	// Idle buckets are dropped on the next sweep
+	now = now.Add(2 * time.Minute)
+	allowed, _ = limiter.reserve("user@example.com")
+	utils.AssertTrue(t, allowed, "Returning user should get a fresh bucket")
+	utils.AssertEqual(t, 1, len(limiter.limiters), "Idle bucket should be evicted")
+}
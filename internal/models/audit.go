@@ -0,0 +1,174 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Audit actions
+const (
+	AuditActionCreate = "create"
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+
+	AuditActionClearErrors = "clear_errors" // Controller errors cleared by an operator
+)
+
+// Audited resource types
+const (
+	AuditResourceCluster  = "cluster"
+	AuditResourceNodePool = "nodepool"
+)
+
+// Spec change operations
+const (
+	SpecChangeAdded   = "added"
+	SpecChangeRemoved = "removed"
+	SpecChangeChanged = "changed"
+)
+
+// AuditChange holds the before and after values of a changed spec field
+type AuditChange struct {
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// AuditEntry is an immutable record of a write operation on a cluster or nodepool
+type AuditEntry struct {
+	ID           uuid.UUID              `json:"id" db:"id"`
+	ActorEmail   string                 `json:"actor_email" db:"actor_email"`
+	Action       string                 `json:"action" db:"action"`
+	ResourceType string                 `json:"resource_type" db:"resource_type"`
+	ResourceID   uuid.UUID              `json:"resource_id" db:"resource_id"`
+	Diff         map[string]AuditChange `json:"diff" db:"diff"` // Changed spec field paths, e.g. "platform.gcp.region"
+	Reason       string                 `json:"reason,omitempty" db:"reason"`
+	CreatedAt    time.Time              `json:"created_at" db:"created_at"`
+}
+
+// NewAuditEntry builds an audit entry with the diff between the before and after specs.
+// before is nil for creates and after is nil for deletes.
+func NewAuditEntry(actorEmail, action, resourceType string, resourceID uuid.UUID, before, after interface{}) (*AuditEntry, error) {
+	diff, err := SpecDiff(before, after)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuditEntry{
+		ActorEmail:   actorEmail,
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Diff:         diff,
+	}, nil
+}
+
+// SpecDiff compares the JSON form of two specs and returns the changed fields keyed by their
+// dotted path. Objects present on both sides are compared field by field; arrays, and fields
+// present on only one side, are recorded whole. A nil spec compares as empty.
+func SpecDiff(before, after interface{}) (map[string]AuditChange, error) {
+	beforeValue, err := toJSONValue(before)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode spec before change: %w", err)
+	}
+	afterValue, err := toJSONValue(after)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode spec after change: %w", err)
+	}
+
+	diff := map[string]AuditChange{}
+	diffJSONValues("", beforeValue, afterValue, diff)
+	return diff, nil
+}
+
+// SpecChange is a single field-level difference between two specs
+type SpecChange struct {
+	Path      string      `json:"path"`
+	Operation string      `json:"operation"` // added, removed or changed
+	Old       interface{} `json:"old,omitempty"`
+	New       interface{} `json:"new,omitempty"`
+}
+
+// SpecChanges returns the differences between two specs as a list sorted by path, classifying
+// each changed field by whether it was added, removed or changed. It compares the same way as
+// SpecDiff.
+func SpecChanges(before, after interface{}) ([]SpecChange, error) {
+	diff, err := SpecDiff(before, after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]SpecChange, 0, len(diff))
+	for path, change := range diff {
+		operation := SpecChangeChanged
+		switch {
+		case change.Before == nil:
+			operation = SpecChangeAdded
+		case change.After == nil:
+			operation = SpecChangeRemoved
+		}
+		changes = append(changes, SpecChange{
+			Path:      path,
+			Operation: operation,
+			Old:       change.Before,
+			New:       change.After,
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes, nil
+}
+
+// toJSONValue round-trips v through JSON so specs compare by their serialized fields
+func toJSONValue(v interface{}) (interface{}, error) {
+	if v == nil || (reflect.ValueOf(v).Kind() == reflect.Ptr && reflect.ValueOf(v).IsNil()) {
+		return map[string]interface{}{}, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// diffJSONValues records every leaf under path whose value differs between before and after
+func diffJSONValues(path string, before, after interface{}, diff map[string]AuditChange) {
+	beforeMap, beforeIsMap := before.(map[string]interface{})
+	afterMap, afterIsMap := after.(map[string]interface{})
+	if !beforeIsMap || !afterIsMap {
+		if !reflect.DeepEqual(before, after) {
+			diff[path] = AuditChange{Before: before, After: after}
+		}
+		return
+	}
+
+	// Fields present on only one side are recorded whole, with nil for the missing side
+	for key, value := range beforeMap {
+		diffJSONValues(joinAuditPath(path, key), value, afterMap[key], diff)
+	}
+	for key, value := range afterMap {
+		if _, ok := beforeMap[key]; !ok {
+			diffJSONValues(joinAuditPath(path, key), nil, value, diff)
+		}
+	}
+}
+
+// joinAuditPath appends a field name to a dotted diff path
+func joinAuditPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
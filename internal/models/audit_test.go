@@ -0,0 +1,86 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/apahim/cls-backend/internal/utils"
+)
+
+func TestSpecDiff(t *testing.T) {
+	before := ClusterSpec{
+		InfraID:  "infra-1",
+		Platform: PlatformSpec{Type: "GCP", GCP: &GCPSpec{ProjectID: "project-1", Region: "us-central1"}},
+	}
+	after := before
+	after.Platform.GCP = &GCPSpec{ProjectID: "project-1", Region: "us-east1"}
+
+	diff, err := SpecDiff(before, after)
+	utils.AssertError(t, err, false, "Should diff specs")
+	utils.AssertEqual(t, 1, len(diff), "Only the changed field should be in the diff")
+	utils.AssertEqual(t, "us-central1", diff["platform.gcp.region"].Before, "Diff should keep the old value")
+	utils.AssertEqual(t, "us-east1", diff["platform.gcp.region"].After, "Diff should keep the new value")
+
+	// Unchanged specs have an empty diff
+	diff, err = SpecDiff(before, before)
+	utils.AssertError(t, err, false, "Should diff specs")
+	utils.AssertEqual(t, 0, len(diff), "Unchanged spec should have an empty diff")
+
+	// Creates diff against an empty spec, so every top-level field is recorded whole
+	diff, err = SpecDiff(nil, before)
+	utils.AssertError(t, err, false, "Should diff against an empty spec")
+	utils.AssertEqual(t, "infra-1", diff["infraID"].After, "Create should record new fields")
+	utils.AssertNil(t, diff["infraID"].Before, "Create should have no old values")
+	utils.AssertNotNil(t, diff["platform"].After, "Create should record new objects whole")
+
+	// Deletes diff against an empty spec the other way round
+	diff, err = SpecDiff(&before, nil)
+	utils.AssertError(t, err, false, "Should diff against an empty spec")
+	utils.AssertEqual(t, "infra-1", diff["infraID"].Before, "Delete should record old fields")
+	utils.AssertNil(t, diff["infraID"].After, "Delete should have no new values")
+}
+
+func TestSpecChanges(t *testing.T) {
+	before := ClusterSpec{
+		Platform: PlatformSpec{Type: "GCP"},
+		Release:  ReleaseSpec{Image: "quay.io/openshift-release-dev/ocp-release:4.16.0-x86_64", Version: "4.16.0"},
+		Networking: NetworkingSpec{
+			ClusterNetwork: []NetworkEntry{{CIDR: "10.128.0.0/14", HostPrefix: 23}},
+			ServiceNetwork: []string{"172.30.0.0/16"},
+			PodCIDR:        "10.128.0.0/14",
+		},
+	}
+	after := before
+	after.Release.Image = "quay.io/openshift-release-dev/ocp-release:4.17.0-x86_64"
+	after.Networking = NetworkingSpec{
+		ClusterNetwork: []NetworkEntry{{CIDR: "10.132.0.0/14", HostPrefix: 23}},
+		ServiceNetwork: []string{"172.30.0.0/16"},
+		ServiceCIDR:    "172.30.0.0/16",
+	}
+
+	changes, err := SpecChanges(before, after)
+	utils.AssertError(t, err, false, "Should diff specs")
+
+	want := []SpecChange{
+		{Path: "networking.clusterNetwork", Operation: SpecChangeChanged},
+		{Path: "networking.podCIDR", Operation: SpecChangeRemoved, Old: "10.128.0.0/14"},
+		{Path: "networking.serviceCIDR", Operation: SpecChangeAdded, New: "172.30.0.0/16"},
+		{Path: "release.image", Operation: SpecChangeChanged, Old: before.Release.Image, New: after.Release.Image},
+	}
+	utils.AssertEqual(t, len(want), len(changes), "Only the changed fields should be listed")
+	for i, change := range changes {
+		utils.AssertEqual(t, want[i].Path, change.Path, "Changes should be sorted by path", i)
+		utils.AssertEqual(t, want[i].Operation, change.Operation, "Change should be classified", change.Path)
+		if want[i].Path == "networking.clusterNetwork" {
+			// Arrays are compared whole
+			utils.AssertNotNil(t, change.Old, "Changed array should keep its old value")
+			utils.AssertNotNil(t, change.New, "Changed array should keep its new value")
+			continue
+		}
+		utils.AssertEqual(t, want[i].Old, change.Old, "Change should keep the old value", change.Path)
+		utils.AssertEqual(t, want[i].New, change.New, "Change should keep the new value", change.Path)
+	}
+
+	changes, err = SpecChanges(before, before)
+	utils.AssertError(t, err, false, "Should diff specs")
+	utils.AssertEqual(t, 0, len(changes), "Unchanged spec should have no changes")
+}
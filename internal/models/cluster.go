@@ -3,10 +3,14 @@ package models
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"regexp"
+	"strings"
 	"time"
 
+	"github.com/apahim/cls-backend/internal/utils"
 	"github.com/google/uuid"
 )
 
@@ -17,6 +21,18 @@ const (
 	// GCPInfraIDPattern is the regex pattern for valid GCP resource names.
 	// Must start with a lowercase letter, followed by lowercase letters, digits, or hyphens.
 	GCPInfraIDPattern = `^[a-z][-a-z0-9]*$`
+
+	// MaxResourceNameLength is the maximum length of a cluster or nodepool name, the RFC 1123
+	// label limit of the Kubernetes resources controllers name after them.
+	MaxResourceNameLength = 63
+
+	// ResourceNamePattern is the RFC 1123 label pattern cluster and nodepool names must match:
+	// lowercase letters, digits and hyphens, starting and ending with a letter or digit.
+	ResourceNamePattern = `^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+
+	// GCPServiceAccountEmailPattern matches user-managed GCP service account emails,
+	// e.g. nodepool@my-project.iam.gserviceaccount.com.
+	GCPServiceAccountEmailPattern = `^[a-z][-a-z0-9]*[a-z0-9]@[a-z][-a-z0-9]*[a-z0-9]\.iam\.gserviceaccount\.com$`
 )
 
 // Valid channel groups for Cincinnati version resolution.
@@ -29,6 +45,34 @@ var validChannelGroups = map[string]bool{
 
 var gcpInfraIDRegex = regexp.MustCompile(GCPInfraIDPattern)
 
+var resourceNameRegex = regexp.MustCompile(ResourceNamePattern)
+
+var gcpServiceAccountEmailRegex = regexp.MustCompile(GCPServiceAccountEmailPattern)
+
+// endpointAccessRule describes what a GCP endpoint access mode needs from the rest of
+// the spec to produce a working cluster
+type endpointAccessRule struct {
+	publicZone  bool // dns.publicZone must be set
+	privateZone bool // dns.privateZone must be set
+	subnet      bool // platform.gcp.network and subnet must be set
+}
+
+// endpointAccessRules lists the supported platform.gcp.endpointAccess values and their
+// requirements. An empty endpointAccess is not checked.
+//
+//	endpointAccess    publicZone  privateZone  network/subnet
+//	Public            required    -            -
+//	PublicAndPrivate  required    required     required
+//	Private           -           required     required
+//
+// Public API endpoints are only resolvable through the public zone, private endpoints only
+// through the private zone, and private endpoints are published in the cluster subnet.
+var endpointAccessRules = map[string]endpointAccessRule{
+	"Public":           {publicZone: true},
+	"PublicAndPrivate": {publicZone: true, privateZone: true, subnet: true},
+	"Private":          {privateZone: true, subnet: true},
+}
+
 // Status represents the status of a resource
 type Status string
 
@@ -52,6 +96,57 @@ const (
 	HealthUnknown   Health = "Unknown"
 )
 
+// Statuses lists the Status values resources can be filtered by
+var Statuses = []string{
+	string(StatusPending), string(StatusReady), string(StatusError), string(StatusDeleting), string(StatusUnknown),
+}
+
+// Healths lists the Health values resources can be filtered by
+var Healths = []string{
+	string(HealthHealthy), string(HealthDegraded), string(HealthUnhealthy), string(HealthUnknown),
+}
+
+// ClusterPhases lists the aggregated status phases clusters can be filtered by
+var ClusterPhases = []string{"Pending", "Progressing", "Ready", "Failed", string(HealthDegraded)}
+
+// IsValidStatus reports whether status is one of Statuses
+func IsValidStatus(status string) bool {
+	return isOneOf(status, Statuses)
+}
+
+// IsValidHealth reports whether health is one of Healths
+func IsValidHealth(health string) bool {
+	return isOneOf(health, Healths)
+}
+
+// IsValidClusterPhase reports whether phase is one of ClusterPhases
+func IsValidClusterPhase(phase string) bool {
+	return isOneOf(phase, ClusterPhases)
+}
+
+// SplitFilterValues splits a comma-separated filter such as "Progressing,Failed" into its
+// distinct values, ignoring surrounding whitespace and empty entries
+func SplitFilterValues(raw string) []string {
+	var values []string
+	for _, value := range strings.Split(raw, ",") {
+		value = strings.TrimSpace(value)
+		if value != "" && !isOneOf(value, values) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// isOneOf reports whether value is in valid
+func isOneOf(value string, valid []string) bool {
+	for _, v := range valid {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
 // Cluster represents a cluster in the database
 type Cluster struct {
 	ID              uuid.UUID          `json:"id" db:"id"`
@@ -68,6 +163,21 @@ type Cluster struct {
 
 	// Status management field
 	StatusDirty bool `json:"-" db:"status_dirty"`
+
+	// StatusStale is set when the status could not be recalculated, so Status is the last
+	// cached one (or empty) and its phase should not be trusted; StatusError says so
+	StatusStale bool   `json:"status_stale" db:"-"`
+	StatusError string `json:"status_error,omitempty" db:"-"`
+}
+
+// StatusRecalculationFailed is the StatusError of a stale status. The underlying error is
+// only logged, since it may expose database internals to API clients.
+const StatusRecalculationFailed = "status recalculation failed"
+
+// MarkStatusStale flags the cluster's status as unreliable after a failed recalculation
+func (c *Cluster) MarkStatusStale() {
+	c.StatusStale = true
+	c.StatusError = StatusRecalculationFailed
 }
 
 // ClusterWithObservedGeneration extends Cluster with observed generation for API responses
@@ -76,6 +186,12 @@ type ClusterWithObservedGeneration struct {
 	ObservedGeneration *int64 `json:"observed_generation"`
 }
 
+// ClusterWithReconciliation extends Cluster with its reconciliation timing for API responses
+type ClusterWithReconciliation struct {
+	Cluster
+	ReconciliationTiming
+}
+
 // ClusterSpec represents the cluster specification
 type ClusterSpec struct {
 	InfraID                  string         `json:"infraID"`
@@ -85,6 +201,7 @@ type ClusterSpec struct {
 	DNS                      DNSSpec        `json:"dns"`
 	ServiceAccountSigningKey string         `json:"serviceAccountSigningKey,omitempty"` // Base64-encoded PEM private key
 	IssuerURL                string         `json:"issuerURL,omitempty"`                // OIDC issuer URL
+	ExpectNoControllers      bool           `json:"expectNoControllers,omitempty"`      // Ready without any controller reports
 }
 
 // PlatformSpec represents platform-specific configuration
@@ -156,7 +273,9 @@ type ClusterStatusInfo struct {
 	Phase              string      `json:"phase,omitempty"`   // Current lifecycle phase
 	Message            string      `json:"message,omitempty"` // Human-readable status message
 	Reason             string      `json:"reason,omitempty"`  // Machine-readable reason
+	ProgressPercent    int         `json:"progressPercent"`   // Weighted percentage of ready controllers
 	LastUpdateTime     time.Time   `json:"lastUpdateTime"`
+	AggregatorVersion  int         `json:"aggregatorVersion,omitempty"` // Aggregation logic version that computed this status
 }
 
 // ClusterStatus represents the overall cluster status (DEPRECATED - use ClusterStatusInfo)
@@ -186,6 +305,7 @@ type Condition struct {
 	Reason             string    `json:"reason"`
 	Message            string    `json:"message"`
 	Severity           string    `json:"severity,omitempty"` // Info, Warning, Error, Critical
+	ObservedGeneration int64     `json:"observedGeneration,omitempty"`
 }
 
 // ErrorType represents the type of error
@@ -210,6 +330,98 @@ type ErrorInfo struct {
 	Details        map[string]string `json:"details,omitempty"`
 	RetryAfter     *time.Duration    `json:"retryAfter,omitempty"`
 	Timestamp      time.Time         `json:"timestamp"`
+
+	// Set when identical errors from several controllers are collapsed into one entry
+	Occurrences         int      `json:"occurrences,omitempty"`
+	AffectedControllers []string `json:"affectedControllers,omitempty"`
+}
+
+// DedupeErrors drops repeats of an error reported by the same controller, such as one
+// nodepool controller failing the same way on several nodepools. The remaining entry keeps
+// the latest timestamp, and entries keep the order in which each error was first seen.
+func DedupeErrors(errs []ErrorInfo) []ErrorInfo {
+	type errorKey struct {
+		controllerName string
+		errorType      ErrorType
+		errorCode      string
+		message        string
+	}
+
+	var deduped []ErrorInfo
+	index := make(map[errorKey]int)
+	for _, e := range errs {
+		key := errorKey{controllerName: e.ControllerName, errorType: e.ErrorType, errorCode: e.ErrorCode, message: e.Message}
+		i, seen := index[key]
+		if !seen {
+			index[key] = len(deduped)
+			deduped = append(deduped, e)
+			continue
+		}
+		if e.Timestamp.After(deduped[i].Timestamp) {
+			deduped[i].Timestamp = e.Timestamp
+		}
+	}
+
+	return deduped
+}
+
+// CollapseErrors merges errors with the same type, code and message into a
+// single entry carrying the occurrence count and the affected controllers.
+// Entries keep the order in which each error was first seen.
+func CollapseErrors(errs []ErrorInfo) []ErrorInfo {
+	type errorKey struct {
+		errorType ErrorType
+		errorCode string
+		message   string
+	}
+
+	var collapsed []ErrorInfo
+	index := make(map[errorKey]int)
+	for _, e := range errs {
+		key := errorKey{errorType: e.ErrorType, errorCode: e.ErrorCode, message: e.Message}
+		i, seen := index[key]
+		if !seen {
+			entry := e
+			entry.Occurrences = 1
+			entry.AffectedControllers = nil
+			if e.ControllerName != "" {
+				entry.AffectedControllers = []string{e.ControllerName}
+			}
+			index[key] = len(collapsed)
+			collapsed = append(collapsed, entry)
+			continue
+		}
+
+		entry := &collapsed[i]
+		entry.Occurrences++
+		if e.Timestamp.After(entry.Timestamp) {
+			entry.Timestamp = e.Timestamp
+		}
+		if e.ControllerName != "" && !containsString(entry.AffectedControllers, e.ControllerName) {
+			entry.AffectedControllers = append(entry.AffectedControllers, e.ControllerName)
+		}
+	}
+
+	// Single errors are returned as reported
+	for i := range collapsed {
+		if collapsed[i].Occurrences == 1 {
+			collapsed[i].Occurrences = 0
+			collapsed[i].AffectedControllers = nil
+		} else {
+			collapsed[i].ControllerName = ""
+		}
+	}
+
+	return collapsed
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
 }
 
 // ControllerStatus represents the status of a controller for a cluster
@@ -307,6 +519,38 @@ type ClusterCreateRequest struct {
 	Spec            ClusterSpec `json:"spec" binding:"required"`
 }
 
+// ValidateName trims surrounding whitespace from the cluster name and checks it is a valid
+// RFC 1123 label
+func (r *ClusterCreateRequest) ValidateName() error {
+	return validateResourceName("cluster", &r.Name)
+}
+
+// validateResourceName trims surrounding whitespace from the name of a kind of resource and
+// checks it is a valid RFC 1123 label, so downstream Kubernetes resources can be named after it
+func validateResourceName(kind string, name *string) error {
+	*name = strings.TrimSpace(*name)
+	if *name == "" {
+		return specFieldErrorf("name", "%s name is required", kind)
+	}
+
+	if len(*name) > MaxResourceNameLength {
+		return specFieldErrorf("name",
+			"%s name '%s' is invalid: must be %d characters or less (got %d)",
+			kind, *name, MaxResourceNameLength, len(*name),
+		)
+	}
+
+	if !resourceNameRegex.MatchString(*name) {
+		return specFieldErrorf("name",
+			"%s name '%s' is invalid: must contain only lowercase letters, digits, or hyphens "+
+				"and start and end with a letter or digit (pattern: %s)",
+			kind, *name, ResourceNamePattern,
+		)
+	}
+
+	return nil
+}
+
 // ValidateGCPInfraID validates that the infrastructure ID meets GCP resource naming
 // constraints when the platform type is GCP. Non-GCP platforms are not affected.
 func (r *ClusterCreateRequest) ValidateGCPInfraID() error {
@@ -317,14 +561,14 @@ func (r *ClusterCreateRequest) ValidateGCPInfraID() error {
 	infraID := r.Spec.InfraID
 
 	if len(infraID) > MaxInfraIDLength {
-		return fmt.Errorf(
+		return specFieldErrorf("infraID",
 			"infrastructure ID '%s' is invalid: must be %d characters or less (got %d)",
 			infraID, MaxInfraIDLength, len(infraID),
 		)
 	}
 
 	if !gcpInfraIDRegex.MatchString(infraID) {
-		return fmt.Errorf(
+		return specFieldErrorf("infraID",
 			"infrastructure ID '%s' is invalid: must start with a lowercase letter "+
 				"and contain only lowercase letters, digits, or hyphens (pattern: %s)",
 			infraID, GCPInfraIDPattern,
@@ -336,33 +580,271 @@ func (r *ClusterCreateRequest) ValidateGCPInfraID() error {
 
 // ValidateRelease validates the release spec in the cluster create request.
 // Both version and channelGroup are required (either provided by the user or
-// applied as defaults by the service layer).
+// applied as defaults by the service layer); every failing field is reported.
 func (r *ClusterCreateRequest) ValidateRelease() error {
 	release := &r.Spec.Release
 
+	var errs utils.ValidationErrors
 	if release.Version == "" {
-		return fmt.Errorf("release.version is required")
+		errs.Add("release.version", "release.version is required", nil)
 	}
 
 	if release.ChannelGroup == "" {
-		return fmt.Errorf("release.channelGroup is required")
-	}
-
-	if !validChannelGroups[release.ChannelGroup] {
-		return fmt.Errorf(
+		errs.Add("release.channelGroup", "release.channelGroup is required", nil)
+	} else if !validChannelGroups[release.ChannelGroup] {
+		errs.Add("release.channelGroup", fmt.Sprintf(
 			"invalid channelGroup '%s': must be one of stable, fast, candidate, eus",
 			release.ChannelGroup,
+		), nil)
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+// specFieldErrorf creates a ValidationErrors holding a single failure of field with a
+// formatted message
+func specFieldErrorf(field, format string, args ...interface{}) error {
+	return utils.NewValidationErrors(utils.ValidationDetails{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// Validate validates the cluster spec and returns utils.ValidationErrors listing the first
+// failure of each check:
+//   - a release image, when given, must be a well-formed pullspec
+//   - every networking CIDR must parse, clusterNetwork hostPrefix values must not be
+//     shorter than their CIDR prefix, and cluster (pod) networks must not overlap service
+//     networks
+//   - the GCP endpoint access mode must be compatible with the DNS zones and network, see
+//     endpointAccessRules
+//   - a GCP workload identity configuration must be complete
+func (s *ClusterSpec) Validate() error {
+	checks := []func() error{
+		s.Release.validateImage,
+		s.validateNetworking,
+		s.validateEndpointAccess,
+		s.validateWorkloadIdentity,
+	}
+
+	var errs utils.ValidationErrors
+	for _, check := range checks {
+		err := check()
+		if err == nil {
+			continue
+		}
+		var fieldErrs utils.ValidationErrors
+		if errors.As(err, &fieldErrs) {
+			errs = append(errs, fieldErrs...)
+		} else {
+			errs.Add("", err.Error(), nil)
+		}
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+// validateNetworking checks the cluster and service network CIDRs
+func (s *ClusterSpec) validateNetworking() error {
+	networking := &s.Networking
+
+	var clusterNets, serviceNets []namedNetwork
+
+	for i, entry := range networking.ClusterNetwork {
+		field := fmt.Sprintf("networking.clusterNetwork[%d].cidr", i)
+		ipNet, err := parseNetworkCIDR(field, entry.CIDR)
+		if err != nil {
+			return err
+		}
+
+		if entry.HostPrefix != 0 {
+			prefixLen, bits := ipNet.Mask.Size()
+			if entry.HostPrefix < prefixLen || entry.HostPrefix > bits {
+				return specFieldErrorf(fmt.Sprintf("networking.clusterNetwork[%d].hostPrefix", i),
+					"networking.clusterNetwork[%d].hostPrefix %d is invalid: must be between the CIDR prefix length %d and %d",
+					i, entry.HostPrefix, prefixLen, bits,
+				)
+			}
+		}
+		clusterNets = append(clusterNets, namedNetwork{field: field, ipNet: ipNet})
+	}
+
+	if networking.PodCIDR != "" {
+		ipNet, err := parseNetworkCIDR("networking.podCIDR", networking.PodCIDR)
+		if err != nil {
+			return err
+		}
+		clusterNets = append(clusterNets, namedNetwork{field: "networking.podCIDR", ipNet: ipNet})
+	}
+
+	for i, cidr := range networking.ServiceNetwork {
+		field := fmt.Sprintf("networking.serviceNetwork[%d]", i)
+		ipNet, err := parseNetworkCIDR(field, cidr)
+		if err != nil {
+			return err
+		}
+		serviceNets = append(serviceNets, namedNetwork{field: field, ipNet: ipNet})
+	}
+
+	if networking.ServiceCIDR != "" {
+		ipNet, err := parseNetworkCIDR("networking.serviceCIDR", networking.ServiceCIDR)
+		if err != nil {
+			return err
+		}
+		serviceNets = append(serviceNets, namedNetwork{field: "networking.serviceCIDR", ipNet: ipNet})
+	}
+
+	for _, clusterNet := range clusterNets {
+		for _, serviceNet := range serviceNets {
+			if networksOverlap(clusterNet.ipNet, serviceNet.ipNet) {
+				return specFieldErrorf(clusterNet.field,
+					"%s '%s' overlaps %s '%s'",
+					clusterNet.field, clusterNet.ipNet, serviceNet.field, serviceNet.ipNet,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateWorkloadIdentity checks that a GCP workload identity configuration names its pool
+// and provider and that its service accounts are GCP service account emails. Controllers
+// can't act on an incomplete configuration, so it is rejected up front.
+func (s *ClusterSpec) validateWorkloadIdentity() error {
+	if s.Platform.GCP == nil || s.Platform.GCP.WorkloadIdentity == nil {
+		return nil
+	}
+	wif := s.Platform.GCP.WorkloadIdentity
+
+	required := []struct {
+		field string
+		value string
+	}{
+		{"platform.gcp.workloadIdentity.projectNumber", wif.ProjectNumber},
+		{"platform.gcp.workloadIdentity.poolID", wif.PoolID},
+		{"platform.gcp.workloadIdentity.providerID", wif.ProviderID},
+	}
+	for _, r := range required {
+		if strings.TrimSpace(r.value) == "" {
+			return specFieldErrorf(r.field, "%s is required", r.field)
+		}
+	}
+
+	refs := wif.ServiceAccountsRef
+	if refs == nil {
+		return nil
+	}
+
+	emails := []struct {
+		field    string
+		value    string
+		required bool
+	}{
+		{"nodePoolEmail", refs.NodePoolEmail, true},
+		{"controlPlaneEmail", refs.ControlPlaneEmail, true},
+		{"cloudControllerEmail", refs.CloudControllerEmail, true},
+		{"storageEmail", refs.StorageEmail, false},
+		{"imageRegistryEmail", refs.ImageRegistryEmail, false},
+		{"networkEmail", refs.NetworkEmail, false},
+	}
+	for _, e := range emails {
+		field := "platform.gcp.workloadIdentity.serviceAccountsRef." + e.field
+		if e.value == "" {
+			if e.required {
+				return specFieldErrorf(field, "%s is required", field)
+			}
+			continue
+		}
+		if !gcpServiceAccountEmailRegex.MatchString(e.value) {
+			return specFieldErrorf(field,
+				"%s '%s' is invalid: must be a service account email ending in .iam.gserviceaccount.com",
+				field, e.value,
+			)
+		}
+	}
+
+	return nil
+}
+
+// validateEndpointAccess checks the GCP endpoint access mode against endpointAccessRules
+func (s *ClusterSpec) validateEndpointAccess() error {
+	gcp := s.Platform.GCP
+	if gcp == nil || gcp.EndpointAccess == "" {
+		return nil
+	}
+
+	rule, ok := endpointAccessRules[gcp.EndpointAccess]
+	if !ok {
+		return specFieldErrorf("platform.gcp.endpointAccess",
+			"invalid platform.gcp.endpointAccess '%s': must be one of Public, PublicAndPrivate, Private",
+			gcp.EndpointAccess,
+		)
+	}
+
+	if rule.publicZone && s.DNS.PublicZone == "" {
+		return specFieldErrorf("platform.gcp.endpointAccess",
+			"platform.gcp.endpointAccess '%s' requires dns.publicZone: the API endpoint is resolved through the public zone",
+			gcp.EndpointAccess,
+		)
+	}
+
+	if rule.privateZone && s.DNS.PrivateZone == "" {
+		return specFieldErrorf("platform.gcp.endpointAccess",
+			"platform.gcp.endpointAccess '%s' requires dns.privateZone: the private API endpoint is resolved through the private zone",
+			gcp.EndpointAccess,
+		)
+	}
+
+	if rule.subnet && (gcp.Network == "" || gcp.Subnet == "") {
+		return specFieldErrorf("platform.gcp.endpointAccess",
+			"platform.gcp.endpointAccess '%s' requires platform.gcp.network and platform.gcp.subnet: private endpoints are published in the cluster subnet",
+			gcp.EndpointAccess,
 		)
 	}
 
 	return nil
 }
 
+// namedNetwork pairs a parsed CIDR with the spec field it came from
+type namedNetwork struct {
+	field string
+	ipNet *net.IPNet
+}
+
+// parseNetworkCIDR parses a CIDR, naming the spec field in the error
+func parseNetworkCIDR(field, cidr string) (*net.IPNet, error) {
+	if cidr == "" {
+		return nil, specFieldErrorf(field, "%s is required", field)
+	}
+
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, specFieldErrorf(field, "%s '%s' is not a valid CIDR", field, cidr)
+	}
+	return ipNet, nil
+}
+
+// networksOverlap reports whether two networks share any addresses
+func networksOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
 // ClusterUpdateRequest represents a request to update a cluster
 type ClusterUpdateRequest struct {
 	Spec ClusterSpec `json:"spec" binding:"required"`
 }
 
+// ClusterSpecDiff previews the changes an update to a cluster's spec would make
+type ClusterSpecDiff struct {
+	ClusterID  uuid.UUID    `json:"cluster_id"`
+	Generation int64        `json:"generation"` // Generation of the current spec the candidate was compared to
+	Changes    []SpecChange `json:"changes"`
+}
+
 // TableName returns the table name for the Cluster model
 func (Cluster) TableName() string {
 	return "clusters"
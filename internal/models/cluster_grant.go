@@ -0,0 +1,47 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ClusterGrantRoleViewer lets the grantee read the cluster and its status
+const ClusterGrantRoleViewer = "viewer"
+
+// ClusterGrant shares a cluster with a user other than the one who created it
+type ClusterGrant struct {
+	ClusterID    uuid.UUID `json:"cluster_id" db:"cluster_id"`
+	GranteeEmail string    `json:"grantee_email" db:"grantee_email"`
+	Role         string    `json:"role" db:"role"`
+	CreatedBy    string    `json:"created_by" db:"created_by"` // Owner who granted access
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
+
+// ClusterGrantRequest represents a request to share a cluster with another user
+type ClusterGrantRequest struct {
+	GranteeEmail string `json:"grantee_email" binding:"required"`
+	Role         string `json:"role,omitempty"` // Defaults to viewer
+}
+
+// Validate normalizes the request and checks the grantee and role. The owner cannot be a
+// grantee of their own cluster.
+func (r *ClusterGrantRequest) Validate(owner string) error {
+	r.GranteeEmail = strings.TrimSpace(r.GranteeEmail)
+	if r.Role == "" {
+		r.Role = ClusterGrantRoleViewer
+	}
+
+	if !strings.Contains(r.GranteeEmail, "@") {
+		return fmt.Errorf("grantee_email '%s' is not an email address", r.GranteeEmail)
+	}
+	if r.GranteeEmail == owner {
+		return fmt.Errorf("grantee_email '%s' already owns the cluster", r.GranteeEmail)
+	}
+	if r.Role != ClusterGrantRoleViewer {
+		return fmt.Errorf("role '%s' is not supported: must be %s", r.Role, ClusterGrantRoleViewer)
+	}
+	return nil
+}
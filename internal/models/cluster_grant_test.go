@@ -0,0 +1,53 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/apahim/cls-backend/internal/utils"
+)
+
+func TestClusterGrantRequestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  ClusterGrantRequest
+		wantErr  bool
+		wantRole string
+	}{
+		{
+			name:     "role defaults to viewer",
+			request:  ClusterGrantRequest{GranteeEmail: " grantee@example.com "},
+			wantRole: ClusterGrantRoleViewer,
+		},
+		{
+			name:     "explicit viewer role",
+			request:  ClusterGrantRequest{GranteeEmail: "grantee@example.com", Role: "viewer"},
+			wantRole: ClusterGrantRoleViewer,
+		},
+		{
+			name:    "grantee is not an email address",
+			request: ClusterGrantRequest{GranteeEmail: "grantee"},
+			wantErr: true,
+		},
+		{
+			name:    "grantee owns the cluster",
+			request: ClusterGrantRequest{GranteeEmail: "owner@example.com"},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported role",
+			request: ClusterGrantRequest{GranteeEmail: "grantee@example.com", Role: "admin"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate("owner@example.com")
+			utils.AssertError(t, err, tt.wantErr, "Validate()")
+			if !tt.wantErr {
+				utils.AssertEqual(t, "grantee@example.com", tt.request.GranteeEmail, "Grantee email should be trimmed")
+				utils.AssertEqual(t, tt.wantRole, tt.request.Role, "Role should be set")
+			}
+		})
+	}
+}
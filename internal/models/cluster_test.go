@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -204,6 +205,38 @@ func TestCondition(t *testing.T) {
 	utils.AssertEqual(t, now, condition.LastTransitionTime, "Condition last transition time")
 }
 
+func TestCondition_ObservedGenerationSerialization(t *testing.T) {
+	condition := Condition{
+		Type:               "Ready",
+		Status:             "True",
+		LastTransitionTime: time.Date(2025, 10, 17, 0, 0, 0, 0, time.UTC),
+		Reason:             "AllControllersReady",
+		ObservedGeneration: 3,
+	}
+
+	data, err := json.Marshal(condition)
+	utils.AssertError(t, err, false, "Should serialize condition")
+	utils.AssertContains(t, string(data), `"observedGeneration":3`, "Observed generation should serialize in camelCase")
+
+	var decoded Condition
+	err = json.Unmarshal(data, &decoded)
+	utils.AssertError(t, err, false, "Should deserialize condition")
+	utils.AssertEqual(t, int64(3), decoded.ObservedGeneration, "Observed generation should round-trip")
+
+	// Conditions reported before the field existed have no observed generation
+	data, err = json.Marshal(Condition{Type: "Ready", Status: "Unknown"})
+	utils.AssertError(t, err, false, "Should serialize condition")
+	utils.AssertFalse(t, strings.Contains(string(data), "observedGeneration"), "Unset observed generation should be omitted")
+
+	// Controller-reported conditions keep theirs through storage
+	stored, err := ConditionList{condition}.Value()
+	utils.AssertError(t, err, false, "Should encode condition list")
+	var scanned ConditionList
+	err = scanned.Scan(stored)
+	utils.AssertError(t, err, false, "Should scan condition list")
+	utils.AssertEqual(t, int64(3), scanned[0].ObservedGeneration, "Stored condition should keep its observed generation")
+}
+
 func TestErrorInfo(t *testing.T) {
 	retryAfter := 5 * time.Minute
 	errorInfo := ErrorInfo{
@@ -224,6 +257,79 @@ func TestErrorInfo(t *testing.T) {
 	utils.AssertEqual(t, retryAfter, *errorInfo.RetryAfter, "Retry after duration")
 }
 
+func TestCollapseErrors(t *testing.T) {
+	earlier := time.Now().Add(-time.Minute)
+	later := time.Now()
+	quota := func(controller string, ts time.Time) ErrorInfo {
+		return ErrorInfo{
+			ControllerName: controller,
+			ErrorType:      ErrorTypeTransient,
+			ErrorCode:      "QUOTA_EXCEEDED",
+			Message:        "GCP API quota exceeded",
+			Timestamp:      ts,
+		}
+	}
+
+	errs := []ErrorInfo{
+		quota("dns-controller", earlier),
+		{
+			ControllerName: "iam-controller",
+			ErrorType:      ErrorTypeConfiguration,
+			ErrorCode:      "PERMISSION_DENIED",
+			Message:        "Service account lacks permissions",
+			Timestamp:      earlier,
+		},
+		quota("network-controller", later),
+		quota("storage-controller", earlier),
+		quota("dns-controller", earlier),
+	}
+
+	collapsed := CollapseErrors(errs)
+	utils.AssertEqual(t, 2, len(collapsed), "Identical errors should collapse into one entry")
+
+	utils.AssertEqual(t, "QUOTA_EXCEEDED", collapsed[0].ErrorCode, "First seen error should come first")
+	utils.AssertEqual(t, 4, collapsed[0].Occurrences, "Occurrences should count every identical error")
+	utils.AssertEqual(t, "dns-controller,network-controller,storage-controller",
+		strings.Join(collapsed[0].AffectedControllers, ","), "Affected controllers should be listed once each")
+	utils.AssertEqual(t, "", collapsed[0].ControllerName, "Collapsed entry should not name a single controller")
+	utils.AssertEqual(t, later, collapsed[0].Timestamp, "Collapsed entry should keep the latest timestamp")
+
+	utils.AssertEqual(t, "iam-controller", collapsed[1].ControllerName, "Single error should be returned as reported")
+	utils.AssertEqual(t, 0, collapsed[1].Occurrences, "Single error should not report occurrences")
+	utils.AssertNil(t, collapsed[1].AffectedControllers, "Single error should not list affected controllers")
+
+	utils.AssertEqual(t, 0, len(CollapseErrors(nil)), "No errors should collapse to nothing")
+}
+
+func TestDedupeErrors(t *testing.T) {
+	earlier := time.Now().Add(-time.Minute)
+	later := time.Now()
+	quota := func(controller string, ts time.Time) ErrorInfo {
+		return ErrorInfo{
+			ControllerName: controller,
+			ErrorType:      ErrorTypeTransient,
+			ErrorCode:      "QUOTA_EXCEEDED",
+			Message:        "GCP API quota exceeded",
+			Timestamp:      ts,
+		}
+	}
+
+	errs := []ErrorInfo{
+		quota("nodepool-controller", earlier),
+		quota("dns-controller", earlier),
+		quota("nodepool-controller", later),
+	}
+
+	deduped := DedupeErrors(errs)
+	utils.AssertEqual(t, 2, len(deduped), "Repeats from the same controller should be dropped")
+	utils.AssertEqual(t, "nodepool-controller", deduped[0].ControllerName, "First seen error should come first")
+	utils.AssertEqual(t, later, deduped[0].Timestamp, "Deduplicated entry should keep the latest timestamp")
+	utils.AssertEqual(t, 0, deduped[0].Occurrences, "Deduplicated entry should not report occurrences")
+	utils.AssertEqual(t, "dns-controller", deduped[1].ControllerName, "Other controllers' errors should be kept")
+
+	utils.AssertEqual(t, 0, len(DedupeErrors(nil)), "No errors should dedupe to nothing")
+}
+
 func TestBeforeCreate(t *testing.T) {
 	cluster := &Cluster{}
 	cluster.BeforeCreate()
@@ -295,6 +401,54 @@ func TestNodePool(t *testing.T) {
 	utils.AssertEqual(t, int32(3), *nodepool.Spec.Replicas, "Replicas count")
 }
 
+func TestValidateResourceNames(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      string
+		wantErr   bool
+		errSubstr string
+	}{
+		{name: "lowercase letters", input: "prod", want: "prod"},
+		{name: "letters, digits and hyphens", input: "prod-cluster-01", want: "prod-cluster-01"},
+		{name: "leading digit", input: "1st-cluster", want: "1st-cluster"},
+		{name: "single character", input: "a", want: "a"},
+		{name: "exactly 63 characters", input: strings.Repeat("a", 63), want: strings.Repeat("a", 63)},
+		{name: "surrounding whitespace is trimmed", input: "  prod-cluster\n", want: "prod-cluster"},
+		{name: "empty", input: "", wantErr: true, errSubstr: "name is required"},
+		{name: "whitespace only", input: "   ", wantErr: true, errSubstr: "name is required"},
+		{name: "uppercase", input: "Prod", wantErr: true, errSubstr: "lowercase letters"},
+		{name: "underscore", input: "prod_cluster", wantErr: true, errSubstr: "lowercase letters"},
+		{name: "dot", input: "prod.cluster", wantErr: true, errSubstr: "lowercase letters"},
+		{name: "inner space", input: "prod cluster", wantErr: true, errSubstr: "lowercase letters"},
+		{name: "leading hyphen", input: "-prod", wantErr: true, errSubstr: "start and end with a letter or digit"},
+		{name: "trailing hyphen", input: "prod-", wantErr: true, errSubstr: "start and end with a letter or digit"},
+		{name: "64 characters", input: strings.Repeat("a", 64), wantErr: true, errSubstr: "must be 63 characters or less (got 64)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &ClusterCreateRequest{Name: tt.input}
+			clusterErr := cluster.ValidateName()
+
+			nodepoolName := tt.input
+			nodepoolErr := ValidateNodePoolName(&nodepoolName)
+
+			utils.AssertError(t, clusterErr, tt.wantErr, "cluster name validation")
+			utils.AssertError(t, nodepoolErr, tt.wantErr, "nodepool name validation")
+			if tt.wantErr {
+				utils.AssertContains(t, clusterErr.Error(), "cluster name", "error should name the resource")
+				utils.AssertContains(t, clusterErr.Error(), tt.errSubstr, "error message")
+				utils.AssertContains(t, nodepoolErr.Error(), "nodepool name", "error should name the resource")
+				utils.AssertContains(t, nodepoolErr.Error(), tt.errSubstr, "error message")
+			} else {
+				utils.AssertEqual(t, tt.want, cluster.Name, "cluster name should be normalized")
+				utils.AssertEqual(t, tt.want, nodepoolName, "nodepool name should be normalized")
+			}
+		})
+	}
+}
+
 func TestValidateGCPInfraID(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -252,6 +252,42 @@ func TestStatusAndHealthTypes(t *testing.T) {
 	}
 }
 
+func TestStatusAndHealthFilterValues(t *testing.T) {
+	for _, status := range []Status{StatusPending, StatusReady, StatusError, StatusDeleting, StatusUnknown} {
+		utils.AssertTrue(t, IsValidStatus(string(status)), "Status constant should be a valid filter", status)
+	}
+	for _, health := range []Health{HealthHealthy, HealthDegraded, HealthUnhealthy, HealthUnknown} {
+		utils.AssertTrue(t, IsValidHealth(string(health)), "Health constant should be a valid filter", health)
+	}
+
+	for _, invalid := range []string{"", "ready", "Running", "Healthy"} {
+		utils.AssertFalse(t, IsValidStatus(invalid), "Unknown status should be rejected", invalid)
+	}
+	for _, invalid := range []string{"", "healthy", "Sick", "Ready"} {
+		utils.AssertFalse(t, IsValidHealth(invalid), "Unknown health should be rejected", invalid)
+	}
+}
+
+func TestSplitFilterValues(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want []string
+	}{
+		{raw: "", want: nil},
+		{raw: "Ready", want: []string{"Ready"}},
+		{raw: "Progressing,Failed", want: []string{"Progressing", "Failed"}},
+		{raw: " Progressing , Failed ", want: []string{"Progressing", "Failed"}},
+		{raw: "Ready,,Ready,", want: []string{"Ready"}},
+		{raw: ",", want: nil},
+	}
+
+	for _, tt := range tests {
+		got := SplitFilterValues(tt.raw)
+		utils.AssertEqual(t, fmt.Sprint(tt.want), fmt.Sprint(got), "Filter values should be split", tt.raw)
+		utils.AssertEqual(t, len(tt.want), len(got), "Filter value count should match", tt.raw)
+	}
+}
+
 func TestValidateRelease(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -327,6 +363,374 @@ func TestValidateRelease(t *testing.T) {
 	}
 }
 
+func TestValidateReleaseReportsEveryField(t *testing.T) {
+	req := &ClusterCreateRequest{Name: "test-cluster"}
+
+	err := req.ValidateRelease()
+	fieldErrors, ok := err.(utils.ValidationErrors)
+	utils.AssertTrue(t, ok, "ValidateRelease should return utils.ValidationErrors")
+	utils.AssertEqual(t, 2, len(fieldErrors), "Both missing fields should be listed")
+	utils.AssertEqual(t, "release.version", fieldErrors[0].Field, "Version should be listed first")
+	utils.AssertEqual(t, "release.channelGroup", fieldErrors[1].Field, "Channel group should be listed")
+	utils.AssertEqual(t, "release.version is required; release.channelGroup is required", err.Error(),
+		"Error should join the field messages")
+}
+
+func TestClusterSpecValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		networking NetworkingSpec
+		wantErr    bool
+		errField   string
+	}{
+		{
+			name:       "empty networking accepted",
+			networking: NetworkingSpec{},
+			wantErr:    false,
+		},
+		{
+			name: "valid cluster and service networks",
+			networking: NetworkingSpec{
+				ClusterNetwork: []NetworkEntry{{CIDR: "10.128.0.0/14", HostPrefix: 23}},
+				ServiceNetwork: []string{"172.30.0.0/16"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid pod and service CIDRs",
+			networking: NetworkingSpec{
+				PodCIDR:     "10.132.0.0/14",
+				ServiceCIDR: "10.128.0.0/14",
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid IPv6 networks",
+			networking: NetworkingSpec{
+				ClusterNetwork: []NetworkEntry{{CIDR: "fd01::/48", HostPrefix: 64}},
+				ServiceNetwork: []string{"fd02::/112"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "hostPrefix equal to prefix length accepted",
+			networking: NetworkingSpec{
+				ClusterNetwork: []NetworkEntry{{CIDR: "10.128.0.0/23", HostPrefix: 23}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "malformed clusterNetwork CIDR rejected",
+			networking: NetworkingSpec{
+				ClusterNetwork: []NetworkEntry{{CIDR: "10.0.0/8"}},
+			},
+			wantErr:  true,
+			errField: "networking.clusterNetwork[0].cidr",
+		},
+		{
+			name: "empty clusterNetwork CIDR rejected",
+			networking: NetworkingSpec{
+				ClusterNetwork: []NetworkEntry{{CIDR: "10.128.0.0/14"}, {CIDR: ""}},
+			},
+			wantErr:  true,
+			errField: "networking.clusterNetwork[1].cidr",
+		},
+		{
+			name: "hostPrefix shorter than prefix length rejected",
+			networking: NetworkingSpec{
+				ClusterNetwork: []NetworkEntry{{CIDR: "10.128.0.0/14", HostPrefix: 12}},
+			},
+			wantErr:  true,
+			errField: "networking.clusterNetwork[0].hostPrefix",
+		},
+		{
+			name: "hostPrefix beyond address length rejected",
+			networking: NetworkingSpec{
+				ClusterNetwork: []NetworkEntry{{CIDR: "10.128.0.0/14", HostPrefix: 33}},
+			},
+			wantErr:  true,
+			errField: "networking.clusterNetwork[0].hostPrefix",
+		},
+		{
+			name: "malformed serviceNetwork entry rejected",
+			networking: NetworkingSpec{
+				ServiceNetwork: []string{"172.30.0.0/16", "172.31.0.0"},
+			},
+			wantErr:  true,
+			errField: "networking.serviceNetwork[1]",
+		},
+		{
+			name: "malformed podCIDR rejected",
+			networking: NetworkingSpec{
+				PodCIDR: "10.132.0.0/33",
+			},
+			wantErr:  true,
+			errField: "networking.podCIDR",
+		},
+		{
+			name: "malformed serviceCIDR rejected",
+			networking: NetworkingSpec{
+				ServiceCIDR: "not-a-cidr",
+			},
+			wantErr:  true,
+			errField: "networking.serviceCIDR",
+		},
+		{
+			name: "overlapping cluster and service networks rejected",
+			networking: NetworkingSpec{
+				ClusterNetwork: []NetworkEntry{{CIDR: "10.128.0.0/14", HostPrefix: 23}},
+				ServiceNetwork: []string{"10.130.0.0/16"},
+			},
+			wantErr:  true,
+			errField: "networking.clusterNetwork[0].cidr",
+		},
+		{
+			name: "overlapping pod and service CIDRs rejected",
+			networking: NetworkingSpec{
+				PodCIDR:     "10.0.0.0/8",
+				ServiceCIDR: "10.96.0.0/12",
+			},
+			wantErr:  true,
+			errField: "networking.podCIDR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &ClusterSpec{Networking: tt.networking}
+
+			err := spec.Validate()
+			utils.AssertError(t, err, tt.wantErr, "Validate result should match expected")
+			if tt.wantErr && err != nil {
+				utils.AssertContains(t, err.Error(), tt.errField, "Error should name the invalid field")
+			}
+		})
+	}
+}
+
+func TestClusterSpecValidateFieldErrors(t *testing.T) {
+	spec := &ClusterSpec{
+		Release: ReleaseSpec{Image: "ocp-release"},
+		Networking: NetworkingSpec{
+			ClusterNetwork: []NetworkEntry{{CIDR: "10.128.0.0/14", HostPrefix: 8}},
+		},
+		Platform: PlatformSpec{
+			Type: "GCP",
+			GCP:  &GCPSpec{ProjectID: "test-project", Region: "us-central1", EndpointAccess: "Internal"},
+		},
+	}
+
+	err := spec.Validate()
+	utils.AssertError(t, err, true, "Invalid spec should fail validation")
+
+	fieldErrors, ok := err.(utils.ValidationErrors)
+	utils.AssertTrue(t, ok, "Validate should return utils.ValidationErrors")
+	utils.AssertEqual(t, 3, len(fieldErrors), "Each failing check should be listed")
+	utils.AssertEqual(t, "release.image", fieldErrors[0].Field, "Release image should be listed first")
+	utils.AssertEqual(t, "networking.clusterNetwork[0].hostPrefix", fieldErrors[1].Field, "Host prefix should be listed")
+	utils.AssertEqual(t, "platform.gcp.endpointAccess", fieldErrors[2].Field, "Endpoint access should be listed")
+	utils.AssertContains(t, err.Error(), "hostPrefix 8 is invalid", "Error should join the field messages")
+
+	utils.AssertError(t, (&ClusterSpec{}).Validate(), false, "Empty spec should pass validation")
+}
+
+func TestClusterSpecValidateEndpointAccess(t *testing.T) {
+	gcp := func(endpointAccess string, withSubnet bool) PlatformSpec {
+		spec := &GCPSpec{ProjectID: "test-project", Region: "us-central1", EndpointAccess: endpointAccess}
+		if withSubnet {
+			spec.Network = "test-network"
+			spec.Subnet = "test-subnet"
+		}
+		return PlatformSpec{Type: "GCP", GCP: spec}
+	}
+	bothZones := DNSSpec{BaseDomain: "example.com", PublicZone: "public-zone", PrivateZone: "private-zone"}
+
+	tests := []struct {
+		name     string
+		platform PlatformSpec
+		dns      DNSSpec
+		wantErr  bool
+		errField string
+	}{
+		{
+			name:     "unset endpoint access not checked",
+			platform: gcp("", false),
+			wantErr:  false,
+		},
+		{
+			name:     "public with public zone accepted",
+			platform: gcp("Public", false),
+			dns:      DNSSpec{PublicZone: "public-zone"},
+			wantErr:  false,
+		},
+		{
+			name:     "private with private zone and subnet accepted",
+			platform: gcp("Private", true),
+			dns:      DNSSpec{PrivateZone: "private-zone"},
+			wantErr:  false,
+		},
+		{
+			name:     "public and private with everything accepted",
+			platform: gcp("PublicAndPrivate", true),
+			dns:      bothZones,
+			wantErr:  false,
+		},
+		{
+			name:     "unknown endpoint access rejected",
+			platform: gcp("Internal", true),
+			dns:      bothZones,
+			wantErr:  true,
+			errField: "platform.gcp.endpointAccess",
+		},
+		{
+			name:     "private with only a public zone rejected",
+			platform: gcp("Private", true),
+			dns:      DNSSpec{PublicZone: "public-zone"},
+			wantErr:  true,
+			errField: "dns.privateZone",
+		},
+		{
+			name:     "public with only a private zone rejected",
+			platform: gcp("Public", false),
+			dns:      DNSSpec{PrivateZone: "private-zone"},
+			wantErr:  true,
+			errField: "dns.publicZone",
+		},
+		{
+			name:     "public and private without public zone rejected",
+			platform: gcp("PublicAndPrivate", true),
+			dns:      DNSSpec{PrivateZone: "private-zone"},
+			wantErr:  true,
+			errField: "dns.publicZone",
+		},
+		{
+			name:     "private without subnet rejected",
+			platform: gcp("Private", false),
+			dns:      DNSSpec{PrivateZone: "private-zone"},
+			wantErr:  true,
+			errField: "platform.gcp.subnet",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &ClusterSpec{Platform: tt.platform, DNS: tt.dns}
+
+			err := spec.Validate()
+			utils.AssertError(t, err, tt.wantErr, "Validate result should match expected")
+			if tt.wantErr && err != nil {
+				utils.AssertContains(t, err.Error(), tt.errField, "Error should name the missing field")
+			}
+		})
+	}
+}
+
+func TestClusterSpecValidateWorkloadIdentity(t *testing.T) {
+	validRefs := func() *WIFServiceAccountsRef {
+		return &WIFServiceAccountsRef{
+			NodePoolEmail:        "nodepool@test-project.iam.gserviceaccount.com",
+			ControlPlaneEmail:    "control-plane@test-project.iam.gserviceaccount.com",
+			CloudControllerEmail: "cloud-controller@test-project.iam.gserviceaccount.com",
+		}
+	}
+	validConfig := func() *WorkloadIdentityConfig {
+		return &WorkloadIdentityConfig{
+			ProjectNumber:      "123456789012",
+			PoolID:             "cls-pool",
+			ProviderID:         "cls-provider",
+			ServiceAccountsRef: validRefs(),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		mutate   func(wif *WorkloadIdentityConfig)
+		wantErr  bool
+		errField string
+	}{
+		{name: "complete configuration accepted"},
+		{
+			name:   "service accounts are optional",
+			mutate: func(wif *WorkloadIdentityConfig) { wif.ServiceAccountsRef = nil },
+		},
+		{
+			name: "optional service account accepted",
+			mutate: func(wif *WorkloadIdentityConfig) {
+				wif.ServiceAccountsRef.StorageEmail = "storage@test-project.iam.gserviceaccount.com"
+			},
+		},
+		{
+			name:     "missing project number rejected",
+			mutate:   func(wif *WorkloadIdentityConfig) { wif.ProjectNumber = "" },
+			wantErr:  true,
+			errField: "platform.gcp.workloadIdentity.projectNumber is required",
+		},
+		{
+			name:     "missing pool ID rejected",
+			mutate:   func(wif *WorkloadIdentityConfig) { wif.PoolID = "" },
+			wantErr:  true,
+			errField: "platform.gcp.workloadIdentity.poolID is required",
+		},
+		{
+			name:     "blank provider ID rejected",
+			mutate:   func(wif *WorkloadIdentityConfig) { wif.ProviderID = "  " },
+			wantErr:  true,
+			errField: "platform.gcp.workloadIdentity.providerID is required",
+		},
+		{
+			name:     "missing nodepool email rejected",
+			mutate:   func(wif *WorkloadIdentityConfig) { wif.ServiceAccountsRef.NodePoolEmail = "" },
+			wantErr:  true,
+			errField: "serviceAccountsRef.nodePoolEmail is required",
+		},
+		{
+			name:     "missing control plane email rejected",
+			mutate:   func(wif *WorkloadIdentityConfig) { wif.ServiceAccountsRef.ControlPlaneEmail = "" },
+			wantErr:  true,
+			errField: "serviceAccountsRef.controlPlaneEmail is required",
+		},
+		{
+			name:     "missing cloud controller email rejected",
+			mutate:   func(wif *WorkloadIdentityConfig) { wif.ServiceAccountsRef.CloudControllerEmail = "" },
+			wantErr:  true,
+			errField: "serviceAccountsRef.cloudControllerEmail is required",
+		},
+		{
+			name:     "non service account email rejected",
+			mutate:   func(wif *WorkloadIdentityConfig) { wif.ServiceAccountsRef.ControlPlaneEmail = "admin@example.com" },
+			wantErr:  true,
+			errField: "serviceAccountsRef.controlPlaneEmail 'admin@example.com' is invalid",
+		},
+		{
+			name: "malformed optional email rejected",
+			mutate: func(wif *WorkloadIdentityConfig) {
+				wif.ServiceAccountsRef.NetworkEmail = "network@.iam.gserviceaccount.com"
+			},
+			wantErr:  true,
+			errField: "serviceAccountsRef.networkEmail",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wif := validConfig()
+			if tt.mutate != nil {
+				tt.mutate(wif)
+			}
+			spec := &ClusterSpec{Platform: PlatformSpec{
+				Type: "GCP",
+				GCP:  &GCPSpec{ProjectID: "test-project", Region: "us-central1", WorkloadIdentity: wif},
+			}}
+
+			err := spec.Validate()
+			utils.AssertError(t, err, tt.wantErr, "Validate result should match expected")
+			if tt.wantErr && err != nil {
+				utils.AssertContains(t, err.Error(), tt.errField, "Error should name the invalid field")
+			}
+		})
+	}
+}
+
 // Helper function for validation (this would normally be in the cluster.go file)
 func validateCluster(cluster *Cluster) error {
 	if cluster.Name == "" {
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/apahim/cls-backend/internal/utils"
+)
+
+// DatabasePoolTuneRequest resizes the database connection pool at runtime. Omitted limits are
+// left unchanged.
+type DatabasePoolTuneRequest struct {
+	MaxOpenConns *int `json:"max_open_conns,omitempty"` // 0 means unlimited
+	MaxIdleConns *int `json:"max_idle_conns,omitempty"`
+}
+
+// Validate checks the requested limits against the same rules as DATABASE_MAX_OPEN_CONNS and
+// DATABASE_MAX_IDLE_CONNS. currentMaxOpen is the pool's limit when max_open_conns is omitted.
+func (r *DatabasePoolTuneRequest) Validate(currentMaxOpen int) error {
+	var errs utils.ValidationErrors
+	if r.MaxOpenConns == nil && r.MaxIdleConns == nil {
+		errs.Add("", "at least one of max_open_conns and max_idle_conns is required", nil)
+		return errs
+	}
+
+	maxOpen := currentMaxOpen
+	if r.MaxOpenConns != nil {
+		maxOpen = *r.MaxOpenConns
+		if maxOpen < 0 {
+			errs.Add("max_open_conns", fmt.Sprintf("max_open_conns must not be negative (got %d)", maxOpen), nil)
+		}
+	}
+	if r.MaxIdleConns != nil {
+		maxIdle := *r.MaxIdleConns
+		if maxIdle < 0 {
+			errs.Add("max_idle_conns", fmt.Sprintf("max_idle_conns must not be negative (got %d)", maxIdle), nil)
+		} else if maxOpen > 0 && maxIdle > maxOpen {
+			errs.Add("max_idle_conns", fmt.Sprintf("max_idle_conns (%d) must not exceed max_open_conns (%d)", maxIdle, maxOpen), nil)
+		}
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
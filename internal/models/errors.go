@@ -1,23 +1,61 @@
 package models
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 // Repository errors
 var (
 	ErrClusterNotFound                = errors.New("cluster not found")
+	ErrClusterNotDeleted              = errors.New("cluster is not deleted")
+	ErrClusterNameExists              = errors.New("cluster name already exists")
 	ErrNodePoolNotFound               = errors.New("nodepool not found")
+	ErrControllerStatusNotFound       = errors.New("controller status not found")
 	ErrReconciliationScheduleNotFound = errors.New("reconciliation schedule not found")
+	ErrFailedEventNotFound            = errors.New("failed event not found")
+	ErrIdempotencyKeyNotFound         = errors.New("idempotency key not found")
+	ErrIdempotencyKeyMismatch         = errors.New("idempotency key was used for a different request")
 	ErrInvalidInput                   = errors.New("invalid input")
 	ErrConflict                       = errors.New("resource conflict")
 	ErrDuplicateEntry                 = errors.New("duplicate entry")
+	ErrStaleGeneration                = errors.New("observed generation is older than the stored status")
+	ErrReconcilePublisherUnavailable  = errors.New("reconcile event publisher is not configured")
+	ErrClusterGrantNotFound           = errors.New("cluster grant not found")
+
+	// ErrStatusUpdateDeprecated is returned by the removed overall status/health setters;
+	// status is now reported per controller and aggregated
+	ErrStatusUpdateDeprecated = errors.New("overall status updates are no longer supported, report controller status instead")
 )
 
+// ClusterHasNodePoolsError is returned when a cluster that still has active nodepools is
+// deleted without force
+type ClusterHasNodePoolsError struct {
+	NodePoolCount int64
+}
+
+func (e *ClusterHasNodePoolsError) Error() string {
+	return fmt.Sprintf("cluster has %d active nodepools, delete them first or use force=true", e.NodePoolCount)
+}
+
 // ListOptions represents common filtering and pagination options
 type ListOptions struct {
-	Status string `json:"status,omitempty"`
-	Health string `json:"health,omitempty"`
-	Limit  int    `json:"limit,omitempty"`
-	Offset int    `json:"offset,omitempty"`
+	// Status and Health match any of their values; an empty list does not filter
+	Status []string `json:"status,omitempty"`
+	Health []string `json:"health,omitempty"`
+	Limit  int      `json:"limit,omitempty"`
+	Offset int      `json:"offset,omitempty"`
+
+	// Cursor enables keyset pagination and takes precedence over Offset when set
+	Cursor *ListCursor `json:"-"`
+
+	// CreatedAfter (inclusive) and CreatedBefore (exclusive) restrict results to a creation window
+	CreatedAfter  *time.Time `json:"created_after,omitempty"`
+	CreatedBefore *time.Time `json:"created_before,omitempty"`
+
+	// TargetProjectID restricts results to clusters targeting a GCP project; empty does not filter
+	TargetProjectID string `json:"target_project_id,omitempty"`
 }
 
 // Validate validates the list options
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/apahim/cls-backend/internal/utils"
 	"github.com/google/uuid"
 )
 
@@ -29,13 +30,56 @@ type NodePool struct {
 
 // NodePoolSpec represents the node pool specification
 type NodePoolSpec struct {
-	Replicas         *int32               `json:"replicas,omitempty"`
+	Replicas         *int32               `json:"replicas,omitempty"`    // Ignored when Autoscaling is set
+	Autoscaling      *NodePoolAutoscaling `json:"autoscaling,omitempty"` // Optional min/max bounds for autoscaled pools
 	Management       NodePoolManagement   `json:"management"`
 	Platform         NodePoolPlatformSpec `json:"platform"`
 	Release          NodePoolReleaseSpec  `json:"release"`
 	NodeDrainTimeout string               `json:"nodeDrainTimeout,omitempty"`
 }
 
+// NodePoolAutoscaling represents the replica bounds for an autoscaled node pool
+type NodePoolAutoscaling struct {
+	MinReplicas int32 `json:"minReplicas"`
+	MaxReplicas int32 `json:"maxReplicas"`
+}
+
+// ValidateAutoscaling validates the autoscaling bounds when autoscaling is enabled.
+// Bounds must satisfy 0 <= minReplicas <= maxReplicas. Failures are reported as
+// utils.ValidationErrors with fields relative to the spec.
+func (nps *NodePoolSpec) ValidateAutoscaling() error {
+	if nps.Autoscaling == nil {
+		return nil
+	}
+
+	var errs utils.ValidationErrors
+	if nps.Autoscaling.MinReplicas < 0 {
+		errs.Add("autoscaling.minReplicas",
+			fmt.Sprintf("autoscaling.minReplicas must be 0 or greater (got %d)", nps.Autoscaling.MinReplicas),
+			nps.Autoscaling.MinReplicas)
+	}
+
+	if nps.Autoscaling.MinReplicas > nps.Autoscaling.MaxReplicas {
+		errs.Add("autoscaling.minReplicas", fmt.Sprintf(
+			"autoscaling.minReplicas (%d) must be less than or equal to autoscaling.maxReplicas (%d)",
+			nps.Autoscaling.MinReplicas, nps.Autoscaling.MaxReplicas,
+		), nps.Autoscaling.MinReplicas)
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+// NormalizeReplicas drops the fixed replica count when autoscaling is enabled,
+// since controllers size autoscaled pools from the min/max bounds instead.
+func (nps *NodePoolSpec) NormalizeReplicas() {
+	if nps.Autoscaling != nil {
+		nps.Replicas = nil
+	}
+}
+
 // NodePoolManagement represents node pool management configuration
 type NodePoolManagement struct {
 	UpgradeType string                 `json:"upgradeType,omitempty"` // Replace, InPlace
@@ -100,6 +144,7 @@ type NodePoolStatusInfo struct {
 	Phase              string      `json:"phase,omitempty"`    // Pending, Progressing, Ready, Failed
 	Message            string      `json:"message,omitempty"`  // Human-readable status message
 	Reason             string      `json:"reason,omitempty"`   // Machine-readable reason code
+	ProgressPercent    int         `json:"progressPercent"`    // Weighted percentage of ready controllers
 	LastUpdateTime     time.Time   `json:"lastUpdateTime"`     // When status was last calculated
 }
 
@@ -173,11 +218,99 @@ type NodePoolCreateRequest struct {
 	Spec NodePoolSpec `json:"spec" binding:"required"`
 }
 
+// NodePoolPhases lists the aggregated status phases nodepools can be filtered by; they are
+// the phases the nodepool aggregation rules produce
+var NodePoolPhases = []string{"Pending", "Progressing", "Ready", "Failed", string(HealthDegraded)}
+
+// IsValidNodePoolPhase reports whether phase is one of NodePoolPhases
+func IsValidNodePoolPhase(phase string) bool {
+	return isOneOf(phase, NodePoolPhases)
+}
+
+// ValidateNodePoolName trims surrounding whitespace from a nodepool name and checks it is a
+// valid RFC 1123 label
+func ValidateNodePoolName(name *string) error {
+	return validateResourceName("nodepool", name)
+}
+
+// NodePoolBatchCreateRequest represents a request to create several node pools in one cluster
+type NodePoolBatchCreateRequest struct {
+	NodePools []NodePoolCreateRequest `json:"nodepools"`
+}
+
 // NodePoolUpdateRequest represents a request to update a node pool
 type NodePoolUpdateRequest struct {
 	Spec NodePoolSpec `json:"spec" binding:"required"`
 }
 
+// NodePoolScaleRequest represents a request to change only a node pool's replica count
+type NodePoolScaleRequest struct {
+	Replicas *int32 `json:"replicas" binding:"required"`
+}
+
+// Validate checks that the requested replica count is 0 or greater
+func (r *NodePoolScaleRequest) Validate() error {
+	if *r.Replicas < 0 {
+		return utils.NewValidationErrors(utils.ValidationDetails{
+			Field:   "replicas",
+			Message: fmt.Sprintf("replicas must be 0 or greater (got %d)", *r.Replicas),
+		})
+	}
+	return nil
+}
+
+// Nodepool event types recorded for each lifecycle operation
+const (
+	NodePoolEventCreated = "created"
+	NodePoolEventUpdated = "updated"
+	NodePoolEventScaled  = "scaled"
+	NodePoolEventDeleted = "deleted"
+)
+
+// NodePoolEvent represents a nodepool lifecycle event
+type NodePoolEvent struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	NodePoolID  uuid.UUID `json:"nodepool_id" db:"nodepool_id"`
+	ClusterID   uuid.UUID `json:"cluster_id" db:"cluster_id"`
+	EventType   string    `json:"event_type" db:"event_type"` // created, updated, scaled, deleted
+	Generation  int64     `json:"generation" db:"generation"`
+	Changes     JSONB     `json:"changes,omitempty" db:"metadata"`
+	PublishedAt time.Time `json:"published_at" db:"published_at"`
+}
+
+// NodePoolEventListOptions selects a page of a nodepool's events
+type NodePoolEventListOptions struct {
+	EventType string // Matches the event type exactly; empty does not filter
+	Limit     int
+	Offset    int
+}
+
+// NewNodePoolEvent creates an event of the given type for the nodepool's current generation
+func NewNodePoolEvent(eventType string, nodepool *NodePool, changes JSONB) *NodePoolEvent {
+	return &NodePoolEvent{
+		NodePoolID: nodepool.ID,
+		ClusterID:  nodepool.ClusterID,
+		EventType:  eventType,
+		Generation: nodepool.Generation,
+		Changes:    changes,
+	}
+}
+
+// TableName returns the table name for NodePoolEvent
+func (NodePoolEvent) TableName() string {
+	return "nodepool_events"
+}
+
+// BeforeCreate sets default values before creating a nodepool event
+func (e *NodePoolEvent) BeforeCreate() {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	if e.PublishedAt.IsZero() {
+		e.PublishedAt = time.Now()
+	}
+}
+
 // TableName returns the table name for the NodePool model
 func (NodePool) TableName() string {
 	return "nodepools"
@@ -204,6 +204,91 @@ func TestRollingUpdateConfig(t *testing.T) {
 	utils.AssertEqual(t, "25%", *config.MaxSurge, "MaxSurge should match")
 }
 
+func TestNodePoolSpecValidateAutoscaling(t *testing.T) {
+	tests := []struct {
+		name        string
+		autoscaling *NodePoolAutoscaling
+		wantErr     bool
+	}{
+		{
+			name:        "autoscaling not set",
+			autoscaling: nil,
+			wantErr:     false,
+		},
+		{
+			name:        "valid range",
+			autoscaling: &NodePoolAutoscaling{MinReplicas: 1, MaxReplicas: 5},
+			wantErr:     false,
+		},
+		{
+			name:        "equal bounds",
+			autoscaling: &NodePoolAutoscaling{MinReplicas: 3, MaxReplicas: 3},
+			wantErr:     false,
+		},
+		{
+			name:        "scale to zero",
+			autoscaling: &NodePoolAutoscaling{MinReplicas: 0, MaxReplicas: 2},
+			wantErr:     false,
+		},
+		{
+			name:        "inverted bounds",
+			autoscaling: &NodePoolAutoscaling{MinReplicas: 5, MaxReplicas: 1},
+			wantErr:     true,
+		},
+		{
+			name:        "negative minimum",
+			autoscaling: &NodePoolAutoscaling{MinReplicas: -1, MaxReplicas: 3},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := NodePoolSpec{Autoscaling: tt.autoscaling}
+			err := spec.ValidateAutoscaling()
+			utils.AssertError(t, err, tt.wantErr, "Autoscaling validation result should match expected")
+		})
+	}
+}
+
+func TestNodePoolSpecNormalizeReplicas(t *testing.T) {
+	replicas := int32(3)
+
+	// Fixed replica count is kept when autoscaling is not set
+	spec := NodePoolSpec{Replicas: &replicas}
+	spec.NormalizeReplicas()
+	utils.AssertNotNil(t, spec.Replicas, "Replicas should be kept without autoscaling")
+	utils.AssertEqual(t, int32(3), *spec.Replicas, "Replicas should be unchanged")
+
+	// Fixed replica count is ignored when autoscaling is set
+	spec = NodePoolSpec{
+		Replicas:    &replicas,
+		Autoscaling: &NodePoolAutoscaling{MinReplicas: 1, MaxReplicas: 5},
+	}
+	utils.AssertError(t, spec.ValidateAutoscaling(), false, "Replicas alongside autoscaling should not be rejected")
+	spec.NormalizeReplicas()
+	utils.AssertNil(t, spec.Replicas, "Replicas should be cleared when autoscaling is set")
+	utils.AssertEqual(t, int32(1), spec.Autoscaling.MinReplicas, "MinReplicas should be preserved")
+	utils.AssertEqual(t, int32(5), spec.Autoscaling.MaxReplicas, "MaxReplicas should be preserved")
+}
+
+func TestNodePoolSpecAutoscalingSerialization(t *testing.T) {
+	spec := NodePoolSpec{
+		Autoscaling: &NodePoolAutoscaling{MinReplicas: 2, MaxReplicas: 6},
+	}
+
+	value, err := spec.Value()
+	utils.AssertError(t, err, false, "Value() should not return error")
+
+	var scannedSpec NodePoolSpec
+	err = scannedSpec.Scan(value.([]byte))
+	utils.AssertError(t, err, false, "Scan() should not return error")
+	utils.AssertNotNil(t, scannedSpec.Autoscaling, "Autoscaling should survive round trip")
+	utils.AssertEqual(t, int32(2), scannedSpec.Autoscaling.MinReplicas, "MinReplicas should match")
+	utils.AssertEqual(t, int32(6), scannedSpec.Autoscaling.MaxReplicas, "MaxReplicas should match")
+	utils.AssertNil(t, scannedSpec.Replicas, "Replicas should remain unset")
+}
+
 func TestNodePoolTableName(t *testing.T) {
 	nodepool := NodePool{}
 	utils.AssertEqual(t, "nodepools", nodepool.TableName(), "Table name should be 'nodepools'")
@@ -0,0 +1,108 @@
+package models
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ListCursor identifies the last row of a page for keyset pagination.
+// Lists are ordered by (created_at DESC, id DESC), so the next page starts
+// strictly after this position.
+type ListCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// Encode returns the opaque string form of the cursor for use in API responses
+func (c *ListCursor) Encode() string {
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeListCursor parses an opaque cursor produced by ListCursor.Encode
+func DecodeListCursor(encoded string) (*ListCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidInput)
+	}
+
+	parts := strings.SplitN(string(raw), "|", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidInput)
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor timestamp", ErrInvalidInput)
+	}
+
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor id", ErrInvalidInput)
+	}
+
+	return &ListCursor{CreatedAt: createdAt, ID: id}, nil
+}
+
+// PageInfo tells clients whether further pages exist without computing it from total, limit
+// and offset themselves
+type PageInfo struct {
+	HasMore    bool `json:"has_more"`
+	TotalPages int  `json:"total_pages"`
+}
+
+// NewPageInfo describes a page of count items starting at offset within total matching items
+func NewPageInfo(total int64, limit, offset, count int) PageInfo {
+	info := PageInfo{HasMore: int64(offset+count) < total}
+	if limit > 0 {
+		info.TotalPages = int((total + int64(limit) - 1) / int64(limit))
+	} else if total > 0 {
+		info.TotalPages = 1
+	}
+	return info
+}
+
+// ListClustersResponse represents a page of clusters returned by the list endpoint
+type ListClustersResponse struct {
+	Clusters   []*Cluster `json:"clusters"`
+	Total      int64      `json:"total"`
+	Limit      int        `json:"limit"`
+	Offset     int        `json:"offset"`
+	NextCursor string     `json:"next_cursor,omitempty"` // Empty when there are no more pages
+	PageInfo
+}
+
+// ListClusterStatusesResponse represents a paginated list of compact cluster statuses
+type ListClusterStatusesResponse struct {
+	Statuses   []*CompactClusterStatus `json:"statuses"`
+	Total      int64                   `json:"total"`
+	Limit      int                     `json:"limit"`
+	Offset     int                     `json:"offset"`
+	NextCursor string                  `json:"next_cursor,omitempty"` // Empty when there are no more pages
+	PageInfo
+}
+
+// NewClusterPageInfo describes a page of clusters listed with opts. A cursor page does not know
+// its position in the full list, so it has more pages exactly when it has a next cursor.
+func NewClusterPageInfo(clusters []*Cluster, total int64, opts *ListOptions) PageInfo {
+	info := NewPageInfo(total, opts.Limit, opts.Offset, len(clusters))
+	if opts.Cursor != nil {
+		info.HasMore = NextClusterCursor(clusters, opts.Limit) != ""
+	}
+	return info
+}
+
+// NextClusterCursor returns the cursor for the page following clusters, or an
+// empty string when the page was not full and no further rows exist
+func NextClusterCursor(clusters []*Cluster, limit int) string {
+	if limit <= 0 || len(clusters) < limit {
+		return ""
+	}
+	last := clusters[len(clusters)-1]
+	cursor := ListCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	return cursor.Encode()
+}
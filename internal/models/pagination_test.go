@@ -0,0 +1,92 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/google/uuid"
+)
+
+func TestListCursor_EncodeDecode(t *testing.T) {
+	cursor := ListCursor{
+		CreatedAt: time.Date(2025, 10, 17, 10, 0, 0, 123456000, time.UTC),
+		ID:        uuid.New(),
+	}
+
+	decoded, err := DecodeListCursor(cursor.Encode())
+	utils.AssertError(t, err, false, "Should decode encoded cursor")
+	utils.AssertTrue(t, cursor.CreatedAt.Equal(decoded.CreatedAt), "CreatedAt should round trip")
+	utils.AssertEqual(t, cursor.ID, decoded.ID, "ID should round trip")
+}
+
+func TestDecodeListCursor_Invalid(t *testing.T) {
+	tests := []string{
+		"not base64!",
+		"bm8tc2VwYXJhdG9y",             // "no-separator"
+		"bm90LWEtdGltZXxub3QtYS11dWlk", // "not-a-time|not-a-uuid"
+	}
+
+	for _, encoded := range tests {
+		_, err := DecodeListCursor(encoded)
+		utils.AssertTrue(t, errors.Is(err, ErrInvalidInput), "Malformed cursor should return ErrInvalidInput", encoded)
+	}
+}
+
+func TestNextClusterCursor(t *testing.T) {
+	now := time.Now()
+	clusters := []*Cluster{
+		{ID: uuid.New(), CreatedAt: now},
+		{ID: uuid.New(), CreatedAt: now.Add(-time.Minute)},
+	}
+
+	// Full page yields a cursor pointing at the last row
+	next := NextClusterCursor(clusters, 2)
+	utils.AssertNotEqual(t, "", next, "Full page should return a next cursor")
+	cursor, err := DecodeListCursor(next)
+	utils.AssertError(t, err, false, "Should decode next cursor")
+	utils.AssertEqual(t, clusters[1].ID, cursor.ID, "Cursor should reference the last cluster")
+
+	// Short page means there are no more rows
+	utils.AssertEqual(t, "", NextClusterCursor(clusters, 3), "Partial page should not return a cursor")
+	utils.AssertEqual(t, "", NextClusterCursor(nil, 2), "Empty page should not return a cursor")
+}
+
+func TestNewPageInfo(t *testing.T) {
+	// Five items paged two at a time
+	tests := []struct {
+		offset  int
+		count   int
+		hasMore bool
+	}{
+		{offset: 0, count: 2, hasMore: true},
+		{offset: 2, count: 2, hasMore: true},
+		{offset: 4, count: 1, hasMore: false},
+	}
+
+	for _, tt := range tests {
+		info := NewPageInfo(5, 2, tt.offset, tt.count)
+		utils.AssertEqual(t, tt.hasMore, info.HasMore, "Unexpected has_more", tt.offset)
+		utils.AssertEqual(t, 3, info.TotalPages, "Five items should span three pages", tt.offset)
+	}
+
+	utils.AssertEqual(t, PageInfo{}, NewPageInfo(0, 2, 0, 0), "Empty list should have no pages")
+	utils.AssertEqual(t, PageInfo{TotalPages: 2}, NewPageInfo(4, 2, 2, 2), "Exactly full last page should not have more")
+	utils.AssertEqual(t, PageInfo{TotalPages: 1}, NewPageInfo(3, 0, 0, 3), "Unlimited list should be a single page")
+}
+
+func TestNewClusterPageInfo(t *testing.T) {
+	now := time.Now()
+	clusters := []*Cluster{
+		{ID: uuid.New(), CreatedAt: now},
+		{ID: uuid.New(), CreatedAt: now.Add(-time.Minute)},
+	}
+
+	// A cursor page starts at offset zero, so has_more follows the next cursor instead
+	info := NewClusterPageInfo(clusters, 5, &ListOptions{Limit: 2, Cursor: &ListCursor{CreatedAt: now, ID: uuid.New()}})
+	utils.AssertTrue(t, info.HasMore, "Full cursor page should have more")
+	info = NewClusterPageInfo(clusters[:1], 5, &ListOptions{Limit: 2, Cursor: &ListCursor{CreatedAt: now, ID: uuid.New()}})
+	utils.AssertFalse(t, info.HasMore, "Short cursor page should be the last")
+	utils.AssertEqual(t, 3, info.TotalPages, "Total pages should not depend on the cursor")
+}
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/apahim/cls-backend/internal/utils"
@@ -26,12 +28,46 @@ type ReconciliationSchedule struct {
 	IsHealthy         *bool      `json:"is_healthy" db:"is_healthy"` // NULL = unknown, true = healthy, false = unhealthy
 }
 
+// ReconciliationTiming is when a cluster was last reconciled and when it is next due. Both are
+// nil until the cluster has been reconciled; NextReconcileAt is also nil while reconciliation
+// is paused or disabled.
+type ReconciliationTiming struct {
+	LastReconciledAt *time.Time `json:"last_reconciled_at"`
+	NextReconcileAt  *time.Time `json:"next_reconcile_at"`
+}
+
 // ReconciliationTarget represents a cluster that needs reconciliation (fan-out to all controllers)
 type ReconciliationTarget struct {
 	ClusterID         uuid.UUID  `json:"cluster_id" db:"cluster_id"`
 	Reason            string     `json:"reason" db:"reason"`
 	LastReconciledAt  *time.Time `json:"last_reconciled_at" db:"last_reconciled_at"`
 	ClusterGeneration int64      `json:"cluster_generation" db:"cluster_generation"`
+	Platform          string     `json:"platform" db:"platform"` // Cluster platform type, for per-platform concurrency
+}
+
+// MinReconciliationInterval is the shortest per-cluster reconciliation interval accepted
+const MinReconciliationInterval = 10 * time.Second
+
+// ReconciliationIntervalRequest represents a request to override a cluster's reconciliation interval
+type ReconciliationIntervalRequest struct {
+	Interval *string `json:"reconciliation_interval"` // Go duration, e.g. "15s"; null restores the default
+}
+
+// ParseInterval validates the requested interval; a nil result clears the override
+func (r *ReconciliationIntervalRequest) ParseInterval() (*time.Duration, error) {
+	if r.Interval == nil {
+		return nil, nil
+	}
+
+	interval, err := time.ParseDuration(*r.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reconciliation_interval %q: %w", *r.Interval, err)
+	}
+	if interval < MinReconciliationInterval {
+		return nil, fmt.Errorf("reconciliation_interval must be at least %s (got %s)", MinReconciliationInterval, interval)
+	}
+
+	return &interval, nil
 }
 
 // ReconciliationEvent represents an event published for reconciliation (fan-out to all controllers)
@@ -69,6 +105,7 @@ type NodePoolReconciliationTarget struct {
 	Reason             string     `json:"reason" db:"reason"`
 	LastReconciledAt   *time.Time `json:"last_reconciled_at" db:"last_reconciled_at"`
 	NodePoolGeneration int64      `json:"nodepool_generation" db:"nodepool_generation"`
+	Platform           string     `json:"platform" db:"platform"` // Parent cluster platform type
 }
 
 // NodePoolReconciliationEvent represents an event published for nodepool reconciliation
@@ -82,6 +119,27 @@ type NodePoolReconciliationEvent struct {
 	Metadata   map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// Reconciliation event types
+const (
+	EventTypeClusterReconcile  = "cluster.reconcile"
+	EventTypeNodePoolReconcile = "nodepool.reconcile"
+)
+
+// ReconcileReasonManual is the reason of reconcile events requested through the API
+const ReconcileReasonManual = "manual"
+
+// FailedEvent is a reconciliation event that could not be published after retries
+type FailedEvent struct {
+	ID           uuid.UUID       `json:"id" db:"id"`
+	EventType    string          `json:"event_type" db:"event_type"`
+	ClusterID    uuid.UUID       `json:"cluster_id" db:"cluster_id"`
+	NodePoolID   *uuid.UUID      `json:"nodepool_id,omitempty" db:"nodepool_id"`
+	Payload      json.RawMessage `json:"payload" db:"payload"` // Serialized event as it would have been published
+	ErrorMessage string          `json:"error_message" db:"error_message"`
+	FailedAt     time.Time       `json:"failed_at" db:"failed_at"`
+	ReplayedAt   *time.Time      `json:"replayed_at,omitempty" db:"replayed_at"`
+}
+
 // ReconciliationConfig represents reconciliation configuration
 type ReconciliationConfig struct {
 	Enabled         bool          `json:"enabled"`
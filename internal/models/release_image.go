@@ -0,0 +1,108 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ReleaseImagePattern describes the accepted form of release.image in error messages
+const ReleaseImagePattern = "registry/repository[:tag|@sha256:digest]"
+
+var (
+	// imageRegistryRegex matches a registry host name with an optional port
+	imageRegistryRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?)*(:[0-9]+)?$`)
+	// imagePathComponentRegex matches one slash-separated repository path component
+	imagePathComponentRegex = regexp.MustCompile(`^[a-z0-9]+((\.|_|__|-+)[a-z0-9]+)*$`)
+	// imageTagRegex matches an image tag
+	imageTagRegex = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_.-]{0,127}$`)
+	// imageDigestRegex matches a sha256 image digest
+	imageDigestRegex = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+)
+
+// ImageReference is a parsed OCI image pullspec
+type ImageReference struct {
+	Registry   string // Registry host, with port if given
+	Repository string // Repository path within the registry
+	Tag        string // Empty when not given
+	Digest     string // Empty when not given
+}
+
+// ParseImageReference parses an OCI image pullspec of the form
+// registry/repository[:tag][@sha256:digest]. Unlike container runtimes it does not default
+// the registry, so the first path component must be a host: it has to contain a dot or a
+// port, or be localhost. A bare repository name such as "ocp-release" is rejected.
+func ParseImageReference(ref string) (*ImageReference, error) {
+	parsed := &ImageReference{}
+	name := ref
+
+	if i := strings.Index(name, "@"); i >= 0 {
+		parsed.Digest = name[i+1:]
+		name = name[:i]
+		if !imageDigestRegex.MatchString(parsed.Digest) {
+			return nil, fmt.Errorf("digest '%s' must be sha256: followed by 64 lowercase hex characters", parsed.Digest)
+		}
+	}
+
+	// A colon after the last slash starts the tag; earlier colons belong to the registry port
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		parsed.Tag = name[i+1:]
+		name = name[:i]
+		if !imageTagRegex.MatchString(parsed.Tag) {
+			return nil, fmt.Errorf("tag '%s' is invalid", parsed.Tag)
+		}
+	}
+
+	slash := strings.Index(name, "/")
+	if slash < 0 {
+		return nil, fmt.Errorf("'%s' has no registry", name)
+	}
+	parsed.Registry = name[:slash]
+	parsed.Repository = name[slash+1:]
+
+	if !strings.ContainsAny(parsed.Registry, ".:") && parsed.Registry != "localhost" {
+		return nil, fmt.Errorf("'%s' is not a registry host", parsed.Registry)
+	}
+	if !imageRegistryRegex.MatchString(parsed.Registry) {
+		return nil, fmt.Errorf("registry '%s' is invalid", parsed.Registry)
+	}
+	for _, component := range strings.Split(parsed.Repository, "/") {
+		if !imagePathComponentRegex.MatchString(component) {
+			return nil, fmt.Errorf("repository '%s' is invalid", parsed.Repository)
+		}
+	}
+
+	return parsed, nil
+}
+
+// validateImage checks that a non-empty release image is a well-formed pullspec
+func (r *ReleaseSpec) validateImage() error {
+	if r.Image == "" {
+		return nil
+	}
+	if _, err := ParseImageReference(r.Image); err != nil {
+		return specFieldErrorf("release.image", "release.image '%s' is invalid: %v (must be %s)", r.Image, err, ReleaseImagePattern)
+	}
+	return nil
+}
+
+// ValidateImageRegistry checks that the release image is pulled from one of the allowed
+// registries. Registries are compared case-insensitively; an empty allowlist allows any.
+func (r *ReleaseSpec) ValidateImageRegistry(allowed []string) error {
+	if r.Image == "" || len(allowed) == 0 {
+		return nil
+	}
+	ref, err := ParseImageReference(r.Image)
+	if err != nil {
+		return specFieldErrorf("release.image", "release.image '%s' is invalid: %v (must be %s)", r.Image, err, ReleaseImagePattern)
+	}
+	for _, registry := range allowed {
+		if strings.EqualFold(ref.Registry, registry) {
+			return nil
+		}
+	}
+	return specFieldErrorf("release.image",
+		"release.image registry '%s' is not allowed: must be one of %s",
+		ref.Registry, strings.Join(allowed, ", "),
+	)
+}
@@ -0,0 +1,154 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/apahim/cls-backend/internal/utils"
+)
+
+func TestParseImageReference(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("ab", 32)
+
+	tests := []struct {
+		name     string
+		ref      string
+		want     *ImageReference
+		wantErr  bool
+		errField string
+	}{
+		{
+			name: "tagged release image",
+			ref:  "quay.io/openshift-release-dev/ocp-release:4.17.0-x86_64",
+			want: &ImageReference{Registry: "quay.io", Repository: "openshift-release-dev/ocp-release", Tag: "4.17.0-x86_64"},
+		},
+		{
+			name: "digest pinned image",
+			ref:  "quay.io/openshift-release-dev/ocp-release@" + digest,
+			want: &ImageReference{Registry: "quay.io", Repository: "openshift-release-dev/ocp-release", Digest: digest},
+		},
+		{
+			name: "tag and digest",
+			ref:  "quay.io/openshift-release-dev/ocp-release:4.17.0@" + digest,
+			want: &ImageReference{Registry: "quay.io", Repository: "openshift-release-dev/ocp-release", Tag: "4.17.0", Digest: digest},
+		},
+		{
+			name: "registry with port",
+			ref:  "registry.example.com:5000/ocp/release",
+			want: &ImageReference{Registry: "registry.example.com:5000", Repository: "ocp/release"},
+		},
+		{
+			name: "localhost registry",
+			ref:  "localhost/ocp-release:latest",
+			want: &ImageReference{Registry: "localhost", Repository: "ocp-release", Tag: "latest"},
+		},
+		{
+			name:     "bare word rejected",
+			ref:      "ocp-release",
+			wantErr:  true,
+			errField: "has no registry",
+		},
+		{
+			name:     "missing registry host rejected",
+			ref:      "openshift-release-dev/ocp-release:4.17.0",
+			wantErr:  true,
+			errField: "'openshift-release-dev' is not a registry host",
+		},
+		{
+			name:     "empty repository rejected",
+			ref:      "quay.io/",
+			wantErr:  true,
+			errField: "repository '' is invalid",
+		},
+		{
+			name:     "uppercase repository rejected",
+			ref:      "quay.io/OpenShift/ocp-release",
+			wantErr:  true,
+			errField: "repository 'OpenShift/ocp-release' is invalid",
+		},
+		{
+			name:     "empty tag rejected",
+			ref:      "quay.io/openshift-release-dev/ocp-release:",
+			wantErr:  true,
+			errField: "tag '' is invalid",
+		},
+		{
+			name:     "short digest rejected",
+			ref:      "quay.io/openshift-release-dev/ocp-release@sha256:abc",
+			wantErr:  true,
+			errField: "digest 'sha256:abc'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseImageReference(tt.ref)
+			utils.AssertError(t, err, tt.wantErr, "ParseImageReference result should match expected")
+			if tt.wantErr {
+				if err != nil {
+					utils.AssertContains(t, err.Error(), tt.errField, "Error should describe the problem")
+				}
+				return
+			}
+			utils.AssertEqual(t, *tt.want, *got, "Parsed reference should match")
+		})
+	}
+}
+
+func TestClusterSpecValidateReleaseImage(t *testing.T) {
+	tests := []struct {
+		name    string
+		image   string
+		wantErr bool
+	}{
+		{name: "empty image accepted"},
+		{name: "valid pullspec accepted", image: "quay.io/openshift-release-dev/ocp-release:4.17.0-x86_64"},
+		{name: "bare word rejected", image: "ocp-release", wantErr: true},
+		{name: "stray whitespace rejected", image: "quay.io/openshift-release-dev/ocp-release :4.17.0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &ClusterSpec{Release: ReleaseSpec{Image: tt.image}}
+
+			err := spec.Validate()
+			utils.AssertError(t, err, tt.wantErr, "Validate result should match expected")
+			if tt.wantErr && err != nil {
+				utils.AssertContains(t, err.Error(), "release.image", "Error should name the invalid field")
+				utils.AssertContains(t, err.Error(), ReleaseImagePattern, "Error should describe the expected form")
+			}
+		})
+	}
+}
+
+func TestReleaseSpecValidateImageRegistry(t *testing.T) {
+	allowed := []string{"quay.io", "registry.example.com:5000"}
+
+	tests := []struct {
+		name    string
+		image   string
+		allowed []string
+		wantErr bool
+	}{
+		{name: "allowed registry accepted", image: "quay.io/openshift-release-dev/ocp-release:4.17.0", allowed: allowed},
+		{name: "registry matched case-insensitively", image: "Quay.IO/openshift-release-dev/ocp-release:4.17.0", allowed: allowed},
+		{name: "registry with port accepted", image: "registry.example.com:5000/ocp/release", allowed: allowed},
+		{name: "disallowed registry rejected", image: "docker.io/library/ocp-release:4.17.0", allowed: allowed, wantErr: true},
+		{name: "same host on another port rejected", image: "registry.example.com/ocp/release", allowed: allowed, wantErr: true},
+		{name: "empty allowlist allows any registry", image: "docker.io/library/ocp-release:4.17.0"},
+		{name: "empty image accepted", allowed: allowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			release := &ReleaseSpec{Image: tt.image}
+
+			err := release.ValidateImageRegistry(tt.allowed)
+			utils.AssertError(t, err, tt.wantErr, "ValidateImageRegistry result should match expected")
+			if tt.wantErr && err != nil {
+				utils.AssertContains(t, err.Error(), "is not allowed", "Error should say the registry is not allowed")
+				utils.AssertContains(t, err.Error(), "quay.io", "Error should list the allowed registries")
+			}
+		})
+	}
+}
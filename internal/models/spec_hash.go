@@ -0,0 +1,60 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+)
+
+// SpecHash returns a stable hash of a spec's normalized JSON form. Map keys are serialized in
+// sorted order and null fields are dropped, so specs that only differ in map ordering or in
+// null versus missing fields hash the same.
+func SpecHash(spec interface{}) (string, error) {
+	value, err := toJSONValue(spec)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(normalizeJSONValue(value))
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// SpecsEqual reports whether two specs are equal once normalized. Specs that cannot be
+// serialized fall back to a by-value comparison.
+func SpecsEqual(a, b interface{}) bool {
+	hashA, errA := SpecHash(a)
+	hashB, errB := SpecHash(b)
+	if errA != nil || errB != nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return hashA == hashB
+}
+
+// normalizeJSONValue drops nulls from a decoded JSON value. Empty maps and lists are kept,
+// since an explicitly emptied field is a different spec from an unset one.
+func normalizeJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, field := range v {
+			if field != nil {
+				normalized[key] = normalizeJSONValue(field)
+			}
+		}
+		return normalized
+	case []interface{}:
+		normalized := make([]interface{}, len(v))
+		for i, item := range v {
+			normalized[i] = normalizeJSONValue(item)
+		}
+		return normalized
+	default:
+		return v
+	}
+}
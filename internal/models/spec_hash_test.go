@@ -0,0 +1,76 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/apahim/cls-backend/internal/utils"
+)
+
+func TestSpecHash(t *testing.T) {
+	spec := ClusterSpec{
+		InfraID:  "infra-1",
+		Platform: PlatformSpec{Type: "GCP", GCP: &GCPSpec{ProjectID: "project-1", Region: "us-central1"}},
+	}
+
+	hash, err := SpecHash(spec)
+	utils.AssertError(t, err, false, "Should hash spec")
+	same, err := SpecHash(&spec)
+	utils.AssertError(t, err, false, "Should hash spec pointer")
+	utils.AssertEqual(t, hash, same, "Values and pointers should hash the same")
+
+	changed := spec
+	changed.Platform.GCP = &GCPSpec{ProjectID: "project-1", Region: "us-east1"}
+	changedHash, err := SpecHash(changed)
+	utils.AssertError(t, err, false, "Should hash changed spec")
+	utils.AssertNotEqual(t, hash, changedHash, "Changed specs should hash differently")
+
+	// Nulls are dropped, but empty maps and lists are part of the spec
+	unset, err := SpecHash(map[string]interface{}{"name": "pool"})
+	utils.AssertError(t, err, false, "Should hash spec without the field")
+	null, err := SpecHash(map[string]interface{}{"name": "pool", "labels": nil})
+	utils.AssertError(t, err, false, "Should hash spec with a null field")
+	utils.AssertEqual(t, unset, null, "Null fields should hash like missing ones")
+	for _, empty := range []interface{}{map[string]interface{}{}, []interface{}{}} {
+		emptied, err := SpecHash(map[string]interface{}{"name": "pool", "labels": empty})
+		utils.AssertError(t, err, false, "Should hash spec with an empty field")
+		utils.AssertNotEqual(t, unset, emptied, "Empty fields should not hash like missing ones")
+	}
+}
+
+func TestSpecsEqual(t *testing.T) {
+	pool := func(labels map[string]string, taints []TaintSpec) NodePoolSpec {
+		return NodePoolSpec{
+			Platform: NodePoolPlatformSpec{Type: "GCP", GCP: &NodePoolGCPSpec{
+				InstanceType: "n1-standard-4",
+				Labels:       labels,
+				Taints:       taints,
+			}},
+		}
+	}
+
+	// Map ordering never matters
+	a := map[string]string{}
+	b := map[string]string{}
+	for _, key := range []string{"env", "team", "tier", "zone"} {
+		a[key] = key + "-value"
+	}
+	for _, key := range []string{"zone", "tier", "team", "env"} {
+		b[key] = key + "-value"
+	}
+	utils.AssertTrue(t, SpecsEqual(pool(a, nil), pool(b, nil)), "Map ordering should not matter")
+
+	// Nil and empty collections are the same spec
+	utils.AssertTrue(t, SpecsEqual(pool(nil, nil), pool(map[string]string{}, []TaintSpec{})), "Nil and empty should be equal")
+
+	utils.AssertFalse(t, SpecsEqual(pool(a, nil), pool(map[string]string{"env": "prod"}, nil)), "Different labels should differ")
+
+	replicas := int32(3)
+	scaled := pool(nil, nil)
+	scaled.Replicas = &replicas
+	utils.AssertFalse(t, SpecsEqual(pool(nil, nil), scaled), "Setting a field should differ")
+
+	other := int32(3)
+	rescaled := pool(nil, nil)
+	rescaled.Replicas = &other
+	utils.AssertTrue(t, SpecsEqual(scaled, rescaled), "Pointers should compare by value")
+}
@@ -9,6 +9,26 @@ import (
 	"github.com/google/uuid"
 )
 
+// MaxStatusMetadataBytes caps the serialized size of the metadata a controller attaches to a
+// status report
+const MaxStatusMetadataBytes = 64 << 10 // 64KB
+
+// ValidateStatusMetadataSize returns an error if metadata serializes to more than
+// MaxStatusMetadataBytes
+func ValidateStatusMetadataSize(metadata JSONB) error {
+	if len(metadata) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("failed to encode metadata: %w", err)
+	}
+	if len(data) > MaxStatusMetadataBytes {
+		return fmt.Errorf("metadata is %d bytes, must be at most %d bytes", len(data), MaxStatusMetadataBytes)
+	}
+	return nil
+}
+
 // ClusterControllerStatus represents the status of a controller for a cluster
 type ClusterControllerStatus struct {
 	ClusterID          uuid.UUID     `json:"cluster_id" db:"cluster_id"`
@@ -20,6 +40,32 @@ type ClusterControllerStatus struct {
 	LastUpdated        time.Time     `json:"last_updated" db:"updated_at"`
 }
 
+// ClusterStatusBatchRequest represents several controllers' status reports for one cluster
+type ClusterStatusBatchRequest struct {
+	Statuses []ClusterControllerStatus `json:"statuses"`
+}
+
+// ControllerCondition is a condition reported by a controller, tagged with the controller's name
+type ControllerCondition struct {
+	ControllerName string `json:"controllerName"`
+	Condition
+}
+
+// FlattenControllerConditions returns the conditions of every controller in one list, in the
+// order of the given statuses
+func FlattenControllerConditions(statuses []*ClusterControllerStatus) []ControllerCondition {
+	conditions := []ControllerCondition{}
+	for _, status := range statuses {
+		for _, condition := range status.Conditions {
+			conditions = append(conditions, ControllerCondition{
+				ControllerName: status.ControllerName,
+				Condition:      condition,
+			})
+		}
+	}
+	return conditions
+}
+
 // NodePoolControllerStatus represents the status of a controller for a node pool
 type NodePoolControllerStatus struct {
 	NodePoolID         uuid.UUID     `json:"nodepool_id" db:"nodepool_id"`
@@ -31,6 +77,88 @@ type NodePoolControllerStatus struct {
 	LastUpdated        time.Time     `json:"last_updated" db:"updated_at"`
 }
 
+// ClusterFullView bundles a cluster with its nodepools and every controller status
+// report, so controllers can load everything they reconcile in one call
+type ClusterFullView struct {
+	Cluster                  *Cluster                    `json:"cluster"`
+	Status                   *ClusterStatusInfo          `json:"status"`
+	NodePools                []*NodePool                 `json:"nodepools"`
+	ControllerStatus         []*ClusterControllerStatus  `json:"controller_status"`
+	NodePoolControllerStatus []*NodePoolControllerStatus `json:"nodepool_controller_status"`
+}
+
+// NodePoolStatusView pairs a nodepool's aggregated status with its controller status reports
+type NodePoolStatusView struct {
+	NodePoolID       uuid.UUID                   `json:"nodepool_id"`
+	Name             string                      `json:"name"`
+	Status           *NodePoolStatusInfo         `json:"status"`
+	ControllerStatus []*NodePoolControllerStatus `json:"controller_status"`
+}
+
+// ControllerSummary is a lightweight view of a controller reporting status for a cluster
+type ControllerSummary struct {
+	ControllerName string    `json:"controller_name" db:"controller_name"`
+	Ready          bool      `json:"ready"`
+	Available      bool      `json:"available"`
+	LastUpdated    time.Time `json:"last_updated" db:"updated_at"`
+}
+
+// ControllerCounts summarizes the controllers reporting for a cluster
+type ControllerCounts struct {
+	Total int `json:"total"`
+	Ready int `json:"ready"`
+	Stale int `json:"stale"` // Behind the cluster's current generation
+}
+
+// NodePoolRollup summarizes the readiness of a cluster's nodepools
+type NodePoolRollup struct {
+	Total      int    `json:"nodepools_total"`
+	Ready      int    `json:"nodepools_ready"`
+	WorstPhase string `json:"nodepools_worst_phase,omitempty"` // Empty when the cluster has no nodepools
+}
+
+// CompactClusterStatus is a compact view of a cluster's aggregated status, for listing
+// many clusters at once without their conditions or spec
+type CompactClusterStatus struct {
+	ClusterID          uuid.UUID        `json:"cluster_id"`
+	Name               string           `json:"name"`
+	Generation         int64            `json:"generation"`
+	ObservedGeneration int64            `json:"observed_generation"`
+	Phase              string           `json:"phase"`
+	Reason             string           `json:"reason,omitempty"`
+	Message            string           `json:"message,omitempty"`
+	Ready              bool             `json:"ready"`
+	Controllers        ControllerCounts `json:"controllers"`
+	LastUpdateTime     *time.Time       `json:"last_update_time,omitempty"`
+}
+
+// NewCompactClusterStatus builds the compact status view of an enriched cluster
+func NewCompactClusterStatus(cluster *Cluster, counts ControllerCounts) *CompactClusterStatus {
+	summary := &CompactClusterStatus{
+		ClusterID:   cluster.ID,
+		Name:        cluster.Name,
+		Generation:  cluster.Generation,
+		Phase:       string(StatusPending),
+		Controllers: counts,
+	}
+
+	if status := cluster.Status; status != nil {
+		summary.ObservedGeneration = status.ObservedGeneration
+		if status.Phase != "" {
+			summary.Phase = status.Phase
+		}
+		summary.Reason = status.Reason
+		summary.Message = status.Message
+		summary.Ready = ConditionList(status.Conditions).HasCondition("Ready", "True")
+		if !status.LastUpdateTime.IsZero() {
+			lastUpdate := status.LastUpdateTime
+			summary.LastUpdateTime = &lastUpdate
+		}
+	}
+
+	return summary
+}
+
 // ClusterEvent represents a cluster change event
 type ClusterEvent struct {
 	ID          uuid.UUID `json:"id" db:"id"`
@@ -41,6 +169,40 @@ type ClusterEvent struct {
 	PublishedAt time.Time `json:"published_at" db:"published_at"`
 }
 
+// ClusterEventListOptions selects a page of a cluster's events
+type ClusterEventListOptions struct {
+	EventType string // Matches the event type exactly; empty does not filter
+	Limit     int
+	Offset    int
+}
+
+// EventTypePhaseTransition is the cluster event type recorded when the aggregated phase changes
+const EventTypePhaseTransition = "phase_transition"
+
+// PhaseTransitionRecorder is the controller name stored on phase transition events
+const PhaseTransitionRecorder = "status-aggregator"
+
+// PhaseTransition is a change of a cluster's aggregated phase
+type PhaseTransition struct {
+	FromPhase      string    `json:"from_phase"` // Empty for the first aggregated phase
+	ToPhase        string    `json:"to_phase"`
+	TransitionedAt time.Time `json:"transitioned_at"`
+}
+
+// ControllerStatusHistoryEntry records a change of a cluster controller condition's status
+type ControllerStatusHistoryEntry struct {
+	ID                 int64     `json:"id" db:"id"`
+	ClusterID          uuid.UUID `json:"cluster_id" db:"cluster_id"`
+	ControllerName     string    `json:"controller_name" db:"controller_name"`
+	ConditionType      string    `json:"condition_type" db:"condition_type"`
+	FromStatus         string    `json:"from_status" db:"from_status"` // Empty when the condition was first reported
+	ToStatus           string    `json:"to_status" db:"to_status"`
+	Reason             string    `json:"reason,omitempty" db:"reason"`
+	Message            string    `json:"message,omitempty" db:"message"`
+	ObservedGeneration int64     `json:"observed_generation" db:"observed_generation"`
+	TransitionedAt     time.Time `json:"transitioned_at" db:"transitioned_at"`
+}
+
 // StatusEvent represents a status update event from controllers
 type StatusEvent struct {
 	ClusterID          string      `json:"clusterId"`
@@ -99,11 +261,18 @@ func (ei *ErrorInfo) Scan(value interface{}) error {
 		return nil
 	}
 
-	bytes, ok := value.([]byte)
-	if !ok {
+	var bytes []byte
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
 		return fmt.Errorf("cannot scan %T into ErrorInfo", value)
 	}
 
+	// Reset first so a reused ErrorInfo does not keep fields the stored error omits
+	*ei = ErrorInfo{}
 	return json.Unmarshal(bytes, ei)
 }
 
@@ -138,6 +307,19 @@ type StatusAggregationResult struct {
 	AggregatedAt       time.Time              `json:"aggregated_at"`
 }
 
+// ClearErrorsRequest represents a request to clear the errors reported by a cluster's controllers
+type ClearErrorsRequest struct {
+	Reason           string `json:"reason" binding:"required"`   // Recorded in the audit log
+	IncludeNodePools bool   `json:"include_nodepools,omitempty"` // Also clear the cluster's nodepool controller errors
+}
+
+// ClearErrorsResult reports how many controller status reports had their error cleared
+type ClearErrorsResult struct {
+	ClusterID             uuid.UUID `json:"cluster_id"`
+	ClusterErrorsCleared  int64     `json:"cluster_errors_cleared"`
+	NodePoolErrorsCleared int64     `json:"nodepool_errors_cleared"`
+}
+
 // TableName returns the table name for ClusterControllerStatus
 func (ClusterControllerStatus) TableName() string {
 	return "controller_status"
@@ -204,6 +386,93 @@ func (cl *ConditionList) SetCondition(condition Condition) {
 	*cl = append(*cl, condition)
 }
 
+// MaxConditionClockSkew is how far in the future a reported LastTransitionTime may be
+// before it is rejected, to tolerate small clock differences between controllers and the API
+const MaxConditionClockSkew = 5 * time.Minute
+
+// ValidateTransitionTimes rejects conditions whose LastTransitionTime is further in the
+// future than MaxConditionClockSkew
+func (cl ConditionList) ValidateTransitionTimes(now time.Time) error {
+	limit := now.Add(MaxConditionClockSkew)
+	for _, condition := range cl {
+		if condition.LastTransitionTime.After(limit) {
+			return fmt.Errorf("condition %s has lastTransitionTime %s in the future",
+				condition.Type, condition.LastTransitionTime.Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+// MergeReported applies a controller's reported conditions on top of the stored ones and
+// returns the merged list. Conditions not included in the report are kept, so controllers
+// may report partial updates. Transition times follow SetCondition semantics and are
+// managed server-side: a reported LastTransitionTime is preserved when it is later than the
+// stored one, otherwise the stored time is kept while the status is unchanged and now is
+// used when the status changes (or the condition is new).
+func (cl ConditionList) MergeReported(reported ConditionList, now time.Time) (ConditionList, error) {
+	if err := reported.ValidateTransitionTimes(now); err != nil {
+		return nil, err
+	}
+
+	merged := make(ConditionList, len(cl))
+	copy(merged, cl)
+
+	for _, condition := range reported {
+		existing := merged.GetCondition(condition.Type)
+		reportedTime := condition.LastTransitionTime
+
+		switch {
+		case existing != nil && !reportedTime.IsZero() && reportedTime.After(existing.LastTransitionTime):
+			// Controller supplied a newer transition time - trust it
+		case existing == nil && !reportedTime.IsZero():
+			// New condition with an explicit transition time
+		case existing != nil && existing.Status == condition.Status:
+			condition.LastTransitionTime = existing.LastTransitionTime
+		default:
+			condition.LastTransitionTime = now
+		}
+
+		replaced := false
+		for i := range merged {
+			if merged[i].Type == condition.Type {
+				merged[i] = condition
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, condition)
+		}
+	}
+
+	return merged, nil
+}
+
+// StatusChanges returns a history entry for every condition in cl whose status differs from
+// its status in previous, including conditions previous does not have. Entries carry only the
+// condition details; the caller fills in the cluster, controller and generation.
+func (cl ConditionList) StatusChanges(previous ConditionList) []ControllerStatusHistoryEntry {
+	var changes []ControllerStatusHistoryEntry
+	for _, condition := range cl {
+		fromStatus := ""
+		if existing := previous.GetCondition(condition.Type); existing != nil {
+			if existing.Status == condition.Status {
+				continue
+			}
+			fromStatus = existing.Status
+		}
+		changes = append(changes, ControllerStatusHistoryEntry{
+			ConditionType:  condition.Type,
+			FromStatus:     fromStatus,
+			ToStatus:       condition.Status,
+			Reason:         condition.Reason,
+			Message:        condition.Message,
+			TransitionedAt: condition.LastTransitionTime,
+		})
+	}
+	return changes
+}
+
 // RemoveCondition removes a condition by type
 func (cl *ConditionList) RemoveCondition(conditionType string) {
 	for i, condition := range *cl {
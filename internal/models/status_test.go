@@ -146,6 +146,33 @@ func TestErrorInfo_Scan(t *testing.T) {
 	utils.AssertEqual(t, true, errorInfo.UserActionable, "User actionable should match")
 }
 
+func TestErrorInfo_ScanAllFields(t *testing.T) {
+	retryAfter := 30 * time.Second
+	timestamp := time.Date(2025, 10, 17, 12, 0, 0, 0, time.UTC)
+	stored := `{"controllerName":"gcp-controller","errorType":"Configuration","errorCode":"INVALID_REGION",` +
+		`"message":"Region not supported","userActionable":true,"suggestions":["Use us-central1","Check quotas"],` +
+		`"details":{"region":"mars-1"},"retryAfter":30000000000,"timestamp":"2025-10-17T12:00:00Z"}`
+
+	for _, value := range []interface{}{[]byte(stored), stored} {
+		errorInfo := ErrorInfo{Message: "stale", Occurrences: 4}
+		err := errorInfo.Scan(value)
+		utils.AssertError(t, err, false, "Should scan stored error", value)
+
+		utils.AssertEqual(t, "gcp-controller", errorInfo.ControllerName, "Controller name should match")
+		utils.AssertEqual(t, ErrorTypeConfiguration, errorInfo.ErrorType, "Error type should match")
+		utils.AssertEqual(t, "INVALID_REGION", errorInfo.ErrorCode, "Error code should match")
+		utils.AssertEqual(t, "Region not supported", errorInfo.Message, "Message should match")
+		utils.AssertTrue(t, errorInfo.UserActionable, "User actionable should match")
+		utils.AssertEqual(t, 2, len(errorInfo.Suggestions), "Suggestions should be decoded")
+		utils.AssertEqual(t, "Check quotas", errorInfo.Suggestions[1], "Suggestions should keep their order")
+		utils.AssertEqual(t, "mars-1", errorInfo.Details["region"], "Details should be decoded")
+		utils.AssertNotNil(t, errorInfo.RetryAfter, "Retry after should be decoded")
+		utils.AssertEqual(t, retryAfter, *errorInfo.RetryAfter, "Retry after should match")
+		utils.AssertTrue(t, timestamp.Equal(errorInfo.Timestamp), "Timestamp should match")
+		utils.AssertEqual(t, 0, errorInfo.Occurrences, "Fields missing from the stored error should be reset")
+	}
+}
+
 func TestErrorInfo_ScanNil(t *testing.T) {
 	var errorInfo ErrorInfo
 	err := errorInfo.Scan(nil)
@@ -237,6 +264,70 @@ func TestConditionList_SetCondition(t *testing.T) {
 	utils.AssertTrue(t, conditions[0].LastTransitionTime.After(originalTransitionTime), "Transition time should be updated for status change")
 }
 
+func TestConditionList_MergeReported(t *testing.T) {
+	now := time.Now()
+	storedTime := now.Add(-time.Hour)
+
+	stored := ConditionList{
+		{Type: "Available", Status: "True", Reason: "Ready", LastTransitionTime: storedTime},
+		{Type: "Progressing", Status: "False", Reason: "Done", LastTransitionTime: storedTime},
+	}
+
+	// New condition reported without a transition time gets the server time
+	merged, err := stored.MergeReported(ConditionList{
+		{Type: "Degraded", Status: "False", Reason: "AsExpected"},
+	}, now)
+	utils.AssertError(t, err, false, "Should merge conditions")
+	utils.AssertEqual(t, 3, len(merged), "Unreported conditions should be kept")
+	utils.AssertEqual(t, now, merged.GetCondition("Degraded").LastTransitionTime, "Missing transition time should be set to now")
+	utils.AssertEqual(t, storedTime, merged.GetCondition("Available").LastTransitionTime, "Unreported condition should keep its transition time")
+
+	// Same status without a transition time keeps the stored time
+	merged, err = stored.MergeReported(ConditionList{
+		{Type: "Available", Status: "True", Reason: "StillReady"},
+	}, now)
+	utils.AssertError(t, err, false, "Should merge conditions")
+	utils.AssertEqual(t, "StillReady", merged.GetCondition("Available").Reason, "Reason should be updated")
+	utils.AssertEqual(t, storedTime, merged.GetCondition("Available").LastTransitionTime, "Unchanged status should keep stored transition time")
+
+	// Status change without a transition time uses now
+	merged, err = stored.MergeReported(ConditionList{
+		{Type: "Available", Status: "False", Reason: "NotReady"},
+	}, now)
+	utils.AssertError(t, err, false, "Should merge conditions")
+	utils.AssertEqual(t, now, merged.GetCondition("Available").LastTransitionTime, "Status change should set transition time to now")
+
+	// Status change with a stale transition time uses now
+	merged, err = stored.MergeReported(ConditionList{
+		{Type: "Available", Status: "False", Reason: "NotReady", LastTransitionTime: storedTime.Add(-time.Minute)},
+	}, now)
+	utils.AssertError(t, err, false, "Should merge conditions")
+	utils.AssertEqual(t, now, merged.GetCondition("Available").LastTransitionTime, "Stale transition time should be replaced")
+
+	// Later reported transition time is preserved
+	reportedTime := now.Add(-time.Minute)
+	merged, err = stored.MergeReported(ConditionList{
+		{Type: "Available", Status: "False", Reason: "NotReady", LastTransitionTime: reportedTime},
+	}, now)
+	utils.AssertError(t, err, false, "Should merge conditions")
+	utils.AssertEqual(t, reportedTime, merged.GetCondition("Available").LastTransitionTime, "Later transition time should be preserved")
+
+	// Small clock skew is tolerated
+	_, err = stored.MergeReported(ConditionList{
+		{Type: "Available", Status: "True", LastTransitionTime: now.Add(time.Minute)},
+	}, now)
+	utils.AssertError(t, err, false, "Transition time within skew tolerance should be accepted")
+
+	// Far-future transition times are rejected
+	_, err = stored.MergeReported(ConditionList{
+		{Type: "Available", Status: "True", LastTransitionTime: now.Add(MaxConditionClockSkew + time.Minute)},
+	}, now)
+	utils.AssertError(t, err, true, "Far-future transition time should be rejected")
+
+	// Stored list is not modified
+	utils.AssertEqual(t, "Ready", stored.GetCondition("Available").Reason, "Stored conditions should not be mutated")
+}
+
 func TestConditionList_RemoveCondition(t *testing.T) {
 	conditions := ConditionList{
 		{Type: "Available", Status: "True"},
@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -113,6 +114,38 @@ func TestConditionListHelpers(t *testing.T) {
 	utils.AssertFalse(t, conditions.HasCondition("Progressing", "False"), "Should not have Progressing condition")
 }
 
+func TestConditionListStatusChanges(t *testing.T) {
+	earlier := time.Date(2025, 10, 17, 12, 0, 0, 0, time.UTC)
+	now := earlier.Add(time.Minute)
+
+	previous := ConditionList{
+		{Type: "Ready", Status: "False", Reason: "Provisioning", LastTransitionTime: earlier},
+		{Type: "Available", Status: "True", Reason: "Serving", LastTransitionTime: earlier},
+	}
+	current := ConditionList{
+		{Type: "Ready", Status: "True", Reason: "Provisioned", Message: "All nodes up", LastTransitionTime: now},
+		{Type: "Available", Status: "True", Reason: "StillServing", LastTransitionTime: earlier},
+		{Type: "Degraded", Status: "False", Reason: "Healthy", LastTransitionTime: now},
+	}
+
+	changes := current.StatusChanges(previous)
+	utils.AssertEqual(t, 2, len(changes), "Only conditions whose status changed should be reported")
+
+	utils.AssertEqual(t, "Ready", changes[0].ConditionType, "Changed condition type")
+	utils.AssertEqual(t, "False", changes[0].FromStatus, "Changed condition previous status")
+	utils.AssertEqual(t, "True", changes[0].ToStatus, "Changed condition new status")
+	utils.AssertEqual(t, "Provisioned", changes[0].Reason, "Change should carry the new reason")
+	utils.AssertEqual(t, "All nodes up", changes[0].Message, "Change should carry the new message")
+	utils.AssertEqual(t, now, changes[0].TransitionedAt, "Change should carry the transition time")
+
+	utils.AssertEqual(t, "Degraded", changes[1].ConditionType, "New condition type")
+	utils.AssertEqual(t, "", changes[1].FromStatus, "New condition has no previous status")
+	utils.AssertEqual(t, "False", changes[1].ToStatus, "New condition status")
+
+	// Reporting the same conditions again records nothing
+	utils.AssertEqual(t, 0, len(current.StatusChanges(current)), "Identical conditions should report no changes")
+}
+
 func TestClusterControllerStatusHelpers(t *testing.T) {
 	status := ClusterControllerStatus{
 		ClusterID:      uuid.New(),
@@ -276,3 +309,77 @@ func TestConditionListNilHandling(t *testing.T) {
 }
 
 // Note: Helper methods are already implemented in status.go
+
+func TestNewCompactClusterStatus(t *testing.T) {
+	lastUpdate := time.Date(2025, 10, 17, 0, 0, 0, 0, time.UTC)
+	cluster := &Cluster{
+		ID:         uuid.New(),
+		Name:       "compact-cluster",
+		Generation: 2,
+		Status: &ClusterStatusInfo{
+			ObservedGeneration: 2,
+			Phase:              "Ready",
+			Reason:             "AllControllersReady",
+			Conditions:         []Condition{{Type: "Ready", Status: "True"}},
+			LastUpdateTime:     lastUpdate,
+		},
+	}
+	counts := ControllerCounts{Total: 3, Ready: 3}
+
+	compact := NewCompactClusterStatus(cluster, counts)
+	utils.AssertEqual(t, cluster.ID, compact.ClusterID, "Cluster ID should be copied")
+	utils.AssertEqual(t, "Ready", compact.Phase, "Phase should be copied")
+	utils.AssertEqual(t, int64(2), compact.ObservedGeneration, "Observed generation should be copied")
+	utils.AssertTrue(t, compact.Ready, "Ready condition should be reflected")
+	utils.AssertEqual(t, counts, compact.Controllers, "Controller counts should be attached")
+	utils.AssertNotNil(t, compact.LastUpdateTime, "Last update time should be set")
+
+	// Clusters whose status was never calculated are reported as pending
+	compact = NewCompactClusterStatus(&Cluster{ID: uuid.New(), Name: "new-cluster", Generation: 1}, ControllerCounts{})
+	utils.AssertEqual(t, "Pending", compact.Phase, "Cluster without status should be pending")
+	utils.AssertFalse(t, compact.Ready, "Cluster without status should not be ready")
+	utils.AssertNil(t, compact.LastUpdateTime, "Cluster without status should have no update time")
+}
+
+func TestFlattenControllerConditions(t *testing.T) {
+	statuses := []*ClusterControllerStatus{
+		{
+			ControllerName: "dns-controller",
+			Conditions: ConditionList{
+				{Type: "Ready", Status: "True", Reason: "ZoneCreated"},
+				{Type: "Available", Status: "True", Reason: "Resolving"},
+			},
+		},
+		{ControllerName: "idle-controller"},
+		{
+			ControllerName: "network-controller",
+			Conditions: ConditionList{
+				{Type: "Ready", Status: "False", Reason: "QuotaExceeded"},
+			},
+		},
+	}
+
+	conditions := FlattenControllerConditions(statuses)
+	utils.AssertEqual(t, 3, len(conditions), "Every condition should be flattened")
+	utils.AssertEqual(t, "dns-controller", conditions[0].ControllerName, "First condition controller")
+	utils.AssertEqual(t, "Ready", conditions[0].Type, "First condition type")
+	utils.AssertEqual(t, "Available", conditions[1].Type, "Second condition type")
+	utils.AssertEqual(t, "network-controller", conditions[2].ControllerName, "Last condition controller")
+	utils.AssertEqual(t, "QuotaExceeded", conditions[2].Reason, "Last condition reason")
+
+	data, err := json.Marshal(conditions[2])
+	utils.AssertError(t, err, false, "Should marshal controller condition")
+	utils.AssertContains(t, string(data), `"controllerName":"network-controller"`, "Controller name should be serialized")
+	utils.AssertContains(t, string(data), `"type":"Ready"`, "Condition fields should be inlined")
+
+	utils.AssertEqual(t, 0, len(FlattenControllerConditions(nil)), "No statuses should flatten to an empty list")
+}
+
+func TestValidateStatusMetadataSize(t *testing.T) {
+	utils.AssertError(t, ValidateStatusMetadataSize(nil), false, "Missing metadata should be accepted")
+	utils.AssertError(t, ValidateStatusMetadataSize(JSONB{"nodes": 3}), false, "Small metadata should be accepted")
+
+	err := ValidateStatusMetadataSize(JSONB{"blob": strings.Repeat("A", MaxStatusMetadataBytes)})
+	utils.AssertError(t, err, true, "Metadata over the cap should be rejected")
+	utils.AssertContains(t, err.Error(), "at most 65536 bytes", "Error should name the cap")
+}
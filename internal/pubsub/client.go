@@ -2,6 +2,7 @@ package pubsub
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -13,6 +14,9 @@ import (
 	"google.golang.org/api/option"
 )
 
+// ErrTopicNotFound is returned when publishing to a topic that was not initialized
+var ErrTopicNotFound = errors.New("topic not found")
+
 // Client wraps Google Cloud Pub/Sub client with additional functionality
 type Client struct {
 	client          *pubsub.Client
@@ -210,7 +214,7 @@ func (c *Client) GetTopic(topicName string) (*pubsub.Topic, error) {
 	c.mu.RUnlock()
 
 	if !exists {
-		return nil, fmt.Errorf("topic %s not found", topicName)
+		return nil, fmt.Errorf("%w: %s", ErrTopicNotFound, topicName)
 	}
 
 	return topic, nil
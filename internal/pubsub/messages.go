@@ -29,6 +29,7 @@ const (
 	EventTypeClusterDeleted    = "cluster.deleted"
 	EventTypeNodePoolCreated   = "nodepool.created"
 	EventTypeNodePoolUpdated   = "nodepool.updated"
+	EventTypeNodePoolScaled    = "nodepool.scaled"
 	EventTypeNodePoolDeleted   = "nodepool.deleted"
 	EventTypeNodePoolReconcile = "nodepool.reconcile"
 )
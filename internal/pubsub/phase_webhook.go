@@ -0,0 +1,90 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/config"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// EventTypeClusterPhaseChanged is the event type header of phase transition notifications
+const EventTypeClusterPhaseChanged = "cluster.phase_changed"
+
+// PhaseTransitionNotification is the payload POSTed to phase webhooks
+type PhaseTransitionNotification struct {
+	ClusterID uuid.UUID `json:"cluster_id"`
+	OldPhase  string    `json:"old_phase"`
+	NewPhase  string    `json:"new_phase"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// PhaseWebhookNotifier POSTs a signed notification to every configured webhook when a
+// cluster's aggregated phase changes. Deliveries run in the background with the same retry
+// policy as webhook reconcile delivery, so a slow receiver never holds up status reads.
+type PhaseWebhookNotifier struct {
+	webhooks []*WebhookPublisher
+	logger   *utils.Logger
+	wg       sync.WaitGroup
+}
+
+// NewPhaseWebhookNotifier creates a notifier for the configured webhook URLs
+func NewPhaseWebhookNotifier(cfg config.WebhooksConfig) *PhaseWebhookNotifier {
+	n := &PhaseWebhookNotifier{
+		logger: utils.NewLogger("phase_webhook_notifier"),
+	}
+	for _, url := range cfg.URLs {
+		n.webhooks = append(n.webhooks, NewWebhookPublisher(config.WebhookConfig{
+			URL:          url,
+			Secret:       cfg.Secret,
+			Timeout:      cfg.Timeout,
+			MaxRetries:   cfg.MaxRetries,
+			RetryBackoff: cfg.RetryBackoff,
+		}))
+	}
+	return n
+}
+
+// NotifyPhaseTransition sends the transition to every webhook without waiting for delivery
+func (n *PhaseWebhookNotifier) NotifyPhaseTransition(ctx context.Context, clusterID uuid.UUID, oldPhase, newPhase string) {
+	data, err := json.Marshal(&PhaseTransitionNotification{
+		ClusterID: clusterID,
+		OldPhase:  oldPhase,
+		NewPhase:  newPhase,
+		Timestamp: time.Now().UTC(),
+	})
+	if err != nil {
+		n.logger.WithContext(ctx).Error("Failed to serialize phase transition notification",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		return
+	}
+
+	// Deliveries outlive the request that computed the status but keep its request ID
+	deliveryCtx := context.WithoutCancel(ctx)
+	for _, webhook := range n.webhooks {
+		n.wg.Add(1)
+		go func(webhook *WebhookPublisher) {
+			defer n.wg.Done()
+			if err := webhook.deliver(deliveryCtx, EventTypeClusterPhaseChanged, data); err != nil {
+				n.logger.WithContext(deliveryCtx).Error("Failed to deliver phase transition notification",
+					zap.String("cluster_id", clusterID.String()),
+					zap.String("url", webhook.config.URL),
+					zap.String("old_phase", oldPhase),
+					zap.String("new_phase", newPhase),
+					zap.Error(err),
+				)
+			}
+		}(webhook)
+	}
+}
+
+// Wait blocks until every pending delivery has finished
+func (n *PhaseWebhookNotifier) Wait() {
+	n.wg.Wait()
+}
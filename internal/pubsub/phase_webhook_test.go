@@ -0,0 +1,109 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/config"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/google/uuid"
+)
+
+func newTestPhaseWebhookNotifier(urls ...string) *PhaseWebhookNotifier {
+	return NewPhaseWebhookNotifier(config.WebhooksConfig{
+		URLs:         urls,
+		Secret:       "test-secret",
+		Timeout:      2 * time.Second,
+		MaxRetries:   2,
+		RetryBackoff: time.Millisecond,
+	})
+}
+
+func TestPhaseWebhookNotifier_DeliversSignedPayload(t *testing.T) {
+	var mu sync.Mutex
+	received := map[string]PhaseTransitionNotification{}
+
+	handler := func(name string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+
+			utils.AssertEqual(t, http.MethodPost, r.Method, "Webhook should use POST")
+			utils.AssertEqual(t, EventTypeClusterPhaseChanged, r.Header.Get(WebhookEventTypeHeader), "Event type header")
+			utils.AssertEqual(t, SignWebhookPayload("test-secret", body), r.Header.Get(WebhookSignatureHeader), "Signature should match body")
+
+			var notification PhaseTransitionNotification
+			err := json.Unmarshal(body, &notification)
+			utils.AssertError(t, err, false, "Payload should be JSON")
+
+			mu.Lock()
+			received[name] = notification
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}
+	first := httptest.NewServer(handler("first"))
+	defer first.Close()
+	second := httptest.NewServer(handler("second"))
+	defer second.Close()
+
+	notifier := newTestPhaseWebhookNotifier(first.URL, second.URL)
+	clusterID := uuid.New()
+	before := time.Now().UTC()
+
+	notifier.NotifyPhaseTransition(context.Background(), clusterID, "Progressing", "Ready")
+	notifier.Wait()
+
+	utils.AssertEqual(t, 2, len(received), "Every webhook should be notified")
+	for name, notification := range received {
+		utils.AssertEqual(t, clusterID, notification.ClusterID, "Payload should carry the cluster ID", name)
+		utils.AssertEqual(t, "Progressing", notification.OldPhase, "Payload should carry the old phase", name)
+		utils.AssertEqual(t, "Ready", notification.NewPhase, "Payload should carry the new phase", name)
+		utils.AssertFalse(t, notification.Timestamp.Before(before.Truncate(time.Second)), "Payload should carry the transition time", name)
+	}
+}
+
+func TestPhaseWebhookNotifier_RetriesTransientFailures(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	notifier := newTestPhaseWebhookNotifier(server.URL)
+	notifier.NotifyPhaseTransition(context.Background(), uuid.New(), "", "Pending")
+	notifier.Wait()
+
+	utils.AssertEqual(t, int32(3), atomic.LoadInt32(&calls), "Transient failures should be retried")
+}
+
+func TestPhaseWebhookNotifier_DoesNotBlockCaller(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	notifier := newTestPhaseWebhookNotifier(server.URL)
+
+	// A cancelled caller context does not abort the background delivery
+	ctx, cancel := context.WithCancel(context.Background())
+	start := time.Now()
+	notifier.NotifyPhaseTransition(ctx, uuid.New(), "Ready", "Degraded")
+	cancel()
+	utils.AssertTrue(t, time.Since(start) < time.Second, "Notification should not wait for delivery")
+
+	close(release)
+	notifier.Wait()
+}
@@ -11,9 +11,28 @@ import (
 	"go.uber.org/zap"
 )
 
+// ReconcileEventPublisher delivers reconciliation events to controllers.
+// Publisher (Pub/Sub) is the default implementation; WebhookPublisher
+// delivers the same events over HTTP.
+type ReconcileEventPublisher interface {
+	PublishReconciliationEvent(ctx context.Context, event *models.ReconciliationEvent) error
+	PublishNodePoolReconciliationEvent(ctx context.Context, event *models.NodePoolReconciliationEvent) error
+}
+
+var _ ReconcileEventPublisher = (*Publisher)(nil)
+
+// NewReconcileEventPublisher returns the reconcile event publisher selected by
+// the reconciliation delivery config, falling back to the service's Pub/Sub publisher
+func NewReconcileEventPublisher(cfg config.ReconciliationConfig, service *Service) ReconcileEventPublisher {
+	if cfg.Delivery == config.DeliveryWebhook {
+		return NewWebhookPublisher(cfg.Webhook)
+	}
+	return service.GetPublisher()
+}
+
 // Publisher handles publishing events to Pub/Sub topics
 type Publisher struct {
-	client *Client
+	client messagePublisher
 	logger *utils.Logger
 	config config.PubSubConfig
 }
@@ -41,7 +60,7 @@ func (p *Publisher) PublishClusterEvent(ctx context.Context, eventType string, c
 		return fmt.Errorf("failed to serialize cluster event: %w", err)
 	}
 
-	err = p.client.Publish(ctx, p.config.ClusterEventsTopic, data, event.GetAttributes())
+	err = p.publish(ctx, p.config.ClusterEventsTopic, data, event.GetAttributes())
 	if err != nil {
 		p.logger.Error("Failed to publish cluster event",
 			zap.String("event_type", eventType),
@@ -75,7 +94,7 @@ func (p *Publisher) PublishNodePoolEvent(ctx context.Context, eventType string,
 		return fmt.Errorf("failed to serialize nodepool event: %w", err)
 	}
 
-	err = p.client.Publish(ctx, p.config.NodePoolEventsTopic, data, event.GetAttributes())
+	err = p.publish(ctx, p.config.NodePoolEventsTopic, data, event.GetAttributes())
 	if err != nil {
 		p.logger.Error("Failed to publish nodepool event",
 			zap.String("event_type", eventType),
@@ -123,6 +142,11 @@ func (p *Publisher) PublishNodePoolUpdated(ctx context.Context, nodepool *models
 	return p.PublishNodePoolEvent(ctx, EventTypeNodePoolUpdated, nodepool)
 }
 
+// PublishNodePoolScaled publishes a nodepool scaled event
+func (p *Publisher) PublishNodePoolScaled(ctx context.Context, nodepool *models.NodePool) error {
+	return p.PublishNodePoolEvent(ctx, EventTypeNodePoolScaled, nodepool)
+}
+
 // PublishNodePoolDeleted publishes a nodepool deleted event
 func (p *Publisher) PublishNodePoolDeleted(ctx context.Context, nodepool *models.NodePool) error {
 	return p.PublishNodePoolEvent(ctx, EventTypeNodePoolDeleted, nodepool)
@@ -152,7 +176,7 @@ func (p *Publisher) PublishReconciliationEvent(ctx context.Context, event *model
 		"cluster_id": event.ClusterID,
 	}
 
-	err = p.client.Publish(ctx, p.config.ClusterEventsTopic, data, attributes)
+	err = p.publish(ctx, p.config.ClusterEventsTopic, data, attributes)
 	if err != nil {
 		p.logger.Error("Failed to publish reconciliation event",
 			zap.String("cluster_id", event.ClusterID),
@@ -190,7 +214,7 @@ func (p *Publisher) PublishNodePoolReconciliationEvent(ctx context.Context, even
 		"nodepool_id": event.NodePoolID,
 	}
 
-	err = p.client.Publish(ctx, p.config.NodePoolEventsTopic, data, attributes)
+	err = p.publish(ctx, p.config.NodePoolEventsTopic, data, attributes)
 	if err != nil {
 		p.logger.Error("Failed to publish nodepool reconciliation event",
 			zap.String("nodepool_id", event.NodePoolID),
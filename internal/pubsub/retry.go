@@ -0,0 +1,73 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// messagePublisher is the subset of Client used by Publisher
+type messagePublisher interface {
+	Publish(ctx context.Context, topicName string, data []byte, attributes map[string]string) error
+}
+
+// publish sends a message, retrying transient failures with exponential
+// backoff according to the configured publish retry policy
+func (p *Publisher) publish(ctx context.Context, topicName string, data []byte, attributes map[string]string) error {
+	attempts := p.config.PublishMaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+	backoff := p.config.PublishInitialBackoff
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		err = p.client.Publish(ctx, topicName, data, attributes)
+		if err == nil || !isRetryablePublishError(err) || attempt == attempts {
+			return err
+		}
+
+		p.logger.Warn("Transient publish failure, retrying",
+			zap.String("topic", topicName),
+			zap.Int("attempt", attempt),
+			zap.Duration("backoff", backoff),
+			zap.Error(err),
+		)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if p.config.PublishMaxBackoff > 0 && backoff > p.config.PublishMaxBackoff {
+			backoff = p.config.PublishMaxBackoff
+		}
+	}
+
+	return err
+}
+
+// isRetryablePublishError reports whether a publish failure may succeed on retry.
+// Missing topics, invalid requests and authorization failures are permanent.
+func isRetryablePublishError(err error) bool {
+	if errors.Is(err, ErrTopicNotFound) || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		switch grpcErr.GRPCStatus().Code() {
+		case codes.NotFound, codes.InvalidArgument, codes.PermissionDenied,
+			codes.Unauthenticated, codes.FailedPrecondition:
+			return false
+		}
+	}
+
+	return true
+}
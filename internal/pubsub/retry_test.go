@@ -0,0 +1,98 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/config"
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockMessagePublisher fails with the queued errors before succeeding
+type mockMessagePublisher struct {
+	errs  []error
+	calls int
+}
+
+func (m *mockMessagePublisher) Publish(ctx context.Context, topicName string, data []byte, attributes map[string]string) error {
+	m.calls++
+	if m.calls <= len(m.errs) {
+		return m.errs[m.calls-1]
+	}
+	return nil
+}
+
+func newTestPublisher(client messagePublisher) *Publisher {
+	return &Publisher{
+		client: client,
+		logger: utils.NewLogger("pubsub_publisher_test"),
+		config: config.PubSubConfig{
+			ClusterEventsTopic:    "cluster-events",
+			NodePoolEventsTopic:   "nodepool-events",
+			PublishMaxAttempts:    3,
+			PublishInitialBackoff: time.Millisecond,
+			PublishMaxBackoff:     8 * time.Millisecond,
+		},
+	}
+}
+
+func TestPublisher_RetriesTransientFailures(t *testing.T) {
+	transient := status.Error(codes.Unavailable, "backend unavailable")
+	mock := &mockMessagePublisher{errs: []error{transient, transient}}
+	publisher := newTestPublisher(mock)
+
+	cluster := &models.Cluster{ID: uuid.New(), Name: "retry-cluster", Generation: 1}
+	err := publisher.PublishClusterCreated(context.Background(), cluster)
+
+	utils.AssertError(t, err, false, "Publish should succeed after transient failures")
+	utils.AssertEqual(t, 3, mock.calls, "Publish should be attempted exactly 3 times")
+}
+
+func TestPublisher_RetryLimits(t *testing.T) {
+	tests := []struct {
+		name          string
+		errs          []error
+		expectedCalls int
+	}{
+		{
+			name:          "missing topic is permanent",
+			errs:          []error{fmt.Errorf("failed to get topic: %w", ErrTopicNotFound)},
+			expectedCalls: 1,
+		},
+		{
+			name:          "invalid argument is permanent",
+			errs:          []error{status.Error(codes.InvalidArgument, "bad message")},
+			expectedCalls: 1,
+		},
+		{
+			name: "transient failures exhaust attempts",
+			errs: []error{
+				errors.New("connection reset"),
+				errors.New("connection reset"),
+				errors.New("connection reset"),
+				errors.New("connection reset"),
+			},
+			expectedCalls: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockMessagePublisher{errs: tt.errs}
+			publisher := newTestPublisher(mock)
+
+			nodepool := &models.NodePool{ID: uuid.New(), ClusterID: uuid.New(), Name: "np", Generation: 1}
+			err := publisher.PublishNodePoolCreated(context.Background(), nodepool)
+
+			utils.AssertError(t, err, true, "Publish should fail")
+			utils.AssertEqual(t, tt.expectedCalls, mock.calls, "Unexpected number of publish attempts")
+		})
+	}
+}
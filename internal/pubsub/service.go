@@ -72,6 +72,10 @@ func (s *Service) Start() error {
 
 // Stop stops the Pub/Sub service
 func (s *Service) Stop() error {
+	if s == nil {
+		return nil
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -135,8 +139,13 @@ func (s *Service) GetStatus() string {
 	return s.status
 }
 
-// IsRunning returns true if the service is running
+// IsRunning returns true if the service is running. A nil service, used when Pub/Sub is
+// unavailable in webhook delivery mode, is never running.
 func (s *Service) IsRunning() bool {
+	if s == nil {
+		return false
+	}
+
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	return s.status == "running"
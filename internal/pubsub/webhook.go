@@ -0,0 +1,162 @@
+package pubsub
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/config"
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/utils"
+	"go.uber.org/zap"
+)
+
+// Webhook request headers
+const (
+	WebhookEventTypeHeader = "X-CLS-Event-Type"
+	WebhookSignatureHeader = "X-CLS-Signature" // "sha256=<hex HMAC of the request body>"
+)
+
+// WebhookPublisher delivers reconciliation events as signed HTTP POST requests
+type WebhookPublisher struct {
+	httpClient *http.Client
+	logger     *utils.Logger
+	config     config.WebhookConfig
+}
+
+// NewWebhookPublisher creates a new webhook publisher
+func NewWebhookPublisher(cfg config.WebhookConfig) *WebhookPublisher {
+	return &WebhookPublisher{
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+		logger:     utils.NewLogger("webhook_publisher"),
+		config:     cfg,
+	}
+}
+
+var _ ReconcileEventPublisher = (*WebhookPublisher)(nil)
+
+// PublishReconciliationEvent delivers a cluster reconciliation event to the webhook
+func (w *WebhookPublisher) PublishReconciliationEvent(ctx context.Context, event *models.ReconciliationEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to serialize reconciliation event: %w", err)
+	}
+
+	if err := w.deliver(ctx, event.Type, data); err != nil {
+		w.logger.Error("Failed to deliver reconciliation event",
+			zap.String("cluster_id", event.ClusterID),
+			zap.String("reason", event.Reason),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to deliver reconciliation event: %w", err)
+	}
+
+	w.logger.Debug("Reconciliation event delivered via webhook",
+		zap.String("cluster_id", event.ClusterID),
+		zap.String("reason", event.Reason),
+	)
+
+	return nil
+}
+
+// PublishNodePoolReconciliationEvent delivers a nodepool reconciliation event to the webhook
+func (w *WebhookPublisher) PublishNodePoolReconciliationEvent(ctx context.Context, event *models.NodePoolReconciliationEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to serialize nodepool reconciliation event: %w", err)
+	}
+
+	if err := w.deliver(ctx, event.Type, data); err != nil {
+		w.logger.Error("Failed to deliver nodepool reconciliation event",
+			zap.String("nodepool_id", event.NodePoolID),
+			zap.String("reason", event.Reason),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to deliver nodepool reconciliation event: %w", err)
+	}
+
+	w.logger.Debug("NodePool reconciliation event delivered via webhook",
+		zap.String("nodepool_id", event.NodePoolID),
+		zap.String("cluster_id", event.ClusterID),
+		zap.String("reason", event.Reason),
+	)
+
+	return nil
+}
+
+// deliver POSTs the payload, retrying network errors, 429 and 5xx responses
+// with exponential backoff. Other 4xx responses are treated as permanent.
+func (w *WebhookPublisher) deliver(ctx context.Context, eventType string, data []byte) error {
+	backoff := w.config.RetryBackoff
+	var lastErr error
+
+	for attempt := 0; attempt <= w.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+
+		retryable, err := w.post(ctx, eventType, data)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+
+		if !retryable {
+			return err
+		}
+
+		w.logger.Warn("Webhook delivery failed, will retry",
+			zap.Int("attempt", attempt+1),
+			zap.Error(err),
+		)
+	}
+
+	return fmt.Errorf("giving up after %d attempts: %w", w.config.MaxRetries+1, lastErr)
+}
+
+// post sends a single request and reports whether a failure is retryable
+func (w *WebhookPublisher) post(ctx context.Context, eventType string, data []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.config.URL, bytes.NewReader(data))
+	if err != nil {
+		return false, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventTypeHeader, eventType)
+	if w.config.Secret != "" {
+		req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(w.config.Secret, data))
+	}
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return true, nil
+	}
+
+	retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return retryable, fmt.Errorf("webhook returned status %d", resp.StatusCode)
+}
+
+// SignWebhookPayload returns the signature header value for a payload so
+// receivers can verify it with the shared secret
+func SignWebhookPayload(secret string, data []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(data)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
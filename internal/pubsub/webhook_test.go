@@ -0,0 +1,110 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/config"
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/utils"
+)
+
+func newTestWebhookPublisher(url string) *WebhookPublisher {
+	return NewWebhookPublisher(config.WebhookConfig{
+		URL:          url,
+		Secret:       "test-secret",
+		Timeout:      2 * time.Second,
+		MaxRetries:   2,
+		RetryBackoff: time.Millisecond,
+	})
+}
+
+func TestWebhookPublisher_DeliversSignedPayload(t *testing.T) {
+	var calls int32
+	var received models.ReconciliationEvent
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		body, _ := io.ReadAll(r.Body)
+
+		utils.AssertEqual(t, http.MethodPost, r.Method, "Webhook should use POST")
+		utils.AssertEqual(t, "cluster.reconcile", r.Header.Get(WebhookEventTypeHeader), "Event type header")
+		utils.AssertEqual(t, SignWebhookPayload("test-secret", body), r.Header.Get(WebhookSignatureHeader), "Signature should match body")
+
+		json.Unmarshal(body, &received)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	publisher := newTestWebhookPublisher(server.URL)
+	event := &models.ReconciliationEvent{
+		Type:       "cluster.reconcile",
+		ClusterID:  "b4d1d7f6-0000-0000-0000-000000000001",
+		Reason:     "periodic",
+		Generation: 3,
+		Timestamp:  time.Now(),
+	}
+
+	err := publisher.PublishReconciliationEvent(context.Background(), event)
+	utils.AssertError(t, err, false, "Delivery should succeed")
+	utils.AssertEqual(t, int32(1), atomic.LoadInt32(&calls), "Webhook should be called once")
+	utils.AssertEqual(t, event.ClusterID, received.ClusterID, "Payload should carry the cluster ID")
+	utils.AssertEqual(t, int64(3), received.Generation, "Payload should carry the generation")
+}
+
+func TestWebhookPublisher_RetriesTransientFailures(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	publisher := newTestWebhookPublisher(server.URL)
+	event := &models.NodePoolReconciliationEvent{
+		Type:       "nodepool.reconcile",
+		ClusterID:  "b4d1d7f6-0000-0000-0000-000000000001",
+		NodePoolID: "b4d1d7f6-0000-0000-0000-000000000002",
+		Reason:     "periodic",
+	}
+
+	err := publisher.PublishNodePoolReconciliationEvent(context.Background(), event)
+	utils.AssertError(t, err, false, "Delivery should succeed after retries")
+	utils.AssertEqual(t, int32(3), atomic.LoadInt32(&calls), "Webhook should be retried until success")
+}
+
+func TestWebhookPublisher_GivesUp(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		expectedCalls int32
+	}{
+		{name: "server errors exhaust retries", status: http.StatusInternalServerError, expectedCalls: 3},
+		{name: "client errors are not retried", status: http.StatusBadRequest, expectedCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			publisher := newTestWebhookPublisher(server.URL)
+			err := publisher.PublishReconciliationEvent(context.Background(), &models.ReconciliationEvent{Type: "cluster.reconcile"})
+			utils.AssertError(t, err, true, "Delivery should fail")
+			utils.AssertEqual(t, tt.expectedCalls, atomic.LoadInt32(&calls), "Unexpected number of webhook calls")
+		})
+	}
+}
@@ -28,7 +28,7 @@ type DatabaseChangeNotification struct {
 // DatabaseChangeListener listens for database change notifications and triggers reconciliation
 type DatabaseChangeListener struct {
 	dbConfig  *config.DatabaseConfig
-	publisher *pubsub.Publisher
+	publisher pubsub.ReconcileEventPublisher
 	logger    *utils.Logger
 	conn      *pgx.Conn
 	running   bool
@@ -36,19 +36,19 @@ type DatabaseChangeListener struct {
 	wg        sync.WaitGroup
 	mu        sync.Mutex
 
-	// Debouncing to prevent rapid-fire events
-	lastEventTime map[string]time.Time
-	debounceMap   sync.RWMutex
+	// Per-cluster debouncing with a global rate cap
+	debouncer *clusterDebouncer
 }
 
-// NewDatabaseChangeListener creates a new database change listener
-func NewDatabaseChangeListener(dbConfig *config.DatabaseConfig, publisher *pubsub.Publisher) *DatabaseChangeListener {
+// NewDatabaseChangeListener creates a new database change listener. Changes to a cluster are
+// debounced for debounceInterval, and at most maxEventsPerMinute events are published overall.
+func NewDatabaseChangeListener(dbConfig *config.DatabaseConfig, publisher pubsub.ReconcileEventPublisher, debounceInterval time.Duration, maxEventsPerMinute int) *DatabaseChangeListener {
 	return &DatabaseChangeListener{
-		dbConfig:      dbConfig,
-		publisher:     publisher,
-		logger:        utils.NewLogger("database_change_listener"),
-		stopChan:      make(chan struct{}),
-		lastEventTime: make(map[string]time.Time),
+		dbConfig:  dbConfig,
+		publisher: publisher,
+		logger:    utils.NewLogger("database_change_listener"),
+		stopChan:  make(chan struct{}),
+		debouncer: newClusterDebouncer(debounceInterval, maxEventsPerMinute),
 	}
 }
 
@@ -106,6 +106,7 @@ func (d *DatabaseChangeListener) Stop(ctx context.Context) error {
 
 	// Wait for goroutine to finish
 	d.wg.Wait()
+	d.debouncer.stop()
 	d.logger.Info("Database change listener stopped")
 
 	return nil
@@ -131,8 +132,11 @@ func (d *DatabaseChangeListener) listenLoop(ctx context.Context) {
 			d.logger.Info("Database change listener loop stopping due to context cancellation")
 			return
 		default:
-			// Wait for notification with timeout
-			notification, err := d.conn.WaitForNotification(ctx)
+			// Wait for a notification, waking up when a debounce window holding a
+			// coalesced change ends so its trailing event is published on time
+			waitCtx, cancel := d.notificationWaitContext(ctx)
+			notification, err := d.conn.WaitForNotification(waitCtx)
+			cancel()
 			if err != nil {
 				// Check if we should continue
 				select {
@@ -141,6 +145,8 @@ func (d *DatabaseChangeListener) listenLoop(ctx context.Context) {
 				case <-ctx.Done():
 					return
 				default:
+				}
+				if !pgconn.Timeout(err) {
 					d.logger.Error("Error waiting for database notification", zap.Error(err))
 					// Brief sleep to prevent tight loop on persistent errors
 					time.Sleep(time.Second)
@@ -151,6 +157,38 @@ func (d *DatabaseChangeListener) listenLoop(ctx context.Context) {
 			if notification != nil {
 				d.handleNotification(ctx, notification)
 			}
+			d.publishTrailingEvents(ctx)
+		}
+	}
+}
+
+// notificationWaitContext bounds the wait for the next notification by the end of the earliest
+// debounce window holding a coalesced change. The connection is only used from the listen
+// loop, so trailing events are published there rather than from timers.
+func (d *DatabaseChangeListener) notificationWaitContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if deadline, ok := d.debouncer.nextDeadline(); ok {
+		return context.WithDeadline(ctx, deadline)
+	}
+	return context.WithCancel(ctx)
+}
+
+// publishTrailingEvents publishes the latest coalesced change of every debounce window that
+// has ended
+func (d *DatabaseChangeListener) publishTrailingEvents(ctx context.Context) {
+	publish, dropped := d.debouncer.due()
+
+	for _, change := range dropped {
+		d.logger.Warn("Dropping debounced database change, reconciliation event rate limit reached",
+			zap.String("cluster_id", change.clusterID.String()),
+			zap.String("change_type", change.notification.ChangeType))
+	}
+
+	for _, change := range publish {
+		if err := d.publishReconciliationEvent(ctx, change.clusterID, change.notification); err != nil {
+			d.logger.Error("Failed to publish debounced reconciliation event",
+				zap.String("cluster_id", change.clusterID.String()),
+				zap.String("change_type", change.notification.ChangeType),
+				zap.Error(err))
 		}
 	}
 }
@@ -179,18 +217,21 @@ func (d *DatabaseChangeListener) handleNotification(ctx context.Context, notific
 		return
 	}
 
-	// Check debouncing
-	if d.shouldDebounce(changeNotification) {
+	// Coalesce rapid changes to the same cluster into a trailing event; other clusters are not held back
+	switch d.debouncer.admit(clusterID, changeNotification) {
+	case debounceCoalesced:
 		d.logger.Debug("Debouncing database change notification",
 			zap.String("cluster_id", changeNotification.ClusterID),
 			zap.String("change_type", changeNotification.ChangeType),
 			zap.String("reason", changeNotification.Reason))
 		return
+	case debounceRateLimited:
+		d.logger.Warn("Dropping database change notification, reconciliation event rate limit reached",
+			zap.String("cluster_id", changeNotification.ClusterID),
+			zap.String("change_type", changeNotification.ChangeType))
+		return
 	}
 
-	// Update debounce tracking
-	d.updateDebounceTracking(changeNotification)
-
 	// Publish single reconciliation event (fan-out to all controllers)
 	if err := d.publishReconciliationEvent(ctx, clusterID, changeNotification); err != nil {
 		d.logger.Error("Failed to publish reconciliation event",
@@ -200,42 +241,6 @@ func (d *DatabaseChangeListener) handleNotification(ctx context.Context, notific
 	}
 }
 
-// shouldDebounce checks if an event should be debounced based on recent activity (fan-out approach)
-func (d *DatabaseChangeListener) shouldDebounce(notification DatabaseChangeNotification) bool {
-	d.debounceMap.RLock()
-	defer d.debounceMap.RUnlock()
-
-	// Create debounce key based on cluster and change type only (no controller type for fan-out)
-	debounceKey := fmt.Sprintf("%s:%s", notification.ClusterID, notification.ChangeType)
-
-	lastTime, exists := d.lastEventTime[debounceKey]
-	if !exists {
-		return false
-	}
-
-	// Check if enough time has passed (2 seconds default debounce)
-	return time.Since(lastTime) < 2*time.Second
-}
-
-// updateDebounceTracking updates the debounce tracking for an event (fan-out approach)
-func (d *DatabaseChangeListener) updateDebounceTracking(notification DatabaseChangeNotification) {
-	d.debounceMap.Lock()
-	defer d.debounceMap.Unlock()
-
-	// Create debounce key based on cluster and change type only (no controller type for fan-out)
-	debounceKey := fmt.Sprintf("%s:%s", notification.ClusterID, notification.ChangeType)
-
-	d.lastEventTime[debounceKey] = time.Now()
-
-	// Clean up old entries (older than 5 minutes)
-	cutoffTime := time.Now().Add(-5 * time.Minute)
-	for key, timestamp := range d.lastEventTime {
-		if timestamp.Before(cutoffTime) {
-			delete(d.lastEventTime, key)
-		}
-	}
-}
-
 // publishReconciliationEvent publishes a reconciliation event based on the database change (fan-out to all controllers)
 func (d *DatabaseChangeListener) publishReconciliationEvent(ctx context.Context, clusterID uuid.UUID, notification DatabaseChangeNotification) error {
 	// Get cluster info to obtain current generation
@@ -297,12 +302,9 @@ func (d *DatabaseChangeListener) getClusterInfo(ctx context.Context, clusterID u
 
 // GetStats returns statistics about the database change listener
 func (d *DatabaseChangeListener) GetStats() map[string]interface{} {
-	d.debounceMap.RLock()
-	defer d.debounceMap.RUnlock()
-
 	stats := map[string]interface{}{
 		"running":              d.IsRunning(),
-		"active_debounce_keys": len(d.lastEventTime),
+		"active_debounce_keys": d.debouncer.activeWindows(),
 	}
 
 	return stats
@@ -0,0 +1,181 @@
+package reconciliation
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// debounceDecision is the outcome of offering a change notification to the debouncer
+type debounceDecision int
+
+const (
+	debounceAllowed     debounceDecision = iota // Publish a reconciliation event
+	debounceCoalesced                           // Held for the trailing event published when the window ends
+	debounceRateLimited                         // Dropped by the global events-per-minute cap
+)
+
+// debounceKey identifies a debounce window: changes of different types to the same cluster
+// are debounced separately so one kind of change never masks another
+type debounceKey struct {
+	clusterID  uuid.UUID
+	changeType string
+}
+
+// clusterDebouncer coalesces change notifications per cluster and change type. The first change
+// for a key is published right away and opens a debounce window; further changes for the key
+// inside the window are recorded, and the latest one is published as a single trailing event
+// once the window ends, so the final state of a burst is always reconciled. Keys don't share
+// windows, so a burst on one cluster never delays another. The global events-per-minute cap
+// remains as a backstop against bursts spread over many clusters.
+//
+// Windows are closed lazily: the listener asks for due trailing events with due and waits for
+// notifications no longer than nextDeadline.
+type clusterDebouncer struct {
+	interval           time.Duration
+	maxEventsPerMinute int
+	now                func() time.Time
+
+	mu        sync.Mutex
+	windows   map[debounceKey]*debounceWindow
+	rateStart time.Time
+	rateCount int
+	stopped   bool
+}
+
+// debouncedChange is a coalesced change released as a trailing event
+type debouncedChange struct {
+	clusterID    uuid.UUID
+	notification DatabaseChangeNotification
+}
+
+// debounceWindow tracks an open debounce window and the latest change coalesced into it
+type debounceWindow struct {
+	deadline time.Time
+	pending  *DatabaseChangeNotification
+}
+
+// newClusterDebouncer creates a debouncer. A non-positive interval disables debouncing and a
+// non-positive maxEventsPerMinute disables the global cap.
+func newClusterDebouncer(interval time.Duration, maxEventsPerMinute int) *clusterDebouncer {
+	return &clusterDebouncer{
+		interval:           interval,
+		maxEventsPerMinute: maxEventsPerMinute,
+		now:                time.Now,
+		windows:            make(map[debounceKey]*debounceWindow),
+	}
+}
+
+// admit decides whether a change to clusterID should publish a reconciliation event now.
+// A coalesced change is kept until its window ends and is then returned by due.
+func (d *clusterDebouncer) admit(clusterID uuid.UUID, notification DatabaseChangeNotification) debounceDecision {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	key := debounceKey{clusterID: clusterID, changeType: notification.ChangeType}
+	if window, ok := d.windows[key]; ok && now.Before(window.deadline) {
+		window.pending = &notification
+		return debounceCoalesced
+	}
+
+	if !d.takeRateToken(now) {
+		return debounceRateLimited
+	}
+
+	d.openWindow(key, now)
+	return debounceAllowed
+}
+
+// due returns the trailing events whose windows have ended. Each published trailing event
+// opens a fresh window so a sustained stream of changes yields at most one event per interval;
+// trailing events beyond the global cap are returned as dropped.
+func (d *clusterDebouncer) due() (publish, dropped []debouncedChange) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	for key, window := range d.windows {
+		if now.Before(window.deadline) {
+			continue
+		}
+		delete(d.windows, key)
+		if window.pending == nil {
+			continue
+		}
+
+		change := debouncedChange{clusterID: key.clusterID, notification: *window.pending}
+		if !d.takeRateToken(now) {
+			dropped = append(dropped, change)
+			continue
+		}
+		publish = append(publish, change)
+		d.openWindow(key, now)
+	}
+	return publish, dropped
+}
+
+// nextDeadline returns when the earliest window holding a coalesced change ends
+func (d *clusterDebouncer) nextDeadline() (time.Time, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var next time.Time
+	for _, window := range d.windows {
+		if window.pending != nil && (next.IsZero() || window.deadline.Before(next)) {
+			next = window.deadline
+		}
+	}
+	return next, !next.IsZero()
+}
+
+// activeWindows returns the number of debounce windows that have not ended yet
+func (d *clusterDebouncer) activeWindows() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	active := 0
+	for _, window := range d.windows {
+		if now.Before(window.deadline) {
+			active++
+		}
+	}
+	return active
+}
+
+// stop discards every open window and stops opening new ones
+func (d *clusterDebouncer) stop() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.stopped = true
+	for key := range d.windows {
+		delete(d.windows, key)
+	}
+}
+
+// takeRateToken counts an event against the global cap, reporting false once the cap is
+// reached for the current minute. Callers must hold d.mu.
+func (d *clusterDebouncer) takeRateToken(now time.Time) bool {
+	if d.maxEventsPerMinute <= 0 {
+		return true
+	}
+	if now.Sub(d.rateStart) >= time.Minute {
+		d.rateStart = now
+		d.rateCount = 0
+	}
+	if d.rateCount >= d.maxEventsPerMinute {
+		return false
+	}
+	d.rateCount++
+	return true
+}
+
+// openWindow starts a debounce window for key. Callers must hold d.mu.
+func (d *clusterDebouncer) openWindow(key debounceKey, now time.Time) {
+	if d.interval > 0 && !d.stopped {
+		d.windows[key] = &debounceWindow{deadline: now.Add(d.interval)}
+	}
+}
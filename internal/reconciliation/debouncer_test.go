@@ -0,0 +1,154 @@
+package reconciliation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/google/uuid"
+)
+
+func specChange(reason string) DatabaseChangeNotification {
+	return DatabaseChangeNotification{ChangeType: "spec", Reason: reason}
+}
+
+func TestClusterDebouncer_PerCluster(t *testing.T) {
+	debouncer := newClusterDebouncer(time.Minute, 0)
+	defer debouncer.stop()
+
+	clusterA := uuid.New()
+	clusterB := uuid.New()
+
+	// A burst of changes on cluster A inside its window yields a single event
+	published := 0
+	for i := 0; i < 10; i++ {
+		if debouncer.admit(clusterA, specChange("update")) == debounceAllowed {
+			published++
+		}
+	}
+	utils.AssertEqual(t, 1, published, "Burst on cluster A should publish a single event")
+
+	// Cluster B is not held back by cluster A's window
+	utils.AssertEqual(t, debounceAllowed, debouncer.admit(clusterB, specChange("update")), "Cluster B's change should publish immediately")
+	utils.AssertEqual(t, debounceCoalesced, debouncer.admit(clusterB, specChange("update")), "Cluster B's next change should be coalesced")
+	utils.AssertEqual(t, 2, debouncer.activeWindows(), "Each cluster should have its own window")
+}
+
+func TestClusterDebouncer_PerChangeType(t *testing.T) {
+	debouncer := newClusterDebouncer(time.Minute, 0)
+	defer debouncer.stop()
+
+	clusterID := uuid.New()
+	utils.AssertEqual(t, debounceAllowed, debouncer.admit(clusterID, specChange("update")), "Spec change should publish")
+	utils.AssertEqual(t, debounceAllowed, debouncer.admit(clusterID, DatabaseChangeNotification{ChangeType: "controller_status"}),
+		"A different change type should not be masked by the spec window")
+}
+
+func TestClusterDebouncer_TrailingEvent(t *testing.T) {
+	debouncer := newClusterDebouncer(time.Minute, 0)
+	defer debouncer.stop()
+
+	now := time.Date(2025, 10, 17, 12, 0, 0, 0, time.UTC)
+	debouncer.now = func() time.Time { return now }
+
+	clusterID := uuid.New()
+	utils.AssertEqual(t, debounceAllowed, debouncer.admit(clusterID, specChange("first")), "First change should publish")
+	_, ok := debouncer.nextDeadline()
+	utils.AssertFalse(t, ok, "A window without coalesced changes needs no trailing event")
+
+	utils.AssertEqual(t, debounceCoalesced, debouncer.admit(clusterID, specChange("second")), "Change inside the window should be coalesced")
+	utils.AssertEqual(t, debounceCoalesced, debouncer.admit(clusterID, specChange("third")), "Change inside the window should be coalesced")
+
+	deadline, ok := debouncer.nextDeadline()
+	utils.AssertTrue(t, ok, "A coalesced change should schedule a trailing event")
+	utils.AssertEqual(t, now.Add(time.Minute), deadline, "Trailing event should be due when the window ends")
+
+	publish, _ := debouncer.due()
+	utils.AssertEqual(t, 0, len(publish), "Nothing should be due inside the window")
+
+	// The latest coalesced change is released once the window ends
+	now = now.Add(time.Minute)
+	publish, dropped := debouncer.due()
+	utils.AssertEqual(t, 1, len(publish), "Window end should release a single trailing event")
+	utils.AssertEqual(t, 0, len(dropped), "Trailing event should not be dropped without a cap")
+	utils.AssertEqual(t, clusterID, publish[0].clusterID, "Trailing event should target the cluster")
+	utils.AssertEqual(t, "third", publish[0].notification.Reason, "Trailing event should carry the latest change")
+
+	// The trailing event opens a new window, so a sustained stream stays debounced
+	utils.AssertEqual(t, debounceCoalesced, debouncer.admit(clusterID, specChange("fourth")), "Change right after the trailing event should be coalesced")
+
+	// A window that ends without coalesced changes releases nothing
+	now = now.Add(time.Minute)
+	publish, _ = debouncer.due()
+	utils.AssertEqual(t, 1, len(publish), "Coalesced change should be released")
+	now = now.Add(time.Minute)
+	publish, _ = debouncer.due()
+	utils.AssertEqual(t, 0, len(publish), "A quiet window should release nothing")
+	utils.AssertEqual(t, 0, debouncer.activeWindows(), "All windows should have ended")
+}
+
+func TestClusterDebouncer_WindowExpires(t *testing.T) {
+	debouncer := newClusterDebouncer(time.Minute, 0)
+	defer debouncer.stop()
+
+	now := time.Date(2025, 10, 17, 12, 0, 0, 0, time.UTC)
+	debouncer.now = func() time.Time { return now }
+
+	clusterID := uuid.New()
+	utils.AssertEqual(t, debounceAllowed, debouncer.admit(clusterID, specChange("update")), "First change should publish")
+	utils.AssertEqual(t, 1, debouncer.activeWindows(), "First change should open a window")
+
+	now = now.Add(time.Minute)
+	utils.AssertEqual(t, 0, debouncer.activeWindows(), "Window should have ended")
+	utils.AssertEqual(t, debounceAllowed, debouncer.admit(clusterID, specChange("update")), "Change after the window should publish again")
+}
+
+func TestClusterDebouncer_GlobalRateCap(t *testing.T) {
+	debouncer := newClusterDebouncer(time.Minute, 3)
+	defer debouncer.stop()
+
+	now := time.Date(2025, 10, 17, 12, 0, 0, 0, time.UTC)
+	debouncer.now = func() time.Time { return now }
+
+	// Distinct clusters share the backstop cap
+	for i := 0; i < 3; i++ {
+		utils.AssertEqual(t, debounceAllowed, debouncer.admit(uuid.New(), specChange("update")), "Changes within the cap should publish", i)
+	}
+	limited := uuid.New()
+	utils.AssertEqual(t, debounceRateLimited, debouncer.admit(limited, specChange("update")), "Changes beyond the cap should be dropped")
+
+	// A dropped change does not open a window, so the cluster publishes once the cap resets
+	now = now.Add(time.Minute)
+	utils.AssertEqual(t, debounceAllowed, debouncer.admit(limited, specChange("update")), "Cap should reset after a minute")
+}
+
+func TestClusterDebouncer_TrailingEventRateCap(t *testing.T) {
+	debouncer := newClusterDebouncer(time.Second, 1)
+	defer debouncer.stop()
+
+	now := time.Date(2025, 10, 17, 12, 0, 0, 0, time.UTC)
+	debouncer.now = func() time.Time { return now }
+
+	clusterID := uuid.New()
+	utils.AssertEqual(t, debounceAllowed, debouncer.admit(clusterID, specChange("first")), "First change should publish")
+	utils.AssertEqual(t, debounceCoalesced, debouncer.admit(clusterID, specChange("second")), "Change inside the window should be coalesced")
+
+	// Trailing events count against the global cap too
+	now = now.Add(time.Second)
+	publish, dropped := debouncer.due()
+	utils.AssertEqual(t, 0, len(publish), "Trailing event beyond the cap should not publish")
+	utils.AssertEqual(t, 1, len(dropped), "Trailing event beyond the cap should be reported as dropped")
+}
+
+func TestClusterDebouncer_Disabled(t *testing.T) {
+	debouncer := newClusterDebouncer(0, 0)
+	defer debouncer.stop()
+
+	clusterID := uuid.New()
+	for i := 0; i < 3; i++ {
+		utils.AssertEqual(t, debounceAllowed, debouncer.admit(clusterID, specChange("update")), "Every change should publish without debouncing", i)
+	}
+	utils.AssertEqual(t, 0, debouncer.activeWindows(), "No windows should be opened")
+	_, ok := debouncer.nextDeadline()
+	utils.AssertFalse(t, ok, "No trailing events should be scheduled")
+}
@@ -17,8 +17,8 @@ import (
 type ReactiveReconciliationConfig struct {
 	Enabled              bool          `json:"enabled"`
 	ChangeTypes          []string      `json:"change_types"`           // ["spec", "status", "controller_status"]
-	DebounceInterval     time.Duration `json:"debounce_interval"`      // Prevent rapid-fire events
-	MaxEventsPerMinute   int           `json:"max_events_per_minute"`  // Rate limiting
+	DebounceInterval     time.Duration `json:"debounce_interval"`      // Per-cluster window coalescing rapid-fire events
+	MaxEventsPerMinute   int           `json:"max_events_per_minute"`  // Global rate cap across all clusters
 	DatabasePollInterval time.Duration `json:"database_poll_interval"` // How often to check DB config (fan-out to all controllers)
 }
 
@@ -36,7 +36,7 @@ func DefaultReactiveReconciliationConfig() *ReactiveReconciliationConfig {
 // ReactiveReconciler manages reactive reconciliation triggered by database changes
 type ReactiveReconciler struct {
 	repository       *database.Repository
-	publisher        *pubsub.Publisher
+	publisher        pubsub.ReconcileEventPublisher
 	dbConfig         *config.DatabaseConfig
 	config           *ReactiveReconciliationConfig
 	logger           *utils.Logger
@@ -66,7 +66,7 @@ type ReactiveReconcilerStats struct {
 }
 
 // NewReactiveReconciler creates a new reactive reconciler
-func NewReactiveReconciler(repository *database.Repository, publisher *pubsub.Publisher, dbConfig *config.DatabaseConfig, config *ReactiveReconciliationConfig) *ReactiveReconciler {
+func NewReactiveReconciler(repository *database.Repository, publisher pubsub.ReconcileEventPublisher, dbConfig *config.DatabaseConfig, config *ReactiveReconciliationConfig) *ReactiveReconciler {
 	if config == nil {
 		config = DefaultReactiveReconciliationConfig()
 	}
@@ -99,14 +99,14 @@ func (r *ReactiveReconciler) Start(ctx context.Context) error {
 	}
 
 	// Only start if enabled (either in config or database)
-	if !r.isEnabled() {
+	if !r.IsEnabled() {
 		r.logger.Info("Reactive reconciliation is disabled, not starting database listener")
 		r.running = true // Mark as running even though listener is not started
 		return nil
 	}
 
 	// Create and start database listener
-	r.databaseListener = NewDatabaseChangeListener(r.dbConfig, r.publisher)
+	r.databaseListener = NewDatabaseChangeListener(r.dbConfig, r.publisher, r.config.DebounceInterval, r.config.MaxEventsPerMinute)
 	if err := r.databaseListener.Start(ctx); err != nil {
 		r.logger.Error("Failed to start database change listener", zap.Error(err))
 		return err
@@ -156,8 +156,8 @@ func (r *ReactiveReconciler) IsRunning() bool {
 	return r.running
 }
 
-// isEnabled checks if reactive reconciliation is enabled (config or database)
-func (r *ReactiveReconciler) isEnabled() bool {
+// IsEnabled checks if reactive reconciliation is enabled (config or database)
+func (r *ReactiveReconciler) IsEnabled() bool {
 	r.stats.mu.RLock()
 	defer r.stats.mu.RUnlock()
 
@@ -234,7 +234,7 @@ func (r *ReactiveReconciler) updateConfigFromDatabase(ctx context.Context) error
 
 		if enabled && r.databaseListener == nil {
 			// Enable: start database listener
-			r.databaseListener = NewDatabaseChangeListener(r.dbConfig, r.publisher)
+			r.databaseListener = NewDatabaseChangeListener(r.dbConfig, r.publisher, r.config.DebounceInterval, r.config.MaxEventsPerMinute)
 			if err := r.databaseListener.Start(ctx); err != nil {
 				r.logger.Error("Failed to start database listener after config change", zap.Error(err))
 			} else {
@@ -261,7 +261,7 @@ func (r *ReactiveReconciler) GetStats() map[string]interface{} {
 
 	stats := map[string]interface{}{
 		"running":                 r.IsRunning(),
-		"enabled":                 r.isEnabled(),
+		"enabled":                 r.IsEnabled(),
 		"config_enabled":          r.config.Enabled,
 		"database_config_enabled": r.stats.DatabaseConfigEnabled,
 		"events_received":         r.stats.EventsReceived,
@@ -2,6 +2,8 @@ package reconciliation
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,7 +19,7 @@ import (
 // Scheduler manages periodic reconciliation of clusters
 type Scheduler struct {
 	repository *database.Repository
-	publisher  *pubsub.Publisher
+	publisher  pubsub.ReconcileEventPublisher
 	config     *config.ReconciliationConfig
 	logger     *utils.Logger
 
@@ -26,10 +28,50 @@ type Scheduler struct {
 	stopChan chan struct{}
 	wg       sync.WaitGroup
 	mu       sync.Mutex
+
+	// Statistics
+	stats *SchedulerStats
+}
+
+// SchedulerStats tracks statistics for the reconciliation scheduler
+type SchedulerStats struct {
+	mu                      sync.RWMutex
+	ClusterEventsPublished  int64     `json:"cluster_events_published"`
+	NodePoolEventsPublished int64     `json:"nodepool_events_published"`
+	PublishErrors           int64     `json:"publish_errors"`
+	LookupErrors            int64     `json:"lookup_errors"`
+	DryRunClusterEvents     int64     `json:"dry_run_cluster_events"`
+	DryRunNodePoolEvents    int64     `json:"dry_run_nodepool_events"`
+	LastCheckTime           time.Time `json:"last_check_time"`
+
+	// Outcome of the most recent check
+	lastDuration       time.Duration
+	lastClusterEvents  int
+	lastNodePoolEvents int
+	lastErrors         int
+}
+
+// SchedulerSnapshot is a point-in-time view of the scheduler's most recent check, used to
+// diagnose stuck scheduling. In dry-run mode the event counts are the events the check
+// would have published. Errors count failed publishes and failed lookups of the clusters
+// and nodepools needing reconciliation.
+type SchedulerSnapshot struct {
+	Running            bool      `json:"running"`
+	DryRun             bool      `json:"dry_run"`
+	LastRunTime        time.Time `json:"last_run_time"`
+	LastRunDurationMs  int64     `json:"last_run_duration_ms"`
+	LastClusterEvents  int       `json:"last_cluster_events"`
+	LastNodePoolEvents int       `json:"last_nodepool_events"`
+	LastErrors         int       `json:"last_errors"`
+	TotalErrors        int64     `json:"total_errors"`
+
+	// Events dry-run checks would have published since startup
+	DryRunClusterEvents  int64 `json:"dry_run_cluster_events"`
+	DryRunNodePoolEvents int64 `json:"dry_run_nodepool_events"`
 }
 
 // NewScheduler creates a new reconciliation scheduler
-func NewScheduler(repository *database.Repository, publisher *pubsub.Publisher, cfg *config.ReconciliationConfig) *Scheduler {
+func NewScheduler(repository *database.Repository, publisher pubsub.ReconcileEventPublisher, cfg *config.ReconciliationConfig) *Scheduler {
 	if cfg == nil {
 		// Provide simplified default values - binary state model handles intervals in database
 		defaultConfig := &config.ReconciliationConfig{
@@ -50,6 +92,7 @@ func NewScheduler(repository *database.Repository, publisher *pubsub.Publisher,
 		config:     cfg,
 		logger:     utils.NewLogger("reconciliation_scheduler"),
 		stopChan:   make(chan struct{}),
+		stats:      &SchedulerStats{},
 	}
 }
 
@@ -83,6 +126,7 @@ func (s *Scheduler) Start(ctx context.Context) error {
 		zap.Duration("check_interval", s.config.CheckInterval),
 		zap.Duration("default_interval", s.config.DefaultInterval),
 		zap.Int("max_concurrent", s.config.MaxConcurrent),
+		zap.Bool("dry_run", s.config.DryRun),
 		zap.String("model", "binary_state_30s_5m"))
 
 	s.wg.Add(1)
@@ -91,18 +135,20 @@ func (s *Scheduler) Start(ctx context.Context) error {
 	return nil
 }
 
-// Stop stops the reconciliation scheduler
+// Stop stops the reconciliation scheduler and waits for the current check to finish. s.mu is
+// released before waiting so Stats and IsRunning callers never block the loop from exiting.
 func (s *Scheduler) Stop() {
 	s.mu.Lock()
-	defer s.mu.Unlock()
-
 	if !s.running {
+		s.mu.Unlock()
 		return
 	}
 
 	s.logger.Info("Stopping reconciliation scheduler")
 	s.running = false
 	close(s.stopChan)
+	s.mu.Unlock()
+
 	s.wg.Wait()
 	s.logger.Info("Reconciliation scheduler stopped")
 }
@@ -145,14 +191,26 @@ func (s *Scheduler) checkAndScheduleReconciliation(ctx context.Context) {
 
 	var clusterPublishedEvents int
 	var nodepoolPublishedEvents int
+	var clusterDryRunEvents int
+	var nodepoolDryRunEvents int
 	var errors int
+	var lookupErrors int
 
 	// No complex health status updates needed with simplified binary model
 
+	// Paused clusters are excluded from the targets below; report how many were skipped
+	if paused, err := s.repository.Reconciliation.CountPausedClusters(ctx); err != nil {
+		s.logger.Warn("Failed to count paused clusters", zap.Error(err))
+	} else if paused > 0 {
+		s.logger.Info("Skipping clusters with reconciliation paused",
+			zap.Int("paused_clusters", paused))
+	}
+
 	// Get all clusters needing reconciliation (fan-out approach)
 	allTargets, err := s.repository.Reconciliation.FindClustersNeedingReconciliation(ctx)
 	if err != nil {
 		s.logger.Error("Failed to find clusters needing reconciliation", zap.Error(err))
+		lookupErrors++
 	} else {
 		// Group targets by cluster ID to avoid duplicate events
 		clusterTargets := make(map[uuid.UUID]*models.ReconciliationTarget)
@@ -163,29 +221,38 @@ func (s *Scheduler) checkAndScheduleReconciliation(ctx context.Context) {
 			}
 		}
 
-		// Apply global concurrency limit for clusters
-		processed := 0
+		// Apply per-platform concurrency limits for clusters
+		limiter := newPlatformLimiter(s.config)
 		for _, target := range clusterTargets {
-			if processed >= s.config.MaxConcurrent {
-				s.logger.Debug("Reached max concurrent cluster reconciliations",
-					zap.Int("max", s.config.MaxConcurrent),
-					zap.Int("remaining", len(clusterTargets)-processed))
-				break
+			if !limiter.allow(target.Platform) {
+				continue
 			}
 
-			if s.publishReconciliationEvent(ctx, target) {
+			if s.config.DryRun {
+				s.logDryRunTarget("cluster", target.ClusterID, target.Reason, target.ClusterGeneration)
+				clusterDryRunEvents++
+			} else if s.publishReconciliationEvent(ctx, target) {
 				clusterPublishedEvents++
 			} else {
 				errors++
 			}
-			processed++
 		}
+		limiter.logSkipped(s.logger, "cluster")
+	}
+
+	// Paused nodepools are excluded from the targets below; report how many were skipped
+	if paused, err := s.repository.Reconciliation.CountPausedNodePools(ctx); err != nil {
+		s.logger.Warn("Failed to count paused nodepools", zap.Error(err))
+	} else if paused > 0 {
+		s.logger.Info("Skipping nodepools with reconciliation paused",
+			zap.Int("paused_nodepools", paused))
 	}
 
 	// Get all nodepools needing reconciliation
 	nodepoolTargets, err := s.repository.Reconciliation.FindNodePoolsNeedingReconciliation(ctx)
 	if err != nil {
 		s.logger.Error("Failed to find nodepools needing reconciliation", zap.Error(err))
+		lookupErrors++
 	} else {
 		// Group targets by nodepool ID to avoid duplicate events
 		nodepoolMap := make(map[uuid.UUID]*models.NodePoolReconciliationTarget)
@@ -196,46 +263,152 @@ func (s *Scheduler) checkAndScheduleReconciliation(ctx context.Context) {
 			}
 		}
 
-		// Apply global concurrency limit for nodepools
-		processed := 0
+		// Apply per-platform concurrency limits for nodepools
+		limiter := newPlatformLimiter(s.config)
 		for _, target := range nodepoolMap {
-			if processed >= s.config.MaxConcurrent {
-				s.logger.Debug("Reached max concurrent nodepool reconciliations",
-					zap.Int("max", s.config.MaxConcurrent),
-					zap.Int("remaining", len(nodepoolMap)-processed))
-				break
+			if !limiter.allow(target.Platform) {
+				continue
 			}
 
-			if s.publishNodePoolReconciliationEvent(ctx, target) {
+			if s.config.DryRun {
+				s.logDryRunTarget("nodepool", target.NodePoolID, target.Reason, target.NodePoolGeneration)
+				nodepoolDryRunEvents++
+			} else if s.publishNodePoolReconciliationEvent(ctx, target) {
 				nodepoolPublishedEvents++
 			} else {
 				errors++
 			}
-			processed++
 		}
+		limiter.logSkipped(s.logger, "nodepool")
 	}
 
+	s.stats.mu.Lock()
+	s.stats.ClusterEventsPublished += int64(clusterPublishedEvents)
+	s.stats.NodePoolEventsPublished += int64(nodepoolPublishedEvents)
+	s.stats.PublishErrors += int64(errors)
+	s.stats.LookupErrors += int64(lookupErrors)
+	s.stats.DryRunClusterEvents += int64(clusterDryRunEvents)
+	s.stats.DryRunNodePoolEvents += int64(nodepoolDryRunEvents)
+	s.stats.LastCheckTime = time.Now()
+	s.stats.lastDuration = time.Since(start)
+	s.stats.lastClusterEvents = clusterPublishedEvents + clusterDryRunEvents
+	s.stats.lastNodePoolEvents = nodepoolPublishedEvents + nodepoolDryRunEvents
+	s.stats.lastErrors = errors + lookupErrors
+	s.stats.mu.Unlock()
+
 	duration := time.Since(start)
+	if s.config.DryRun {
+		s.logger.Info("Reconciliation dry-run check completed",
+			zap.Duration("duration", duration),
+			zap.Int("would_publish_cluster_events", clusterDryRunEvents),
+			zap.Int("would_publish_nodepool_events", nodepoolDryRunEvents))
+		return
+	}
+
 	s.logger.Info("Reconciliation check completed",
 		zap.Duration("duration", duration),
 		zap.Int("cluster_events", clusterPublishedEvents),
 		zap.Int("nodepool_events", nodepoolPublishedEvents),
-		zap.Int("errors", errors))
+		zap.Int("errors", errors),
+		zap.Int("lookup_errors", lookupErrors))
+}
+
+// platformLimiter counts the reconcile events scheduled in one check against the global
+// MaxConcurrent and, for platforms with their own cap, against that cap too. Platforms are
+// matched case-insensitively, like the status strategies.
+type platformLimiter struct {
+	limits  map[string]int
+	max     int
+	total   int
+	counts  map[string]int
+	skipped map[string]int
+}
+
+func newPlatformLimiter(cfg *config.ReconciliationConfig) *platformLimiter {
+	limits := make(map[string]int, len(cfg.PlatformMaxConcurrent))
+	for platform, limit := range cfg.PlatformMaxConcurrent {
+		platform = strings.ToUpper(platform)
+		// Keep the stricter cap if a platform is listed twice with different case
+		if existing, ok := limits[platform]; !ok || limit < existing {
+			limits[platform] = limit
+		}
+	}
+
+	return &platformLimiter{
+		limits:  limits,
+		max:     cfg.MaxConcurrent,
+		counts:  make(map[string]int),
+		skipped: make(map[string]int),
+	}
+}
+
+// allow reports whether another event may be scheduled for platform, and counts it if so
+func (l *platformLimiter) allow(platform string) bool {
+	platform = strings.ToUpper(platform)
+
+	limit := l.max
+	if platformLimit, ok := l.limits[platform]; ok && platformLimit < limit {
+		limit = platformLimit
+	}
+
+	if l.total >= l.max || l.counts[platform] >= limit {
+		l.skipped[platform]++
+		return false
+	}
+	l.total++
+	l.counts[platform]++
+	return true
+}
+
+// logSkipped reports the platforms that reached their cap in this check
+func (l *platformLimiter) logSkipped(logger *utils.Logger, kind string) {
+	for platform, skipped := range l.skipped {
+		logger.Debug("Reached max concurrent reconciliations for platform",
+			zap.String("kind", kind),
+			zap.String("platform", platform),
+			zap.Int("scheduled", l.counts[platform]),
+			zap.Int("scheduled_total", l.total),
+			zap.Int("remaining", skipped))
+	}
+}
+
+// logDryRunTarget logs a reconcile event the scheduler would have published. Schedules are
+// left untouched, so a due target is reported again on every tick until dry-run is disabled.
+func (s *Scheduler) logDryRunTarget(kind string, id uuid.UUID, reason string, generation int64) {
+	s.logger.Debug("Dry run: would publish reconciliation event",
+		zap.String("kind", kind),
+		zap.String("id", id.String()),
+		zap.String("reason", reason),
+		zap.Int64("generation", generation))
+}
+
+// withLastReconciled adds last_reconciled_at to event metadata as an explicit null or
+// timestamp, plus a never_reconciled flag so consumers can tell first-time targets apart
+func withLastReconciled(metadata map[string]interface{}, lastReconciledAt *time.Time) map[string]interface{} {
+	if lastReconciledAt == nil {
+		// A typed nil pointer would not compare equal to nil once stored in the map
+		metadata["last_reconciled_at"] = nil
+		metadata["never_reconciled"] = true
+		return metadata
+	}
+
+	metadata["last_reconciled_at"] = lastReconciledAt.UTC()
+	metadata["never_reconciled"] = false
+	return metadata
 }
 
 // publishReconciliationEvent publishes a reconciliation event for a target
 func (s *Scheduler) publishReconciliationEvent(ctx context.Context, target *models.ReconciliationTarget) bool {
 	event := &models.ReconciliationEvent{
-		Type:       "cluster.reconcile",
+		Type:       models.EventTypeClusterReconcile,
 		ClusterID:  target.ClusterID.String(),
 		Reason:     target.Reason,
 		Generation: target.ClusterGeneration,
 		Timestamp:  time.Now(),
-		Metadata: map[string]interface{}{
+		Metadata: withLastReconciled(map[string]interface{}{
 			"scheduled_by":       "reconciliation_scheduler",
-			"last_reconciled_at": target.LastReconciledAt,
 			"cluster_generation": target.ClusterGeneration,
-		},
+		}, target.LastReconciledAt),
 	}
 
 	if err := s.publisher.PublishReconciliationEvent(ctx, event); err != nil {
@@ -243,6 +416,7 @@ func (s *Scheduler) publishReconciliationEvent(ctx context.Context, target *mode
 			zap.String("cluster_id", target.ClusterID.String()),
 			zap.String("reason", target.Reason),
 			zap.Error(err))
+		s.recordFailedEvent(ctx, event.Type, target.ClusterID, nil, event, err)
 		return false
 	}
 
@@ -273,17 +447,16 @@ func (s *Scheduler) publishNodePoolReconciliationEvent(ctx context.Context, targ
 	}
 
 	event := &models.NodePoolReconciliationEvent{
-		Type:       "nodepool.reconcile",
+		Type:       models.EventTypeNodePoolReconcile,
 		ClusterID:  nodepool.ClusterID.String(),
 		NodePoolID: target.NodePoolID.String(),
 		Reason:     target.Reason,
 		Generation: target.NodePoolGeneration,
 		Timestamp:  time.Now(),
-		Metadata: map[string]interface{}{
+		Metadata: withLastReconciled(map[string]interface{}{
 			"scheduled_by":        "reconciliation_scheduler",
-			"last_reconciled_at":  target.LastReconciledAt,
 			"nodepool_generation": target.NodePoolGeneration,
-		},
+		}, target.LastReconciledAt),
 	}
 
 	if err := s.publisher.PublishNodePoolReconciliationEvent(ctx, event); err != nil {
@@ -291,6 +464,7 @@ func (s *Scheduler) publishNodePoolReconciliationEvent(ctx context.Context, targ
 			zap.String("nodepool_id", target.NodePoolID.String()),
 			zap.String("reason", target.Reason),
 			zap.Error(err))
+		s.recordFailedEvent(ctx, event.Type, nodepool.ClusterID, &target.NodePoolID, event, err)
 		return false
 	}
 
@@ -310,6 +484,31 @@ func (s *Scheduler) publishNodePoolReconciliationEvent(ctx context.Context, targ
 	return true
 }
 
+// recordFailedEvent stores an event that could not be published so it can be
+// audited and replayed later
+func (s *Scheduler) recordFailedEvent(ctx context.Context, eventType string, clusterID uuid.UUID, nodepoolID *uuid.UUID, event interface{}, publishErr error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		s.logger.Error("Failed to serialize failed event",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err))
+		return
+	}
+
+	failed := &models.FailedEvent{
+		EventType:    eventType,
+		ClusterID:    clusterID,
+		NodePoolID:   nodepoolID,
+		Payload:      payload,
+		ErrorMessage: publishErr.Error(),
+	}
+	if err := s.repository.Status.RecordFailedEvent(ctx, failed); err != nil {
+		s.logger.Error("Failed to record failed event",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err))
+	}
+}
+
 // TriggerReconciliation manually triggers reconciliation for a specific cluster
 func (s *Scheduler) TriggerReconciliation(ctx context.Context, clusterID string) error {
 	s.logger.Info("Manual reconciliation triggered",
@@ -351,20 +550,56 @@ func (s *Scheduler) TriggerReconciliation(ctx context.Context, clusterID string)
 	return nil
 }
 
+// Stats returns a snapshot of the scheduler's most recent check. It is safe to call while
+// the scheduler is running; before the first check the snapshot is zero apart from Running.
+func (s *Scheduler) Stats() SchedulerSnapshot {
+	// Read before taking the stats lock so s.mu is never acquired while holding it
+	running := s.IsRunning()
+
+	s.stats.mu.RLock()
+	defer s.stats.mu.RUnlock()
+
+	return SchedulerSnapshot{
+		Running:            running,
+		DryRun:             s.config.DryRun,
+		LastRunTime:        s.stats.LastCheckTime,
+		LastRunDurationMs:  s.stats.lastDuration.Milliseconds(),
+		LastClusterEvents:  s.stats.lastClusterEvents,
+		LastNodePoolEvents: s.stats.lastNodePoolEvents,
+		LastErrors:         s.stats.lastErrors,
+		TotalErrors:        s.stats.PublishErrors + s.stats.LookupErrors,
+
+		DryRunClusterEvents:  s.stats.DryRunClusterEvents,
+		DryRunNodePoolEvents: s.stats.DryRunNodePoolEvents,
+	}
+}
+
 // GetStats returns reconciliation scheduler statistics
 func (s *Scheduler) GetStats(ctx context.Context) (map[string]interface{}, error) {
 	stats := map[string]interface{}{
-		"running":          s.IsRunning(),
-		"check_interval":   s.config.CheckInterval.String(),
-		"default_interval": s.config.DefaultInterval.String(),
-		"max_concurrent":   s.config.MaxConcurrent,
-		"enabled":          s.config.Enabled,
-		"approach":         "fan-out", // Indicates we use fan-out to all controllers
-		"model":            "binary_state_model",
-		"intervals":        "30s_needs_attention_5m_stable",
-		"reactive_enabled": s.config.ReactiveEnabled,
+		"running":                 s.IsRunning(),
+		"check_interval":          s.config.CheckInterval.String(),
+		"default_interval":        s.config.DefaultInterval.String(),
+		"max_concurrent":          s.config.MaxConcurrent,
+		"platform_max_concurrent": s.config.PlatformMaxConcurrent,
+		"enabled":                 s.config.Enabled,
+		"approach":                "fan-out", // Indicates we use fan-out to all controllers
+		"model":                   "binary_state_model",
+		"intervals":               "30s_needs_attention_5m_stable",
+		"reactive_enabled":        s.config.ReactiveEnabled,
+		"dry_run":                 s.config.DryRun,
 	}
 
+	s.stats.mu.RLock()
+	stats["cluster_events_published"] = s.stats.ClusterEventsPublished
+	stats["nodepool_events_published"] = s.stats.NodePoolEventsPublished
+	stats["publish_errors"] = s.stats.PublishErrors
+	stats["lookup_errors"] = s.stats.LookupErrors
+	stats["dry_run_cluster_events"] = s.stats.DryRunClusterEvents
+	stats["dry_run_nodepool_events"] = s.stats.DryRunNodePoolEvents
+	stats["last_check_time"] = s.stats.LastCheckTime
+	s.stats.mu.RUnlock()
+
 	// Get pending cluster reconciliations count
 	allTargets, err := s.repository.Reconciliation.FindClustersNeedingReconciliation(ctx)
 	if err != nil {
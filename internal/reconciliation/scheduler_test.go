@@ -0,0 +1,528 @@
+package reconciliation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apahim/cls-backend/internal/config"
+	"github.com/apahim/cls-backend/internal/database"
+	"github.com/apahim/cls-backend/internal/models"
+	"github.com/apahim/cls-backend/internal/utils"
+	"github.com/google/uuid"
+)
+
+// mockPublisher records the reconcile events published by the scheduler
+type mockPublisher struct {
+	mu             sync.Mutex
+	clusterEvents  []*models.ReconciliationEvent
+	nodepoolEvents []*models.NodePoolReconciliationEvent
+}
+
+func (m *mockPublisher) PublishReconciliationEvent(ctx context.Context, event *models.ReconciliationEvent) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.clusterEvents = append(m.clusterEvents, event)
+	return nil
+}
+
+func (m *mockPublisher) PublishNodePoolReconciliationEvent(ctx context.Context, event *models.NodePoolReconciliationEvent) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nodepoolEvents = append(m.nodepoolEvents, event)
+	return nil
+}
+
+// eventsFor returns the cluster reconcile events published for a cluster
+func (m *mockPublisher) eventsFor(clusterID uuid.UUID) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	count := 0
+	for _, event := range m.clusterEvents {
+		if event.ClusterID == clusterID.String() {
+			count++
+		}
+	}
+	return count
+}
+
+// nodepoolEventsFor returns the nodepool reconcile events published for a nodepool
+func (m *mockPublisher) nodepoolEventsFor(nodepoolID uuid.UUID) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	count := 0
+	for _, event := range m.nodepoolEvents {
+		if event.NodePoolID == nodepoolID.String() {
+			count++
+		}
+	}
+	return count
+}
+
+// setupTestRepository creates a repository against a fresh test database with all migrations applied
+func setupTestRepository(t *testing.T) *database.Repository {
+	utils.SkipIfNoTestDB(t)
+
+	testDBURL := utils.SetupTestDB(t)
+	repo, err := database.NewRepository(config.DatabaseConfig{
+		URL:             testDBURL,
+		MaxOpenConns:    10,
+		MaxIdleConns:    5,
+		ConnMaxLifetime: 5 * time.Minute,
+		ConnMaxIdleTime: 1 * time.Minute,
+	})
+	utils.AssertError(t, err, false, "Should create repository")
+	t.Cleanup(func() { repo.Close() })
+
+	migrations, err := filepath.Glob("../database/migrations/*.sql")
+	utils.AssertError(t, err, false, "Should list migrations")
+	sort.Strings(migrations)
+
+	for _, migration := range migrations {
+		sqlBytes, err := os.ReadFile(migration)
+		utils.AssertError(t, err, false, "Should read migration", migration)
+		_, err = repo.GetClient().ExecContext(context.Background(), string(sqlBytes))
+		utils.AssertError(t, err, false, "Should apply migration", migration)
+	}
+
+	return repo
+}
+
+func TestScheduler_PausedClusterIsSkipped(t *testing.T) {
+	repo := setupTestRepository(t)
+	publisher := &mockPublisher{}
+	scheduler := NewScheduler(repo, publisher, &config.ReconciliationConfig{
+		CheckInterval: time.Minute,
+		MaxConcurrent: 50,
+	})
+	ctx := context.Background()
+
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "paused-cluster",
+		CreatedBy:  "owner@example.com",
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	err = repo.Reconciliation.SetReconciliationPaused(ctx, cluster.ID, true)
+	utils.AssertError(t, err, false, "Should pause reconciliation")
+
+	paused, err := repo.Reconciliation.CountPausedClusters(ctx)
+	utils.AssertError(t, err, false, "Should count paused clusters")
+	utils.AssertEqual(t, 1, paused, "Cluster should be counted as paused")
+
+	// A never-reconciled cluster would normally be picked up immediately
+	scheduler.checkAndScheduleReconciliation(ctx)
+	utils.AssertEqual(t, 0, publisher.eventsFor(cluster.ID), "Paused cluster should not be reconciled")
+
+	// Resuming restores reconciliation on the next tick
+	err = repo.Reconciliation.SetReconciliationPaused(ctx, cluster.ID, false)
+	utils.AssertError(t, err, false, "Should resume reconciliation")
+
+	scheduler.checkAndScheduleReconciliation(ctx)
+	utils.AssertEqual(t, 1, publisher.eventsFor(cluster.ID), "Resumed cluster should be reconciled")
+
+	// Unknown clusters cannot be paused
+	err = repo.Reconciliation.SetReconciliationPaused(ctx, uuid.New(), true)
+	utils.AssertEqual(t, models.ErrClusterNotFound, err, "Pausing an unknown cluster should return not found")
+}
+
+func TestScheduler_PausedNodePoolIsSkipped(t *testing.T) {
+	repo := setupTestRepository(t)
+	publisher := &mockPublisher{}
+	scheduler := NewScheduler(repo, publisher, &config.ReconciliationConfig{
+		CheckInterval: time.Minute,
+		MaxConcurrent: 50,
+	})
+	ctx := context.Background()
+
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "nodepool-pause-cluster",
+		CreatedBy:  "owner@example.com",
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	var nodepools []*models.NodePool
+	for _, name := range []string{"draining", "workers"} {
+		nodepool := &models.NodePool{
+			ID:              uuid.New(),
+			ClusterID:       cluster.ID,
+			Name:            name,
+			CreatedBy:       "owner@example.com",
+			Generation:      1,
+			ResourceVersion: uuid.New().String(),
+		}
+		err = repo.NodePools.Create(ctx, nodepool)
+		utils.AssertError(t, err, false, "Should create nodepool", name)
+		nodepools = append(nodepools, nodepool)
+	}
+	paused, sibling := nodepools[0], nodepools[1]
+
+	err = repo.Reconciliation.SetNodePoolReconciliationPaused(ctx, paused.ID, true)
+	utils.AssertError(t, err, false, "Should pause nodepool reconciliation")
+
+	// Never-reconciled nodepools would normally be picked up immediately
+	scheduler.checkAndScheduleReconciliation(ctx)
+	utils.AssertEqual(t, 0, publisher.nodepoolEventsFor(paused.ID), "Paused nodepool should not be reconciled")
+	utils.AssertEqual(t, 1, publisher.nodepoolEventsFor(sibling.ID), "Sibling nodepool should still be reconciled")
+	utils.AssertEqual(t, 1, publisher.eventsFor(cluster.ID), "Parent cluster should still be reconciled")
+
+	// Resuming restores reconciliation on the next tick
+	err = repo.Reconciliation.SetNodePoolReconciliationPaused(ctx, paused.ID, false)
+	utils.AssertError(t, err, false, "Should resume nodepool reconciliation")
+
+	scheduler.checkAndScheduleReconciliation(ctx)
+	utils.AssertEqual(t, 1, publisher.nodepoolEventsFor(paused.ID), "Resumed nodepool should be reconciled")
+
+	// Unknown nodepools cannot be paused
+	err = repo.Reconciliation.SetNodePoolReconciliationPaused(ctx, uuid.New(), true)
+	utils.AssertEqual(t, models.ErrNodePoolNotFound, err, "Pausing an unknown nodepool should return not found")
+}
+
+func TestScheduler_Stats(t *testing.T) {
+	repo := setupTestRepository(t)
+	publisher := &mockPublisher{}
+	scheduler := NewScheduler(repo, publisher, &config.ReconciliationConfig{
+		CheckInterval: time.Minute,
+		MaxConcurrent: 50,
+	})
+	ctx := context.Background()
+
+	utils.AssertTrue(t, scheduler.Stats().LastRunTime.IsZero(), "Stats should be empty before the first check")
+
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "stats-cluster",
+		CreatedBy:  "owner@example.com",
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	err = repo.NodePools.Create(ctx, &models.NodePool{
+		ID:              uuid.New(),
+		ClusterID:       cluster.ID,
+		Name:            "workers",
+		CreatedBy:       "owner@example.com",
+		Generation:      1,
+		ResourceVersion: uuid.New().String(),
+	})
+	utils.AssertError(t, err, false, "Should create nodepool")
+
+	// Stats can be read while a check is running
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			_ = scheduler.Stats()
+		}
+	}()
+	scheduler.checkAndScheduleReconciliation(ctx)
+	<-done
+
+	stats := scheduler.Stats()
+	utils.AssertFalse(t, stats.LastRunTime.IsZero(), "Last run time should be set")
+	utils.AssertTrue(t, stats.LastClusterEvents > 0, "Last check should count cluster events")
+	utils.AssertTrue(t, stats.LastNodePoolEvents > 0, "Last check should count nodepool events")
+	utils.AssertEqual(t, 0, stats.LastErrors, "Last check should have no errors")
+	utils.AssertEqual(t, int64(0), stats.TotalErrors, "No publish errors expected")
+}
+
+func TestScheduler_StatsDuringStop(t *testing.T) {
+	repo := setupTestRepository(t)
+	scheduler := NewScheduler(repo, &mockPublisher{}, &config.ReconciliationConfig{
+		Enabled:         true,
+		CheckInterval:   10 * time.Millisecond,
+		DefaultInterval: time.Minute,
+		MaxConcurrent:   50,
+	})
+	err := scheduler.Start(context.Background())
+	utils.AssertError(t, err, false, "Should start scheduler")
+
+	// Reading stats while the scheduler shuts down must not deadlock either side
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			_ = scheduler.Stats()
+		}
+	}()
+
+	stopped := make(chan struct{})
+	go func() {
+		scheduler.Stop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stop should not block on concurrent Stats calls")
+	}
+	<-done
+	utils.AssertFalse(t, scheduler.Stats().Running, "Stopped scheduler should not report running")
+}
+
+func TestScheduler_StatsCountLookupErrors(t *testing.T) {
+	repo := setupTestRepository(t)
+	publisher := &mockPublisher{}
+	scheduler := NewScheduler(repo, publisher, &config.ReconciliationConfig{
+		CheckInterval: time.Minute,
+		MaxConcurrent: 50,
+	})
+
+	// With the database gone every target lookup fails
+	err := repo.Close()
+	utils.AssertError(t, err, false, "Should close repository")
+
+	scheduler.checkAndScheduleReconciliation(context.Background())
+
+	stats := scheduler.Stats()
+	utils.AssertFalse(t, stats.LastRunTime.IsZero(), "Failed check should still be recorded")
+	utils.AssertEqual(t, 2, stats.LastErrors, "Failed cluster and nodepool lookups should count as errors")
+	utils.AssertEqual(t, int64(2), stats.TotalErrors, "Lookup errors should add to the total")
+	utils.AssertEqual(t, 0, len(publisher.clusterEvents), "Nothing should be published")
+}
+
+func TestScheduler_ReconciliationIntervalOverride(t *testing.T) {
+	repo := setupTestRepository(t)
+	ctx := context.Background()
+
+	newCluster := func(name string) *models.Cluster {
+		cluster := &models.Cluster{
+			ID:         uuid.New(),
+			Name:       name,
+			CreatedBy:  "owner@example.com",
+			Generation: 1,
+			Spec: models.ClusterSpec{
+				Platform: models.PlatformSpec{Type: "GCP"},
+			},
+		}
+		err := repo.Clusters.Create(ctx, cluster)
+		utils.AssertError(t, err, false, "Should create cluster", name)
+
+		_, err = repo.GetClient().ExecContext(ctx, `
+			INSERT INTO controller_status (cluster_id, controller_name, observed_generation)
+			VALUES ($1, 'gcp-environment-validation', 1)`, cluster.ID)
+		utils.AssertError(t, err, false, "Should record controller status", name)
+
+		// Reconciled 20s ago, up to date, and not scheduled again for minutes
+		_, err = repo.GetClient().ExecContext(ctx, `
+			UPDATE reconciliation_schedule
+			SET last_reconciled_at = NOW() - INTERVAL '20 seconds',
+				next_reconcile_at = NOW() + INTERVAL '4 minutes'
+			WHERE cluster_id = $1`, cluster.ID)
+		utils.AssertError(t, err, false, "Should set reconciliation schedule", name)
+
+		return cluster
+	}
+
+	defaultCluster := newCluster("default-interval-cluster")
+	fastCluster := newCluster("fast-interval-cluster")
+
+	interval := 15 * time.Second
+	err := repo.Reconciliation.SetReconciliationInterval(ctx, fastCluster.ID, &interval)
+	utils.AssertError(t, err, false, "Should set reconciliation interval")
+
+	due := func() map[uuid.UUID]string {
+		targets, err := repo.Reconciliation.FindClustersNeedingReconciliation(ctx)
+		utils.AssertError(t, err, false, "Should find clusters needing reconciliation")
+		reasons := map[uuid.UUID]string{}
+		for _, target := range targets {
+			reasons[target.ClusterID] = target.Reason
+		}
+		return reasons
+	}
+
+	reasons := due()
+	utils.AssertEqual(t, "interval_reconciliation", reasons[fastCluster.ID], "Cluster with a 15s interval should be due")
+	_, found := reasons[defaultCluster.ID]
+	utils.AssertFalse(t, found, "Cluster with the default interval should not be due yet")
+
+	// Clearing the override falls back to the schedule's default interval
+	err = repo.Reconciliation.SetReconciliationInterval(ctx, fastCluster.ID, nil)
+	utils.AssertError(t, err, false, "Should clear reconciliation interval")
+
+	_, found = due()[fastCluster.ID]
+	utils.AssertFalse(t, found, "Cluster should not be due once the override is cleared")
+
+	err = repo.Reconciliation.SetReconciliationInterval(ctx, uuid.New(), &interval)
+	utils.AssertEqual(t, models.ErrClusterNotFound, err, "Unknown cluster should return not found")
+}
+
+func TestWithLastReconciled(t *testing.T) {
+	// First-time targets carry an explicit null and the never_reconciled flag
+	metadata := withLastReconciled(map[string]interface{}{"scheduled_by": "reconciliation_scheduler"}, nil)
+	utils.AssertNil(t, metadata["last_reconciled_at"], "Never-reconciled target should have a null timestamp")
+	utils.AssertEqual(t, true, metadata["never_reconciled"], "Never-reconciled target should be flagged")
+	utils.AssertEqual(t, "reconciliation_scheduler", metadata["scheduled_by"], "Existing metadata should be kept")
+
+	data, err := json.Marshal(metadata)
+	utils.AssertError(t, err, false, "Should serialize metadata")
+	utils.AssertContains(t, string(data), `"last_reconciled_at":null`, "Timestamp should serialize as null")
+	utils.AssertContains(t, string(data), `"never_reconciled":true`, "Flag should serialize")
+
+	// Repeat targets carry the timestamp
+	lastReconciled := time.Date(2025, 10, 17, 12, 0, 0, 0, time.UTC)
+	metadata = withLastReconciled(map[string]interface{}{}, &lastReconciled)
+	utils.AssertEqual(t, lastReconciled, metadata["last_reconciled_at"], "Reconciled target should keep its timestamp")
+	utils.AssertEqual(t, false, metadata["never_reconciled"], "Reconciled target should not be flagged")
+
+	data, err = json.Marshal(metadata)
+	utils.AssertError(t, err, false, "Should serialize metadata")
+	utils.AssertContains(t, string(data), `"last_reconciled_at":"2025-10-17T12:00:00Z"`, "Timestamp should serialize")
+}
+
+func TestScheduler_DryRunPublishesNothing(t *testing.T) {
+	repo := setupTestRepository(t)
+	publisher := &mockPublisher{}
+	scheduler := NewScheduler(repo, publisher, &config.ReconciliationConfig{
+		CheckInterval: time.Minute,
+		MaxConcurrent: 50,
+		DryRun:        true,
+	})
+	ctx := context.Background()
+
+	cluster := &models.Cluster{
+		ID:         uuid.New(),
+		Name:       "dry-run-cluster",
+		CreatedBy:  "owner@example.com",
+		Generation: 1,
+		Spec: models.ClusterSpec{
+			Platform: models.PlatformSpec{Type: "GCP"},
+		},
+	}
+	err := repo.Clusters.Create(ctx, cluster)
+	utils.AssertError(t, err, false, "Should create cluster")
+
+	// A never-reconciled cluster is due, but dry-run only counts it
+	scheduler.checkAndScheduleReconciliation(ctx)
+	utils.AssertEqual(t, 0, publisher.eventsFor(cluster.ID), "Dry run should not publish cluster events")
+	utils.AssertEqual(t, 0, len(publisher.nodepoolEvents), "Dry run should not publish nodepool events")
+
+	stats, err := scheduler.GetStats(ctx)
+	utils.AssertError(t, err, false, "Should get scheduler stats")
+	utils.AssertTrue(t, stats["dry_run_cluster_events"].(int64) >= 1, "Dry run should count the due cluster")
+	utils.AssertEqual(t, int64(0), stats["cluster_events_published"], "Dry run should not count published events")
+
+	// Schedules are left untouched, so the cluster is still due on the next tick
+	scheduler.checkAndScheduleReconciliation(ctx)
+	stats, err = scheduler.GetStats(ctx)
+	utils.AssertError(t, err, false, "Should get scheduler stats")
+	utils.AssertTrue(t, stats["dry_run_cluster_events"].(int64) >= 2, "Due cluster should be counted again")
+	utils.AssertEqual(t, 0, publisher.eventsFor(cluster.ID), "Dry run should still not publish")
+
+	// The totals are served by GET /reconciliation/stats
+	snapshot := scheduler.Stats()
+	utils.AssertTrue(t, snapshot.DryRun, "Snapshot should report dry-run mode")
+	utils.AssertEqual(t, stats["dry_run_cluster_events"], snapshot.DryRunClusterEvents, "Snapshot should carry the dry-run cluster total")
+	utils.AssertEqual(t, stats["dry_run_nodepool_events"], snapshot.DryRunNodePoolEvents, "Snapshot should carry the dry-run nodepool total")
+}
+
+func TestPlatformLimiter(t *testing.T) {
+	limiter := newPlatformLimiter(&config.ReconciliationConfig{
+		MaxConcurrent:         4,
+		PlatformMaxConcurrent: map[string]int{"GCP": 2, "AWS": 1},
+	})
+
+	allowed := map[string]int{}
+	for i := 0; i < 5; i++ {
+		for _, platform := range []string{"GCP", "AWS", "Azure"} {
+			if limiter.allow(platform) {
+				allowed[platform]++
+			}
+		}
+	}
+
+	utils.AssertEqual(t, 2, allowed["GCP"], "GCP should be capped at its own limit")
+	utils.AssertEqual(t, 1, allowed["AWS"], "AWS should be capped at its own limit")
+	utils.AssertEqual(t, 1, allowed["Azure"], "Unlisted platforms should share what is left of the global limit")
+	utils.AssertEqual(t, 3, limiter.skipped["GCP"], "Skipped GCP targets should be counted")
+	utils.AssertEqual(t, 4, limiter.total, "The global limit should bound the total")
+
+	// Without platform caps the global limit still bounds every platform together
+	limiter = newPlatformLimiter(&config.ReconciliationConfig{MaxConcurrent: 3})
+	total := 0
+	for i := 0; i < 5; i++ {
+		for _, platform := range []string{"GCP", "AWS", "Azure"} {
+			if limiter.allow(platform) {
+				total++
+			}
+		}
+	}
+	utils.AssertEqual(t, 3, total, "Unlisted platforms should share the global limit")
+}
+
+func TestPlatformLimiter_CaseInsensitive(t *testing.T) {
+	limiter := newPlatformLimiter(&config.ReconciliationConfig{
+		MaxConcurrent:         10,
+		PlatformMaxConcurrent: map[string]int{"gcp": 2},
+	})
+
+	utils.AssertTrue(t, limiter.allow("GCP"), "First GCP target should be allowed")
+	utils.AssertTrue(t, limiter.allow("gcp"), "Second GCP target should be allowed")
+	utils.AssertFalse(t, limiter.allow("Gcp"), "Platform case should not split the cap")
+}
+
+func TestScheduler_PerPlatformConcurrency(t *testing.T) {
+	repo := setupTestRepository(t)
+	publisher := &mockPublisher{}
+	scheduler := NewScheduler(repo, publisher, &config.ReconciliationConfig{
+		CheckInterval:         time.Minute,
+		MaxConcurrent:         50,
+		PlatformMaxConcurrent: map[string]int{"GCP": 2, "AWS": 1},
+	})
+	ctx := context.Background()
+
+	// A slow platform with many due clusters must not use up the other platform's share
+	clusterPlatforms := map[uuid.UUID]string{}
+	for i := 0; i < 4; i++ {
+		for _, platform := range []string{"GCP", "AWS"} {
+			cluster := &models.Cluster{
+				ID:         uuid.New(),
+				Name:       fmt.Sprintf("%s-cluster-%d", strings.ToLower(platform), i),
+				CreatedBy:  "owner@example.com",
+				Generation: 1,
+				Spec: models.ClusterSpec{
+					Platform: models.PlatformSpec{Type: platform},
+				},
+			}
+			err := repo.Clusters.Create(ctx, cluster)
+			utils.AssertError(t, err, false, "Should create cluster", cluster.Name)
+			clusterPlatforms[cluster.ID] = platform
+		}
+	}
+
+	scheduler.checkAndScheduleReconciliation(ctx)
+
+	published := map[string]int{}
+	publisher.mu.Lock()
+	for _, event := range publisher.clusterEvents {
+		published[clusterPlatforms[uuid.MustParse(event.ClusterID)]]++
+	}
+	publisher.mu.Unlock()
+
+	utils.AssertEqual(t, 2, published["GCP"], "GCP clusters should be capped at 2")
+	utils.AssertEqual(t, 1, published["AWS"], "AWS clusters should be capped at 1")
+}
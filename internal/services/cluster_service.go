@@ -21,8 +21,18 @@ type ClusterService struct {
 	logger              *utils.Logger
 	defaultVersion      string
 	defaultChannelGroup string
+	restoreRetention    time.Duration
+
+	// allowedImageRegistries restricts where release images are pulled from; empty allows any
+	allowedImageRegistries []string
+
+	// reconcilePublisher delivers on-demand reconcile events; nil disables them
+	reconcilePublisher pubsub.ReconcileEventPublisher
 }
 
+// defaultRestoreRetention is how long a deleted cluster stays restorable unless configured otherwise
+const defaultRestoreRetention = 7 * 24 * time.Hour
+
 // NewClusterService creates a new cluster service
 func NewClusterService(repository *database.Repository, pubsubService *pubsub.Service, defaultVersion, defaultChannelGroup string) *ClusterService {
 	return &ClusterService{
@@ -31,9 +41,30 @@ func NewClusterService(repository *database.Repository, pubsubService *pubsub.Se
 		logger:              utils.NewLogger("cluster_service"),
 		defaultVersion:      defaultVersion,
 		defaultChannelGroup: defaultChannelGroup,
+		restoreRetention:    defaultRestoreRetention,
 	}
 }
 
+// SetRestoreRetention sets how long after deletion a cluster can still be restored
+func (s *ClusterService) SetRestoreRetention(retention time.Duration) {
+	s.restoreRetention = retention
+}
+
+// SetAllowedImageRegistries sets the registries cluster release images may be pulled from
+func (s *ClusterService) SetAllowedImageRegistries(registries []string) {
+	s.allowedImageRegistries = registries
+}
+
+// SetReconcilePublisher sets the publisher used for on-demand reconcile events
+func (s *ClusterService) SetReconcilePublisher(publisher pubsub.ReconcileEventPublisher) {
+	s.reconcilePublisher = publisher
+}
+
+// ValidateImageRegistry checks the spec's release image against the allowed registries
+func (s *ClusterService) ValidateImageRegistry(spec *models.ClusterSpec) error {
+	return spec.Release.ValidateImageRegistry(s.allowedImageRegistries)
+}
+
 // ApplyDefaults fills in default values for fields not provided by the user.
 func (s *ClusterService) ApplyDefaults(req *models.ClusterCreateRequest) {
 	if req.Spec.Release.Version == "" && s.defaultVersion != "" {
@@ -44,11 +75,75 @@ func (s *ClusterService) ApplyDefaults(req *models.ClusterCreateRequest) {
 	}
 }
 
+// idempotencyKeyTTL is how long a create request's Idempotency-Key is remembered
+const idempotencyKeyTTL = 24 * time.Hour
+
 // CreateCluster creates a new cluster
 func (s *ClusterService) CreateCluster(ctx context.Context, req *models.ClusterCreateRequest, userEmail string) (*models.Cluster, error) {
-	s.logger.Info("Creating cluster",
+	return s.createCluster(ctx, req, userEmail, "", false)
+}
+
+// CreateClusterDryRun checks that the cluster could be created and returns it without
+// storing it or publishing any event. A name the user already has returns ErrClusterNameExists.
+func (s *ClusterService) CreateClusterDryRun(ctx context.Context, req *models.ClusterCreateRequest, userEmail string) (*models.Cluster, error) {
+	return s.createCluster(ctx, req, userEmail, "", true)
+}
+
+// CreateClusterWithIdempotencyKey creates a new cluster unless the user already created one
+// with the same key in the last 24 hours, in which case that cluster is returned and
+// replayed is true. Reusing a key for a different cluster name returns ErrIdempotencyKeyMismatch,
+// and reusing the key of a since-deleted cluster returns ErrConflict.
+func (s *ClusterService) CreateClusterWithIdempotencyKey(ctx context.Context, req *models.ClusterCreateRequest, userEmail, idempotencyKey string) (cluster *models.Cluster, replayed bool, err error) {
+	clusterID, err := s.repository.Idempotency.GetClusterID(ctx, idempotencyKey, userEmail)
+	switch {
+	case err == nil:
+		cluster, err = s.replayClusterCreation(ctx, req, userEmail, clusterID)
+		return cluster, err == nil, err
+	case err != models.ErrIdempotencyKeyNotFound:
+		return nil, false, err
+	}
+
+	cluster, err = s.createCluster(ctx, req, userEmail, idempotencyKey, false)
+	if err != nil && utils.IsPostgreSQLUniqueConstraintViolation(err) {
+		// A concurrent request with the same key may have committed first; replay its
+		// cluster rather than report the duplicate
+		clusterID, lookupErr := s.repository.Idempotency.GetClusterID(ctx, idempotencyKey, userEmail)
+		if lookupErr == nil {
+			cluster, err = s.replayClusterCreation(ctx, req, userEmail, clusterID)
+			return cluster, err == nil, err
+		}
+	}
+	return cluster, false, err
+}
+
+// replayClusterCreation returns the cluster previously created with an idempotency key
+func (s *ClusterService) replayClusterCreation(ctx context.Context, req *models.ClusterCreateRequest, userEmail string, clusterID uuid.UUID) (*models.Cluster, error) {
+	existing, err := s.repository.Clusters.GetByID(ctx, clusterID, userEmail, false)
+	if err == models.ErrClusterNotFound {
+		// The original cluster has since been deleted, so there is nothing to replay
+		return nil, models.ErrConflict
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster for idempotency key: %w", err)
+	}
+	if existing.Name != req.Name {
+		return nil, models.ErrIdempotencyKeyMismatch
+	}
+
+	s.logger.WithContext(ctx).Info("Replaying cluster creation for idempotency key",
+		zap.String("cluster_id", existing.ID.String()),
+		zap.String("user_email", userEmail),
+	)
+	return existing, nil
+}
+
+// createCluster creates a cluster, recording the idempotency key in the same transaction when set.
+// With dryRun, the cluster is only checked and returned.
+func (s *ClusterService) createCluster(ctx context.Context, req *models.ClusterCreateRequest, userEmail, idempotencyKey string, dryRun bool) (*models.Cluster, error) {
+	s.logger.WithContext(ctx).Info("Creating cluster",
 		zap.String("cluster_name", req.Name),
 		zap.String("user_email", userEmail),
+		zap.Bool("dry_run", dryRun),
 	)
 
 	// Note: For cluster creation, we'll check global uniqueness still,
@@ -69,30 +164,39 @@ func (s *ClusterService) CreateCluster(ctx context.Context, req *models.ClusterC
 		UpdatedAt:       time.Now(),
 	}
 
-	// Use transaction to ensure cluster creation and event publishing are atomic
+	if dryRun {
+		exists, err := s.repository.Clusters.NameExists(ctx, req.Name, userEmail)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, models.ErrClusterNameExists
+		}
+		return cluster, nil
+	}
+
+	// Create the cluster, its idempotency key and its audit entry in one transaction
 	err := s.repository.Transaction(ctx, func(txRepo *database.Repository) error {
 		// Create cluster
 		if err := txRepo.Clusters.Create(ctx, cluster); err != nil {
 			return fmt.Errorf("failed to create cluster: %w", err)
 		}
 
-		// Publish cluster creation event
-		if s.pubsub != nil && s.pubsub.IsRunning() {
-			publisher := s.pubsub.GetPublisher()
-			if err := publisher.PublishClusterCreated(ctx, cluster); err != nil {
-				s.logger.Warn("Failed to publish cluster creation event",
-					zap.String("cluster_id", cluster.ID.String()),
-					zap.Error(err),
-				)
-				// Don't fail the operation for event publishing failure
+		if idempotencyKey != "" {
+			if err := txRepo.Idempotency.SaveClusterKey(ctx, idempotencyKey, userEmail, cluster.ID, idempotencyKeyTTL); err != nil {
+				return err
 			}
 		}
 
+		if err := txRepo.Audit.RecordChange(ctx, userEmail, models.AuditActionCreate, models.AuditResourceCluster, cluster.ID, nil, cluster.Spec); err != nil {
+			return err
+		}
+
 		return nil
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to create cluster",
+		s.logger.WithContext(ctx).Error("Failed to create cluster",
 			zap.String("cluster_name", req.Name),
 			zap.String("user_email", userEmail),
 			zap.Error(err),
@@ -100,7 +204,20 @@ func (s *ClusterService) CreateCluster(ctx context.Context, req *models.ClusterC
 		return nil, err
 	}
 
-	s.logger.Info("Successfully created cluster",
+	// Publish cluster creation event once the transaction has committed, so a retried
+	// transaction never publishes it twice
+	if s.pubsub != nil && s.pubsub.IsRunning() {
+		publisher := s.pubsub.GetPublisher()
+		if err := publisher.PublishClusterCreated(ctx, cluster); err != nil {
+			s.logger.WithContext(ctx).Warn("Failed to publish cluster creation event",
+				zap.String("cluster_id", cluster.ID.String()),
+				zap.Error(err),
+			)
+			// Don't fail the operation for event publishing failure
+		}
+	}
+
+	s.logger.WithContext(ctx).Info("Successfully created cluster",
 		zap.String("cluster_id", cluster.ID.String()),
 		zap.String("cluster_name", cluster.Name),
 		zap.String("user_email", userEmail),
@@ -111,27 +228,27 @@ func (s *ClusterService) CreateCluster(ctx context.Context, req *models.ClusterC
 
 // GetCluster gets a cluster by ID with client isolation
 func (s *ClusterService) GetCluster(ctx context.Context, clusterID uuid.UUID, userEmail string) (*models.Cluster, error) {
-	s.logger.Info("Getting cluster",
+	s.logger.WithContext(ctx).Info("Getting cluster",
 		zap.String("cluster_id", clusterID.String()),
 		zap.String("user_email", userEmail),
 	)
 
-	cluster, err := s.repository.Clusters.GetByID(ctx, clusterID, userEmail)
+	cluster, err := s.repository.Clusters.GetByID(ctx, clusterID, userEmail, false)
 	if err != nil {
 		if err == models.ErrClusterNotFound {
-			s.logger.Info("Cluster not found",
+			s.logger.WithContext(ctx).Info("Cluster not found",
 				zap.String("cluster_id", clusterID.String()),
 			)
 			return nil, fmt.Errorf("cluster not found")
 		}
-		s.logger.Error("Failed to get cluster",
+		s.logger.WithContext(ctx).Error("Failed to get cluster",
 			zap.String("cluster_id", clusterID.String()),
 			zap.Error(err),
 		)
 		return nil, err
 	}
 
-	s.logger.Info("Successfully retrieved cluster",
+	s.logger.WithContext(ctx).Info("Successfully retrieved cluster",
 		zap.String("cluster_id", clusterID.String()),
 		zap.String("cluster_name", cluster.Name),
 	)
@@ -141,27 +258,27 @@ func (s *ClusterService) GetCluster(ctx context.Context, clusterID uuid.UUID, us
 
 // GetClusterByName gets a cluster by name
 func (s *ClusterService) GetClusterByName(ctx context.Context, name string, userEmail string) (*models.Cluster, error) {
-	s.logger.Info("Getting cluster by name",
+	s.logger.WithContext(ctx).Info("Getting cluster by name",
 		zap.String("cluster_name", name),
 		zap.String("user_email", userEmail),
 	)
 
-	cluster, err := s.repository.Clusters.GetByName(ctx, name, userEmail)
+	cluster, err := s.repository.Clusters.GetByName(ctx, name, userEmail, false)
 	if err != nil {
 		if err == models.ErrClusterNotFound {
-			s.logger.Info("Cluster not found",
+			s.logger.WithContext(ctx).Info("Cluster not found",
 				zap.String("cluster_name", name),
 			)
 			return nil, fmt.Errorf("cluster not found")
 		}
-		s.logger.Error("Failed to get cluster by name",
+		s.logger.WithContext(ctx).Error("Failed to get cluster by name",
 			zap.String("cluster_name", name),
 			zap.Error(err),
 		)
 		return nil, err
 	}
 
-	s.logger.Info("Successfully retrieved cluster by name",
+	s.logger.WithContext(ctx).Info("Successfully retrieved cluster by name",
 		zap.String("cluster_id", cluster.ID.String()),
 		zap.String("cluster_name", cluster.Name),
 	)
@@ -170,21 +287,17 @@ func (s *ClusterService) GetClusterByName(ctx context.Context, name string, user
 }
 
 // ListClusters lists clusters for a specific user with client isolation
-func (s *ClusterService) ListClusters(ctx context.Context, userEmail string, limit, offset int) ([]*models.Cluster, int64, error) {
-	s.logger.Info("Listing clusters",
+func (s *ClusterService) ListClusters(ctx context.Context, userEmail string, opts *models.ListOptions) ([]*models.Cluster, int64, error) {
+	s.logger.WithContext(ctx).Info("Listing clusters",
 		zap.String("user_email", userEmail),
-		zap.Int("limit", limit),
-		zap.Int("offset", offset),
+		zap.Int("limit", opts.Limit),
+		zap.Int("offset", opts.Offset),
+		zap.Bool("cursor", opts.Cursor != nil),
 	)
 
-	opts := &models.ListOptions{
-		Limit:  limit,
-		Offset: offset,
-	}
-
 	clusters, err := s.repository.Clusters.List(ctx, userEmail, opts)
 	if err != nil {
-		s.logger.Error("Failed to list clusters",
+		s.logger.WithContext(ctx).Error("Failed to list clusters",
 			zap.String("user_email", userEmail),
 			zap.Error(err),
 		)
@@ -192,15 +305,15 @@ func (s *ClusterService) ListClusters(ctx context.Context, userEmail string, lim
 	}
 
 	// Get total count for pagination
-	total, err := s.repository.Clusters.Count(ctx, userEmail)
+	total, err := s.repository.Clusters.CountWithOptions(ctx, userEmail, opts)
 	if err != nil {
-		s.logger.Error("Failed to count clusters",
+		s.logger.WithContext(ctx).Error("Failed to count clusters",
 			zap.Error(err),
 		)
 		return nil, 0, err
 	}
 
-	s.logger.Info("Successfully listed clusters",
+	s.logger.WithContext(ctx).Info("Successfully listed clusters",
 		zap.Int("count", len(clusters)),
 		zap.Int64("total", total),
 	)
@@ -209,21 +322,17 @@ func (s *ClusterService) ListClusters(ctx context.Context, userEmail string, lim
 }
 
 // ListClustersByCreatedBy lists clusters created by a specific user (for future authorization)
-func (s *ClusterService) ListClustersByCreatedBy(ctx context.Context, createdBy string, limit, offset int) ([]*models.Cluster, int64, error) {
-	s.logger.Info("Listing clusters by created_by",
+func (s *ClusterService) ListClustersByCreatedBy(ctx context.Context, createdBy string, opts *models.ListOptions) ([]*models.Cluster, int64, error) {
+	s.logger.WithContext(ctx).Info("Listing clusters by created_by",
 		zap.String("created_by", createdBy),
-		zap.Int("limit", limit),
-		zap.Int("offset", offset),
+		zap.Int("limit", opts.Limit),
+		zap.Int("offset", opts.Offset),
+		zap.Bool("cursor", opts.Cursor != nil),
 	)
 
-	opts := &models.ListOptions{
-		Limit:  limit,
-		Offset: offset,
-	}
-
 	clusters, err := s.repository.Clusters.ListByCreatedBy(ctx, createdBy, opts)
 	if err != nil {
-		s.logger.Error("Failed to list clusters by created_by",
+		s.logger.WithContext(ctx).Error("Failed to list clusters by created_by",
 			zap.String("created_by", createdBy),
 			zap.Error(err),
 		)
@@ -233,14 +342,14 @@ func (s *ClusterService) ListClustersByCreatedBy(ctx context.Context, createdBy
 	// Get total count for pagination
 	total, err := s.repository.Clusters.CountByCreatedBy(ctx, createdBy)
 	if err != nil {
-		s.logger.Error("Failed to count clusters by created_by",
+		s.logger.WithContext(ctx).Error("Failed to count clusters by created_by",
 			zap.String("created_by", createdBy),
 			zap.Error(err),
 		)
 		return nil, 0, err
 	}
 
-	s.logger.Info("Successfully listed clusters by created_by",
+	s.logger.WithContext(ctx).Info("Successfully listed clusters by created_by",
 		zap.String("created_by", createdBy),
 		zap.Int("count", len(clusters)),
 		zap.Int64("total", total),
@@ -251,22 +360,26 @@ func (s *ClusterService) ListClustersByCreatedBy(ctx context.Context, createdBy
 
 // UpdateCluster updates an existing cluster
 func (s *ClusterService) UpdateCluster(ctx context.Context, clusterID uuid.UUID, req *models.ClusterUpdateRequest, userEmail string) (*models.Cluster, error) {
-	s.logger.Info("Updating cluster",
+	s.logger.WithContext(ctx).Info("Updating cluster",
 		zap.String("cluster_id", clusterID.String()),
 		zap.String("user_email", userEmail),
 	)
 
-	// First, get the existing cluster to ensure it exists and user owns it
-	cluster, err := s.repository.Clusters.GetByID(ctx, clusterID, userEmail)
+	// First, get the existing cluster to ensure it exists and user owns it; grantees can
+	// read it but not update it
+	cluster, err := s.repository.Clusters.GetByID(ctx, clusterID, userEmail, false)
+	if err == nil && cluster.CreatedBy != userEmail {
+		err = models.ErrClusterNotFound
+	}
 	if err != nil {
 		if err == models.ErrClusterNotFound {
-			s.logger.Info("Cluster not found for update",
+			s.logger.WithContext(ctx).Info("Cluster not found for update",
 				zap.String("cluster_id", clusterID.String()),
 				zap.String("user_email", userEmail),
 			)
 			return nil, fmt.Errorf("cluster not found")
 		}
-		s.logger.Error("Failed to get cluster for update",
+		s.logger.WithContext(ctx).Error("Failed to get cluster for update",
 			zap.String("cluster_id", clusterID.String()),
 			zap.String("user_email", userEmail),
 			zap.Error(err),
@@ -274,43 +387,53 @@ func (s *ClusterService) UpdateCluster(ctx context.Context, clusterID uuid.UUID,
 		return nil, err
 	}
 
+	if s.specUnchanged(ctx, cluster, req) {
+		return cluster, nil
+	}
+
 	// Update cluster fields
+	previousSpec := cluster.Spec
 	cluster.Spec = req.Spec
 	cluster.Generation++
 	cluster.ResourceVersion = uuid.New().String()
 	cluster.UpdatedAt = time.Now()
 
-	// Use transaction to ensure cluster update and event publishing are atomic
+	// Update the cluster and record its audit entry in one transaction
 	err = s.repository.Transaction(ctx, func(txRepo *database.Repository) error {
 		// Update cluster with client isolation
 		if err := txRepo.Clusters.Update(ctx, cluster, userEmail); err != nil {
 			return fmt.Errorf("failed to update cluster: %w", err)
 		}
 
-		// Publish cluster update event
-		if s.pubsub != nil && s.pubsub.IsRunning() {
-			publisher := s.pubsub.GetPublisher()
-			if err := publisher.PublishClusterUpdated(ctx, cluster); err != nil {
-				s.logger.Warn("Failed to publish cluster update event",
-					zap.String("cluster_id", cluster.ID.String()),
-					zap.Error(err),
-				)
-				// Don't fail the operation for event publishing failure
-			}
+		if err := txRepo.Audit.RecordChange(ctx, userEmail, models.AuditActionUpdate, models.AuditResourceCluster, cluster.ID, previousSpec, cluster.Spec); err != nil {
+			return err
 		}
 
 		return nil
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to update cluster",
+		s.logger.WithContext(ctx).Error("Failed to update cluster",
 			zap.String("cluster_id", clusterID.String()),
 			zap.Error(err),
 		)
 		return nil, err
 	}
 
-	s.logger.Info("Successfully updated cluster",
+	// Publish cluster update event once the transaction has committed, so a retried
+	// transaction never publishes it twice
+	if s.pubsub != nil && s.pubsub.IsRunning() {
+		publisher := s.pubsub.GetPublisher()
+		if err := publisher.PublishClusterUpdated(ctx, cluster); err != nil {
+			s.logger.WithContext(ctx).Warn("Failed to publish cluster update event",
+				zap.String("cluster_id", cluster.ID.String()),
+				zap.Error(err),
+			)
+			// Don't fail the operation for event publishing failure
+		}
+	}
+
+	s.logger.WithContext(ctx).Info("Successfully updated cluster",
 		zap.String("cluster_id", cluster.ID.String()),
 		zap.String("cluster_name", cluster.Name),
 		zap.Int64("generation", cluster.Generation),
@@ -319,25 +442,43 @@ func (s *ClusterService) UpdateCluster(ctx context.Context, clusterID uuid.UUID,
 	return cluster, nil
 }
 
+// specUnchanged reports whether an update would leave the cluster's spec as it is, in which
+// case the update is a no-op: no generation bump, no audit entry and no reconciliation event
+func (s *ClusterService) specUnchanged(ctx context.Context, cluster *models.Cluster, req *models.ClusterUpdateRequest) bool {
+	if !models.SpecsEqual(cluster.Spec, req.Spec) {
+		return false
+	}
+
+	s.logger.WithContext(ctx).Info("Cluster spec unchanged, skipping update",
+		zap.String("cluster_id", cluster.ID.String()),
+		zap.Int64("generation", cluster.Generation),
+	)
+	return true
+}
+
 // DeleteCluster deletes a cluster
 func (s *ClusterService) DeleteCluster(ctx context.Context, clusterID uuid.UUID, force bool, userEmail string) error {
-	s.logger.Info("Deleting cluster",
+	s.logger.WithContext(ctx).Info("Deleting cluster",
 		zap.String("cluster_id", clusterID.String()),
 		zap.String("user_email", userEmail),
 		zap.Bool("force", force),
 	)
 
-	// First, get the existing cluster to ensure it exists and user owns it
-	cluster, err := s.repository.Clusters.GetByID(ctx, clusterID, userEmail)
+	// First, get the existing cluster to ensure it exists and user owns it; grantees can
+	// read it but not delete it
+	cluster, err := s.repository.Clusters.GetByID(ctx, clusterID, userEmail, false)
+	if err == nil && cluster.CreatedBy != userEmail {
+		err = models.ErrClusterNotFound
+	}
 	if err != nil {
 		if err == models.ErrClusterNotFound {
-			s.logger.Info("Cluster not found for deletion",
+			s.logger.WithContext(ctx).Info("Cluster not found for deletion",
 				zap.String("cluster_id", clusterID.String()),
 				zap.String("user_email", userEmail),
 			)
 			return fmt.Errorf("cluster not found")
 		}
-		s.logger.Error("Failed to get cluster for deletion",
+		s.logger.WithContext(ctx).Error("Failed to get cluster for deletion",
 			zap.String("cluster_id", clusterID.String()),
 			zap.String("user_email", userEmail),
 			zap.Error(err),
@@ -348,44 +489,53 @@ func (s *ClusterService) DeleteCluster(ctx context.Context, clusterID uuid.UUID,
 	// Check if cluster is in a state that allows deletion (unless force is true)
 	if !force && cluster.Status != nil && cluster.Status.Phase != "" &&
 		cluster.Status.Phase != "Pending" && cluster.Status.Phase != "Failed" {
-		s.logger.Warn("Cluster not in deletable state",
+		s.logger.WithContext(ctx).Warn("Cluster not in deletable state",
 			zap.String("cluster_id", clusterID.String()),
 			zap.String("status_phase", cluster.Status.Phase),
 		)
 		return fmt.Errorf("cluster must be in Pending or Failed state for deletion, use force=true to override")
 	}
 
-	// Use transaction to ensure cluster deletion and event publishing are atomic
+	// Delete the cluster, cascade the delete and record its audit entry in one transaction
 	err = s.repository.Transaction(ctx, func(txRepo *database.Repository) error {
 		// Soft delete cluster with client isolation
 		if err := txRepo.Clusters.Delete(ctx, clusterID, userEmail); err != nil {
 			return fmt.Errorf("failed to delete cluster: %w", err)
 		}
 
-		// Publish cluster deletion event
-		if s.pubsub != nil && s.pubsub.IsRunning() {
-			publisher := s.pubsub.GetPublisher()
-			if err := publisher.PublishClusterDeleted(ctx, cluster); err != nil {
-				s.logger.Warn("Failed to publish cluster deletion event",
-					zap.String("cluster_id", cluster.ID.String()),
-					zap.Error(err),
-				)
-				// Don't fail the operation for event publishing failure
-			}
+		if err := cascadeClusterDelete(ctx, txRepo, clusterID); err != nil {
+			return err
+		}
+
+		if err := txRepo.Audit.RecordChange(ctx, userEmail, models.AuditActionDelete, models.AuditResourceCluster, clusterID, cluster.Spec, nil); err != nil {
+			return err
 		}
 
 		return nil
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to delete cluster",
+		s.logger.WithContext(ctx).Error("Failed to delete cluster",
 			zap.String("cluster_id", clusterID.String()),
 			zap.Error(err),
 		)
 		return err
 	}
 
-	s.logger.Info("Successfully deleted cluster",
+	// Publish cluster deletion event once the transaction has committed, so a retried
+	// transaction never publishes it twice
+	if s.pubsub != nil && s.pubsub.IsRunning() {
+		publisher := s.pubsub.GetPublisher()
+		if err := publisher.PublishClusterDeleted(ctx, cluster); err != nil {
+			s.logger.WithContext(ctx).Warn("Failed to publish cluster deletion event",
+				zap.String("cluster_id", cluster.ID.String()),
+				zap.Error(err),
+			)
+			// Don't fail the operation for event publishing failure
+		}
+	}
+
+	s.logger.WithContext(ctx).Info("Successfully deleted cluster",
 		zap.String("cluster_id", cluster.ID.String()),
 		zap.String("cluster_name", cluster.Name),
 		zap.Bool("force", force),
@@ -394,38 +544,57 @@ func (s *ClusterService) DeleteCluster(ctx context.Context, clusterID uuid.UUID,
 	return nil
 }
 
+// cascadeClusterDelete removes the controller status of a cluster being deleted and of its
+// nodepools, and soft-deletes the nodepools, so stale reports don't linger in error summaries
+// and aggregation. Each nodepool gets a deleted event, as when it is deleted on its own. It
+// runs in the deletion transaction.
+func cascadeClusterDelete(ctx context.Context, txRepo *database.Repository, clusterID uuid.UUID) error {
+	if err := txRepo.Status.DeleteAllClusterControllerStatus(ctx, clusterID); err != nil {
+		return err
+	}
+	if err := txRepo.Status.DeleteNodePoolControllerStatusByCluster(ctx, clusterID); err != nil {
+		return err
+	}
+	nodepools, err := txRepo.NodePools.DeleteByCluster(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+	for _, nodepool := range nodepools {
+		if err := txRepo.Status.CreateNodePoolEvent(ctx, models.NewNodePoolEvent(models.NodePoolEventDeleted, nodepool, nil)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Access control aware methods
 
 // ListAllClusters lists all clusters (system-wide access for controllers)
-func (s *ClusterService) ListAllClusters(ctx context.Context, limit, offset int) ([]*models.Cluster, int64, error) {
-	s.logger.Info("Listing all clusters (system-wide)",
-		zap.Int("limit", limit),
-		zap.Int("offset", offset),
+func (s *ClusterService) ListAllClusters(ctx context.Context, opts *models.ListOptions) ([]*models.Cluster, int64, error) {
+	s.logger.WithContext(ctx).Info("Listing all clusters (system-wide)",
+		zap.Int("limit", opts.Limit),
+		zap.Int("offset", opts.Offset),
+		zap.Bool("cursor", opts.Cursor != nil),
 	)
 
-	opts := &models.ListOptions{
-		Limit:  limit,
-		Offset: offset,
-	}
-
 	clusters, err := s.repository.Clusters.ListAll(ctx, opts)
 	if err != nil {
-		s.logger.Error("Failed to list all clusters",
+		s.logger.WithContext(ctx).Error("Failed to list all clusters",
 			zap.Error(err),
 		)
 		return nil, 0, err
 	}
 
 	// Get total count for pagination
-	total, err := s.repository.Clusters.CountAll(ctx)
+	total, err := s.repository.Clusters.CountAllWithOptions(ctx, opts)
 	if err != nil {
-		s.logger.Error("Failed to count all clusters",
+		s.logger.WithContext(ctx).Error("Failed to count all clusters",
 			zap.Error(err),
 		)
 		return nil, 0, err
 	}
 
-	s.logger.Info("Successfully listed all clusters",
+	s.logger.WithContext(ctx).Info("Successfully listed all clusters",
 		zap.Int("count", len(clusters)),
 		zap.Int64("total", total),
 	)
@@ -433,44 +602,125 @@ func (s *ClusterService) ListAllClusters(ctx context.Context, limit, offset int)
 	return clusters, total, nil
 }
 
-// GetClusterWithAccessControl gets a cluster with access control validation
-func (s *ClusterService) GetClusterWithAccessControl(ctx context.Context, clusterID uuid.UUID, userCtx *auth.UserContext) (*models.Cluster, error) {
-	s.logger.Info("Getting cluster with access control",
-		zap.String("cluster_id", clusterID.String()),
+// ListAllClustersIncludingDeletedWithAccessControl lists all clusters, soft-deleted ones
+// included, for operators auditing recent deletions. Only controllers can see deleted clusters.
+func (s *ClusterService) ListAllClustersIncludingDeletedWithAccessControl(ctx context.Context, opts *models.ListOptions, userCtx *auth.UserContext) ([]*models.Cluster, int64, error) {
+	if !auth.CanListDeletedClusters(userCtx) {
+		return nil, 0, fmt.Errorf("access denied")
+	}
+
+	s.logger.WithContext(ctx).Info("Listing all clusters including deleted",
 		zap.String("user_email", userCtx.Email),
-		zap.Bool("is_controller", userCtx.IsController),
+		zap.Int("limit", opts.Limit),
+		zap.Int("offset", opts.Offset),
+		zap.Bool("cursor", opts.Cursor != nil),
 	)
 
-	var cluster *models.Cluster
-	var err error
+	clusters, err := s.repository.Clusters.ListAllIncludingDeleted(ctx, opts)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to list all clusters including deleted",
+			zap.Error(err),
+		)
+		return nil, 0, err
+	}
 
+	total, err := s.repository.Clusters.CountAllIncludingDeleted(ctx, opts)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to count all clusters including deleted",
+			zap.Error(err),
+		)
+		return nil, 0, err
+	}
+
+	return clusters, total, nil
+}
+
+// ListClustersByReleaseImageWithAccessControl lists every cluster whose spec references the
+// given release image. Only controllers can search across all users' clusters.
+func (s *ClusterService) ListClustersByReleaseImageWithAccessControl(ctx context.Context, image string, opts *models.ListOptions, userCtx *auth.UserContext) ([]*models.Cluster, int64, error) {
+	if !auth.CanListClustersByReleaseImage(userCtx) {
+		return nil, 0, fmt.Errorf("access denied")
+	}
+
+	s.logger.WithContext(ctx).Info("Listing clusters by release image",
+		zap.String("image", image),
+		zap.String("user_email", userCtx.Email),
+		zap.Int("limit", opts.Limit),
+		zap.Int("offset", opts.Offset),
+	)
+
+	clusters, err := s.repository.Clusters.ListByReleaseImage(ctx, image, opts)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to list clusters by release image",
+			zap.String("image", image),
+			zap.Error(err),
+		)
+		return nil, 0, err
+	}
+
+	total, err := s.repository.Clusters.CountByReleaseImage(ctx, image, opts)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to count clusters by release image",
+			zap.String("image", image),
+			zap.Error(err),
+		)
+		return nil, 0, err
+	}
+
+	return clusters, total, nil
+}
+
+// clusterAccessDeniedError returns the error for a cluster operation the user may not perform.
+// Regular users get "cluster not found" so they cannot probe for other users' clusters;
+// controllers get the real "access denied" to keep debugging straightforward. Controllers can
+// read every cluster, so they are only denied operations reserved to the owner, such as
+// managing grants.
+func clusterAccessDeniedError(userCtx *auth.UserContext) error {
 	if userCtx.IsController {
-		// Controllers can access any cluster
-		cluster, err = s.repository.Clusters.GetByIDWithoutFilter(ctx, clusterID)
-	} else {
-		// Users can only access their own clusters
-		cluster, err = s.repository.Clusters.GetByID(ctx, clusterID, userCtx.Email)
+		return fmt.Errorf("access denied")
 	}
+	return fmt.Errorf("cluster not found")
+}
 
+// GetClusterWithAccessControl gets a cluster with access control validation
+func (s *ClusterService) GetClusterWithAccessControl(ctx context.Context, clusterID uuid.UUID, userCtx *auth.UserContext) (*models.Cluster, error) {
+	s.logger.WithContext(ctx).Info("Getting cluster with access control",
+		zap.String("cluster_id", clusterID.String()),
+		zap.String("user_email", userCtx.Email),
+		zap.Bool("is_controller", userCtx.IsController),
+	)
+
+	// Controllers can access any cluster, users only their own
+	cluster, err := s.repository.Clusters.GetByID(ctx, clusterID, userCtx.Email, userCtx.IsController)
 	if err != nil {
 		if err == models.ErrClusterNotFound {
-			s.logger.Info("Cluster not found or access denied",
+			s.logger.WithContext(ctx).Info("Cluster not found",
 				zap.String("cluster_id", clusterID.String()),
 				zap.String("user_email", userCtx.Email),
 				zap.Bool("is_controller", userCtx.IsController),
 			)
 			return nil, fmt.Errorf("cluster not found")
 		}
-		s.logger.Error("Failed to get cluster",
+		s.logger.WithContext(ctx).Error("Failed to get cluster",
 			zap.String("cluster_id", clusterID.String()),
 			zap.Error(err),
 		)
 		return nil, err
 	}
 
-	// Additional access control check
-	if !auth.CanAccessCluster(userCtx, cluster) {
-		s.logger.Warn("Access denied to cluster",
+	// Users other than the owner can only read the cluster through a grant
+	var grants []*models.ClusterGrant
+	if !userCtx.IsController && cluster.CreatedBy != userCtx.Email {
+		grants, err = s.repository.ClusterGrants.ListByCluster(ctx, clusterID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Additional access control check. Controllers always pass it, so only regular users
+	// get here and they see an opaque not found.
+	if !auth.CanAccessCluster(userCtx, cluster, grants) {
+		s.logger.WithContext(ctx).Warn("Access denied to cluster",
 			zap.String("cluster_id", clusterID.String()),
 			zap.String("user_email", userCtx.Email),
 			zap.String("cluster_created_by", cluster.CreatedBy),
@@ -478,7 +728,7 @@ func (s *ClusterService) GetClusterWithAccessControl(ctx context.Context, cluste
 		return nil, fmt.Errorf("cluster not found")
 	}
 
-	s.logger.Info("Successfully retrieved cluster with access control",
+	s.logger.WithContext(ctx).Info("Successfully retrieved cluster with access control",
 		zap.String("cluster_id", clusterID.String()),
 		zap.String("cluster_name", cluster.Name),
 	)
@@ -488,7 +738,7 @@ func (s *ClusterService) GetClusterWithAccessControl(ctx context.Context, cluste
 
 // UpdateClusterWithAccessControl updates a cluster with access control validation
 func (s *ClusterService) UpdateClusterWithAccessControl(ctx context.Context, clusterID uuid.UUID, req *models.ClusterUpdateRequest, userCtx *auth.UserContext) (*models.Cluster, error) {
-	s.logger.Info("Updating cluster with access control",
+	s.logger.WithContext(ctx).Info("Updating cluster with access control",
 		zap.String("cluster_id", clusterID.String()),
 		zap.String("user_email", userCtx.Email),
 		zap.Bool("is_controller", userCtx.IsController),
@@ -502,7 +752,7 @@ func (s *ClusterService) UpdateClusterWithAccessControl(ctx context.Context, clu
 
 	// Check if user can update this cluster
 	if !auth.CanUpdateCluster(userCtx, cluster) {
-		s.logger.Warn("User not authorized to update cluster",
+		s.logger.WithContext(ctx).Warn("User not authorized to update cluster",
 			zap.String("cluster_id", clusterID.String()),
 			zap.String("user_email", userCtx.Email),
 			zap.String("cluster_created_by", cluster.CreatedBy),
@@ -510,13 +760,18 @@ func (s *ClusterService) UpdateClusterWithAccessControl(ctx context.Context, clu
 		return nil, fmt.Errorf("cluster not found")
 	}
 
+	if s.specUnchanged(ctx, cluster, req) {
+		return cluster, nil
+	}
+
 	// Update cluster fields
+	previousSpec := cluster.Spec
 	cluster.Spec = req.Spec
 	cluster.Generation++
 	cluster.ResourceVersion = uuid.New().String()
 	cluster.UpdatedAt = time.Now()
 
-	// Use transaction to ensure cluster update and event publishing are atomic
+	// Update the cluster and record its audit entry in one transaction
 	err = s.repository.Transaction(ctx, func(txRepo *database.Repository) error {
 		// Update cluster
 		var updateErr error
@@ -530,30 +785,35 @@ func (s *ClusterService) UpdateClusterWithAccessControl(ctx context.Context, clu
 			return fmt.Errorf("failed to update cluster: %w", updateErr)
 		}
 
-		// Publish cluster update event
-		if s.pubsub != nil && s.pubsub.IsRunning() {
-			publisher := s.pubsub.GetPublisher()
-			if err := publisher.PublishClusterUpdated(ctx, cluster); err != nil {
-				s.logger.Warn("Failed to publish cluster update event",
-					zap.String("cluster_id", cluster.ID.String()),
-					zap.Error(err),
-				)
-				// Don't fail the operation for event publishing failure
-			}
+		if err := txRepo.Audit.RecordChange(ctx, userCtx.Email, models.AuditActionUpdate, models.AuditResourceCluster, cluster.ID, previousSpec, cluster.Spec); err != nil {
+			return err
 		}
 
 		return nil
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to update cluster",
+		s.logger.WithContext(ctx).Error("Failed to update cluster",
 			zap.String("cluster_id", clusterID.String()),
 			zap.Error(err),
 		)
 		return nil, err
 	}
 
-	s.logger.Info("Successfully updated cluster with access control",
+	// Publish cluster update event once the transaction has committed, so a retried
+	// transaction never publishes it twice
+	if s.pubsub != nil && s.pubsub.IsRunning() {
+		publisher := s.pubsub.GetPublisher()
+		if err := publisher.PublishClusterUpdated(ctx, cluster); err != nil {
+			s.logger.WithContext(ctx).Warn("Failed to publish cluster update event",
+				zap.String("cluster_id", cluster.ID.String()),
+				zap.Error(err),
+			)
+			// Don't fail the operation for event publishing failure
+		}
+	}
+
+	s.logger.WithContext(ctx).Info("Successfully updated cluster with access control",
 		zap.String("cluster_id", cluster.ID.String()),
 		zap.String("cluster_name", cluster.Name),
 		zap.Int64("generation", cluster.Generation),
@@ -564,7 +824,7 @@ func (s *ClusterService) UpdateClusterWithAccessControl(ctx context.Context, clu
 
 // DeleteClusterWithAccessControl deletes a cluster with access control validation
 func (s *ClusterService) DeleteClusterWithAccessControl(ctx context.Context, clusterID uuid.UUID, force bool, userCtx *auth.UserContext) error {
-	s.logger.Info("Deleting cluster with access control",
+	s.logger.WithContext(ctx).Info("Deleting cluster with access control",
 		zap.String("cluster_id", clusterID.String()),
 		zap.String("user_email", userCtx.Email),
 		zap.Bool("is_controller", userCtx.IsController),
@@ -579,7 +839,7 @@ func (s *ClusterService) DeleteClusterWithAccessControl(ctx context.Context, clu
 
 	// Check if user can delete this cluster
 	if !auth.CanDeleteCluster(userCtx, cluster) {
-		s.logger.Warn("User not authorized to delete cluster",
+		s.logger.WithContext(ctx).Warn("User not authorized to delete cluster",
 			zap.String("cluster_id", clusterID.String()),
 			zap.String("user_email", userCtx.Email),
 			zap.String("cluster_created_by", cluster.CreatedBy),
@@ -590,15 +850,27 @@ func (s *ClusterService) DeleteClusterWithAccessControl(ctx context.Context, clu
 	// Check if cluster is in a state that allows deletion (unless force is true)
 	if !force && cluster.Status != nil && cluster.Status.Phase != "" &&
 		cluster.Status.Phase != "Pending" && cluster.Status.Phase != "Failed" {
-		s.logger.Warn("Cluster not in deletable state",
+		s.logger.WithContext(ctx).Warn("Cluster not in deletable state",
 			zap.String("cluster_id", clusterID.String()),
 			zap.String("status_phase", cluster.Status.Phase),
 		)
 		return fmt.Errorf("cluster must be in Pending or Failed state for deletion, use force=true to override")
 	}
 
-	// Use transaction to ensure cluster deletion and event publishing are atomic
+	// Delete the cluster, cascade the delete and record its audit entry in one transaction
 	err = s.repository.Transaction(ctx, func(txRepo *database.Repository) error {
+		// Refuse to strand active nodepools unless force is set, in which case they are
+		// soft-deleted with the cluster below
+		if !force {
+			count, err := txRepo.NodePools.CountByCluster(ctx, clusterID)
+			if err != nil {
+				return err
+			}
+			if count > 0 {
+				return &models.ClusterHasNodePoolsError{NodePoolCount: count}
+			}
+		}
+
 		// Delete cluster
 		var deleteErr error
 		if userCtx.IsController {
@@ -611,30 +883,39 @@ func (s *ClusterService) DeleteClusterWithAccessControl(ctx context.Context, clu
 			return fmt.Errorf("failed to delete cluster: %w", deleteErr)
 		}
 
-		// Publish cluster deletion event
-		if s.pubsub != nil && s.pubsub.IsRunning() {
-			publisher := s.pubsub.GetPublisher()
-			if err := publisher.PublishClusterDeleted(ctx, cluster); err != nil {
-				s.logger.Warn("Failed to publish cluster deletion event",
-					zap.String("cluster_id", cluster.ID.String()),
-					zap.Error(err),
-				)
-				// Don't fail the operation for event publishing failure
-			}
+		if err := cascadeClusterDelete(ctx, txRepo, clusterID); err != nil {
+			return err
+		}
+
+		if err := txRepo.Audit.RecordChange(ctx, userCtx.Email, models.AuditActionDelete, models.AuditResourceCluster, clusterID, cluster.Spec, nil); err != nil {
+			return err
 		}
 
 		return nil
 	})
 
 	if err != nil {
-		s.logger.Error("Failed to delete cluster",
+		s.logger.WithContext(ctx).Error("Failed to delete cluster",
 			zap.String("cluster_id", clusterID.String()),
 			zap.Error(err),
 		)
 		return err
 	}
 
-	s.logger.Info("Successfully deleted cluster with access control",
+	// Publish cluster deletion event once the transaction has committed, so a retried
+	// transaction never publishes it twice
+	if s.pubsub != nil && s.pubsub.IsRunning() {
+		publisher := s.pubsub.GetPublisher()
+		if err := publisher.PublishClusterDeleted(ctx, cluster); err != nil {
+			s.logger.WithContext(ctx).Warn("Failed to publish cluster deletion event",
+				zap.String("cluster_id", cluster.ID.String()),
+				zap.Error(err),
+			)
+			// Don't fail the operation for event publishing failure
+		}
+	}
+
+	s.logger.WithContext(ctx).Info("Successfully deleted cluster with access control",
 		zap.String("cluster_id", cluster.ID.String()),
 		zap.String("cluster_name", cluster.Name),
 		zap.Bool("force", force),
@@ -642,3 +923,491 @@ func (s *ClusterService) DeleteClusterWithAccessControl(ctx context.Context, clu
 
 	return nil
 }
+
+// PurgeClusterWithAccessControl permanently removes a soft-deleted cluster and its related data
+func (s *ClusterService) PurgeClusterWithAccessControl(ctx context.Context, clusterID uuid.UUID, userCtx *auth.UserContext) error {
+	s.logger.WithContext(ctx).Info("Purging cluster with access control",
+		zap.String("cluster_id", clusterID.String()),
+		zap.String("user_email", userCtx.Email),
+		zap.Bool("is_controller", userCtx.IsController),
+	)
+
+	if !auth.CanPurgeCluster(userCtx) {
+		s.logger.WithContext(ctx).Warn("User not authorized to purge cluster",
+			zap.String("cluster_id", clusterID.String()),
+			zap.String("user_email", userCtx.Email),
+		)
+		return fmt.Errorf("not authorized to purge clusters")
+	}
+
+	if err := s.repository.Clusters.Purge(ctx, clusterID); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to purge cluster",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	s.logger.WithContext(ctx).Info("Successfully purged cluster",
+		zap.String("cluster_id", clusterID.String()),
+	)
+
+	return nil
+}
+
+// RestoreClusterWithAccessControl restores a cluster the user soft-deleted within the retention window
+func (s *ClusterService) RestoreClusterWithAccessControl(ctx context.Context, clusterID uuid.UUID, userCtx *auth.UserContext) (*models.Cluster, error) {
+	s.logger.WithContext(ctx).Info("Restoring cluster with access control",
+		zap.String("cluster_id", clusterID.String()),
+		zap.String("user_email", userCtx.Email),
+		zap.Duration("retention", s.restoreRetention),
+	)
+
+	// Only the owner can restore; Restore filters on created_by
+	if err := s.repository.Clusters.Restore(ctx, clusterID, userCtx.Email, s.restoreRetention); err != nil {
+		if err != models.ErrClusterNotFound {
+			s.logger.WithContext(ctx).Error("Failed to restore cluster",
+				zap.String("cluster_id", clusterID.String()),
+				zap.Error(err),
+			)
+		}
+		return nil, err
+	}
+
+	cluster, err := s.repository.Clusters.GetByID(ctx, clusterID, userCtx.Email, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get restored cluster: %w", err)
+	}
+
+	s.logger.WithContext(ctx).Info("Successfully restored cluster",
+		zap.String("cluster_id", clusterID.String()),
+		zap.String("cluster_name", cluster.Name),
+	)
+
+	return cluster, nil
+}
+
+// UpsertClusterControllerStatuses stores several controllers' status reports for a cluster in
+// one transaction, so either every report is stored or none is, and marks the cluster dirty
+// once instead of once per report. Like single reports, a report for an older generation than
+// the stored one fails with models.ErrStaleGeneration unless force is set. Callers must
+// already have checked access to the cluster, and every report must be for the same cluster.
+func (s *ClusterService) UpsertClusterControllerStatuses(ctx context.Context, statuses []*models.ClusterControllerStatus, force bool) error {
+	if len(statuses) == 0 {
+		return nil
+	}
+
+	stored := make([]models.ClusterControllerStatus, len(statuses))
+	err := s.repository.Transaction(ctx, func(txRepo *database.Repository) error {
+		if err := txRepo.Status.DeferStatusDirty(ctx); err != nil {
+			return err
+		}
+
+		for i, status := range statuses {
+			// Upserting merges conditions into the report, so work on a copy in case the
+			// transaction is retried
+			stored[i] = *status
+
+			var err error
+			if force {
+				err = txRepo.Status.ForceUpsertClusterControllerStatus(ctx, &stored[i])
+			} else {
+				err = txRepo.Status.UpsertClusterControllerStatus(ctx, &stored[i])
+			}
+			if err != nil {
+				return fmt.Errorf("controller %s: %w", status.ControllerName, err)
+			}
+		}
+		return txRepo.Clusters.MarkDirtyStatus(ctx, statuses[0].ClusterID)
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := range statuses {
+		*statuses[i] = stored[i]
+	}
+	return nil
+}
+
+// GetReconciliationTiming returns when a cluster was last reconciled and is next due. Callers
+// must already have checked access to the cluster.
+func (s *ClusterService) GetReconciliationTiming(ctx context.Context, clusterID uuid.UUID) (*models.ReconciliationTiming, error) {
+	timing, err := s.repository.Reconciliation.GetReconciliationTiming(ctx, clusterID)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("Failed to get cluster reconciliation timing",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return timing, nil
+}
+
+// SetReconciliationPausedWithAccessControl pauses or resumes reconciliation for a cluster
+func (s *ClusterService) SetReconciliationPausedWithAccessControl(ctx context.Context, clusterID uuid.UUID, paused bool, userCtx *auth.UserContext) error {
+	s.logger.WithContext(ctx).Info("Setting cluster reconciliation pause state with access control",
+		zap.String("cluster_id", clusterID.String()),
+		zap.Bool("paused", paused),
+		zap.String("user_email", userCtx.Email),
+		zap.Bool("is_controller", userCtx.IsController),
+	)
+
+	cluster, err := s.GetClusterWithAccessControl(ctx, clusterID, userCtx)
+	if err != nil {
+		return err
+	}
+
+	if !auth.CanPauseReconciliation(userCtx, cluster) {
+		s.logger.WithContext(ctx).Warn("User not authorized to pause cluster reconciliation",
+			zap.String("cluster_id", clusterID.String()),
+			zap.String("user_email", userCtx.Email),
+			zap.String("cluster_created_by", cluster.CreatedBy),
+		)
+		return fmt.Errorf("cluster not found")
+	}
+
+	if err := s.repository.Reconciliation.SetReconciliationPaused(ctx, clusterID, paused); err != nil {
+		if err == models.ErrClusterNotFound {
+			return fmt.Errorf("cluster not found")
+		}
+		s.logger.WithContext(ctx).Error("Failed to set cluster reconciliation pause state",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// SetReconciliationIntervalWithAccessControl sets or clears (nil) the reconciliation interval override for a cluster
+func (s *ClusterService) SetReconciliationIntervalWithAccessControl(ctx context.Context, clusterID uuid.UUID, interval *time.Duration, userCtx *auth.UserContext) error {
+	s.logger.WithContext(ctx).Info("Setting cluster reconciliation interval with access control",
+		zap.String("cluster_id", clusterID.String()),
+		zap.Any("interval", interval),
+		zap.String("user_email", userCtx.Email),
+		zap.Bool("is_controller", userCtx.IsController),
+	)
+
+	cluster, err := s.GetClusterWithAccessControl(ctx, clusterID, userCtx)
+	if err != nil {
+		return err
+	}
+
+	if !auth.CanSetReconciliationInterval(userCtx, cluster) {
+		s.logger.WithContext(ctx).Warn("User not authorized to set cluster reconciliation interval",
+			zap.String("cluster_id", clusterID.String()),
+			zap.String("user_email", userCtx.Email),
+			zap.String("cluster_created_by", cluster.CreatedBy),
+		)
+		return fmt.Errorf("cluster not found")
+	}
+
+	if err := s.repository.Reconciliation.SetReconciliationInterval(ctx, clusterID, interval); err != nil {
+		if err == models.ErrClusterNotFound {
+			return fmt.Errorf("cluster not found")
+		}
+		s.logger.WithContext(ctx).Error("Failed to set cluster reconciliation interval",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// TriggerReconciliationWithAccessControl publishes a cluster.reconcile event with reason
+// "manual" right away instead of waiting for the scheduler, then advances the cluster's
+// reconciliation schedule the same way the scheduler does after publishing
+func (s *ClusterService) TriggerReconciliationWithAccessControl(ctx context.Context, clusterID uuid.UUID, userCtx *auth.UserContext) (*models.ReconciliationEvent, error) {
+	s.logger.WithContext(ctx).Info("Triggering cluster reconciliation with access control",
+		zap.String("cluster_id", clusterID.String()),
+		zap.String("user_email", userCtx.Email),
+		zap.Bool("is_controller", userCtx.IsController),
+	)
+
+	cluster, err := s.GetClusterWithAccessControl(ctx, clusterID, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !auth.CanTriggerReconciliation(userCtx, cluster) {
+		s.logger.WithContext(ctx).Warn("User not authorized to trigger cluster reconciliation",
+			zap.String("cluster_id", clusterID.String()),
+			zap.String("user_email", userCtx.Email),
+			zap.String("cluster_created_by", cluster.CreatedBy),
+		)
+		return nil, fmt.Errorf("cluster not found")
+	}
+
+	if s.reconcilePublisher == nil {
+		return nil, models.ErrReconcilePublisherUnavailable
+	}
+
+	event := &models.ReconciliationEvent{
+		Type:       models.EventTypeClusterReconcile,
+		ClusterID:  cluster.ID.String(),
+		Reason:     models.ReconcileReasonManual,
+		Generation: cluster.Generation,
+		Timestamp:  time.Now(),
+		Metadata: map[string]interface{}{
+			"scheduled_by":       "api",
+			"requested_by":       userCtx.Email,
+			"cluster_generation": cluster.Generation,
+		},
+	}
+
+	if err := s.reconcilePublisher.PublishReconciliationEvent(ctx, event); err != nil {
+		s.logger.WithContext(ctx).Error("Failed to publish manual reconciliation event",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("failed to publish reconciliation event: %w", err)
+	}
+
+	if err := s.repository.Reconciliation.UpdateReconciliationSchedule(ctx, clusterID); err != nil {
+		// The event was published, so the request still succeeds
+		s.logger.WithContext(ctx).Warn("Failed to update reconciliation schedule after manual reconciliation",
+			zap.String("cluster_id", clusterID.String()),
+			zap.Error(err),
+		)
+	}
+
+	return event, nil
+}
+
+// DeleteClusterControllerStatusWithAccessControl removes a controller's status report for a
+// cluster and marks the cluster dirty so its status is recomputed without that controller
+func (s *ClusterService) DeleteClusterControllerStatusWithAccessControl(ctx context.Context, clusterID uuid.UUID, controllerName string, userCtx *auth.UserContext) error {
+	if !auth.CanDeleteControllerStatus(userCtx) {
+		return fmt.Errorf("access denied")
+	}
+
+	if _, err := s.GetClusterWithAccessControl(ctx, clusterID, userCtx); err != nil {
+		return err
+	}
+
+	s.logger.WithContext(ctx).Info("Deleting cluster controller status",
+		zap.String("cluster_id", clusterID.String()),
+		zap.String("controller_name", controllerName),
+		zap.String("user_email", userCtx.Email),
+	)
+
+	return s.repository.Transaction(ctx, func(txRepo *database.Repository) error {
+		if err := txRepo.Status.DeleteClusterControllerStatus(ctx, clusterID, controllerName); err != nil {
+			return err
+		}
+
+		// Deletes do not fire the status dirty triggers
+		if err := txRepo.Clusters.MarkDirtyStatus(ctx, clusterID); err != nil {
+			return fmt.Errorf("failed to mark cluster status dirty: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// DiffClusterSpecWithAccessControl compares a candidate spec against a cluster's current spec
+// without persisting anything, so a user can preview an update before applying it
+func (s *ClusterService) DiffClusterSpecWithAccessControl(ctx context.Context, clusterID uuid.UUID, spec *models.ClusterSpec, userCtx *auth.UserContext) (*models.ClusterSpecDiff, error) {
+	cluster, err := s.GetClusterWithAccessControl(ctx, clusterID, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only users who could apply the update may preview it
+	if !auth.CanUpdateCluster(userCtx, cluster) {
+		return nil, fmt.Errorf("cluster not found")
+	}
+
+	changes, err := models.SpecChanges(cluster.Spec, spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff cluster spec: %w", err)
+	}
+
+	return &models.ClusterSpecDiff{
+		ClusterID:  cluster.ID,
+		Generation: cluster.Generation,
+		Changes:    changes,
+	}, nil
+}
+
+// ClearClusterErrorsWithAccessControl clears the last error on a cluster's controller status
+// reports, and optionally its nodepools', so the phase is recomputed without them. Controllers
+// that are still failing repopulate the error on their next report.
+func (s *ClusterService) ClearClusterErrorsWithAccessControl(ctx context.Context, clusterID uuid.UUID, req *models.ClearErrorsRequest, userCtx *auth.UserContext) (*models.ClearErrorsResult, error) {
+	if !auth.CanClearControllerErrors(userCtx) {
+		return nil, fmt.Errorf("access denied")
+	}
+
+	if _, err := s.GetClusterWithAccessControl(ctx, clusterID, userCtx); err != nil {
+		return nil, err
+	}
+
+	s.logger.WithContext(ctx).Info("Clearing cluster controller errors",
+		zap.String("cluster_id", clusterID.String()),
+		zap.Bool("include_nodepools", req.IncludeNodePools),
+		zap.String("reason", req.Reason),
+		zap.String("user_email", userCtx.Email),
+	)
+
+	result := &models.ClearErrorsResult{ClusterID: clusterID}
+	err := s.repository.Transaction(ctx, func(txRepo *database.Repository) error {
+		cleared, err := txRepo.Status.ClearClusterErrors(ctx, clusterID)
+		if err != nil {
+			return err
+		}
+		result.ClusterErrorsCleared = cleared
+
+		if req.IncludeNodePools {
+			cleared, err := txRepo.Status.ClearNodePoolErrorsByCluster(ctx, clusterID)
+			if err != nil {
+				return err
+			}
+			result.NodePoolErrorsCleared = cleared
+		}
+
+		// The nodepool dirty trigger only marks the nodepool, so mark the cluster explicitly
+		if err := txRepo.Clusters.MarkDirtyStatus(ctx, clusterID); err != nil {
+			return fmt.Errorf("failed to mark cluster status dirty: %w", err)
+		}
+
+		return txRepo.Audit.Record(ctx, &models.AuditEntry{
+			ActorEmail:   userCtx.Email,
+			Action:       models.AuditActionClearErrors,
+			ResourceType: models.AuditResourceCluster,
+			ResourceID:   clusterID,
+			Reason:       req.Reason,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetClusterFullViewWithAccessControl loads a cluster with its nodepools and all controller
+// status reports for controllers reconciling it
+func (s *ClusterService) GetClusterFullViewWithAccessControl(ctx context.Context, clusterID uuid.UUID, userCtx *auth.UserContext) (*models.ClusterFullView, error) {
+	if !auth.CanGetClusterFullView(userCtx) {
+		return nil, fmt.Errorf("access denied")
+	}
+
+	cluster, err := s.GetClusterWithAccessControl(ctx, clusterID, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	nodepools, err := s.repository.NodePools.ListByClusterInternal(ctx, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodepools: %w", err)
+	}
+
+	controllerStatus, err := s.repository.Status.ListClusterControllerStatus(ctx, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cluster controller status: %w", err)
+	}
+
+	// One query for every nodepool's reports rather than one per nodepool
+	nodepoolControllerStatus, err := s.repository.Status.ListNodePoolControllerStatusByCluster(ctx, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodepool controller status: %w", err)
+	}
+
+	if controllerStatus == nil {
+		controllerStatus = []*models.ClusterControllerStatus{}
+	}
+	if nodepoolControllerStatus == nil {
+		nodepoolControllerStatus = []*models.NodePoolControllerStatus{}
+	}
+
+	return &models.ClusterFullView{
+		Cluster:                  cluster,
+		Status:                   cluster.Status,
+		NodePools:                nodepools,
+		ControllerStatus:         controllerStatus,
+		NodePoolControllerStatus: nodepoolControllerStatus,
+	}, nil
+}
+
+// getClusterForGrants gets a cluster whose grants the user wants to manage. Only the owner
+// manages grants; grantees and other users get "cluster not found".
+func (s *ClusterService) getClusterForGrants(ctx context.Context, clusterID uuid.UUID, userCtx *auth.UserContext) (*models.Cluster, error) {
+	cluster, err := s.GetClusterWithAccessControl(ctx, clusterID, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !auth.CanManageClusterGrants(userCtx, cluster) {
+		s.logger.WithContext(ctx).Warn("User not authorized to manage cluster grants",
+			zap.String("cluster_id", clusterID.String()),
+			zap.String("user_email", userCtx.Email),
+			zap.String("cluster_created_by", cluster.CreatedBy),
+		)
+		return nil, clusterAccessDeniedError(userCtx)
+	}
+
+	return cluster, nil
+}
+
+// ListClusterGrantsWithAccessControl lists the users a cluster is shared with
+func (s *ClusterService) ListClusterGrantsWithAccessControl(ctx context.Context, clusterID uuid.UUID, userCtx *auth.UserContext) ([]*models.ClusterGrant, error) {
+	if _, err := s.getClusterForGrants(ctx, clusterID, userCtx); err != nil {
+		return nil, err
+	}
+
+	return s.repository.ClusterGrants.ListByCluster(ctx, clusterID)
+}
+
+// GrantClusterAccessWithAccessControl shares read access to a cluster with another user.
+// An invalid request returns an error wrapping models.ErrInvalidInput.
+func (s *ClusterService) GrantClusterAccessWithAccessControl(ctx context.Context, clusterID uuid.UUID, req *models.ClusterGrantRequest, userCtx *auth.UserContext) (*models.ClusterGrant, error) {
+	cluster, err := s.getClusterForGrants(ctx, clusterID, userCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(cluster.CreatedBy); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidInput, err)
+	}
+
+	grant := &models.ClusterGrant{
+		ClusterID:    clusterID,
+		GranteeEmail: req.GranteeEmail,
+		Role:         req.Role,
+		CreatedBy:    userCtx.Email,
+	}
+	if err := s.repository.ClusterGrants.Upsert(ctx, grant); err != nil {
+		return nil, err
+	}
+
+	s.logger.WithContext(ctx).Info("Granted cluster access",
+		zap.String("cluster_id", clusterID.String()),
+		zap.String("grantee_email", grant.GranteeEmail),
+		zap.String("role", grant.Role),
+	)
+
+	return grant, nil
+}
+
+// RevokeClusterAccessWithAccessControl removes a user's grant on a cluster. A user without
+// a grant returns models.ErrClusterGrantNotFound.
+func (s *ClusterService) RevokeClusterAccessWithAccessControl(ctx context.Context, clusterID uuid.UUID, granteeEmail string, userCtx *auth.UserContext) error {
+	if _, err := s.getClusterForGrants(ctx, clusterID, userCtx); err != nil {
+		return err
+	}
+
+	if err := s.repository.ClusterGrants.Delete(ctx, clusterID, granteeEmail); err != nil {
+		return err
+	}
+
+	s.logger.WithContext(ctx).Info("Revoked cluster access",
+		zap.String("cluster_id", clusterID.String()),
+		zap.String("grantee_email", granteeEmail),
+	)
+
+	return nil
+}
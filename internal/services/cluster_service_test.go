@@ -0,0 +1,34 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/apahim/cls-backend/internal/auth"
+	"github.com/apahim/cls-backend/internal/utils"
+)
+
+func TestClusterAccessDeniedError(t *testing.T) {
+	tests := []struct {
+		name    string
+		userCtx *auth.UserContext
+		want    string
+	}{
+		{
+			name:    "controllers see the real reason",
+			userCtx: auth.NewUserContext("controller@system.local"),
+			want:    "access denied",
+		},
+		{
+			name:    "regular users see an opaque not found",
+			userCtx: auth.NewUserContext("user@example.com"),
+			want:    "cluster not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := clusterAccessDeniedError(tt.userCtx)
+			utils.AssertEqual(t, tt.want, err.Error(), "Access denied error")
+		})
+	}
+}
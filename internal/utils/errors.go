@@ -1,7 +1,6 @@
 package utils
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -34,6 +33,8 @@ const (
 	ErrCodeExternal     = "EXTERNAL_ERROR"
 	ErrCodeUnauthorized = "UNAUTHORIZED"
 	ErrCodeForbidden    = "FORBIDDEN"
+	ErrCodeTooLarge     = "PAYLOAD_TOO_LARGE"
+	ErrCodeUnavailable  = "SERVICE_UNAVAILABLE"
 )
 
 // APIError represents a structured API error
@@ -200,14 +201,17 @@ type ValidationDetails struct {
 // ValidationErrors represents multiple validation errors
 type ValidationErrors []ValidationDetails
 
-// Error implements the error interface
+// Error joins the field messages
 func (v ValidationErrors) Error() string {
 	if len(v) == 0 {
 		return "validation failed"
 	}
 
-	data, _ := json.Marshal(v)
-	return fmt.Sprintf("validation failed: %s", string(data))
+	messages := make([]string, len(v))
+	for i, detail := range v {
+		messages[i] = detail.Message
+	}
+	return strings.Join(messages, "; ")
 }
 
 // NewValidationErrors creates a new validation errors collection
@@ -224,6 +228,18 @@ func (v *ValidationErrors) Add(field, message string, value any) {
 	})
 }
 
+// Prefixed returns a copy of the errors for an item nested under prefix, e.g. an entry of a
+// batch request
+func (v ValidationErrors) Prefixed(prefix string) ValidationErrors {
+	prefixed := make(ValidationErrors, len(v))
+	for i, detail := range v {
+		detail.Field = prefix + "." + detail.Field
+		detail.Message = prefix + ": " + detail.Message
+		prefixed[i] = detail
+	}
+	return prefixed
+}
+
 // HasErrors returns true if there are validation errors
 func (v ValidationErrors) HasErrors() bool {
 	return len(v) > 0
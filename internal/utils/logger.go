@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"context"
+
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -97,6 +99,42 @@ func (l *Logger) With(fields ...zap.Field) *Logger {
 	}
 }
 
+// WithContext returns a logger that includes the request ID carried by ctx, if any, so log
+// lines from the API, service and repository layers of one request can be correlated
+func (l *Logger) WithContext(ctx context.Context) *Logger {
+	requestID := RequestIDFromContext(ctx)
+	if requestID == "" {
+		return l
+	}
+	return l.With(zap.String("request_id", requestID))
+}
+
+// WithContextFields is WithContext for plain zap loggers
+func WithContextFields(logger *zap.Logger, ctx context.Context) *zap.Logger {
+	requestID := RequestIDFromContext(ctx)
+	if requestID == "" {
+		return logger
+	}
+	return logger.With(zap.String("request_id", requestID))
+}
+
+// requestIDKey is the context key of the request ID
+type requestIDKey struct{}
+
+// ContextWithRequestID returns a copy of ctx carrying the request ID
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID carried by ctx, or "" if there is none
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
 // Sync flushes the logger
 func (l *Logger) Sync() {
 	l.logger.Sync()